@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/praetorian-inc/augustus/pkg/anonymize"
+	"github.com/praetorian-inc/augustus/pkg/results"
+)
+
+// AnonymizeCmd strips or hashes target-identifying details from a scan
+// results JSONL file (written by 'augustus scan --output'), producing a
+// shareable attack-outcome corpus plus a local mapping file for later
+// de-anonymization.
+type AnonymizeCmd struct {
+	ResultsFile string   `arg:"" help:"JSONL results file written by 'augustus scan --output'." type:"existingfile"`
+	OutputFile  string   `arg:"" help:"Path to write the anonymized JSONL corpus." name:"output-file" type:"path"`
+	MappingFile string   `help:"Path to write the placeholder -> original mapping file, kept locally and never shared with the corpus." name:"mapping-file" required:"" type:"path"`
+	Org         []string `help:"Additional literal strings to scrub (generator names, model names, org names, internal hostnames). Repeatable." name:"org"`
+}
+
+func (a *AnonymizeCmd) Run() error {
+	return a.run(os.Stdout)
+}
+
+func (a *AnonymizeCmd) run(out io.Writer) error {
+	in, err := results.ReadAttemptResultsJSONL(a.ResultsFile)
+	if err != nil {
+		return err
+	}
+
+	anon := anonymize.New(a.Org)
+	redacted := make([]results.AttemptResult, len(in))
+	for i, r := range in {
+		redacted[i] = anon.Result(r)
+	}
+
+	if err := results.WriteAttemptResultsJSONL(a.OutputFile, redacted); err != nil {
+		return fmt.Errorf("failed to write anonymized corpus: %w", err)
+	}
+	if err := anonymize.SaveMapping(a.MappingFile, anon.Mapping); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Anonymized %d results written to: %s\n", len(redacted), a.OutputFile)
+	fmt.Fprintf(out, "Mapping written to: %s (keep this private - it de-anonymizes the corpus)\n", a.MappingFile)
+	return nil
+}
+
+// DeanonymizeCmd reverses an AnonymizeCmd export using its mapping file,
+// for a recipient of a shared corpus who was also given the mapping (e.g.
+// to cross-reference a researcher's finding back to the original target).
+type DeanonymizeCmd struct {
+	ResultsFile string `arg:"" help:"Anonymized JSONL results file written by 'augustus anonymize'." type:"existingfile"`
+	OutputFile  string `arg:"" help:"Path to write the de-anonymized JSONL file." name:"output-file" type:"path"`
+	MappingFile string `help:"Mapping file written by 'augustus anonymize'." name:"mapping-file" required:"" type:"existingfile"`
+}
+
+func (d *DeanonymizeCmd) Run() error {
+	return d.run(os.Stdout)
+}
+
+func (d *DeanonymizeCmd) run(out io.Writer) error {
+	in, err := results.ReadAttemptResultsJSONL(d.ResultsFile)
+	if err != nil {
+		return err
+	}
+
+	m, err := anonymize.LoadMapping(d.MappingFile)
+	if err != nil {
+		return err
+	}
+
+	restored := make([]results.AttemptResult, len(in))
+	for i, r := range in {
+		restored[i] = r
+		restored[i].Prompt = anonymize.Deanonymize(r.Prompt, m)
+		restored[i].Response = anonymize.Deanonymize(r.Response, m)
+		restored[i].Error = anonymize.Deanonymize(r.Error, m)
+	}
+
+	if err := results.WriteAttemptResultsJSONL(d.OutputFile, restored); err != nil {
+		return fmt.Errorf("failed to write de-anonymized file: %w", err)
+	}
+
+	fmt.Fprintf(out, "De-anonymized %d results written to: %s\n", len(restored), d.OutputFile)
+	return nil
+}
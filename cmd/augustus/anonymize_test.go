@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnonymizeCmd_Run_WritesCorpusAndMapping(t *testing.T) {
+	dir := t.TempDir()
+	inPath := filepath.Join(dir, "in.jsonl")
+	outPath := filepath.Join(dir, "out.jsonl")
+	mappingPath := filepath.Join(dir, "mapping.json")
+
+	require.NoError(t, results.WriteAttemptResultsJSONL(inPath, []results.AttemptResult{
+		{Probe: "dan.Dan_11_0", Prompt: "hit https://target.example.com/api via openai.OpenAI", Response: "refused", Detector: "dan.DAN", Passed: true},
+	}))
+
+	cmd := &AnonymizeCmd{ResultsFile: inPath, OutputFile: outPath, MappingFile: mappingPath, Org: []string{"openai.OpenAI"}}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	redacted, err := results.ReadAttemptResultsJSONL(outPath)
+	require.NoError(t, err)
+	require.Len(t, redacted, 1)
+	assert.NotContains(t, redacted[0].Prompt, "target.example.com")
+	assert.NotContains(t, redacted[0].Prompt, "openai.OpenAI")
+	assert.Equal(t, "dan.Dan_11_0", redacted[0].Probe)
+
+	assert.FileExists(t, mappingPath)
+}
+
+func TestDeanonymizeCmd_Run_RestoresOriginal(t *testing.T) {
+	dir := t.TempDir()
+	inPath := filepath.Join(dir, "in.jsonl")
+	anonPath := filepath.Join(dir, "anon.jsonl")
+	restoredPath := filepath.Join(dir, "restored.jsonl")
+	mappingPath := filepath.Join(dir, "mapping.json")
+
+	require.NoError(t, results.WriteAttemptResultsJSONL(inPath, []results.AttemptResult{
+		{Probe: "dan.Dan_11_0", Prompt: "hit https://target.example.com/api", Response: "refused"},
+	}))
+
+	anonCmd := &AnonymizeCmd{ResultsFile: inPath, OutputFile: anonPath, MappingFile: mappingPath}
+	require.NoError(t, anonCmd.run(&bytes.Buffer{}))
+
+	deanonCmd := &DeanonymizeCmd{ResultsFile: anonPath, OutputFile: restoredPath, MappingFile: mappingPath}
+	require.NoError(t, deanonCmd.run(&bytes.Buffer{}))
+
+	restored, err := results.ReadAttemptResultsJSONL(restoredPath)
+	require.NoError(t, err)
+	require.Len(t, restored, 1)
+	assert.Equal(t, "hit https://target.example.com/api", restored[0].Prompt)
+}
@@ -0,0 +1,434 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/config"
+	"github.com/praetorian-inc/augustus/pkg/harnesses"
+)
+
+// ApiCmd runs augustus as an HTTP service: submit a scan as a job, poll its
+// status, stream its attempts as they complete, and fetch its results.
+// Platforms that want to embed augustus without shelling out to the CLI (or
+// parsing its JSONL output) run this instead.
+type ApiCmd struct {
+	Addr    string `help:"Address to listen on." default:":8080"`
+	APIKey  string `help:"If set, require this value in the X-API-Key header on every request." env:"AUGUSTUS_API_KEY" name:"api-key"`
+	Workers int    `help:"Number of scan jobs to run concurrently." default:"2"`
+}
+
+func (a *ApiCmd) Run() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return runAPI(ctx, a, os.Stderr)
+}
+
+// apiJobStatus is the lifecycle state of a submitted scan job.
+type apiJobStatus string
+
+const (
+	apiJobQueued    apiJobStatus = "queued"
+	apiJobRunning   apiJobStatus = "running"
+	apiJobCompleted apiJobStatus = "completed"
+	apiJobFailed    apiJobStatus = "failed"
+)
+
+// apiJobRequest is the JSON body of a POST /jobs submission.
+type apiJobRequest struct {
+	Generator     string   `json:"generator"`
+	ConfigJSON    string   `json:"config,omitempty"`
+	Probes        []string `json:"probes,omitempty"`
+	ProbesGlob    string   `json:"probes_glob,omitempty"`
+	AllProbes     bool     `json:"all_probes,omitempty"`
+	Detectors     []string `json:"detectors,omitempty"`
+	DetectorsGlob string   `json:"detectors_glob,omitempty"`
+	Harness       string   `json:"harness,omitempty"`
+	ConfigFile    string   `json:"config_file,omitempty"`
+}
+
+// apiJob tracks one submitted scan from queued through completion.
+// Attempts accumulate as the scan runs so GET /jobs/{id}/results can be
+// polled mid-run, and subscribers registered for the SSE stream each get
+// their own copy of every attempt as it's processed.
+type apiJob struct {
+	ID        string        `json:"id"`
+	Status    apiJobStatus  `json:"status"`
+	CreatedAt time.Time     `json:"created_at"`
+	Request   apiJobRequest `json:"request"`
+	Summary   *runSummary   `json:"summary,omitempty"`
+	Error     string        `json:"error,omitempty"`
+
+	mu          sync.Mutex
+	attempts    []*attempt.Attempt
+	subscribers map[chan *attempt.Attempt]struct{}
+	done        chan struct{}
+}
+
+func newAPIJob(req apiJobRequest) *apiJob {
+	return &apiJob{
+		ID:          generateJobID(),
+		Status:      apiJobQueued,
+		CreatedAt:   time.Now(),
+		Request:     req,
+		subscribers: make(map[chan *attempt.Attempt]struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+func generateJobID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// setStatus updates the job's lifecycle state under lock.
+func (j *apiJob) setStatus(status apiJobStatus) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.Status = status
+}
+
+// publish records a processed attempt and fans it out to every subscriber
+// currently listening on the job's SSE stream. Slow or absent subscribers
+// never block the scan: each subscriber channel is buffered, and a full
+// channel just drops the event for that subscriber.
+func (j *apiJob) publish(a *attempt.Attempt) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.attempts = append(j.attempts, a)
+	for ch := range j.subscribers {
+		select {
+		case ch <- a:
+		default:
+		}
+	}
+}
+
+// subscribe registers a channel for SSE delivery and returns the attempts
+// already processed, so a late subscriber can catch up before live events
+// start arriving on the channel.
+func (j *apiJob) subscribe() (chan *attempt.Attempt, []*attempt.Attempt) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	ch := make(chan *attempt.Attempt, 64)
+	j.subscribers[ch] = struct{}{}
+	caughtUp := make([]*attempt.Attempt, len(j.attempts))
+	copy(caughtUp, j.attempts)
+	return ch, caughtUp
+}
+
+func (j *apiJob) unsubscribe(ch chan *attempt.Attempt) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	delete(j.subscribers, ch)
+}
+
+func (j *apiJob) finish(summary runSummary, runErr error) {
+	j.mu.Lock()
+	j.Summary = &summary
+	if runErr != nil {
+		j.Status = apiJobFailed
+		j.Error = runErr.Error()
+	} else {
+		j.Status = apiJobCompleted
+	}
+	subs := make([]chan *attempt.Attempt, 0, len(j.subscribers))
+	for ch := range j.subscribers {
+		subs = append(subs, ch)
+	}
+	j.mu.Unlock()
+	close(j.done)
+	for _, ch := range subs {
+		close(ch)
+	}
+}
+
+func (j *apiJob) snapshotAttempts() []*attempt.Attempt {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	out := make([]*attempt.Attempt, len(j.attempts))
+	copy(out, j.attempts)
+	return out
+}
+
+// apiServer holds the in-memory job queue and serves the HTTP API.
+type apiServer struct {
+	ctx    context.Context
+	apiKey string
+
+	mu   sync.RWMutex
+	jobs map[string]*apiJob
+
+	queue chan *apiJob
+}
+
+func newAPIServer(ctx context.Context, apiKey string, workers int) *apiServer {
+	s := &apiServer{
+		ctx:    ctx,
+		apiKey: apiKey,
+		jobs:   make(map[string]*apiJob),
+		queue:  make(chan *apiJob, 256),
+	}
+	for i := 0; i < workers; i++ {
+		go s.worker()
+	}
+	return s
+}
+
+func (s *apiServer) worker() {
+	for job := range s.queue {
+		s.runJob(job)
+	}
+}
+
+func (s *apiServer) submit(req apiJobRequest) *apiJob {
+	job := newAPIJob(req)
+	s.mu.Lock()
+	s.jobs[job.ID] = job
+	s.mu.Unlock()
+	s.queue <- job
+	return job
+}
+
+func (s *apiServer) get(id string) (*apiJob, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, ok := s.jobs[id]
+	return job, ok
+}
+
+// runJob drives one submitted scan through the real scan pipeline,
+// streaming each processed attempt to publish as it completes rather than
+// waiting for the whole scan to finish. The pipeline itself lives in
+// runAPIJob, shared with the queue consumer in worker.go, so the two paths
+// can't drift apart.
+func (s *apiServer) runJob(job *apiJob) {
+	runAPIJob(s.ctx, job)
+}
+
+// runAPIJob runs a submitted job's scan to completion: build the ScanCmd
+// the same way the CLI's scan subcommand would, resolve its configuration,
+// and run it, publishing each attempt to job.publish as it completes. Both
+// apiServer (in-process HTTP job queue) and the queue consumer in
+// worker.go call this, so a fix to the shared pipeline - like using the
+// caller's real ctx instead of a hardcoded one - only needs to happen once.
+func runAPIJob(ctx context.Context, job *apiJob) {
+	job.setStatus(apiJobRunning)
+	req := job.Request
+
+	harness := req.Harness
+	if harness == "" {
+		harness = "probewise.Probewise"
+	}
+
+	scanCmd := &ScanCmd{
+		Generator:     req.Generator,
+		Probe:         req.Probes,
+		ProbesGlob:    req.ProbesGlob,
+		All:           req.AllProbes,
+		Detectors:     req.Detectors,
+		DetectorsGlob: req.DetectorsGlob,
+		ConfigFile:    req.ConfigFile,
+		Config:        req.ConfigJSON,
+		Harness:       harness,
+	}
+	cfg := scanCmd.loadScanConfig()
+	if err := scanCmd.expandGlobPatterns(cfg); err != nil {
+		job.finish(runSummary{}, err)
+		return
+	}
+
+	var yamlCfg *config.Config
+	if cfg.configFile != "" {
+		var err error
+		yamlCfg, err = loadScanYAMLConfig(cfg.configFile, cfg.strictConfig)
+		if err != nil {
+			job.finish(runSummary{}, fmt.Errorf("failed to load config file: %w", err))
+			return
+		}
+	}
+
+	resolved, err := config.Resolve(yamlCfg, scanCmd.buildCLIOverrides())
+	if err != nil {
+		job.finish(runSummary{}, fmt.Errorf("failed to resolve configuration: %w", err))
+		return
+	}
+
+	eval := &quietEvaluator{}
+	runErr := runScanResolved(ctx, cfg, yamlCfg, resolved, eval, job.publish)
+
+	summary := summarizeAttempts(job.snapshotAttempts())
+	job.finish(summary, runErr)
+}
+
+var _ harnesses.Evaluator = (*quietEvaluator)(nil)
+
+// runAPI starts the HTTP server and blocks until ctx is cancelled.
+func runAPI(ctx context.Context, a *ApiCmd, out io.Writer) error {
+	logStartupDiagnostics(out, "api")
+
+	srv := newAPIServer(ctx, a.APIKey, a.Workers)
+	ready := &readiness{}
+	ready.setReady(true, "")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /jobs", srv.handleSubmit)
+	mux.HandleFunc("GET /jobs/{id}", srv.handleGetJob)
+	mux.HandleFunc("GET /jobs/{id}/events", srv.handleEvents)
+	mux.HandleFunc("GET /jobs/{id}/results", srv.handleResults)
+	registerHealthRoutes(mux, ready)
+
+	httpServer := &http.Server{Addr: a.Addr, Handler: srv.authMiddleware(mux)}
+
+	go watchConfigReload(ctx, "api", "", false, out, func(*config.Config) {})
+
+	errCh := make(chan error, 1)
+	go func() {
+		fmt.Fprintf(out, "api: listening on %s\n", a.Addr)
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return httpServer.Shutdown(shutdownCtx)
+	case err := <-errCh:
+		return err
+	}
+}
+
+// authMiddleware rejects requests missing a matching X-API-Key header when
+// an API key is configured. No key configured means no auth, matching the
+// rest of augustus's opt-in-to-lock-down posture (e.g. --policy-file).
+// /healthz and /readyz are always exempt, since Kubernetes-style liveness
+// and readiness probes don't send custom headers.
+func (s *apiServer) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.apiKey == "" || r.URL.Path == "/healthz" || r.URL.Path == "/readyz" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		provided := r.Header.Get("X-API-Key")
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(s.apiKey)) != 1 {
+			http.Error(w, `{"error":"invalid or missing X-API-Key"}`, http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *apiServer) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	var req apiJobRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, `{"error":"invalid JSON body"}`, http.StatusBadRequest)
+		return
+	}
+	if req.Generator == "" {
+		http.Error(w, `{"error":"generator is required"}`, http.StatusBadRequest)
+		return
+	}
+
+	job := s.submit(req)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(job)
+}
+
+func (s *apiServer) handleGetJob(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.get(r.PathValue("id"))
+	if !ok {
+		http.Error(w, `{"error":"job not found"}`, http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(job)
+}
+
+func (s *apiServer) handleResults(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.get(r.PathValue("id"))
+	if !ok {
+		http.Error(w, `{"error":"job not found"}`, http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(job.snapshotAttempts())
+}
+
+// handleEvents streams every attempt the job processes as a Server-Sent
+// Event, starting with any attempts already processed before the client
+// connected, then closing the stream once the job finishes.
+func (s *apiServer) handleEvents(w http.ResponseWriter, r *http.Request) {
+	job, ok := s.get(r.PathValue("id"))
+	if !ok {
+		http.Error(w, `{"error":"job not found"}`, http.StatusNotFound)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, `{"error":"streaming unsupported"}`, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	ch, caughtUp := job.subscribe()
+	defer job.unsubscribe(ch)
+
+	writeEvent := func(a *attempt.Attempt) bool {
+		payload, err := json.Marshal(a)
+		if err != nil {
+			return true
+		}
+		if _, err := fmt.Fprintf(w, "event: attempt\ndata: %s\n\n", payload); err != nil {
+			return false
+		}
+		flusher.Flush()
+		return true
+	}
+
+	for _, a := range caughtUp {
+		if !writeEvent(a) {
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-job.done:
+			fmt.Fprintf(w, "event: done\ndata: {}\n\n")
+			flusher.Flush()
+			return
+		case a, ok := <-ch:
+			if !ok {
+				fmt.Fprintf(w, "event: done\ndata: {}\n\n")
+				flusher.Flush()
+				return
+			}
+			if !writeEvent(a) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+var errBoom = errors.New("boom")
+
+func TestApiJob_PublishFansOutToSubscribers(t *testing.T) {
+	job := newAPIJob(apiJobRequest{Generator: "test.Repeat"})
+
+	ch, caughtUp := job.subscribe()
+	if len(caughtUp) != 0 {
+		t.Fatalf("caughtUp = %v, want empty for a fresh job", caughtUp)
+	}
+
+	a := passingAttempt("dan.Dan_11_0")
+	job.publish(a)
+
+	select {
+	case got := <-ch:
+		if got != a {
+			t.Errorf("subscriber received %v, want %v", got, a)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("subscriber never received published attempt")
+	}
+
+	if len(job.snapshotAttempts()) != 1 {
+		t.Errorf("snapshotAttempts() len = %d, want 1", len(job.snapshotAttempts()))
+	}
+}
+
+func TestApiJob_SubscribeCatchesUpBeforeLiveEvents(t *testing.T) {
+	job := newAPIJob(apiJobRequest{Generator: "test.Repeat"})
+	job.publish(passingAttempt("dan.Dan_11_0"))
+
+	_, caughtUp := job.subscribe()
+	if len(caughtUp) != 1 {
+		t.Fatalf("caughtUp len = %d, want 1", len(caughtUp))
+	}
+}
+
+func TestApiJob_FinishClosesSubscriberChannels(t *testing.T) {
+	job := newAPIJob(apiJobRequest{Generator: "test.Repeat"})
+	ch, _ := job.subscribe()
+
+	job.finish(runSummary{Total: 1, Passed: 1}, nil)
+
+	if job.Status != apiJobCompleted {
+		t.Errorf("Status = %q, want %q", job.Status, apiJobCompleted)
+	}
+	select {
+	case _, ok := <-ch:
+		if ok {
+			t.Error("subscriber channel should be closed after finish")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("subscriber channel was never closed")
+	}
+	select {
+	case <-job.done:
+	default:
+		t.Error("job.done should be closed after finish")
+	}
+}
+
+func TestApiJob_FinishRecordsError(t *testing.T) {
+	job := newAPIJob(apiJobRequest{Generator: "test.Repeat"})
+	job.finish(runSummary{}, errBoom)
+
+	if job.Status != apiJobFailed {
+		t.Errorf("Status = %q, want %q", job.Status, apiJobFailed)
+	}
+	if job.Error != errBoom.Error() {
+		t.Errorf("Error = %q, want %q", job.Error, errBoom.Error())
+	}
+}
+
+func TestApiServer_SubmitAndGet(t *testing.T) {
+	s := newAPIServer(context.Background(), "", 0)
+	job := s.submit(apiJobRequest{Generator: "test.Repeat"})
+
+	got, ok := s.get(job.ID)
+	if !ok {
+		t.Fatal("get() ok = false, want true")
+	}
+	if got.ID != job.ID {
+		t.Errorf("get() returned job %q, want %q", got.ID, job.ID)
+	}
+
+	if _, ok := s.get("does-not-exist"); ok {
+		t.Error("get() ok = true for unknown job id, want false")
+	}
+}
+
+func TestAuthMiddleware_NoKeyConfiguredAllowsAllRequests(t *testing.T) {
+	s := newAPIServer(context.Background(), "", 0)
+	handler := s.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", rec.Code)
+	}
+}
+
+func TestAuthMiddleware_RejectsMissingOrWrongKey(t *testing.T) {
+	s := newAPIServer(context.Background(), "secret", 0)
+	handler := s.authMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status with no key = %d, want 401", rec.Code)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-API-Key", "wrong")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status with wrong key = %d, want 401", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-API-Key", "secret")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("status with correct key = %d, want 200", rec.Code)
+	}
+}
+
+func TestHandleSubmit_RequiresGenerator(t *testing.T) {
+	s := newAPIServer(context.Background(), "", 0)
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/jobs", strings.NewReader(`{}`))
+
+	s.handleSubmit(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400", rec.Code)
+	}
+}
+
+func TestHandleGetJob_UnknownIDReturns404(t *testing.T) {
+	s := newAPIServer(context.Background(), "", 0)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /jobs/{id}", s.handleGetJob)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/jobs/does-not-exist", nil))
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want 404", rec.Code)
+	}
+}
+
+// TestAPIServer_EndToEnd drives a full scan through a real running HTTP
+// server: submit a job, poll it to completion, read its SSE stream, and
+// fetch its final results.
+func TestAPIServer_EndToEnd(t *testing.T) {
+	s := newAPIServer(context.Background(), "", 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /jobs", s.handleSubmit)
+	mux.HandleFunc("GET /jobs/{id}", s.handleGetJob)
+	mux.HandleFunc("GET /jobs/{id}/events", s.handleEvents)
+	mux.HandleFunc("GET /jobs/{id}/results", s.handleResults)
+	srv := httptest.NewServer(s.authMiddleware(mux))
+	defer srv.Close()
+
+	body := `{"generator":"test.Repeat","probes":["test.Test"],"detectors":["always.Pass"]}`
+	resp, err := http.Post(srv.URL+"/jobs", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("POST /jobs: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("POST /jobs status = %d, want 202", resp.StatusCode)
+	}
+	var submitted apiJob
+	if err := json.NewDecoder(resp.Body).Decode(&submitted); err != nil {
+		t.Fatalf("decode submit response: %v", err)
+	}
+
+	eventsResp, err := http.Get(srv.URL + "/jobs/" + submitted.ID + "/events")
+	if err != nil {
+		t.Fatalf("GET /jobs/{id}/events: %v", err)
+	}
+	defer eventsResp.Body.Close()
+	scanner := bufio.NewScanner(eventsResp.Body)
+	sawDone := false
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "event: done") {
+			sawDone = true
+			break
+		}
+	}
+	if !sawDone {
+		t.Fatal("SSE stream never sent a done event")
+	}
+
+	var job apiJob
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		getResp, err := http.Get(srv.URL + "/jobs/" + submitted.ID)
+		if err != nil {
+			t.Fatalf("GET /jobs/{id}: %v", err)
+		}
+		_ = json.NewDecoder(getResp.Body).Decode(&job)
+		getResp.Body.Close()
+		if job.Status == apiJobCompleted || job.Status == apiJobFailed {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if job.Status != apiJobCompleted {
+		t.Fatalf("job status = %q, want %q (error: %s)", job.Status, apiJobCompleted, job.Error)
+	}
+	if job.Summary == nil || job.Summary.Total == 0 {
+		t.Fatalf("job.Summary = %+v, want a populated summary", job.Summary)
+	}
+
+	resultsResp, err := http.Get(srv.URL + "/jobs/" + submitted.ID + "/results")
+	if err != nil {
+		t.Fatalf("GET /jobs/{id}/results: %v", err)
+	}
+	defer resultsResp.Body.Close()
+	var results []*attempt.Attempt
+	if err := json.NewDecoder(resultsResp.Body).Decode(&results); err != nil {
+		t.Fatalf("decode results response: %v", err)
+	}
+	if len(results) == 0 {
+		t.Fatal("results response was empty")
+	}
+}
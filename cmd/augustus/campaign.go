@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/praetorian-inc/augustus/pkg/campaign"
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"golang.org/x/sync/errgroup"
+)
+
+// CampaignCmd groups campaign-related subcommands.
+type CampaignCmd struct {
+	Run CampaignRunCmd `cmd:"" help:"Run every scan job in a campaign file and produce a consolidated report."`
+}
+
+// CampaignRunCmd runs a campaign.Campaign: a sequence of scans against a
+// shared output directory, followed by one consolidated cross-scan report.
+type CampaignRunCmd struct {
+	File      string `arg:"" help:"Campaign YAML file." type:"existingfile"`
+	OutputDir string `help:"Shared output directory for per-job JSONL results and the consolidated report." default:"./campaign-results" type:"path" name:"output-dir"`
+}
+
+func (c *CampaignRunCmd) Run() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return runCampaign(ctx, c, os.Stdout)
+}
+
+// campaignJobResult records the outcome of a single job for the
+// consolidated report.
+type campaignJobResult struct {
+	Name      string          `json:"name"`
+	Generator string          `json:"generator"`
+	Summary   results.Summary `json:"summary,omitempty"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// campaignReport is the consolidated, cross-scan artifact for an entire
+// campaign run.
+type campaignReport struct {
+	CampaignName string              `json:"campaign_name"`
+	Jobs         []campaignJobResult `json:"jobs"`
+	Totals       results.Summary     `json:"totals"`
+}
+
+// runCampaign is the testable core of CampaignRunCmd.Run: it loads the
+// campaign file, runs each job in order, and writes a consolidated report.
+func runCampaign(ctx context.Context, c *CampaignRunCmd, out io.Writer) error {
+	camp, err := campaign.Load(c.File)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(c.OutputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	report := campaignReport{CampaignName: camp.Name, Totals: results.Summary{ByProbe: make(map[string]results.ProbeStats)}}
+
+	maxParallel := camp.MaxParallelJobs
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+
+	// jobResults is filled in by job index, not completion order, so the
+	// report's job list always matches the campaign file's order regardless
+	// of how many jobs ran concurrently.
+	jobResults := make([]campaignJobResult, len(camp.Jobs))
+	var outMu sync.Mutex
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxParallel)
+	for i, job := range camp.Jobs {
+		i, job := i, job
+		g.Go(func() error {
+			jobResults[i] = runCampaignJob(gctx, c, job, &outMu, out)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	for _, jobResult := range jobResults {
+		report.Jobs = append(report.Jobs, jobResult)
+		if jobResult.Error == "" {
+			mergeSummary(&report.Totals, jobResult.Summary)
+		}
+	}
+
+	reportPath := filepath.Join(c.OutputDir, "campaign-report.json")
+	if err := writeCampaignReport(reportPath, report); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "campaign: consolidated report written to %s\n", reportPath)
+
+	return nil
+}
+
+// runCampaignJob runs a single campaign job to completion and returns its
+// result. outMu serializes progress messages to out, since jobs may run
+// concurrently when the campaign sets max_parallel_jobs > 1.
+func runCampaignJob(ctx context.Context, c *CampaignRunCmd, job campaign.Job, outMu *sync.Mutex, out io.Writer) campaignJobResult {
+	logf := func(format string, args ...any) {
+		outMu.Lock()
+		defer outMu.Unlock()
+		fmt.Fprintf(out, format, args...)
+	}
+
+	logf("campaign: running job %q (generator: %s)\n", job.Name, job.Generator)
+
+	jobResult := campaignJobResult{Name: job.Name, Generator: job.Generator}
+
+	harness := job.Harness
+	if harness == "" {
+		harness = "probewise.Probewise"
+	}
+	scanCmd := &ScanCmd{
+		Generator:           job.Generator,
+		Probe:               job.Probes,
+		ProbesGlob:          job.ProbesGlob,
+		All:                 job.AllProbes,
+		Detectors:           job.Detectors,
+		Buff:                job.Buffs,
+		ConfigFile:          job.ConfigFile,
+		Harness:             harness,
+		Concurrency:         job.Concurrency,
+		AdaptiveConcurrency: job.AdaptiveConcurrency,
+	}
+	cfg := scanCmd.loadScanConfig()
+	if err := scanCmd.expandGlobPatterns(cfg); err != nil {
+		jobResult.Error = err.Error()
+		logf("campaign: job %q failed: %v\n", job.Name, err)
+		return jobResult
+	}
+	cfg.outputFile = filepath.Join(c.OutputDir, job.Name+".jsonl")
+
+	eval := &quietEvaluator{}
+	if err := runScan(ctx, cfg, eval); err != nil {
+		jobResult.Error = err.Error()
+		logf("campaign: job %q failed: %v\n", job.Name, err)
+		return jobResult
+	}
+
+	if err := results.WriteJSONL(cfg.outputFile, eval.attempts); err != nil {
+		jobResult.Error = err.Error()
+		logf("campaign: job %q failed to write results: %v\n", job.Name, err)
+		return jobResult
+	}
+
+	jobResult.Summary = results.ComputeSummary(eval.attempts)
+	return jobResult
+}
+
+// mergeSummary folds a job's summary into the campaign-wide totals.
+// Per-probe latency and error-class breakdowns stay on the individual job
+// summaries; totals only track pass/fail counts.
+func mergeSummary(totals *results.Summary, job results.Summary) {
+	totals.TotalAttempts += job.TotalAttempts
+	totals.Passed += job.Passed
+	totals.Failed += job.Failed
+
+	for probe, stats := range job.ByProbe {
+		existing := totals.ByProbe[probe]
+		existing.Total += stats.Total
+		existing.Passed += stats.Passed
+		existing.Failed += stats.Failed
+		totals.ByProbe[probe] = existing
+	}
+}
+
+// writeCampaignReport writes the consolidated report as indented JSON.
+func writeCampaignReport(path string, report campaignReport) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create campaign report: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("failed to encode campaign report: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/results"
+)
+
+func writeTestCampaign(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "campaign.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestRunCampaign_RunsJobsAndWritesReport(t *testing.T) {
+	campaignPath := writeTestCampaign(t, `
+name: smoke-test
+jobs:
+  - name: job-one
+    generator: test.Repeat
+    probes: ["test.Test"]
+    detectors: ["always.Pass"]
+  - name: job-two
+    generator: test.Repeat
+    probes: ["test.Test"]
+    detectors: ["always.Pass"]
+`)
+	outputDir := t.TempDir()
+	cmd := &CampaignRunCmd{File: campaignPath, OutputDir: outputDir}
+
+	var out bytes.Buffer
+	if err := runCampaign(context.Background(), cmd, &out); err != nil {
+		t.Fatalf("runCampaign() error = %v", err)
+	}
+
+	for _, name := range []string{"job-one.jsonl", "job-two.jsonl", "campaign-report.json"} {
+		if _, err := os.Stat(filepath.Join(outputDir, name)); err != nil {
+			t.Errorf("expected output file %s: %v", name, err)
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "campaign-report.json"))
+	if err != nil {
+		t.Fatalf("ReadFile(campaign-report.json) error = %v", err)
+	}
+	var report campaignReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("Unmarshal(campaign-report.json) error = %v", err)
+	}
+	if report.CampaignName != "smoke-test" {
+		t.Errorf("CampaignName = %q, want %q", report.CampaignName, "smoke-test")
+	}
+	if len(report.Jobs) != 2 {
+		t.Fatalf("len(Jobs) = %d, want 2", len(report.Jobs))
+	}
+	if report.Totals.TotalAttempts == 0 {
+		t.Error("Totals.TotalAttempts should be non-zero across both jobs")
+	}
+}
+
+func TestRunCampaign_RecordsJobErrorsWithoutAbortingCampaign(t *testing.T) {
+	campaignPath := writeTestCampaign(t, `
+name: partial-failure
+jobs:
+  - name: bad-generator
+    generator: does.NotExist
+    probes: ["test.Test"]
+  - name: good-job
+    generator: test.Repeat
+    probes: ["test.Test"]
+    detectors: ["always.Pass"]
+`)
+	outputDir := t.TempDir()
+	cmd := &CampaignRunCmd{File: campaignPath, OutputDir: outputDir}
+
+	var out bytes.Buffer
+	if err := runCampaign(context.Background(), cmd, &out); err != nil {
+		t.Fatalf("runCampaign() error = %v, want nil (job failures are recorded, not fatal)", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "campaign-report.json"))
+	if err != nil {
+		t.Fatalf("ReadFile(campaign-report.json) error = %v", err)
+	}
+	var report campaignReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("Unmarshal(campaign-report.json) error = %v", err)
+	}
+	if report.Jobs[0].Error == "" {
+		t.Error("expected first job to record an error for an unknown generator")
+	}
+	if report.Jobs[1].Error != "" {
+		t.Errorf("expected second job to succeed, got error: %s", report.Jobs[1].Error)
+	}
+}
+
+func TestRunCampaign_MaxParallelJobsPreservesJobOrder(t *testing.T) {
+	campaignPath := writeTestCampaign(t, `
+name: parallel-smoke-test
+max_parallel_jobs: 2
+jobs:
+  - name: bad-generator
+    generator: does.NotExist
+    probes: ["test.Test"]
+  - name: good-job-one
+    generator: test.Repeat
+    probes: ["test.Test"]
+    detectors: ["always.Pass"]
+    concurrency: 2
+  - name: good-job-two
+    generator: test.Repeat
+    probes: ["test.Test"]
+    detectors: ["always.Pass"]
+`)
+	outputDir := t.TempDir()
+	cmd := &CampaignRunCmd{File: campaignPath, OutputDir: outputDir}
+
+	var out bytes.Buffer
+	if err := runCampaign(context.Background(), cmd, &out); err != nil {
+		t.Fatalf("runCampaign() error = %v, want nil (job failures are recorded, not fatal)", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outputDir, "campaign-report.json"))
+	if err != nil {
+		t.Fatalf("ReadFile(campaign-report.json) error = %v", err)
+	}
+	var report campaignReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("Unmarshal(campaign-report.json) error = %v", err)
+	}
+	if len(report.Jobs) != 3 {
+		t.Fatalf("len(Jobs) = %d, want 3", len(report.Jobs))
+	}
+	if report.Jobs[0].Name != "bad-generator" || report.Jobs[0].Error == "" {
+		t.Errorf("Jobs[0] = %+v, want bad-generator with an error", report.Jobs[0])
+	}
+	if report.Jobs[1].Name != "good-job-one" || report.Jobs[1].Error != "" {
+		t.Errorf("Jobs[1] = %+v, want good-job-one with no error", report.Jobs[1])
+	}
+	if report.Jobs[2].Name != "good-job-two" || report.Jobs[2].Error != "" {
+		t.Errorf("Jobs[2] = %+v, want good-job-two with no error", report.Jobs[2])
+	}
+	if report.Totals.TotalAttempts == 0 {
+		t.Error("Totals.TotalAttempts should be non-zero across the two successful jobs")
+	}
+}
+
+func TestRunCampaign_InvalidCampaignFile(t *testing.T) {
+	cmd := &CampaignRunCmd{File: filepath.Join(t.TempDir(), "missing.yaml"), OutputDir: t.TempDir()}
+
+	if err := runCampaign(context.Background(), cmd, &bytes.Buffer{}); err == nil {
+		t.Fatal("runCampaign() error = nil, want error for missing campaign file")
+	}
+}
+
+func TestMergeSummary(t *testing.T) {
+	totals := results.Summary{ByProbe: make(map[string]results.ProbeStats)}
+	mergeSummary(&totals, results.Summary{
+		TotalAttempts: 3, Passed: 2, Failed: 1,
+		ByProbe: map[string]results.ProbeStats{"dan.Dan_11_0": {Total: 1, Passed: 1}},
+	})
+	mergeSummary(&totals, results.Summary{
+		TotalAttempts: 2, Passed: 1, Failed: 1,
+		ByProbe: map[string]results.ProbeStats{"dan.Dan_11_0": {Total: 1, Failed: 1}},
+	})
+
+	if totals.TotalAttempts != 5 || totals.Passed != 3 || totals.Failed != 2 {
+		t.Fatalf("merged totals = %+v, want {Total:5 Passed:3 Failed:2}", totals)
+	}
+	if totals.ByProbe["dan.Dan_11_0"].Total != 2 {
+		t.Errorf("ByProbe[dan.Dan_11_0].Total = %d, want 2", totals.ByProbe["dan.Dan_11_0"].Total)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/alecthomas/kong"
@@ -10,12 +11,25 @@ import (
 // CLI represents the Augustus command-line interface.
 var CLI struct {
 	// Global flags
-	Debug      bool          `help:"Enable debug mode." short:"d" env:"AUGUSTUS_DEBUG"`
-	Version    VersionCmd    `cmd:"" help:"Print version information."`
-	Help       HelpCmd       `cmd:"" hidden:"" default:"1"`
-	List       ListCmd       `cmd:"" help:"List available probes, detectors, generators."`
-	Scan       ScanCmd       `cmd:"" help:"Run vulnerability scan against LLM."`
-	Completion CompletionCmd `cmd:"" help:"Generate shell completion scripts."`
+	Debug        bool            `help:"Enable debug mode." short:"d" env:"AUGUSTUS_DEBUG"`
+	Version      VersionCmd      `cmd:"" help:"Print version information."`
+	Help         HelpCmd         `cmd:"" hidden:"" default:"1"`
+	List         ListCmd         `cmd:"" help:"List available probes, detectors, generators."`
+	Config       ConfigCmd       `cmd:"" help:"Scaffold and validate YAML config files."`
+	Scan         ScanCmd         `cmd:"" help:"Run vulnerability scan against LLM."`
+	Daemon       DaemonCmd       `cmd:"" help:"Run recurring scans on a cron schedule."`
+	Api          ApiCmd          `cmd:"" name:"api" help:"Run an HTTP API server for submitting and polling scan jobs."`
+	Worker       WorkerCmd       `cmd:"" name:"worker" help:"Consume scan jobs from a Redis/SQS queue for horizontally scaled scanning."`
+	Campaign     CampaignCmd     `cmd:"" help:"Run multi-scan red-team campaigns."`
+	Repl         ReplCmd         `cmd:"" help:"Interactive prompt for probe prototyping."`
+	EvalDetector EvalDetectorCmd `cmd:"" name:"eval-detector" help:"Measure a detector's precision/recall/F1 against a labeled corpus."`
+	Report       ReportCmd       `cmd:"" help:"Report on cross-run trends from a store file."`
+	Completion   CompletionCmd   `cmd:"" help:"Generate shell completion scripts."`
+	Keygen       KeygenCmd       `cmd:"" help:"Generate an Ed25519 keypair for signing scan result artifacts."`
+	Verify       VerifyCmd       `cmd:"" help:"Verify a signed scan result artifact's detached signature."`
+	Anonymize    AnonymizeCmd    `cmd:"" help:"Strip target-identifying details from a results file for sharing as a research corpus."`
+	Deanonymize  DeanonymizeCmd  `cmd:"" help:"Reverse 'augustus anonymize' using its mapping file."`
+	Inspect      InspectCmd      `cmd:"" help:"Pretty-print sampled attempts from a results file for terminal triage."`
 }
 
 // VersionCmd prints version information.
@@ -42,10 +56,12 @@ func (h *HelpCmd) Run(ctx *kong.Context) error {
 }
 
 // ListCmd lists available capabilities.
-type ListCmd struct{}
+type ListCmd struct {
+	Schema bool `help:"Show published config schemas for each capability." name:"schema"`
+}
 
 func (l *ListCmd) Run() error {
-	listCapabilities()
+	listCapabilities(l.Schema)
 	return nil
 }
 
@@ -55,40 +71,75 @@ type ScanCmd struct {
 	Generator string `arg:"" help:"Generator name (e.g., openai.OpenAI, anthropic.Anthropic)." required:""`
 
 	// Probe selection (mutually exclusive groups)
-	Probe      []string `help:"Probe names (repeatable)." short:"p" name:"probe" group:"probes" xor:"probe-selection"`
-	ProbesGlob string   `help:"Comma-separated probe glob patterns (e.g., 'dan.*,encoding.*')." name:"probes-glob" group:"probes" xor:"probe-selection"`
-	All        bool     `help:"Run all registered probes." group:"probes" xor:"probe-selection"`
+	Probe         []string `help:"Probe names (repeatable)." short:"p" name:"probe" group:"probes" xor:"probe-selection"`
+	ProbesGlob    string   `help:"Comma-separated probe glob patterns (e.g., 'dan.*,encoding.*')." name:"probes-glob" group:"probes" xor:"probe-selection"`
+	All           bool     `help:"Run all registered probes." group:"probes" xor:"probe-selection"`
+	ExcludeProbes string   `help:"Comma-separated probe glob patterns to drop from the selection above, e.g. '--all --exclude-probes \"glitch.*,tap.*\"'." name:"exclude-probes"`
 
 	// Detector selection
-	Detectors     []string `help:"Detector names (repeatable)." name:"detector"`
-	DetectorsGlob string   `help:"Comma-separated detector glob patterns." name:"detectors-glob"`
+	Detectors        []string `help:"Detector names (repeatable)." name:"detector"`
+	DetectorsGlob    string   `help:"Comma-separated detector glob patterns." name:"detectors-glob"`
+	ExcludeDetectors string   `help:"Comma-separated detector glob patterns to drop from the selection above." name:"exclude-detectors"`
 
 	// Buff selection
 	Buff      []string `help:"Buff names to apply (repeatable)." short:"b" name:"buff"`
 	BuffsGlob string   `help:"Comma-separated buff glob patterns (e.g., 'encoding.*')." name:"buffs-glob"`
+	BuffParam []string `help:"Sweep a buff parameter across values: '<buff>:<param>=<v1>,<v2>' (e.g. 'flip.WordOrder:variant=cot,full'). Repeatable; implies the buff even without --buff." name:"buff-param"`
 
 	// Configuration
-	ConfigFile string `help:"YAML config file path." type:"existingfile" name:"config-file"`
-	Config     string `help:"JSON config for generator." short:"c"`
-	Model      string `help:"Model name for generator (shorthand for --config '{\"model\":\"...\"}')." short:"m"`
-	Profile    string `help:"Named profile to apply from config file." name:"profile"`
+	ConfigFile   string `help:"YAML config file path." type:"existingfile" name:"config-file"`
+	Config       string `help:"JSON config for generator." short:"c"`
+	Model        string `help:"Model name for generator (shorthand for --config '{\"model\":\"...\"}')." short:"m"`
+	Profile      string `help:"Named profile to apply from config file." name:"profile"`
+	StrictConfig bool   `help:"Reject unknown keys anywhere in --config-file's YAML and validate probe/detector/buff names in it against the registry." name:"strict-config"`
 
 	// Execution
-	Harness      string        `help:"Harness name (default: probewise.Probewise)." default:"probewise.Probewise"`
-	Timeout      time.Duration `help:"Overall scan timeout (0 = no timeout)."`
-	Concurrency  int           `help:"Max concurrent probes (default: 10)." env:"AUGUSTUS_CONCURRENCY"`
-	ProbeTimeout time.Duration `help:"Per-probe timeout (0 = no timeout)."`
+	Harness             string        `help:"Harness name (default: probewise.Probewise)." default:"probewise.Probewise"`
+	Timeout             time.Duration `help:"Overall scan timeout (0 = no timeout)."`
+	Concurrency         int           `help:"Max concurrent probes (default: 10)." env:"AUGUSTUS_CONCURRENCY"`
+	ProbeTimeout        time.Duration `help:"Per-probe timeout (0 = no timeout)."`
+	AttemptTimeout      time.Duration `help:"Per-attempt (single generator call) timeout (0 = no timeout)." name:"attempt-timeout"`
+	DetectorTimeout     time.Duration `help:"Per-detector-call timeout (0 = no timeout)." name:"detector-timeout"`
+	ShutdownGracePeriod time.Duration `help:"On SIGINT/SIGTERM, how long to let in-flight probes finish before forcibly canceling them (0 = cancel immediately)." name:"shutdown-grace-period"`
+	DryRun              bool          `help:"Print every prompt that would be sent (including buff-transformed variants) and exit without calling the generator." name:"dry-run"`
 
 	// Output
 	Format  string `help:"Output format." enum:"table,json,jsonl" default:"table" short:"f"`
+	Query   string `help:"jq-style query (see 'augustus inspect --query') evaluated over the results, e.g. '$[?(@.passed==false)].prompt'. Requires --format json or jsonl." name:"query"`
 	Output  string `help:"JSONL output file path." short:"o" type:"path"`
 	HTML    string `help:"HTML report file path." type:"path" name:"html"`
+	CSV     string `help:"CSV findings report file path." type:"path" name:"csv"`
+	XLSX    string `help:"XLSX findings workbook file path, one sheet per probe." type:"path" name:"xlsx"`
+	Store   string `help:"Append this run to a trend store file for 'augustus report trends' (JSONL, created if missing)." type:"path" name:"store"`
 	Verbose bool   `help:"Verbose output." short:"v"`
 
+	// Redacted deliverable output (client-safe variant, same run as above)
+	RedactedHTML    string  `help:"Write a second, client-safe HTML report here: harmful payloads truncated and hashed, secrets masked. Derived from the same attempts as --html." type:"path" name:"redacted-html"`
+	RedactedOutput  string  `help:"Write a second, client-safe JSONL file here, redacted the same way as --redacted-html." type:"path" name:"redacted-output"`
+	RedactThreshold float64 `help:"Score at/above which a payload counts as harmful for --redacted-html/--redacted-output (default: attempt.DefaultVulnerabilityThreshold)." name:"redact-threshold"`
+
+	// Signing
+	SignKey string `help:"Sign --output's JSONL file with this Ed25519 private key (see 'augustus keygen'), writing a detached '<output>.sig'." name:"sign-key" type:"existingfile"`
+
 	// Runtime hooks
 	Setup   string `help:"Shell command run once before all probes. Stdout KEY=VALUE lines are injected into the generator request template as $KEY." name:"setup"`
 	Prepare string `help:"Shell command run before each probe. Receives AUGUSTUS_LAST_RESPONSE env var with raw response from the previous probe." name:"prepare"`
 	Cleanup string `help:"Shell command run once after all probes complete." name:"cleanup"`
+
+	// Evidence capture
+	Capture string `help:"Record outbound generator HTTP traffic for evidence (e.g. 'har:/tmp/scan.har'). Only generators built on pkg/transport (anthropic.*, openai.*, rest.*) are captured; others log a warning and produce an empty file." name:"capture"`
+
+	// Optimization
+	Dedup                  bool `help:"Skip generator calls for exact-duplicate prompts across probes, reusing the first response (useful with --all)." name:"dedup"`
+	AdaptiveConcurrency    bool `help:"Automatically lower concurrency when the generator reports rate limiting (429s), and ramp it back up after a cool-down, instead of a fixed --concurrency." name:"adaptive-concurrency"`
+	AdaptiveMinConcurrency int  `help:"Floor for --adaptive-concurrency; the limit never drops below this." name:"adaptive-min-concurrency" default:"1"`
+
+	// Policy enforcement
+	PolicyFile string `help:"YAML policy file restricting allowed probes/generators/severity per environment." name:"policy-file" type:"existingfile"`
+	PolicyEnv  string `help:"Environment name to enforce from --policy-file (e.g. 'prod', 'staging')." name:"policy-env"`
+
+	// Judge calibration
+	CalibrateJudge bool `help:"Before scanning, run every selected judge.* detector over a small bundled set of known-harmful/known-benign outputs and warn if its observed accuracy is poor." name:"calibrate-judge"`
 }
 
 func (s *ScanCmd) Run() error {
@@ -121,6 +172,36 @@ func (s *ScanCmd) Validate() error {
 		return fmt.Errorf("--profile requires --config-file")
 	}
 
+	// Strict validation only means something when there's YAML to validate.
+	if s.StrictConfig && s.ConfigFile == "" {
+		return fmt.Errorf("--strict-config requires --config-file")
+	}
+
+	// Capture currently only supports the HAR format.
+	if s.Capture != "" && !strings.HasPrefix(s.Capture, "har:") {
+		return fmt.Errorf("--capture must be in the form 'har:<path>'")
+	}
+
+	// --query only makes sense against structured output.
+	if s.Query != "" && s.Format != "json" && s.Format != "jsonl" {
+		return fmt.Errorf("--query requires --format json or jsonl")
+	}
+
+	// --policy-file and --policy-env must be used together.
+	if (s.PolicyFile != "") != (s.PolicyEnv != "") {
+		return fmt.Errorf("--policy-file and --policy-env must be used together")
+	}
+
+	// Nothing to sign without a JSONL file to sign.
+	if s.SignKey != "" && s.Output == "" {
+		return fmt.Errorf("--sign-key requires --output")
+	}
+
+	// --redact-threshold only means something when a redacted output is requested.
+	if s.RedactThreshold != 0 && s.RedactedHTML == "" && s.RedactedOutput == "" {
+		return fmt.Errorf("--redact-threshold requires --redacted-html or --redacted-output")
+	}
+
 	return nil
 }
 
@@ -47,19 +47,15 @@ func TestCLIStructParsing(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var cli struct {
-				Debug   bool       `help:"Enable debug mode." short:"d"`
-				Version VersionCmd `cmd:"" help:"Print version."`
-				Help    HelpCmd    `cmd:"" hidden:"" default:"1"`
-				List    ListCmd    `cmd:"" help:"List capabilities."`
-				Scan    ScanCmd    `cmd:"" help:"Run scan."`
-			}
-
+			// Parse the real CLI struct (the one main.go actually uses) so a
+			// flag collision or other struct-tag mistake anywhere in it -
+			// e.g. two subcommands both claiming short:"d" - fails this test
+			// instead of shipping a binary that panics on every invocation.
 			var stdout bytes.Buffer
 			didExit := false
 			exitCode := -1
 
-			parser, err := kong.New(&cli,
+			parser, err := kong.New(&CLI,
 				kong.Name("augustus"),
 				kong.Exit(func(code int) { // Prevent os.Exit during tests
 					didExit = true
@@ -486,6 +482,13 @@ func TestListCmdRun(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestListCmdRun_WithSchema tests ListCmd.Run() with --schema set
+func TestListCmdRun_WithSchema(t *testing.T) {
+	cmd := ListCmd{Schema: true}
+	err := cmd.Run()
+	assert.NoError(t, err)
+}
+
 // TestScanCmdValidate tests the custom Validate() method
 func TestScanCmdValidate(t *testing.T) {
 	tests := []struct {
@@ -598,6 +601,42 @@ func TestScanCmd_Validate_ProfileRequiresConfigFile(t *testing.T) {
 	assert.Contains(t, err.Error(), "--profile requires --config-file")
 }
 
+// TestScanCmd_Validate_StrictConfigRequiresConfigFile tests StrictConfig validation.
+func TestScanCmd_Validate_StrictConfigRequiresConfigFile(t *testing.T) {
+	cmd := ScanCmd{
+		Generator:    "openai.OpenAI",
+		Probe:        []string{"dan.Dan"},
+		StrictConfig: true,
+		// No ConfigFile
+	}
+	err := cmd.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "--strict-config requires --config-file")
+}
+
+// TestScanCmd_Validate_CaptureRequiresHARScheme tests --capture validation.
+func TestScanCmd_Validate_CaptureRequiresHARScheme(t *testing.T) {
+	cmd := ScanCmd{
+		Generator: "openai.OpenAI",
+		Probe:     []string{"dan.Dan"},
+		Capture:   "/tmp/scan.har",
+	}
+	err := cmd.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "--capture must be in the form")
+}
+
+// TestScanCmd_Validate_CaptureHARSchemeAccepted tests that a valid
+// "har:<path>" capture target passes validation.
+func TestScanCmd_Validate_CaptureHARSchemeAccepted(t *testing.T) {
+	cmd := ScanCmd{
+		Generator: "openai.OpenAI",
+		Probe:     []string{"dan.Dan"},
+		Capture:   "har:/tmp/scan.har",
+	}
+	assert.NoError(t, cmd.Validate())
+}
+
 // TestScanCmdModelFlagParsing tests that --model flag is parsed correctly.
 func TestScanCmdModelFlagParsing(t *testing.T) {
 	tests := []struct {
@@ -8,41 +8,61 @@ import (
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/harnesses"
 	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
 )
 
 var version = "0.0.9"
 
-func listCapabilities() {
+func listCapabilities(showSchema bool) {
 	fmt.Println("Registered Capabilities")
 	fmt.Println("=======================")
 	fmt.Println()
 
 	fmt.Printf("Probes (%d):\n", probes.Registry.Count())
-	for _, name := range probes.List() {
-		fmt.Printf("  - %s\n", name)
-	}
+	printCapabilityNames(probes.Registry, probes.List(), showSchema)
 	fmt.Println()
 
 	fmt.Printf("Generators (%d):\n", generators.Registry.Count())
-	for _, name := range generators.List() {
-		fmt.Printf("  - %s\n", name)
-	}
+	printCapabilityNames(generators.Registry, generators.List(), showSchema)
 	fmt.Println()
 
 	fmt.Printf("Detectors (%d):\n", detectors.Registry.Count())
-	for _, name := range detectors.List() {
-		fmt.Printf("  - %s\n", name)
-	}
+	printCapabilityNames(detectors.Registry, detectors.List(), showSchema)
 	fmt.Println()
 
 	fmt.Printf("Harnesses (%d):\n", harnesses.Registry.Count())
-	for _, name := range harnesses.List() {
-		fmt.Printf("  - %s\n", name)
-	}
+	printCapabilityNames(harnesses.Registry, harnesses.List(), showSchema)
 	fmt.Println()
 
 	fmt.Printf("Buffs (%d):\n", buffs.Registry.Count())
-	for _, name := range buffs.List() {
+	printCapabilityNames(buffs.Registry, buffs.List(), showSchema)
+}
+
+// printCapabilityNames prints one line per name, plus its published config
+// schema (if any) when showSchema is set. Generic over the registry's
+// capability type so it works across probes/generators/detectors/harnesses/buffs,
+// whose registries are instantiated with different type parameters.
+func printCapabilityNames[T any](reg *registry.Registry[T], names []string, showSchema bool) {
+	for _, name := range names {
 		fmt.Printf("  - %s\n", name)
+		if !showSchema {
+			continue
+		}
+		schema, ok := reg.Schema(name)
+		if !ok {
+			fmt.Println("      (no schema published)")
+			continue
+		}
+		for _, f := range schema.Fields {
+			required := ""
+			if f.Required {
+				required = ", required"
+			}
+			def := ""
+			if f.Default != nil {
+				def = fmt.Sprintf(", default=%v", f.Default)
+			}
+			fmt.Printf("      %s %s%s%s - %s\n", f.Name, f.Type, required, def, f.Description)
+		}
 	}
 }
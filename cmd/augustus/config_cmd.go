@@ -0,0 +1,129 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// ConfigCmd groups config scaffolding/validation subcommands.
+type ConfigCmd struct {
+	Init     ConfigInitCmd     `cmd:"" help:"Write a starter YAML config for a given provider."`
+	Validate ConfigValidateCmd `cmd:"" help:"Validate a YAML config file without running a scan."`
+}
+
+// ConfigInitCmd writes a commented starter config.yaml for the chosen
+// provider, analogous to `npm init`/`cargo init` scaffolding commands.
+type ConfigInitCmd struct {
+	Provider string `help:"Generator family to scaffold." enum:"openai,anthropic,rest" default:"openai"`
+	Output   string `help:"Path to write the config to." default:"config.yaml" type:"path" short:"o"`
+	Force    bool   `help:"Overwrite Output if it already exists."`
+}
+
+func (c *ConfigInitCmd) Run() error {
+	if !c.Force {
+		if _, err := os.Stat(c.Output); err == nil {
+			return fmt.Errorf("%s already exists (use --force to overwrite)", c.Output)
+		}
+	}
+
+	content, err := scaffoldConfig(c.Provider)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(c.Output, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", c.Output, err)
+	}
+
+	fmt.Printf("wrote %s (provider: %s)\n", c.Output, c.Provider)
+	return nil
+}
+
+// scaffoldConfig returns a commented starter YAML config for provider,
+// covering run settings, the generator block with env-var placeholders for
+// secrets, and a handful of recommended starter probes.
+func scaffoldConfig(provider string) (string, error) {
+	var generatorBlock string
+	switch provider {
+	case "openai":
+		generatorBlock = `generators:
+  openai.OpenAI:
+    model: "gpt-4"
+    temperature: 0.7
+    api_key: "${OPENAI_API_KEY}"   # set this in your environment, don't commit a real key
+`
+	case "anthropic":
+		generatorBlock = `generators:
+  anthropic.Anthropic:
+    model: "claude-3-opus-20240229"
+    temperature: 0.7
+    api_key: "${ANTHROPIC_API_KEY}"   # set this in your environment, don't commit a real key
+`
+	case "rest":
+		generatorBlock = `generators:
+  rest.Rest:
+    uri: "https://api.example.com/v1/chat/completions"
+    method: "POST"
+    headers:
+      Authorization: "Bearer ${API_KEY}"   # set this in your environment, don't commit a real key
+    req_template_json_object:
+      model: "your-model-name"
+      messages:
+        - role: "user"
+          content: "$INPUT"
+    response_json: true
+    response_json_field: "$.choices[0].message.content"
+`
+	default:
+		return "", fmt.Errorf("unknown provider %q (want openai, anthropic, or rest)", provider)
+	}
+
+	return fmt.Sprintf(`# Augustus configuration, scaffolded by 'augustus config init --provider %s'.
+# Validate with: augustus config validate <this file>
+# Run with:      augustus scan <generator> --probe <probe> --config-file <this file>
+
+run:
+  max_attempts: 3
+  timeout: "30s"
+  concurrency: 10
+  probe_timeout: "5m"
+
+%s
+# A small, fast-running starting set of probes. Add more with --probe/--all,
+# or 'augustus list' to see everything registered.
+probes:
+  settings:
+    dan.Dan_11_0: {}
+    grandma.Win10: {}
+    malwaregen.TopLevel: {}
+
+detectors:
+  always:
+    enabled: true
+
+output:
+  format: "jsonl"
+  path: "./results.jsonl"
+`, provider, generatorBlock), nil
+}
+
+// ConfigValidateCmd checks a YAML config file's structure without running a
+// scan, wrapping the same strict validation --scan --strict-config uses.
+type ConfigValidateCmd struct {
+	File string `arg:"" help:"YAML config file to validate." type:"existingfile"`
+}
+
+func (c *ConfigValidateCmd) Run() error {
+	return validateConfigFile(c.File, os.Stdout)
+}
+
+// validateConfigFile is the testable core of ConfigValidateCmd.Run.
+func validateConfigFile(path string, out io.Writer) error {
+	if _, err := loadScanYAMLConfig(path, true); err != nil {
+		return fmt.Errorf("%s is invalid: %w", path, err)
+	}
+
+	fmt.Fprintf(out, "%s is valid\n", path)
+	return nil
+}
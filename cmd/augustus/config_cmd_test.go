@@ -0,0 +1,87 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaffoldConfig_KnownProviders(t *testing.T) {
+	for _, provider := range []string{"openai", "anthropic", "rest"} {
+		t.Run(provider, func(t *testing.T) {
+			content, err := scaffoldConfig(provider)
+			require.NoError(t, err)
+			assert.Contains(t, content, "run:")
+			assert.Contains(t, content, "generators:")
+			assert.Contains(t, content, "probes:")
+			assert.Contains(t, content, "API_KEY}")
+		})
+	}
+}
+
+func TestScaffoldConfig_UnknownProvider(t *testing.T) {
+	_, err := scaffoldConfig("does-not-exist")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown provider")
+}
+
+func TestConfigInitCmd_WritesScaffoldAndRefusesOverwrite(t *testing.T) {
+	tmpDir := t.TempDir()
+	outPath := filepath.Join(tmpDir, "config.yaml")
+
+	cmd := &ConfigInitCmd{Provider: "openai", Output: outPath}
+	require.NoError(t, cmd.Run())
+
+	data, err := os.ReadFile(outPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "openai.OpenAI")
+
+	err = (&ConfigInitCmd{Provider: "openai", Output: outPath}).Run()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+
+	force := &ConfigInitCmd{Provider: "anthropic", Output: outPath, Force: true}
+	require.NoError(t, force.Run())
+	data, err = os.ReadFile(outPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "anthropic.Anthropic")
+}
+
+func TestValidateConfigFile_ScaffoldedConfigPasses(t *testing.T) {
+	t.Setenv("OPENAI_API_KEY", "test-key")
+
+	tmpDir := t.TempDir()
+	outPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, (&ConfigInitCmd{Provider: "openai", Output: outPath}).Run())
+
+	var out strings.Builder
+	err := validateConfigFile(outPath, &out)
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "is valid")
+}
+
+func TestValidateConfigFile_RejectsUnknownKey(t *testing.T) {
+	tmpDir := t.TempDir()
+	outPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(outPath, []byte("runn:\n  max_attempts: 5\n"), 0644))
+
+	var out strings.Builder
+	err := validateConfigFile(outPath, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is invalid")
+}
+
+func TestValidateConfigFile_RejectsUnknownProbeName(t *testing.T) {
+	tmpDir := t.TempDir()
+	outPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(outPath, []byte("probes:\n  settings:\n    does.NotExist: {}\n"), 0644))
+
+	var out strings.Builder
+	err := validateConfigFile(outPath, &out)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does.NotExist")
+}
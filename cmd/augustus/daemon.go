@@ -0,0 +1,352 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/config"
+	"github.com/praetorian-inc/augustus/pkg/cronsched"
+	"github.com/praetorian-inc/augustus/pkg/harnesses"
+	"github.com/praetorian-inc/augustus/pkg/results"
+)
+
+// DaemonCmd runs recurring scans on a cron schedule, rotating result files
+// and tracking how the pass/fail counts shift between consecutive runs.
+// Teams that previously wrapped augustus in cron plus shell glue can use
+// this instead.
+type DaemonCmd struct {
+	// Required
+	Generator string `arg:"" help:"Generator name (e.g., openai.OpenAI, anthropic.Anthropic)." required:""`
+
+	// Probe selection (mutually exclusive, mirrors ScanCmd)
+	Probe      []string `help:"Probe names (repeatable)." short:"p" name:"probe" group:"probes" xor:"probe-selection"`
+	ProbesGlob string   `help:"Comma-separated probe glob patterns (e.g., 'dan.*,encoding.*')." name:"probes-glob" group:"probes" xor:"probe-selection"`
+	All        bool     `help:"Run all registered probes." group:"probes" xor:"probe-selection"`
+
+	// Detector selection
+	Detectors     []string `help:"Detector names (repeatable)." name:"detector"`
+	DetectorsGlob string   `help:"Comma-separated detector glob patterns." name:"detectors-glob"`
+
+	// Configuration
+	ConfigFile string `help:"YAML config file path (generator settings, probe/detector overrides)." type:"existingfile" name:"config" required:""`
+	Harness    string `help:"Harness name (default: probewise.Probewise)." default:"probewise.Probewise"`
+
+	// Scheduling
+	Schedule string `help:"Cron schedule for recurring scans (5-field: minute hour dom month dow)." required:""`
+	Once     bool   `help:"Run a single scan immediately and exit, instead of waiting on the schedule."`
+
+	// Results
+	OutputDir string `help:"Directory for rotated per-run JSONL result files." default:"./augustus-daemon" type:"path" name:"output-dir"`
+	Keep      int    `help:"Number of rotated result files to retain (0 = keep all)." default:"30"`
+
+	// Status endpoint
+	StatusAddr string `help:"Address for the daemon's HTTP status endpoint (empty disables it)." name:"status-addr"`
+}
+
+func (d *DaemonCmd) Run() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return runDaemon(ctx, d, os.Stderr)
+}
+
+// runSummary is a compact pass/fail tally for one daemon run, used to
+// compute deltas between consecutive runs without re-parsing full attempts.
+type runSummary struct {
+	Total   int            `json:"total"`
+	Passed  int            `json:"passed"`
+	Failed  int            `json:"failed"`
+	ByProbe map[string]int `json:"failed_by_probe,omitempty"` // probe -> failed count
+}
+
+func summarizeAttempts(attempts []*attempt.Attempt) runSummary {
+	sum := runSummary{ByProbe: make(map[string]int)}
+	for _, r := range results.ToAttemptResults(attempts) {
+		sum.Total++
+		if r.Passed {
+			sum.Passed++
+		} else {
+			sum.Failed++
+			sum.ByProbe[r.Probe]++
+		}
+	}
+	return sum
+}
+
+// runDelta describes how failures shifted between two consecutive runs.
+type runDelta struct {
+	PreviousFailed int      `json:"previous_failed"`
+	CurrentFailed  int      `json:"current_failed"`
+	NewlyFailing   []string `json:"newly_failing_probes,omitempty"`
+	NowPassing     []string `json:"now_passing_probes,omitempty"`
+}
+
+func computeDelta(prev, curr runSummary) runDelta {
+	delta := runDelta{PreviousFailed: prev.Failed, CurrentFailed: curr.Failed}
+
+	for probe := range curr.ByProbe {
+		if prev.ByProbe[probe] == 0 {
+			delta.NewlyFailing = append(delta.NewlyFailing, probe)
+		}
+	}
+	for probe := range prev.ByProbe {
+		if curr.ByProbe[probe] == 0 {
+			delta.NowPassing = append(delta.NowPassing, probe)
+		}
+	}
+	sort.Strings(delta.NewlyFailing)
+	sort.Strings(delta.NowPassing)
+
+	return delta
+}
+
+// daemonStatus holds the state served by the status endpoint. All access
+// goes through its methods, since the HTTP handler and the scan loop run
+// on different goroutines.
+type daemonStatus struct {
+	mu          sync.RWMutex
+	lastRunTime time.Time
+	nextRunTime time.Time
+	lastSummary *runSummary
+	lastDelta   *runDelta
+	lastError   string
+}
+
+type daemonStatusView struct {
+	LastRunTime time.Time   `json:"last_run_time,omitempty"`
+	NextRunTime time.Time   `json:"next_run_time,omitempty"`
+	LastSummary *runSummary `json:"last_summary,omitempty"`
+	LastDelta   *runDelta   `json:"last_delta,omitempty"`
+	LastError   string      `json:"last_error,omitempty"`
+}
+
+func (s *daemonStatus) recordRun(at time.Time, summary runSummary, delta *runDelta, runErr error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastRunTime = at
+	s.lastSummary = &summary
+	s.lastDelta = delta
+	if runErr != nil {
+		s.lastError = runErr.Error()
+	} else {
+		s.lastError = ""
+	}
+}
+
+func (s *daemonStatus) setNextRunTime(t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextRunTime = t
+}
+
+func (s *daemonStatus) previousSummary() *runSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lastSummary
+}
+
+func (s *daemonStatus) view() daemonStatusView {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return daemonStatusView{
+		LastRunTime: s.lastRunTime,
+		NextRunTime: s.nextRunTime,
+		LastSummary: s.lastSummary,
+		LastDelta:   s.lastDelta,
+		LastError:   s.lastError,
+	}
+}
+
+func (s *daemonStatus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(s.view())
+}
+
+// quietEvaluator collects attempts for a daemon run without printing
+// anything to stdout; rendering is the scan command's job, not the
+// daemon's.
+type quietEvaluator struct {
+	attempts []*attempt.Attempt
+}
+
+func (q *quietEvaluator) Evaluate(_ context.Context, attempts []*attempt.Attempt) error {
+	q.attempts = attempts
+	return nil
+}
+
+var _ harnesses.Evaluator = (*quietEvaluator)(nil)
+
+// runDaemon parses the schedule and loops, running a scan at each tick
+// until ctx is cancelled (or, with --once, after the first run).
+func runDaemon(ctx context.Context, d *DaemonCmd, out io.Writer) error {
+	sched, err := cronsched.Parse(d.Schedule)
+	if err != nil {
+		return fmt.Errorf("invalid --schedule: %w", err)
+	}
+
+	if err := os.MkdirAll(d.OutputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	logStartupDiagnostics(out, "daemon")
+
+	status := &daemonStatus{}
+	if prevPath, ok := latestRotationFile(d.OutputDir); ok {
+		if prevSummary, err := loadRunSummary(prevPath); err == nil {
+			status.lastSummary = &prevSummary
+		}
+	}
+
+	ready := &readiness{}
+	ready.setReady(true, "")
+
+	if d.StatusAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/", status)
+		registerHealthRoutes(mux, ready)
+		srv := &http.Server{Addr: d.StatusAddr, Handler: mux}
+		go func() {
+			if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fmt.Fprintf(out, "daemon: status endpoint stopped: %v\n", err)
+			}
+		}()
+		defer srv.Shutdown(context.Background())
+	}
+
+	go watchConfigReload(ctx, "daemon", d.ConfigFile, false, out, func(*config.Config) {})
+
+	for {
+		if err := runDaemonScan(ctx, d, status); err != nil {
+			fmt.Fprintf(out, "daemon: scan run failed: %v\n", err)
+		}
+
+		if d.Once {
+			return nil
+		}
+
+		next := sched.Next(time.Now())
+		status.setNextRunTime(next)
+		fmt.Fprintf(out, "daemon: next scan scheduled for %s\n", next.Format(time.RFC3339))
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Until(next)):
+		}
+	}
+}
+
+// runDaemonScan executes a single recurring scan, rotates the result file,
+// and updates status with the run's summary and delta vs the previous run.
+func runDaemonScan(ctx context.Context, d *DaemonCmd, status *daemonStatus) error {
+	scanCmd := &ScanCmd{
+		Generator:     d.Generator,
+		Probe:         d.Probe,
+		ProbesGlob:    d.ProbesGlob,
+		All:           d.All,
+		Detectors:     d.Detectors,
+		DetectorsGlob: d.DetectorsGlob,
+		ConfigFile:    d.ConfigFile,
+		Harness:       d.Harness,
+	}
+	cfg := scanCmd.loadScanConfig()
+	if err := scanCmd.expandGlobPatterns(cfg); err != nil {
+		return err
+	}
+
+	eval := &quietEvaluator{}
+	runErr := runScan(ctx, cfg, eval)
+
+	runAt := time.Now()
+	summary := summarizeAttempts(eval.attempts)
+
+	var delta *runDelta
+	if runErr == nil {
+		if prev := status.previousSummary(); prev != nil {
+			dl := computeDelta(*prev, summary)
+			delta = &dl
+		}
+
+		rotationPath := filepath.Join(d.OutputDir, fmt.Sprintf("scan-%s.jsonl", runAt.UTC().Format("20060102T150405Z")))
+		if err := results.WriteJSONL(rotationPath, eval.attempts); err != nil {
+			return fmt.Errorf("failed to write rotated results: %w", err)
+		}
+		if err := pruneRotations(d.OutputDir, d.Keep); err != nil {
+			return fmt.Errorf("failed to prune old results: %w", err)
+		}
+	}
+
+	status.recordRun(runAt, summary, delta, runErr)
+	return runErr
+}
+
+// latestRotationFile returns the most recently rotated result file in dir,
+// if any. File names sort chronologically since they're stamped with an
+// RFC3339-like timestamp.
+func latestRotationFile(dir string) (string, bool) {
+	matches, err := filepath.Glob(filepath.Join(dir, "scan-*.jsonl"))
+	if err != nil || len(matches) == 0 {
+		return "", false
+	}
+	sort.Strings(matches)
+	return matches[len(matches)-1], true
+}
+
+// loadRunSummary reconstructs a runSummary from a previously rotated JSONL
+// result file, so deltas survive a daemon restart.
+func loadRunSummary(path string) (runSummary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return runSummary{}, err
+	}
+	defer file.Close()
+
+	sum := runSummary{ByProbe: make(map[string]int)}
+	decoder := json.NewDecoder(file)
+	for decoder.More() {
+		var r results.AttemptResult
+		if err := decoder.Decode(&r); err != nil {
+			return runSummary{}, err
+		}
+		sum.Total++
+		if r.Passed {
+			sum.Passed++
+		} else {
+			sum.Failed++
+			sum.ByProbe[r.Probe]++
+		}
+	}
+	return sum, nil
+}
+
+// pruneRotations deletes the oldest rotated result files beyond keep. A
+// keep of 0 disables pruning.
+func pruneRotations(dir string, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+	matches, err := filepath.Glob(filepath.Join(dir, "scan-*.jsonl"))
+	if err != nil {
+		return err
+	}
+	if len(matches) <= keep {
+		return nil
+	}
+	sort.Strings(matches)
+	for _, path := range matches[:len(matches)-keep] {
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+func passingAttempt(probe string) *attempt.Attempt {
+	a := attempt.New("prompt")
+	a.Probe = probe
+	a.Status = attempt.StatusComplete
+	a.Scores = []float64{0.0}
+	return a
+}
+
+func failingAttempt(probe string) *attempt.Attempt {
+	a := attempt.New("prompt")
+	a.Probe = probe
+	a.Status = attempt.StatusComplete
+	a.Scores = []float64{1.0}
+	return a
+}
+
+func TestSummarizeAttempts(t *testing.T) {
+	attempts := []*attempt.Attempt{
+		passingAttempt("dan.Dan_11_0"),
+		failingAttempt("dan.Dan_11_0"),
+		failingAttempt("goodside.Tag"),
+	}
+
+	sum := summarizeAttempts(attempts)
+
+	if sum.Total != 3 || sum.Passed != 1 || sum.Failed != 2 {
+		t.Fatalf("summarizeAttempts() = %+v, want {Total:3 Passed:1 Failed:2}", sum)
+	}
+	if sum.ByProbe["dan.Dan_11_0"] != 1 {
+		t.Errorf("ByProbe[dan.Dan_11_0] = %d, want 1", sum.ByProbe["dan.Dan_11_0"])
+	}
+	if sum.ByProbe["goodside.Tag"] != 1 {
+		t.Errorf("ByProbe[goodside.Tag] = %d, want 1", sum.ByProbe["goodside.Tag"])
+	}
+}
+
+func TestComputeDelta_NewlyFailingAndNowPassing(t *testing.T) {
+	prev := runSummary{Failed: 1, ByProbe: map[string]int{"dan.Dan_11_0": 1}}
+	curr := runSummary{Failed: 1, ByProbe: map[string]int{"goodside.Tag": 1}}
+
+	delta := computeDelta(prev, curr)
+
+	if delta.PreviousFailed != 1 || delta.CurrentFailed != 1 {
+		t.Errorf("delta failed counts = %+v", delta)
+	}
+	if len(delta.NewlyFailing) != 1 || delta.NewlyFailing[0] != "goodside.Tag" {
+		t.Errorf("NewlyFailing = %v, want [goodside.Tag]", delta.NewlyFailing)
+	}
+	if len(delta.NowPassing) != 1 || delta.NowPassing[0] != "dan.Dan_11_0" {
+		t.Errorf("NowPassing = %v, want [dan.Dan_11_0]", delta.NowPassing)
+	}
+}
+
+func TestRunDaemonScan_RotatesAndSummarizes(t *testing.T) {
+	dir := t.TempDir()
+	d := &DaemonCmd{
+		Generator:  "test.Repeat",
+		Probe:      []string{"test.Test"},
+		Detectors:  []string{"always.Pass"},
+		Harness:    "probewise.Probewise",
+		ConfigFile: "",
+		OutputDir:  dir,
+		Keep:       5,
+	}
+	status := &daemonStatus{}
+
+	if err := runDaemonScan(context.Background(), d, status); err != nil {
+		t.Fatalf("runDaemonScan() error = %v", err)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "scan-*.jsonl"))
+	if len(matches) != 1 {
+		t.Fatalf("rotated files = %d, want 1", len(matches))
+	}
+
+	view := status.view()
+	if view.LastSummary == nil || view.LastSummary.Total == 0 {
+		t.Fatal("status.view().LastSummary should be populated after a run")
+	}
+}
+
+func TestPruneRotations_KeepsOnlyNewest(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 5; i++ {
+		path := filepath.Join(dir, "scan-"+string(rune('a'+i))+".jsonl")
+		if err := os.WriteFile(path, []byte("{}\n"), 0o644); err != nil {
+			t.Fatalf("WriteFile() error = %v", err)
+		}
+	}
+
+	if err := pruneRotations(dir, 2); err != nil {
+		t.Fatalf("pruneRotations() error = %v", err)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "scan-*.jsonl"))
+	if len(matches) != 2 {
+		t.Fatalf("remaining files = %d, want 2", len(matches))
+	}
+}
+
+func TestPruneRotations_ZeroKeepIsNoOp(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "scan-a.jsonl"), []byte("{}\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	if err := pruneRotations(dir, 0); err != nil {
+		t.Fatalf("pruneRotations() error = %v", err)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "scan-*.jsonl"))
+	if len(matches) != 1 {
+		t.Fatalf("remaining files = %d, want 1 (keep=0 should not prune)", len(matches))
+	}
+}
+
+func TestDaemonStatus_ServeHTTP(t *testing.T) {
+	status := &daemonStatus{}
+	status.recordRun(time.Now(), runSummary{Total: 2, Passed: 1, Failed: 1}, nil, nil)
+
+	srv := httptest.NewServer(status)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("GET status endpoint: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status code = %d, want 200", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+}
+
+func TestRunDaemon_OnceRunsExactlyOneScan(t *testing.T) {
+	dir := t.TempDir()
+	d := &DaemonCmd{
+		Generator: "test.Repeat",
+		Probe:     []string{"test.Test"},
+		Detectors: []string{"always.Pass"},
+		Harness:   "probewise.Probewise",
+		Schedule:  "0 2 * * *",
+		Once:      true,
+		OutputDir: dir,
+		Keep:      5,
+	}
+
+	if err := runDaemon(context.Background(), d, os.Stderr); err != nil {
+		t.Fatalf("runDaemon() error = %v", err)
+	}
+
+	matches, _ := filepath.Glob(filepath.Join(dir, "scan-*.jsonl"))
+	if len(matches) != 1 {
+		t.Fatalf("rotated files after one --once run = %d, want 1", len(matches))
+	}
+}
+
+func TestRunDaemon_InvalidSchedule(t *testing.T) {
+	d := &DaemonCmd{
+		Generator: "test.Repeat",
+		Probe:     []string{"test.Test"},
+		Schedule:  "not a schedule",
+		OutputDir: t.TempDir(),
+	}
+
+	if err := runDaemon(context.Background(), d, os.Stderr); err == nil {
+		t.Fatal("runDaemon() error = nil, want error for invalid --schedule")
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/config"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+// dryRunGenerator is a stub types.Generator used by --dry-run. Probes (and
+// the buffs wrapping them) need a Generator to produce attempts, but a dry
+// run must never reach a real endpoint, so it always returns an empty
+// response instead of making a call.
+type dryRunGenerator struct{}
+
+// Generate returns n empty responses without contacting any provider.
+func (d *dryRunGenerator) Generate(_ context.Context, _ *attempt.Conversation, n int) ([]attempt.Message, error) {
+	if n <= 0 {
+		n = 1
+	}
+	responses := make([]attempt.Message, n)
+	for i := range responses {
+		responses[i] = attempt.NewAssistantMessage("")
+	}
+	return responses, nil
+}
+
+// ClearHistory is a no-op for dryRunGenerator.
+func (d *dryRunGenerator) ClearHistory() {}
+
+// Name returns the generator's fully qualified name.
+func (d *dryRunGenerator) Name() string { return "dryrun.NoCall" }
+
+// Description returns a human-readable description.
+func (d *dryRunGenerator) Description() string {
+	return "Stub generator used by --dry-run; never makes real API calls"
+}
+
+// dryRunPrompt is the exported record for a single prompt that --dry-run
+// would have sent, after buff transformation.
+type dryRunPrompt struct {
+	Probe  string `json:"probe"`
+	Prompt string `json:"prompt"`
+}
+
+// runDryRunScan instantiates probes and buffs exactly as a real scan would,
+// runs them against a stub generator to capture the final (buff-transformed)
+// prompts, and prints/exports them without ever calling a real generator.
+func runDryRunScan(ctx context.Context, cfg *scanConfig, yamlCfg *config.Config, resolved *config.ResolvedConfig) error {
+	probeNames := cfg.probeNames
+	if cfg.allProbes {
+		probeNames = probes.List()
+	}
+
+	probeList, err := createProbes(probeNames, yamlCfg, cfg.generatorName, resolved.GeneratorConfig)
+	if err != nil {
+		return err
+	}
+
+	buffNames, buffParamSweeps, err := resolveBuffSweeps(cfg, yamlCfg)
+	if err != nil {
+		return err
+	}
+	probeList, err = createAndApplyBuffs(probeList, buffNames, buffParamSweeps, yamlCfg)
+	if err != nil {
+		return err
+	}
+
+	gen := &dryRunGenerator{}
+	var records []dryRunPrompt
+	for _, probe := range probeList {
+		attempts, err := probe.Probe(ctx, gen)
+		if err != nil {
+			return fmt.Errorf("probe %s failed during dry run: %w", probe.Name(), err)
+		}
+		for _, a := range attempts {
+			records = append(records, dryRunPrompt{Probe: probe.Name(), Prompt: a.Prompt})
+		}
+	}
+
+	switch cfg.outputFormat {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(map[string]any{"prompts": records, "count": len(records)}); err != nil {
+			return fmt.Errorf("failed to encode prompts: %w", err)
+		}
+	case "jsonl":
+		encoder := json.NewEncoder(os.Stdout)
+		for _, r := range records {
+			if err := encoder.Encode(r); err != nil {
+				return fmt.Errorf("failed to encode prompt: %w", err)
+			}
+		}
+	default:
+		for _, r := range records {
+			fmt.Printf("[%s] %s\n", r.Probe, r.Prompt)
+		}
+		fmt.Fprintf(os.Stderr, "\n%d prompt(s) across %d probe(s) - dry run, no generator calls made\n", len(records), len(probeList))
+	}
+
+	if cfg.outputFile != "" {
+		if err := writeDryRunJSONL(cfg.outputFile, records); err != nil {
+			return fmt.Errorf("failed to write JSONL output: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "\nJSONL output written to: %s\n", cfg.outputFile)
+	}
+
+	return nil
+}
+
+// writeDryRunJSONL writes dry-run prompt records to a JSONL file, one JSON
+// object per line, mirroring pkg/results.WriteJSONL's layout.
+func writeDryRunJSONL(outputPath string, records []dryRunPrompt) error {
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create parent directories: %w", err)
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	for _, r := range records {
+		if err := encoder.Encode(r); err != nil {
+			return fmt.Errorf("failed to encode record: %w", err)
+		}
+	}
+
+	return nil
+}
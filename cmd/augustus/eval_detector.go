@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/evaldetector"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// EvalDetectorCmd measures a detector's accuracy against a labeled corpus.
+type EvalDetectorCmd struct {
+	Name      string  `arg:"" help:"Detector name (e.g., dan.DAN)."`
+	Corpus    string  `help:"Path to a labeled JSONL corpus (lines of {\"prompt\",\"output\",\"label\"}); see examples/eval-detector-corpus.jsonl for a starter." required:"" type:"existingfile"`
+	Config    string  `help:"JSON config for the detector." short:"c"`
+	Threshold float64 `help:"Score above which an example is classified vulnerable." default:"0.5"`
+}
+
+func (e *EvalDetectorCmd) Run() error {
+	return runEvalDetector(context.Background(), e, os.Stdout)
+}
+
+// runEvalDetector is the testable core of EvalDetectorCmd.Run.
+func runEvalDetector(ctx context.Context, cfg *EvalDetectorCmd, out io.Writer) error {
+	detCfg := registry.Config{}
+	if cfg.Config != "" {
+		if err := json.Unmarshal([]byte(cfg.Config), &detCfg); err != nil {
+			return fmt.Errorf("invalid detector config JSON: %w", err)
+		}
+	}
+
+	det, err := detectors.Create(cfg.Name, detCfg)
+	if err != nil {
+		return fmt.Errorf("failed to create detector %s: %w", cfg.Name, err)
+	}
+
+	corpus, err := evaldetector.LoadCorpus(cfg.Corpus)
+	if err != nil {
+		return err
+	}
+
+	report, err := evaldetector.Evaluate(ctx, det, corpus, cfg.Threshold)
+	if err != nil {
+		return err
+	}
+
+	printEvalDetectorReport(out, cfg.Name, report)
+	return nil
+}
+
+// printEvalDetectorReport renders a confusion matrix and precision/recall/F1
+// summary, matching the tabwriter-based layout used elsewhere for terminal
+// output (see internal/sinks/stdout).
+func printEvalDetectorReport(out io.Writer, detectorName string, report evaldetector.Report) {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "Detector\t%s\n", detectorName)
+	fmt.Fprintf(w, "Corpus size\t%d\n", report.Total())
+	fmt.Fprintf(w, "Threshold\t%.2f\n", report.Threshold)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "\tPredicted Vulnerable\tPredicted Safe")
+	fmt.Fprintf(w, "Actual Vulnerable\t%d (TP)\t%d (FN)\n", report.TruePositives, report.FalseNegatives)
+	fmt.Fprintf(w, "Actual Safe\t%d (FP)\t%d (TN)\n", report.FalsePositives, report.TrueNegatives)
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Precision\t%.4f\n", report.Precision())
+	fmt.Fprintf(w, "Recall\t%.4f\n", report.Recall())
+	fmt.Fprintf(w, "F1\t%.4f\n", report.F1())
+	w.Flush()
+}
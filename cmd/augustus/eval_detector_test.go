@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestCorpus(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "corpus.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestRunEvalDetector_AlwaysPassReportsAllFalseNegatives(t *testing.T) {
+	corpusPath := writeTestCorpus(t, `{"prompt":"p1","output":"o1","label":1}
+{"prompt":"p2","output":"o2","label":0}
+`)
+
+	cfg := &EvalDetectorCmd{
+		Name:      "always.Pass",
+		Corpus:    corpusPath,
+		Threshold: 0.5,
+	}
+
+	var out bytes.Buffer
+	err := runEvalDetector(context.Background(), cfg, &out)
+	require.NoError(t, err)
+
+	report := out.String()
+	assert.Contains(t, report, "always.Pass")
+	assert.Contains(t, report, "Corpus size")
+	assert.Contains(t, report, "2")
+	// always.Pass never flags anything vulnerable, so the one vulnerable
+	// example is a false negative and the one safe example is a true negative.
+	assert.Contains(t, report, "0 (TP)")
+	assert.Contains(t, report, "1 (FN)")
+	assert.Contains(t, report, "0 (FP)")
+	assert.Contains(t, report, "1 (TN)")
+}
+
+func TestRunEvalDetector_UnknownDetectorErrors(t *testing.T) {
+	corpusPath := writeTestCorpus(t, `{"output":"o1","label":1}`)
+
+	cfg := &EvalDetectorCmd{
+		Name:   "nonexistent.Detector",
+		Corpus: corpusPath,
+	}
+
+	var out bytes.Buffer
+	err := runEvalDetector(context.Background(), cfg, &out)
+	require.Error(t, err)
+}
+
+func TestRunEvalDetector_MissingCorpusErrors(t *testing.T) {
+	cfg := &EvalDetectorCmd{
+		Name:   "always.Pass",
+		Corpus: filepath.Join(t.TempDir(), "missing.jsonl"),
+	}
+
+	var out bytes.Buffer
+	err := runEvalDetector(context.Background(), cfg, &out)
+	require.Error(t, err)
+}
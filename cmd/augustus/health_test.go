@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/config"
+)
+
+func TestRegisterHealthRoutes_HealthzAlwaysOK(t *testing.T) {
+	mux := http.NewServeMux()
+	registerHealthRoutes(mux, &readiness{})
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want 200", rec.Code)
+	}
+}
+
+func TestRegisterHealthRoutes_ReadyzReflectsState(t *testing.T) {
+	r := &readiness{}
+	mux := http.NewServeMux()
+	registerHealthRoutes(mux, r)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("status before ready = %d, want 503", rec.Code)
+	}
+
+	r.setReady(true, "")
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("status after ready = %d, want 200", rec.Code)
+	}
+}
+
+func TestWatchConfigReload_ReloadsOnSIGHUP(t *testing.T) {
+	dir := t.TempDir()
+	configPath := dir + "/config.yaml"
+	if err := os.WriteFile(configPath, []byte("generator:\n  name: test.Repeat\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reloaded := make(chan *config.Config, 1)
+	var out bytes.Buffer
+	go watchConfigReload(ctx, "test", configPath, false, &out, func(cfg *config.Config) {
+		reloaded <- cfg
+	})
+
+	// Give the goroutine time to register its signal handler before sending.
+	time.Sleep(50 * time.Millisecond)
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatalf("sending SIGHUP: %v", err)
+	}
+
+	select {
+	case cfg := <-reloaded:
+		if cfg == nil {
+			t.Error("onReload called with nil config")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("onReload was never called after SIGHUP")
+	}
+}
+
+func TestWatchConfigReload_NoConfigFileLogsDiagnosticsOnSIGHUP(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var out bytes.Buffer
+	called := make(chan struct{}, 1)
+	go watchConfigReload(ctx, "test", "", false, &out, func(*config.Config) {
+		called <- struct{}{}
+	})
+
+	time.Sleep(50 * time.Millisecond)
+	if err := syscall.Kill(syscall.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatalf("sending SIGHUP: %v", err)
+	}
+
+	select {
+	case <-called:
+		t.Fatal("onReload should not be called when no config file is configured")
+	case <-time.After(200 * time.Millisecond):
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"golang.org/x/term"
+
+	"github.com/praetorian-inc/augustus/pkg/results"
+)
+
+// InspectCmd pretty-prints a sample of attempts from a scan results JSONL
+// file, filtered by probe and pass/fail, so triaging a run doesn't require
+// opening the HTML report or reaching for jq.
+type InspectCmd struct {
+	ResultsFile string `arg:"" help:"JSONL results file written by 'augustus scan --output'." type:"existingfile"`
+	Probe       string `help:"Only show attempts from this probe." name:"probe"`
+	Failed      bool   `help:"Only show attempts where the model was vulnerable." name:"failed" xor:"status"`
+	Passed      bool   `help:"Only show attempts that passed (model resisted)." name:"passed" xor:"status"`
+	Limit       int    `help:"Maximum number of attempts to print." name:"limit" default:"10"`
+	NoColor     bool   `help:"Disable ANSI color output." name:"no-color"`
+	Query       string `help:"jq-style query (see pkg/jsonpath) evaluated over the matched attempts instead of the default pretty-printed view, e.g. '$[?(@.passed==false)].prompt'." name:"query"`
+}
+
+func (c *InspectCmd) Run() error {
+	return c.run(os.Stdout)
+}
+
+func (c *InspectCmd) run(out io.Writer) error {
+	all, err := results.ReadAttemptResultsJSONL(c.ResultsFile)
+	if err != nil {
+		return err
+	}
+
+	matched := make([]results.AttemptResult, 0, len(all))
+	for _, r := range all {
+		if c.Probe != "" && r.Probe != c.Probe {
+			continue
+		}
+		if c.Failed && r.Passed {
+			continue
+		}
+		if c.Passed && !r.Passed {
+			continue
+		}
+		matched = append(matched, r)
+	}
+
+	shown := matched
+	if c.Limit > 0 && len(shown) > c.Limit {
+		shown = shown[:c.Limit]
+	}
+
+	if c.Query != "" {
+		return c.runQuery(out, shown)
+	}
+
+	useColor := !c.NoColor && isTerminalWriter(out)
+	for i, r := range shown {
+		printAttemptResult(out, i+1, r, useColor)
+	}
+
+	fmt.Fprintf(out, "%d of %d matching attempts shown\n", len(shown), len(matched))
+	return nil
+}
+
+// runQuery evaluates --query against the already-filtered/limited attempts
+// and prints the result as JSON, in place of the pretty-printed view - for
+// piping into another tool rather than reading in a terminal.
+func (c *InspectCmd) runQuery(out io.Writer, shown []results.AttemptResult) error {
+	result, err := applyQuery(shown, c.Query)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(result)
+}
+
+// isTerminalWriter reports whether out is a terminal, so color codes are only
+// emitted for interactive use and not when output is piped or redirected.
+func isTerminalWriter(out io.Writer) bool {
+	f, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	return term.IsTerminal(int(f.Fd()))
+}
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiDim    = "\x1b[2m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiCyan   = "\x1b[36m"
+)
+
+func colorize(s, code string, enabled bool) string {
+	if !enabled {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+func printAttemptResult(out io.Writer, n int, r results.AttemptResult, color bool) {
+	verdict := colorize("PASSED", ansiGreen, color)
+	if !r.Passed {
+		verdict = colorize("FAILED", ansiRed, color)
+	}
+
+	fmt.Fprintf(out, "%s %s  probe=%s detector=%s status=%s\n",
+		colorize(fmt.Sprintf("[%d]", n), ansiBold, color), verdict, r.Probe, r.Detector, r.Status)
+	fmt.Fprintf(out, "  %s %s\n", colorize("Prompt:", ansiCyan, color), r.Prompt)
+	fmt.Fprintf(out, "  %s %s\n", colorize("Response:", ansiCyan, color), r.Response)
+	if len(r.Scores) > 0 {
+		fmt.Fprintf(out, "  %s %v\n", colorize("Scores:", ansiCyan, color), r.Scores)
+	}
+	if r.Error != "" {
+		fmt.Fprintf(out, "  %s %s\n", colorize("Error:", ansiYellow, color), r.Error)
+	}
+	if len(r.Metadata) > 0 {
+		fmt.Fprintf(out, "  %s %s\n", colorize("Metadata:", ansiCyan, color), formatMetadata(r.Metadata))
+	}
+	fmt.Fprintln(out, colorize("---", ansiDim, color))
+}
+
+// formatMetadata renders metadata as sorted key=value pairs for stable,
+// diffable output across runs.
+func formatMetadata(m map[string]any) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	s := ""
+	for i, k := range keys {
+		if i > 0 {
+			s += " "
+		}
+		s += fmt.Sprintf("%s=%v", k, m[k])
+	}
+	return s
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeInspectFixture(t *testing.T, dir string) string {
+	t.Helper()
+	path := filepath.Join(dir, "results.jsonl")
+	require.NoError(t, results.WriteAttemptResultsJSONL(path, []results.AttemptResult{
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Prompt: "prompt1", Response: "response1", Passed: true, Scores: []float64{0.1}},
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Prompt: "prompt2", Response: "response2", Passed: false, Scores: []float64{0.9}},
+		{Probe: "encoding.Base64", Detector: "always.Pass", Prompt: "prompt3", Response: "response3", Passed: true, Scores: []float64{0.0}},
+	}))
+	return path
+}
+
+func TestInspectCmd_Run_NoFilters(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Limit: 10, NoColor: true}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	output := out.String()
+	assert.Contains(t, output, "prompt1")
+	assert.Contains(t, output, "prompt2")
+	assert.Contains(t, output, "prompt3")
+	assert.Contains(t, output, "3 of 3 matching attempts shown")
+}
+
+func TestInspectCmd_Run_FiltersByProbe(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Probe: "encoding.Base64", Limit: 10, NoColor: true}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	output := out.String()
+	assert.Contains(t, output, "prompt3")
+	assert.NotContains(t, output, "prompt1")
+	assert.Contains(t, output, "1 of 1 matching attempts shown")
+}
+
+func TestInspectCmd_Run_FiltersByFailed(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Failed: true, Limit: 10, NoColor: true}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	output := out.String()
+	assert.Contains(t, output, "prompt2")
+	assert.NotContains(t, output, "prompt1")
+	assert.NotContains(t, output, "prompt3")
+	assert.Contains(t, output, "FAILED")
+	assert.NotContains(t, output, "PASSED")
+}
+
+func TestInspectCmd_Run_FiltersByPassed(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Passed: true, Limit: 10, NoColor: true}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	output := out.String()
+	assert.Contains(t, output, "2 of 2 matching attempts shown")
+	assert.NotContains(t, output, "FAILED")
+}
+
+func TestInspectCmd_Run_RespectsLimit(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Limit: 1, NoColor: true}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	output := out.String()
+	assert.Contains(t, output, "prompt1")
+	assert.NotContains(t, output, "prompt2")
+	assert.Contains(t, output, "1 of 3 matching attempts shown")
+}
+
+func TestInspectCmd_Run_NoColorOmitsAnsiCodes(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Limit: 10, NoColor: true}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	assert.NotContains(t, out.String(), "\x1b[")
+}
+
+func TestInspectCmd_Run_NonTerminalWriterOmitsColorEvenWithoutNoColor(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Limit: 10}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	assert.NotContains(t, out.String(), "\x1b[", "a bytes.Buffer is never a terminal, so color should stay off")
+}
+
+func TestInspectCmd_Run_Query(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Limit: 10, Query: `$[?(@.passed==false)].prompt`}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	var got []string
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	assert.Equal(t, []string{"prompt2"}, got)
+}
+
+func TestInspectCmd_Run_QueryCombinesWithProbeFilter(t *testing.T) {
+	dir := t.TempDir()
+	path := writeInspectFixture(t, dir)
+
+	cmd := &InspectCmd{ResultsFile: path, Probe: "dan.Dan_11_0", Limit: 10, Query: "$[*].prompt"}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	var got []string
+	require.NoError(t, json.Unmarshal(out.Bytes(), &got))
+	assert.Equal(t, []string{"prompt1", "prompt2"}, got)
+}
+
+func TestInspectCmd_Run_IncludesMetadataAndError(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "results.jsonl")
+	require.NoError(t, results.WriteAttemptResultsJSONL(path, []results.AttemptResult{
+		{
+			Probe:    "dan.Dan_11_0",
+			Detector: "dan.DAN",
+			Prompt:   "prompt1",
+			Error:    "rate limited",
+			Metadata: map[string]any{"variant": "cot"},
+		},
+	}))
+
+	cmd := &InspectCmd{ResultsFile: path, Limit: 10, NoColor: true}
+	var out bytes.Buffer
+	require.NoError(t, cmd.run(&out))
+
+	output := out.String()
+	assert.Contains(t, output, "rate limited")
+	assert.Contains(t, output, "variant=cot")
+}
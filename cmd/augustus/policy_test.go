@@ -0,0 +1,112 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeTestPolicy(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestEnforcePolicy_NoPolicyFileIsNoOp(t *testing.T) {
+	cfg := &scanConfig{generatorName: "test.Repeat", probeNames: []string{"test.Test"}}
+
+	if err := enforcePolicy(cfg); err != nil {
+		t.Errorf("enforcePolicy() error = %v, want nil when no policy file is set", err)
+	}
+}
+
+func TestEnforcePolicy_DeniesDisallowedProbe(t *testing.T) {
+	path := writeTestPolicy(t, `
+environments:
+  prod:
+    allowed_probes: ["encoding.*"]
+    allowed_generators: ["*"]
+`)
+	cfg := &scanConfig{
+		generatorName: "test.Repeat",
+		probeNames:    []string{"test.Test"},
+		policyFile:    path,
+		policyEnv:     "prod",
+	}
+
+	if err := enforcePolicy(cfg); err == nil {
+		t.Fatal("enforcePolicy() error = nil, want denial for probe not in allowed_probes")
+	}
+}
+
+func TestEnforcePolicy_AllowsPermittedScan(t *testing.T) {
+	path := writeTestPolicy(t, `
+environments:
+  staging:
+    allowed_probes: ["test.*"]
+    allowed_generators: ["test.*"]
+`)
+	cfg := &scanConfig{
+		generatorName: "test.Repeat",
+		probeNames:    []string{"test.Test"},
+		policyFile:    path,
+		policyEnv:     "staging",
+	}
+
+	if err := enforcePolicy(cfg); err != nil {
+		t.Errorf("enforcePolicy() error = %v, want nil for permitted scan", err)
+	}
+}
+
+func TestEnforcePolicy_UndefinedEnvironment(t *testing.T) {
+	path := writeTestPolicy(t, `
+environments:
+  staging:
+    allowed_probes: ["*"]
+`)
+	cfg := &scanConfig{
+		generatorName: "test.Repeat",
+		probeNames:    []string{"test.Test"},
+		policyFile:    path,
+		policyEnv:     "prod",
+	}
+
+	if err := enforcePolicy(cfg); err == nil {
+		t.Fatal("enforcePolicy() error = nil, want error for undefined environment")
+	}
+}
+
+func TestEnforcePolicy_AllProbesExpandsBeforeChecking(t *testing.T) {
+	path := writeTestPolicy(t, `
+environments:
+  prod:
+    allowed_probes: ["test.*"]
+`)
+	cfg := &scanConfig{
+		generatorName: "test.Repeat",
+		allProbes:     true,
+		policyFile:    path,
+		policyEnv:     "prod",
+	}
+
+	// With --all, some registered probe (e.g. malwaregen.*) won't match
+	// "test.*", so the scan should be denied.
+	if err := enforcePolicy(cfg); err == nil {
+		t.Fatal("enforcePolicy() error = nil, want denial since --all includes probes outside allowed_probes")
+	}
+}
+
+func TestScanCmd_Validate_PolicyFlagsMustBeUsedTogether(t *testing.T) {
+	s := &ScanCmd{Generator: "test.Repeat", Probe: []string{"test.Test"}, PolicyFile: "policy.yaml"}
+	if err := s.Validate(); err == nil {
+		t.Fatal("Validate() error = nil, want error when --policy-file is set without --policy-env")
+	}
+
+	s = &ScanCmd{Generator: "test.Repeat", Probe: []string{"test.Test"}, PolicyEnv: "prod"}
+	if err := s.Validate(); err == nil {
+		t.Fatal("Validate() error = nil, want error when --policy-env is set without --policy-file")
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/praetorian-inc/augustus/pkg/jsonpath"
+	"github.com/praetorian-inc/augustus/pkg/results"
+)
+
+// applyQuery evaluates a jq-style query (see pkg/jsonpath) against a slice of
+// attempt results, rooted at the JSON array those results encode to. Shared
+// by 'scan --query' and 'inspect --query' so both commands support the same
+// query syntax over the same result shape.
+func applyQuery(resultList []results.AttemptResult, query string) (any, error) {
+	data, err := json.Marshal(resultList)
+	if err != nil {
+		return nil, fmt.Errorf("query: marshaling results: %w", err)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("query: decoding results: %w", err)
+	}
+
+	return jsonpath.Evaluate(decoded, query)
+}
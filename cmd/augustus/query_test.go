@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyQuery_FiltersFailedPrompts(t *testing.T) {
+	resultList := []results.AttemptResult{
+		{Probe: "dan.Dan_11_0", Prompt: "p1", Passed: true},
+		{Probe: "dan.Dan_11_0", Prompt: "p2", Passed: false},
+		{Probe: "encoding.Base64", Prompt: "p3", Passed: false},
+	}
+
+	got, err := applyQuery(resultList, `$[?(@.passed==false)].prompt`)
+	require.NoError(t, err)
+
+	prompts, ok := got.([]any)
+	require.True(t, ok, "expected a fanned-out slice for a filter query")
+	assert.Equal(t, []any{"p2", "p3"}, prompts)
+}
+
+func TestApplyQuery_InvalidQueryReturnsError(t *testing.T) {
+	resultList := []results.AttemptResult{{Probe: "dan.Dan_11_0", Prompt: "p1"}}
+
+	_, err := applyQuery(resultList, "$.nonexistent")
+	assert.Error(t, err)
+}
@@ -0,0 +1,271 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/buffs"
+	"github.com/praetorian-inc/augustus/pkg/config"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// replHelp is printed by the :help command.
+const replHelp = `commands:
+  :buff <name>      apply a buff to every payload sent from now on
+  :buff clear       remove all active buffs
+  :buffs            list active buffs
+  :detect <name>    run a detector on every response from now on
+  :detect clear     remove all active detectors
+  :detectors        list active detectors
+  :help             show this message
+  :quit, :exit      leave the repl
+
+anything else is sent to the generator as a payload.`
+
+// ReplCmd starts an interactive prompt for prototyping probes: type a
+// payload, optionally apply buffs, send it to a generator, and run
+// detectors on the response inline, without a full scan round-trip.
+type ReplCmd struct {
+	Generator  string   `arg:"" help:"Generator name (e.g., openai.OpenAI, anthropic.Anthropic)." required:""`
+	ConfigFile string   `help:"YAML config file path." type:"existingfile" name:"config-file"`
+	Config     string   `help:"JSON config for generator." short:"c"`
+	Model      string   `help:"Model name for generator (shorthand for --config '{\"model\":\"...\"}')." short:"m"`
+	Detector   []string `help:"Detector names to run on every response (repeatable)." name:"detector"`
+}
+
+func (r *ReplCmd) Run() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return runRepl(ctx, r, os.Stdin, os.Stdout)
+}
+
+// replSession holds the buffs and detectors currently active in a repl,
+// which the user can grow or clear between payloads via ":buff"/":detect".
+type replSession struct {
+	gen       types.Generator
+	buffList  []buffs.Buff
+	detectors []detectors.Detector
+}
+
+// runRepl is the testable core of ReplCmd.Run: it resolves the generator
+// config, creates the generator and any initial detectors, then drives a
+// read-eval-print loop over in, writing output to out.
+func runRepl(ctx context.Context, r *ReplCmd, in io.Reader, out io.Writer) error {
+	genConfig, err := buildGeneratorConfig(r.Generator, r.ConfigFile, r.Config, r.Model)
+	if err != nil {
+		return err
+	}
+
+	gen, err := generators.Create(r.Generator, genConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create generator %s: %w", r.Generator, err)
+	}
+
+	session := &replSession{gen: gen}
+	for _, name := range r.Detector {
+		det, err := detectors.Create(name, registry.Config{})
+		if err != nil {
+			return fmt.Errorf("failed to create detector %s: %w", name, err)
+		}
+		session.detectors = append(session.detectors, det)
+	}
+
+	fmt.Fprintf(out, "augustus repl - generator: %s (:help for commands, :quit to leave)\n", gen.Name())
+
+	scanner := bufio.NewScanner(in)
+	fmt.Fprint(out, "> ")
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "":
+			// fall through to re-prompt
+		case strings.HasPrefix(line, ":"):
+			if session.handleCommand(line, out) {
+				return nil
+			}
+		default:
+			if err := session.send(ctx, line, out); err != nil {
+				fmt.Fprintf(out, "error: %v\n", err)
+			}
+		}
+		fmt.Fprint(out, "> ")
+	}
+
+	return scanner.Err()
+}
+
+// handleCommand runs a single ":"-prefixed repl command, returning true if
+// the repl should exit.
+func (s *replSession) handleCommand(line string, out io.Writer) bool {
+	fields := strings.Fields(line)
+	switch fields[0] {
+	case ":quit", ":exit":
+		return true
+
+	case ":help":
+		fmt.Fprintln(out, replHelp)
+
+	case ":buff":
+		if len(fields) < 2 {
+			fmt.Fprintln(out, "usage: :buff <name>|clear")
+			return false
+		}
+		if fields[1] == "clear" {
+			s.buffList = nil
+			fmt.Fprintln(out, "buffs cleared")
+			return false
+		}
+		buff, err := buffs.Create(fields[1], registry.Config{})
+		if err != nil {
+			fmt.Fprintf(out, "error: %v\n", err)
+			return false
+		}
+		s.buffList = append(s.buffList, buff)
+		fmt.Fprintf(out, "added buff: %s\n", buff.Name())
+
+	case ":buffs":
+		if len(s.buffList) == 0 {
+			fmt.Fprintln(out, "(no buffs active)")
+			return false
+		}
+		for _, b := range s.buffList {
+			fmt.Fprintf(out, "  %s\n", b.Name())
+		}
+
+	case ":detect":
+		if len(fields) < 2 {
+			fmt.Fprintln(out, "usage: :detect <name>|clear")
+			return false
+		}
+		if fields[1] == "clear" {
+			s.detectors = nil
+			fmt.Fprintln(out, "detectors cleared")
+			return false
+		}
+		det, err := detectors.Create(fields[1], registry.Config{})
+		if err != nil {
+			fmt.Fprintf(out, "error: %v\n", err)
+			return false
+		}
+		s.detectors = append(s.detectors, det)
+		fmt.Fprintf(out, "added detector: %s\n", det.Name())
+
+	case ":detectors":
+		if len(s.detectors) == 0 {
+			fmt.Fprintln(out, "(no detectors active)")
+			return false
+		}
+		for _, d := range s.detectors {
+			fmt.Fprintf(out, "  %s\n", d.Name())
+		}
+
+	default:
+		fmt.Fprintf(out, "unknown command: %s (try :help)\n", fields[0])
+	}
+
+	return false
+}
+
+// send runs payload through the active buff chain (if any), calls the
+// generator once per resulting attempt, runs any PostBuff.Untransform hooks
+// on the response (e.g. translating a low-resource-language response back to
+// English), and runs every active detector on the untransformed response,
+// printing each step to out.
+func (s *replSession) send(ctx context.Context, payload string, out io.Writer) error {
+	attempts := []*attempt.Attempt{attempt.New(payload)}
+
+	var chain *buffs.BuffChain
+	if len(s.buffList) > 0 {
+		chain = buffs.NewBuffChain(s.buffList...)
+		var err error
+		attempts, err = chain.Apply(ctx, attempts)
+		if err != nil {
+			return fmt.Errorf("buff chain failed: %w", err)
+		}
+	}
+
+	for _, a := range attempts {
+		conv := attempt.NewConversation()
+		conv.AddPrompt(a.Prompt)
+
+		responses, err := s.gen.Generate(ctx, conv, 1)
+		if err != nil {
+			fmt.Fprintf(out, "prompt: %s\ngenerator error: %v\n", a.Prompt, err)
+			continue
+		}
+		for _, resp := range responses {
+			a.AddOutput(resp.Content)
+		}
+
+		if chain != nil && chain.HasPostBuffHooks() {
+			a, err = chain.ApplyPostBuffs(ctx, a)
+			if err != nil {
+				fmt.Fprintf(out, "prompt: %s\npost-buff error: %v\n", a.Prompt, err)
+				continue
+			}
+		}
+
+		fmt.Fprintf(out, "prompt:   %s\n", a.Prompt)
+		for _, o := range a.Outputs {
+			fmt.Fprintf(out, "response: %s\n", o)
+		}
+
+		for _, det := range s.detectors {
+			scores, err := det.Detect(ctx, a)
+			if err != nil {
+				fmt.Fprintf(out, "  %s: error: %v\n", det.Name(), err)
+				continue
+			}
+			fmt.Fprintf(out, "  %s: %v\n", det.Name(), scores)
+		}
+	}
+
+	return nil
+}
+
+// buildGeneratorConfig resolves a generator's registry.Config from an
+// optional YAML config file, JSON config override, and --model shorthand,
+// using the same precedence chain as `augustus scan`.
+func buildGeneratorConfig(generatorName, configFile, configJSON, model string) (registry.Config, error) {
+	var yamlCfg *config.Config
+	if configFile != "" {
+		var err error
+		yamlCfg, err = config.LoadConfig(configFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load config file: %w", err)
+		}
+	}
+
+	cliOverrides := config.CLIOverrides{GeneratorName: generatorName, ConfigJSON: configJSON}
+	if model != "" {
+		if cliOverrides.ConfigJSON == "" {
+			cliOverrides.ConfigJSON = `{"model":"` + model + `"}`
+		} else {
+			var cfgMap map[string]any
+			if err := json.Unmarshal([]byte(cliOverrides.ConfigJSON), &cfgMap); err == nil {
+				cfgMap["model"] = model
+				if b, err := json.Marshal(cfgMap); err == nil {
+					cliOverrides.ConfigJSON = string(b)
+				}
+			}
+		}
+	}
+
+	resolved, err := config.Resolve(yamlCfg, cliOverrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve configuration: %w", err)
+	}
+
+	return resolved.GeneratorConfig, nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRunRepl_SendsPayloadAndPrintsResponse(t *testing.T) {
+	cmd := &ReplCmd{Generator: "test.Repeat"}
+	in := strings.NewReader("hello\n:quit\n")
+	var out strings.Builder
+
+	if err := runRepl(context.Background(), cmd, in, &out); err != nil {
+		t.Fatalf("runRepl() error = %v", err)
+	}
+
+	if !strings.Contains(out.String(), "prompt:   hello") {
+		t.Errorf("output missing echoed prompt: %q", out.String())
+	}
+	if !strings.Contains(out.String(), "response: hello") {
+		t.Errorf("output missing generator response: %q", out.String())
+	}
+}
+
+func TestRunRepl_InitialDetectorRunsOnResponse(t *testing.T) {
+	cmd := &ReplCmd{Generator: "test.Repeat", Detector: []string{"always.Pass"}}
+	in := strings.NewReader("hi\n:quit\n")
+	var out strings.Builder
+
+	if err := runRepl(context.Background(), cmd, in, &out); err != nil {
+		t.Fatalf("runRepl() error = %v", err)
+	}
+
+	if !strings.Contains(out.String(), "always.Pass:") {
+		t.Errorf("output missing detector score: %q", out.String())
+	}
+}
+
+func TestRunRepl_BuffCommandTransformsPayload(t *testing.T) {
+	cmd := &ReplCmd{Generator: "test.Repeat"}
+	in := strings.NewReader(":buff encoding.Base64\nsecret\n:quit\n")
+	var out strings.Builder
+
+	if err := runRepl(context.Background(), cmd, in, &out); err != nil {
+		t.Fatalf("runRepl() error = %v", err)
+	}
+
+	if strings.Contains(out.String(), "prompt:   secret\n") {
+		t.Errorf("expected payload to be base64-encoded before sending, got: %q", out.String())
+	}
+	if !strings.Contains(out.String(), "added buff: encoding.Base64") {
+		t.Errorf("output missing buff confirmation: %q", out.String())
+	}
+}
+
+func TestRunRepl_UnknownGeneratorReturnsError(t *testing.T) {
+	cmd := &ReplCmd{Generator: "does.NotExist"}
+	in := strings.NewReader("")
+	var out strings.Builder
+
+	if err := runRepl(context.Background(), cmd, in, &out); err == nil {
+		t.Fatal("runRepl() error = nil, want error for unknown generator")
+	}
+}
+
+func TestRunRepl_UnknownCommandPrintsHint(t *testing.T) {
+	cmd := &ReplCmd{Generator: "test.Repeat"}
+	in := strings.NewReader(":bogus\n:quit\n")
+	var out strings.Builder
+
+	if err := runRepl(context.Background(), cmd, in, &out); err != nil {
+		t.Fatalf("runRepl() error = %v", err)
+	}
+
+	if !strings.Contains(out.String(), "unknown command") {
+		t.Errorf("output missing unknown-command hint: %q", out.String())
+	}
+}
+
+func TestRunRepl_PostBuffUntransformsResponseBeforeDetection(t *testing.T) {
+	cmd := &ReplCmd{Generator: "test.Repeat", Detector: []string{"always.Pass"}}
+	in := strings.NewReader(":buff test.ReverseRoundtrip\nhello\n:quit\n")
+	var out strings.Builder
+
+	if err := runRepl(context.Background(), cmd, in, &out); err != nil {
+		t.Fatalf("runRepl() error = %v", err)
+	}
+
+	if !strings.Contains(out.String(), "response: hello") {
+		t.Errorf("expected response to be untransformed back to %q before printing, got: %q", "hello", out.String())
+	}
+}
+
+func TestRunRepl_ClearCommandsRemoveState(t *testing.T) {
+	cmd := &ReplCmd{Generator: "test.Repeat"}
+	in := strings.NewReader(":buff encoding.Base64\n:buff clear\n:buffs\n:quit\n")
+	var out strings.Builder
+
+	if err := runRepl(context.Background(), cmd, in, &out); err != nil {
+		t.Fatalf("runRepl() error = %v", err)
+	}
+
+	if !strings.Contains(out.String(), "(no buffs active)") {
+		t.Errorf("expected buffs to be cleared, got: %q", out.String())
+	}
+}
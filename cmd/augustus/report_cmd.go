@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/praetorian-inc/augustus/pkg/store"
+)
+
+// ReportCmd groups cross-run reporting subcommands.
+type ReportCmd struct {
+	Trends    ReportTrendsCmd    `cmd:"" help:"Show per-probe pass-rate trends, regressions, and model comparisons from a store file."`
+	Summarize ReportSummarizeCmd `cmd:"" help:"Render an executive summary (top risks, stats, notable examples) from a JSONL results file."`
+}
+
+// ReportTrendsCmd reports on a store file built up by repeated
+// `augustus scan --store <path>` runs: per-probe pass-rate over time,
+// regressions between consecutive runs, and a same-probe comparison across
+// generators.
+type ReportTrendsCmd struct {
+	StoreFile     string  `arg:"" help:"Store file written by 'augustus scan --store'." type:"existingfile"`
+	Probe         string  `help:"Limit the pass-rate trend and model comparison to a single probe. Omit to only show regression highlights across all probes."`
+	RegressionMin float64 `help:"Minimum pass-rate drop between consecutive runs to report as a regression." default:"0.2" name:"regression-min"`
+}
+
+func (r *ReportTrendsCmd) Run() error {
+	return r.run(os.Stdout)
+}
+
+// run is the testable core of ReportTrendsCmd.Run.
+func (r *ReportTrendsCmd) run(out io.Writer) error {
+	s, err := store.Open(r.StoreFile)
+	if err != nil {
+		return fmt.Errorf("failed to open store file: %w", err)
+	}
+	if len(s.Runs()) == 0 {
+		fmt.Fprintf(out, "no runs found in %s\n", r.StoreFile)
+		return nil
+	}
+
+	if r.Probe != "" {
+		writeProbeTrend(out, s, r.Probe)
+		writeModelComparison(out, s, r.Probe)
+	}
+	writeRegressionHighlights(out, s, r.RegressionMin)
+
+	return nil
+}
+
+func writeProbeTrend(out io.Writer, s *store.Store, probe string) {
+	points := s.ProbeTrend(probe)
+	fmt.Fprintf(out, "Pass-rate trend: %s\n", probe)
+	fmt.Fprintln(out, "==================================================")
+	if len(points) == 0 {
+		fmt.Fprintln(out, "no runs exercised this probe")
+		return
+	}
+	for _, p := range points {
+		fmt.Fprintf(out, "  %-24s %-24s %d/%d passed (%.0f%%)\n", p.Timestamp, p.Generator, p.Passed, p.Total, p.PassRate*100)
+	}
+	fmt.Fprintln(out)
+}
+
+func writeModelComparison(out io.Writer, s *store.Store, probe string) {
+	comparisons := s.ModelComparison(probe)
+	fmt.Fprintf(out, "Model comparison (latest run per generator): %s\n", probe)
+	fmt.Fprintln(out, "==================================================")
+	if len(comparisons) == 0 {
+		fmt.Fprintln(out, "no runs exercised this probe")
+		return
+	}
+	for _, c := range comparisons {
+		fmt.Fprintf(out, "  %-24s %d/%d passed (%.0f%%)\n", c.Generator, c.Passed, c.Total, c.PassRate*100)
+	}
+	fmt.Fprintln(out)
+}
+
+func writeRegressionHighlights(out io.Writer, s *store.Store, minDrop float64) {
+	regressions := s.RegressionHighlights(minDrop)
+	fmt.Fprintf(out, "Regression highlights (pass rate drop >= %.0f%%)\n", minDrop*100)
+	fmt.Fprintln(out, "==================================================")
+	if len(regressions) == 0 {
+		fmt.Fprintln(out, "none found")
+		return
+	}
+	for _, reg := range regressions {
+		fmt.Fprintf(out, "  %s / %s: %.0f%% -> %.0f%% (%s -> %s)\n",
+			reg.Probe, reg.Generator, reg.FromPassRate*100, reg.ToPassRate*100, reg.FromRunID, reg.ToRunID)
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/template"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/results"
+)
+
+// ReportSummarizeCmd renders an executive summary (top risks, per-probe
+// stats, notable examples) from a JSONL results file written by `augustus
+// scan --format jsonl` or `--output`, using a Go template users can
+// override, optionally drafting a narrative section with a configured LLM.
+type ReportSummarizeCmd struct {
+	ResultsFile  string `arg:"" help:"JSONL results file written by 'augustus scan --format jsonl' or '--output'." type:"existingfile"`
+	TemplateFile string `help:"Go template file overriding the default Markdown summary layout." type:"existingfile" name:"template-file"`
+	Output       string `help:"Write the summary to this file instead of stdout." type:"path"`
+	TopN         int    `help:"Number of top risks and notable examples to include." default:"5" name:"top-n"`
+
+	// Narrative generation (optional): reuses the same generator + JSON
+	// config shape as 'augustus scan', rather than the global YAML judge
+	// section, since this command has no YAML config of its own.
+	NarrativeGenerator string `help:"Generator name used to draft a narrative overview (e.g. openai.OpenAI). Omit to skip narrative generation." name:"narrative-generator"`
+	NarrativeConfig    string `help:"JSON config for --narrative-generator." name:"narrative-config"`
+}
+
+func (r *ReportSummarizeCmd) Run() error {
+	return runReportSummarize(context.Background(), r, os.Stdout)
+}
+
+// runReportSummarize is the testable core of ReportSummarizeCmd.Run.
+func runReportSummarize(ctx context.Context, cfg *ReportSummarizeCmd, out io.Writer) error {
+	attemptResults, err := results.ReadAttemptResultsJSONL(cfg.ResultsFile)
+	if err != nil {
+		return err
+	}
+
+	summary := results.BuildExecutiveSummary(attemptResults, cfg.TopN)
+
+	if cfg.NarrativeGenerator != "" {
+		narrative, err := draftNarrative(ctx, cfg.NarrativeGenerator, cfg.NarrativeConfig, summary)
+		if err != nil {
+			return fmt.Errorf("failed to draft narrative: %w", err)
+		}
+		summary.Narrative = narrative
+		summary.NarrativeIsMachineGenerated = true
+	}
+
+	tmplSource := results.DefaultSummaryTemplate
+	if cfg.TemplateFile != "" {
+		content, err := os.ReadFile(cfg.TemplateFile)
+		if err != nil {
+			return fmt.Errorf("failed to read template file: %w", err)
+		}
+		tmplSource = string(content)
+	}
+
+	tmpl, err := template.New("summary").Parse(tmplSource)
+	if err != nil {
+		return fmt.Errorf("failed to parse summary template: %w", err)
+	}
+
+	dest := out
+	if cfg.Output != "" {
+		f, err := os.Create(cfg.Output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer f.Close()
+		dest = f
+	}
+
+	if err := tmpl.Execute(dest, summary); err != nil {
+		return fmt.Errorf("failed to render summary template: %w", err)
+	}
+	if cfg.Output != "" {
+		fmt.Fprintf(os.Stderr, "\nExecutive summary written to: %s\n", cfg.Output)
+	}
+
+	return nil
+}
+
+// draftNarrative creates the configured generator and asks it to draft a
+// short narrative overview, following the same generators.Create +
+// Conversation pattern used by internal/detectors/judge for LLM-as-judge
+// calls outside the normal scan pipeline.
+func draftNarrative(ctx context.Context, generatorName, configJSON string, summary results.ExecutiveSummary) (string, error) {
+	genCfg := registry.Config{}
+	if configJSON != "" {
+		if err := json.Unmarshal([]byte(configJSON), &genCfg); err != nil {
+			return "", fmt.Errorf("invalid narrative generator config JSON: %w", err)
+		}
+	}
+
+	gen, err := generators.Create(generatorName, genCfg)
+	if err != nil {
+		return "", fmt.Errorf("failed to create narrative generator %s: %w", generatorName, err)
+	}
+
+	conv := attempt.NewConversation()
+	conv.WithSystem("You are a security analyst writing a concise executive summary of an LLM vulnerability scan for a non-technical audience.")
+	conv.AddPrompt(results.NarrativePrompt(summary))
+
+	responses, err := gen.Generate(ctx, conv, 1)
+	if err != nil {
+		return "", err
+	}
+	if len(responses) == 0 {
+		return "", fmt.Errorf("narrative generator returned no responses")
+	}
+
+	return responses[0].Content, nil
+}
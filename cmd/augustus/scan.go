@@ -18,34 +18,65 @@ import (
 	"github.com/praetorian-inc/augustus/pkg/config"
 	"github.com/praetorian-inc/augustus/pkg/detectors"
 	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/har"
 	"github.com/praetorian-inc/augustus/pkg/harnesses"
 	"github.com/praetorian-inc/augustus/pkg/hooks"
+	"github.com/praetorian-inc/augustus/pkg/policy"
 	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/redact"
 	"github.com/praetorian-inc/augustus/pkg/registry"
 	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/praetorian-inc/augustus/pkg/sign"
+	"github.com/praetorian-inc/augustus/pkg/sinks"
+	"github.com/praetorian-inc/augustus/pkg/store"
+	"github.com/praetorian-inc/augustus/pkg/transport"
 	"github.com/praetorian-inc/augustus/pkg/types"
 )
 
 // scanConfig holds the configuration for a scan command.
 type scanConfig struct {
-	generatorName string
-	probeNames    []string
-	detectorNames []string
-	buffNames     []string
-	harnessName   string
-	configFile    string // YAML config file path
-	configJSON    string
-	outputFormat  string
-	outputFile    string // JSONL output file path
-	htmlFile      string // HTML report file path
-	verbose       bool
-	allProbes     bool          // Run all registered probes
-	timeout       time.Duration // Overall scan timeout
-	concurrency   int           // Max concurrent probes
-	probeTimeout  time.Duration // Per-probe timeout
-	setup         string        // Shell command: once before all probes
-	prepare       string        // Shell command: before each probe
-	cleanup       string        // Shell command: after all probes
+	generatorName          string
+	probeNames             []string
+	excludeProbes          string // Comma-separated probe glob patterns to drop from probeNames
+	detectorNames          []string
+	excludeDetectors       string // Comma-separated detector glob patterns to drop from the resolved detector list
+	buffNames              []string
+	buffParams             []string // "<buff>:<param>=<v1>,<v2>" sweep declarations, see cli.ParseBuffParam
+	harnessName            string
+	configFile             string // YAML config file path
+	strictConfig           bool   // Reject unknown keys/names in configFile
+	configJSON             string
+	outputFormat           string
+	query                  string // jq-style query (see pkg/jsonpath) applied to json/jsonl output
+	outputFile             string // JSONL output file path
+	htmlFile               string // HTML report file path
+	csvFile                string // CSV findings report file path
+	xlsxFile               string // XLSX findings workbook file path
+	storeFile              string // Trend store file path (for 'augustus report trends')
+	verbose                bool
+	allProbes              bool               // Run all registered probes
+	timeout                time.Duration      // Overall scan timeout
+	concurrency            int                // Max concurrent probes
+	probeTimeout           time.Duration      // Per-probe timeout
+	attemptTimeout         time.Duration      // Per-attempt (single generator call) timeout
+	detectorTimeout        time.Duration      // Per-detector-call timeout
+	shutdownGracePeriod    time.Duration      // Grace period for in-flight probes on SIGINT/SIGTERM
+	setup                  string             // Shell command: once before all probes
+	prepare                string             // Shell command: before each probe
+	cleanup                string             // Shell command: after all probes
+	capture                string             // Evidence capture target, e.g. "har:/tmp/scan.har"
+	dedup                  bool               // Skip generator calls for exact-duplicate prompts
+	adaptiveConcurrency    bool               // Auto-tune concurrency down on 429s, back up after cool-down
+	adaptiveMinConcurrency int                // Floor for adaptiveConcurrency
+	dryRun                 bool               // Print generated prompts instead of scanning
+	extraSinks             []sinks.Sink       // Additional output.sinks from YAML config
+	policyFile             string             // Policy YAML file restricting probes/generators/severity
+	policyEnv              string             // Environment name to enforce from policyFile
+	redactedHTMLFile       string             // Redacted, client-safe HTML report path
+	redactedOutputFile     string             // Redacted, client-safe JSONL path
+	redactThreshold        float64            // Score at/above which a payload is truncated for the redacted variants
+	thresholds             results.Thresholds // Per-probe/per-detector vulnerability threshold overrides from YAML config
+	calibrateJudge         bool               // Calibrate selected judge.* detectors against a bundled known-harmful/benign set before scanning
 }
 
 // Kong helper methods
@@ -57,11 +88,15 @@ func (s *ScanCmd) execute() error {
 		return err
 	}
 
+	if err := enforcePolicy(cfg); err != nil {
+		return err
+	}
+
 	// Load YAML config if provided
 	var yamlCfg *config.Config
 	if cfg.configFile != "" {
 		var err error
-		yamlCfg, err = config.LoadConfig(cfg.configFile)
+		yamlCfg, err = loadScanYAMLConfig(cfg.configFile, cfg.strictConfig)
 		if err != nil {
 			return fmt.Errorf("failed to load config file: %w", err)
 		}
@@ -90,39 +125,91 @@ func (s *ScanCmd) execute() error {
 		collectJSONLPath = "" // Streaming handles JSONL; don't double-write
 	}
 
+	extraSinks, err := createSinks(yamlCfg)
+	if err != nil {
+		return err
+	}
+
 	eval := s.createEvaluator(&scanConfig{
-		outputFormat: resolved.OutputFormat,
-		outputFile:   collectJSONLPath,
-		htmlFile:     resolved.HTMLFile,
-		verbose:      s.Verbose,
+		generatorName:      s.Generator,
+		outputFormat:       resolved.OutputFormat,
+		outputFile:         collectJSONLPath,
+		htmlFile:           resolved.HTMLFile,
+		csvFile:            resolved.CSVFile,
+		xlsxFile:           resolved.XLSXFile,
+		storeFile:          resolved.StoreFile,
+		verbose:            s.Verbose,
+		extraSinks:         extraSinks,
+		query:              s.Query,
+		redactedHTMLFile:   resolved.RedactedHTMLFile,
+		redactedOutputFile: resolved.RedactedOutputFile,
+		redactThreshold:    resolved.RedactThreshold,
+		thresholds:         yamlCfg.ResolveThresholds(),
 	})
 	ctx, cancel := s.setupContext()
 	defer cancel()
 
-	return runScanResolved(ctx, cfg, yamlCfg, resolved, eval, onAttemptProcessed)
+	runErr := runScanResolved(ctx, cfg, yamlCfg, resolved, eval, onAttemptProcessed)
+	if streamWriter != nil {
+		streamWriter.Close()
+	}
+	if runErr != nil {
+		return runErr
+	}
+
+	if s.SignKey != "" && resolved.OutputFile != "" {
+		if _, err := sign.SignFile(resolved.OutputFile, s.SignKey); err != nil {
+			return fmt.Errorf("failed to sign output: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "\nSignature written to: %s.sig\n", resolved.OutputFile)
+	}
+
+	return nil
 }
 
 // loadScanConfig converts Kong struct to legacy scanConfig
 func (s *ScanCmd) loadScanConfig() *scanConfig {
 	return &scanConfig{
-		generatorName: s.Generator,
-		probeNames:    s.Probe,
-		detectorNames: s.Detectors,
-		buffNames:     s.Buff,
-		harnessName:   s.Harness,
-		configFile:    s.ConfigFile,
-		configJSON:    s.Config,
-		outputFormat:  s.Format,
-		outputFile:    s.Output,
-		htmlFile:      s.HTML,
-		verbose:       s.Verbose,
-		allProbes:     s.All,
-		timeout:       s.Timeout,
-		concurrency:   s.Concurrency,
-		probeTimeout:  s.ProbeTimeout,
-		setup:         s.Setup,
-		prepare:       s.Prepare,
-		cleanup:       s.Cleanup,
+		generatorName:          s.Generator,
+		probeNames:             s.Probe,
+		excludeProbes:          s.ExcludeProbes,
+		detectorNames:          s.Detectors,
+		excludeDetectors:       s.ExcludeDetectors,
+		buffNames:              s.Buff,
+		buffParams:             s.BuffParam,
+		harnessName:            s.Harness,
+		configFile:             s.ConfigFile,
+		strictConfig:           s.StrictConfig,
+		configJSON:             s.Config,
+		outputFormat:           s.Format,
+		query:                  s.Query,
+		outputFile:             s.Output,
+		htmlFile:               s.HTML,
+		csvFile:                s.CSV,
+		xlsxFile:               s.XLSX,
+		storeFile:              s.Store,
+		verbose:                s.Verbose,
+		allProbes:              s.All,
+		timeout:                s.Timeout,
+		concurrency:            s.Concurrency,
+		probeTimeout:           s.ProbeTimeout,
+		attemptTimeout:         s.AttemptTimeout,
+		detectorTimeout:        s.DetectorTimeout,
+		shutdownGracePeriod:    s.ShutdownGracePeriod,
+		setup:                  s.Setup,
+		prepare:                s.Prepare,
+		cleanup:                s.Cleanup,
+		capture:                s.Capture,
+		dedup:                  s.Dedup,
+		adaptiveConcurrency:    s.AdaptiveConcurrency,
+		adaptiveMinConcurrency: s.AdaptiveMinConcurrency,
+		dryRun:                 s.DryRun,
+		policyFile:             s.PolicyFile,
+		policyEnv:              s.PolicyEnv,
+		redactedHTMLFile:       s.RedactedHTML,
+		redactedOutputFile:     s.RedactedOutput,
+		redactThreshold:        s.RedactThreshold,
+		calibrateJudge:         s.CalibrateJudge,
 	}
 }
 
@@ -130,10 +217,16 @@ func (s *ScanCmd) loadScanConfig() *scanConfig {
 // Zero-value fields mean "not set" (since Kong defaults were removed in Task 10).
 func (s *ScanCmd) buildCLIOverrides() config.CLIOverrides {
 	cli := config.CLIOverrides{
-		GeneratorName: s.Generator,
-		ConfigJSON:    s.Config,
-		HTMLFile:      s.HTML,
-		ProfileName:   s.Profile,
+		GeneratorName:      s.Generator,
+		ConfigJSON:         s.Config,
+		HTMLFile:           s.HTML,
+		CSVFile:            s.CSV,
+		XLSXFile:           s.XLSX,
+		StoreFile:          s.Store,
+		ProfileName:        s.Profile,
+		RedactedHTMLFile:   s.RedactedHTML,
+		RedactedOutputFile: s.RedactedOutput,
+		RedactThreshold:    s.RedactThreshold,
 	}
 
 	// Merge --model into ConfigJSON (takes precedence over --config model key)
@@ -160,6 +253,15 @@ func (s *ScanCmd) buildCLIOverrides() config.CLIOverrides {
 	if s.ProbeTimeout > 0 {
 		cli.ProbeTimeout = &s.ProbeTimeout
 	}
+	if s.AttemptTimeout > 0 {
+		cli.AttemptTimeout = &s.AttemptTimeout
+	}
+	if s.DetectorTimeout > 0 {
+		cli.DetectorTimeout = &s.DetectorTimeout
+	}
+	if s.ShutdownGracePeriod > 0 {
+		cli.ShutdownGracePeriod = &s.ShutdownGracePeriod
+	}
 	if s.Format != "" {
 		cli.OutputFormat = s.Format
 	}
@@ -216,19 +318,29 @@ func (s *ScanCmd) createEvaluator(cfg *scanConfig) harnesses.Evaluator {
 	var eval harnesses.Evaluator
 	switch cfg.outputFormat {
 	case "json":
-		eval = &jsonEvaluator{}
+		eval = &jsonEvaluator{query: cfg.query}
 	case "jsonl":
-		eval = &jsonlEvaluator{}
+		eval = &jsonlEvaluator{query: cfg.query}
 	default:
-		eval = &tableEvaluator{verbose: cfg.verbose}
+		eval = &tableEvaluator{verbose: cfg.verbose, thresholds: cfg.thresholds}
 	}
 
 	// Wrap evaluator with file output if needed
-	if cfg.outputFile != "" || cfg.htmlFile != "" {
+	if cfg.outputFile != "" || cfg.htmlFile != "" || cfg.csvFile != "" || cfg.xlsxFile != "" || cfg.storeFile != "" || len(cfg.extraSinks) > 0 ||
+		cfg.redactedHTMLFile != "" || cfg.redactedOutputFile != "" {
 		eval = &collectingEvaluator{
-			inner:     eval,
-			jsonlPath: cfg.outputFile,
-			htmlPath:  cfg.htmlFile,
+			inner:             eval,
+			jsonlPath:         cfg.outputFile,
+			htmlPath:          cfg.htmlFile,
+			csvPath:           cfg.csvFile,
+			xlsxPath:          cfg.xlsxFile,
+			storePath:         cfg.storeFile,
+			generatorName:     cfg.generatorName,
+			extraSinks:        cfg.extraSinks,
+			redactedHTMLPath:  cfg.redactedHTMLFile,
+			redactedJSONLPath: cfg.redactedOutputFile,
+			redactThreshold:   cfg.redactThreshold,
+			thresholds:        cfg.thresholds,
 		}
 	}
 
@@ -250,7 +362,7 @@ func runScan(ctx context.Context, cfg *scanConfig, eval harnesses.Evaluator) err
 	var yamlCfg *config.Config
 	if cfg.configFile != "" {
 		var err error
-		yamlCfg, err = config.LoadConfig(cfg.configFile)
+		yamlCfg, err = loadScanYAMLConfig(cfg.configFile, cfg.strictConfig)
 		if err != nil {
 			return fmt.Errorf("failed to load config file: %w", err)
 		}
@@ -258,11 +370,17 @@ func runScan(ctx context.Context, cfg *scanConfig, eval harnesses.Evaluator) err
 
 	// Build CLI overrides from scanConfig
 	cli := config.CLIOverrides{
-		GeneratorName: cfg.generatorName,
-		ConfigJSON:    cfg.configJSON,
-		OutputFormat:  cfg.outputFormat,
-		OutputFile:    cfg.outputFile,
-		HTMLFile:      cfg.htmlFile,
+		GeneratorName:      cfg.generatorName,
+		ConfigJSON:         cfg.configJSON,
+		OutputFormat:       cfg.outputFormat,
+		OutputFile:         cfg.outputFile,
+		HTMLFile:           cfg.htmlFile,
+		CSVFile:            cfg.csvFile,
+		XLSXFile:           cfg.xlsxFile,
+		StoreFile:          cfg.storeFile,
+		RedactedHTMLFile:   cfg.redactedHTMLFile,
+		RedactedOutputFile: cfg.redactedOutputFile,
+		RedactThreshold:    cfg.redactThreshold,
 	}
 	if cfg.concurrency > 0 {
 		cli.Concurrency = &cfg.concurrency
@@ -273,6 +391,15 @@ func runScan(ctx context.Context, cfg *scanConfig, eval harnesses.Evaluator) err
 	if cfg.probeTimeout > 0 {
 		cli.ProbeTimeout = &cfg.probeTimeout
 	}
+	if cfg.attemptTimeout > 0 {
+		cli.AttemptTimeout = &cfg.attemptTimeout
+	}
+	if cfg.detectorTimeout > 0 {
+		cli.DetectorTimeout = &cfg.detectorTimeout
+	}
+	if cfg.shutdownGracePeriod > 0 {
+		cli.ShutdownGracePeriod = &cfg.shutdownGracePeriod
+	}
 
 	// Resolve configuration
 	resolved, err := config.Resolve(yamlCfg, cli)
@@ -386,23 +513,142 @@ func createDetectors(detectorNames []string, probeList []probes.Prober, yamlCfg
 	return detectorList, nil
 }
 
+// calibrateDetectors runs every detector in detectorList that implements
+// types.Calibratable (judge.Judge, judge.Refusal) over its bundled
+// known-harmful/known-benign calibration set and prints the observed
+// accuracy, warning when it falls below a usable threshold. Detectors that
+// don't implement the interface (most of them) are skipped silently.
+func calibrateDetectors(ctx context.Context, detectorList []detectors.Detector) error {
+	for _, d := range detectorList {
+		calibratable, ok := d.(types.Calibratable)
+		if !ok {
+			continue
+		}
+
+		result, err := calibratable.Calibrate(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to calibrate detector %s: %w", d.Name(), err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Calibration for %s: %d/%d correct (%.0f%%), %d false positives, %d false negatives\n",
+			d.Name(), result.Correct, result.Total, result.Accuracy()*100, result.FalsePositives, result.FalseNegatives)
+
+		if result.Accuracy() < types.MinAcceptableCalibrationAccuracy {
+			fmt.Fprintf(os.Stderr, "WARNING: %s scored below %.0f%% accuracy on the calibration set - consider a different judge model or confidence_cutoff\n",
+				d.Name(), types.MinAcceptableCalibrationAccuracy*100)
+		}
+	}
+
+	return nil
+}
+
+// loadScanYAMLConfig loads a scan's --config-file. When strict is true (via
+// --strict-config), it reparses with config.LoadConfigStrict for
+// line-numbered unknown-key errors, then validates every probe/detector/buff
+// name referenced in the config against the registered capabilities.
+func loadScanYAMLConfig(path string, strict bool) (*config.Config, error) {
+	yamlCfg, err := config.LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strict && !yamlCfg.Strict {
+		return yamlCfg, nil
+	}
+
+	if _, err := config.LoadConfigStrict(path); err != nil {
+		return nil, err
+	}
+	if err := yamlCfg.ValidateCapabilityNames(probes.Registry.Has, detectors.Registry.Has, buffs.Registry.Has); err != nil {
+		return nil, err
+	}
+
+	return yamlCfg, nil
+}
+
+// resolveBuffSweeps determines the buff names to apply and any per-buff
+// parameter sweeps (--buff-param, falling back to buffs.param_sweeps in
+// yamlCfg), for both a real scan and --dry-run. A buff named only by a
+// sweep is added to buffNames automatically, so sweeping it doesn't also
+// require a redundant --buff/buffs.names entry.
+func resolveBuffSweeps(cfg *scanConfig, yamlCfg *config.Config) ([]string, []cli.BuffParamSweep, error) {
+	buffNames := cfg.buffNames
+	if len(buffNames) == 0 && yamlCfg != nil && len(yamlCfg.Buffs.Names) > 0 {
+		buffNames = yamlCfg.Buffs.Names
+	}
+
+	var buffParamSweeps []cli.BuffParamSweep
+	for _, raw := range cfg.buffParams {
+		sweep, err := cli.ParseBuffParam(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --buff-param: %w", err)
+		}
+		buffParamSweeps = append(buffParamSweeps, sweep)
+	}
+	if len(buffParamSweeps) == 0 && yamlCfg != nil {
+		for _, sweep := range yamlCfg.Buffs.ParamSweeps {
+			buffParamSweeps = append(buffParamSweeps, cli.BuffParamSweep{
+				BuffName: sweep.Buff,
+				Param:    sweep.Param,
+				Values:   sweep.Values,
+			})
+		}
+	}
+
+	buffNameSet := make(map[string]struct{}, len(buffNames))
+	for _, name := range buffNames {
+		buffNameSet[name] = struct{}{}
+	}
+	for _, sweep := range buffParamSweeps {
+		if _, ok := buffNameSet[sweep.BuffName]; !ok {
+			buffNameSet[sweep.BuffName] = struct{}{}
+			buffNames = append(buffNames, sweep.BuffName)
+		}
+	}
+
+	return buffNames, buffParamSweeps, nil
+}
+
 // createAndApplyBuffs creates buff instances and applies them to probes.
-func createAndApplyBuffs(probeList []probes.Prober, buffNames []string, yamlCfg *config.Config) ([]probes.Prober, error) {
+// paramSweeps declares, per buff name, a parameter to sweep across multiple
+// values (see cli.ParseBuffParam) - a buff named there is expanded into one
+// configured instance per value and pooled via buffs.NewSweepBuff instead of
+// created as a single instance.
+func createAndApplyBuffs(probeList []probes.Prober, buffNames []string, paramSweeps []cli.BuffParamSweep, yamlCfg *config.Config) ([]probes.Prober, error) {
 	if len(buffNames) == 0 {
 		return probeList, nil
 	}
 
+	sweepsByBuff := make(map[string][]cli.BuffParamSweep, len(paramSweeps))
+	for _, sweep := range paramSweeps {
+		sweepsByBuff[sweep.BuffName] = append(sweepsByBuff[sweep.BuffName], sweep)
+	}
+
 	buffList := make([]buffs.Buff, 0, len(buffNames))
 	for _, buffName := range buffNames {
 		buffCfg := registry.Config{}
 		if yamlCfg != nil {
 			buffCfg = yamlCfg.ResolveBuffConfig(buffName)
 		}
-		buff, err := buffs.Create(buffName, buffCfg)
+
+		sweeps := sweepsByBuff[buffName]
+		if len(sweeps) == 0 {
+			if err := buffs.Registry.ValidateConfig(buffName, buffCfg); err != nil {
+				return nil, fmt.Errorf("failed to create buff %s: %w", buffName, err)
+			}
+			buff, err := buffs.Create(buffName, buffCfg)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create buff %s: %w", buffName, err)
+			}
+			buffList = append(buffList, buff)
+			continue
+		}
+
+		variants, err := createBuffSweepVariants(buffName, buffCfg, sweeps)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create buff %s: %w", buffName, err)
+			return nil, err
 		}
-		buffList = append(buffList, buff)
+		buffList = append(buffList, buffs.NewSweepBuff(buffName, variants))
 	}
 
 	buffChain := buffs.NewBuffChain(buffList...)
@@ -418,8 +664,48 @@ func createAndApplyBuffs(probeList []probes.Prober, buffNames []string, yamlCfg
 	return wrappedProbes, nil
 }
 
+// createBuffSweepVariants expands baseCfg into one configured buffName
+// instance per combination of sweeps' values, cross-producting when more
+// than one parameter is swept for the same buff.
+func createBuffSweepVariants(buffName string, baseCfg registry.Config, sweeps []cli.BuffParamSweep) ([]buffs.Buff, error) {
+	configs := []registry.Config{baseCfg}
+	for _, sweep := range sweeps {
+		var expanded []registry.Config
+		for _, cfg := range configs {
+			for _, value := range sweep.Values {
+				variant := make(registry.Config, len(cfg)+1)
+				for k, v := range cfg {
+					variant[k] = v
+				}
+				variant[sweep.Param] = value
+				expanded = append(expanded, variant)
+			}
+		}
+		configs = expanded
+	}
+
+	variants := make([]buffs.Buff, 0, len(configs))
+	for _, cfg := range configs {
+		if err := buffs.Registry.ValidateConfig(buffName, cfg); err != nil {
+			return nil, fmt.Errorf("failed to create buff %s: %w", buffName, err)
+		}
+		buff, err := buffs.Create(buffName, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create buff %s: %w", buffName, err)
+		}
+		variants = append(variants, buff)
+	}
+	return variants, nil
+}
+
 // runScanResolved executes the scan with resolved configuration.
 func runScanResolved(ctx context.Context, cfg *scanConfig, yamlCfg *config.Config, resolved *config.ResolvedConfig, eval harnesses.Evaluator, onAttemptProcessed func(*attempt.Attempt)) error {
+	// --dry-run short-circuits before any hook, capture, or generator setup
+	// runs, since none of those should touch a real endpoint either.
+	if cfg.dryRun {
+		return runDryRunScan(ctx, cfg, yamlCfg, resolved)
+	}
+
 	// Resolve runtime hooks: YAML config provides defaults, CLI flags override.
 	if yamlCfg != nil {
 		if cfg.setup == "" && yamlCfg.Hooks.Setup != "" {
@@ -466,12 +752,32 @@ func runScanResolved(ctx context.Context, cfg *scanConfig, yamlCfg *config.Confi
 		}
 	}
 
+	// Evidence capture: record every outbound generator HTTP request/response
+	// for --capture har:<path>. Injected into the generator config map so
+	// transport.ConfigFromMap can pick it up when the generator's HTTP
+	// client is built - see pkg/transport.HARRecorderConfigKey.
+	var harRecorder *har.Recorder
+	var harPath string
+	if cfg.capture != "" {
+		harPath = strings.TrimPrefix(cfg.capture, "har:")
+		harRecorder = har.NewRecorder()
+		resolved.GeneratorConfig[transport.HARRecorderConfigKey] = harRecorder
+	}
+
 	// Create generator
 	gen, err := generators.Create(cfg.generatorName, resolved.GeneratorConfig)
 	if err != nil {
 		return fmt.Errorf("failed to create generator %s: %w", cfg.generatorName, err)
 	}
 
+	// Only anthropic/openai/rest-family generators build their HTTP client
+	// through pkg/transport, so only they honor the recorder above; every
+	// other generator silently ignores it. Warn rather than let --capture
+	// produce a HAR file with zero entries and no indication why.
+	if harRecorder != nil && !harRecorder.Attached() {
+		slog.Warn("--capture is not supported by this generator; HAR file will be empty", "generator", cfg.generatorName)
+	}
+
 	// Wrap generator with runtime hooks if prepare is configured
 	if cfg.prepare != "" || len(setupVars) > 0 {
 		var prepareHook *hooks.Hook
@@ -481,6 +787,18 @@ func runScanResolved(ctx context.Context, cfg *scanConfig, yamlCfg *config.Confi
 		gen = hooks.NewHookedGenerator(gen, prepareHook, setupVars)
 	}
 
+	// Wrap generator with an AIMD concurrency controller if requested, so
+	// rate-limit errors from the provider throttle concurrent calls instead
+	// of the whole scan failing or users hand-tuning --concurrency per
+	// provider.
+	if cfg.adaptiveConcurrency {
+		adaptiveOpts := harnesses.DefaultAdaptiveConcurrencyOptions(resolved.ScannerOpts.Concurrency)
+		if cfg.adaptiveMinConcurrency > 0 {
+			adaptiveOpts.Min = cfg.adaptiveMinConcurrency
+		}
+		gen = harnesses.NewAdaptiveConcurrencyGenerator(gen, adaptiveOpts)
+	}
+
 	// Get probe names
 	probeNames := cfg.probeNames
 	if cfg.allProbes {
@@ -519,24 +837,98 @@ func runScanResolved(ctx context.Context, cfg *scanConfig, yamlCfg *config.Confi
 		}
 	}
 
+	// Apply probes.include/exclude/tags from YAML config, if set, so scan
+	// scope can be pinned in a reviewed config file instead of CLI flags.
+	if yamlCfg != nil {
+		filtered, err := yamlCfg.ResolveProbeNames(probeNames)
+		if err != nil {
+			return fmt.Errorf("failed to resolve probes.include/exclude/tags: %w", err)
+		}
+		if len(filtered) == 0 {
+			return fmt.Errorf("probes.include/exclude/tags filtered out all %d selected probes", len(probeNames))
+		}
+		probeNames = filtered
+	}
+
+	// Apply --exclude-probes, dropping matches from whatever --probe/
+	// --probes-glob/--all selected, so "everything except a few" doesn't
+	// require enumerating dozens of names.
+	if cfg.excludeProbes != "" {
+		filtered, err := cli.ExcludeCommaSeparatedGlobs(probeNames, cfg.excludeProbes)
+		if err != nil {
+			return fmt.Errorf("invalid --exclude-probes: %w", err)
+		}
+		if len(filtered) == 0 {
+			return fmt.Errorf("--exclude-probes excluded all %d selected probes", len(probeNames))
+		}
+		probeNames = filtered
+	}
+
 	// Create probes
 	probeList, err := createProbes(probeNames, yamlCfg, cfg.generatorName, resolved.GeneratorConfig)
 	if err != nil {
 		return err
 	}
 
+	// Skip probes whose capability requirements (multi-turn, vision, tools,
+	// system-prompt) the selected generator doesn't satisfy, so an
+	// incompatible pairing is reported up front instead of failing
+	// confusingly mid-scan.
+	var skippedProbes []harnesses.SkippedProbe
+	probeList, skippedProbes = harnesses.FilterByCapabilities(probeList, gen)
+	for _, skipped := range skippedProbes {
+		fmt.Fprintf(os.Stderr, "WARNING: skipping probe %s - generator %s does not support: %s\n",
+			skipped.Name, skipped.Generator, strings.Join(skipped.Missing, ", "))
+	}
+	if len(probeList) == 0 {
+		return fmt.Errorf("generator %s lacks the capabilities required by every selected probe", gen.Name())
+	}
+
 	// Create detectors
 	detectorList, err := createDetectors(cfg.detectorNames, probeList, yamlCfg, cfg.generatorName, resolved.GeneratorConfig)
 	if err != nil {
 		return err
 	}
 
+	// Apply --exclude-detectors to whatever detectorList resolved to,
+	// whether from explicit names/globs or auto-discovery from probes.
+	if cfg.excludeDetectors != "" {
+		names := make([]string, len(detectorList))
+		for i, d := range detectorList {
+			names[i] = d.Name()
+		}
+		keep, err := cli.ExcludeCommaSeparatedGlobs(names, cfg.excludeDetectors)
+		if err != nil {
+			return fmt.Errorf("invalid --exclude-detectors: %w", err)
+		}
+		if len(keep) == 0 {
+			return fmt.Errorf("--exclude-detectors excluded all %d resolved detectors", len(detectorList))
+		}
+		keepSet := make(map[string]struct{}, len(keep))
+		for _, name := range keep {
+			keepSet[name] = struct{}{}
+		}
+		filtered := detectorList[:0]
+		for _, d := range detectorList {
+			if _, ok := keepSet[d.Name()]; ok {
+				filtered = append(filtered, d)
+			}
+		}
+		detectorList = filtered
+	}
+
+	if cfg.calibrateJudge {
+		if err := calibrateDetectors(ctx, detectorList); err != nil {
+			return err
+		}
+	}
+
 	// Create and apply buffs
-	buffNames := cfg.buffNames
-	if len(buffNames) == 0 && yamlCfg != nil && len(yamlCfg.Buffs.Names) > 0 {
-		buffNames = yamlCfg.Buffs.Names
+	buffNames, buffParamSweeps, err := resolveBuffSweeps(cfg, yamlCfg)
+	if err != nil {
+		return err
 	}
-	probeList, err = createAndApplyBuffs(probeList, buffNames, yamlCfg)
+	probeList, err = createAndApplyBuffs(probeList, buffNames, buffParamSweeps, yamlCfg)
 	if err != nil {
 		return err
 	}
@@ -546,6 +938,8 @@ func runScanResolved(ctx context.Context, cfg *scanConfig, yamlCfg *config.Confi
 		"scanner_opts": &resolved.ScannerOpts,
 		"concurrency":  resolved.ScannerOpts.Concurrency,
 		"timeout":      resolved.ScannerOpts.Timeout,
+		"prompt_cap":   resolved.PromptCap,
+		"dedup":        cfg.dedup,
 	}
 	if onAttemptProcessed != nil {
 		harnessConfig["on_attempt_processed"] = onAttemptProcessed
@@ -558,6 +952,24 @@ func runScanResolved(ctx context.Context, cfg *scanConfig, yamlCfg *config.Confi
 	// Run the scan
 	scanErr := harness.Run(ctx, gen, probeList, detectorList, eval)
 
+	// Report generator cache effectiveness when --dedup was enabled and the
+	// harness tracked any hits or misses.
+	if reporter, ok := harness.(harnesses.CacheStatsReporter); ok {
+		if stats := reporter.CacheStats(); stats.Hits > 0 || stats.Misses > 0 {
+			fmt.Fprintf(os.Stderr, "Generator cache: %d hits, %d misses\n", stats.Hits, stats.Misses)
+		}
+	}
+
+	// Evidence capture: flush recorded traffic even if the scan failed, so
+	// partial evidence is still available for debugging.
+	if harRecorder != nil {
+		if err := harRecorder.WriteFile(harPath); err != nil {
+			scanErr = errors.Join(scanErr, fmt.Errorf("failed to write HAR capture: %w", err))
+		} else {
+			slog.Info("wrote HAR capture", "path", harPath)
+		}
+	}
+
 	// Runtime hooks: run cleanup hook after scan
 	if cfg.cleanup != "" {
 		slog.Info("running cleanup hook")
@@ -577,7 +989,8 @@ func runScanResolved(ctx context.Context, cfg *scanConfig, yamlCfg *config.Confi
 }
 
 type tableEvaluator struct {
-	verbose bool
+	verbose    bool
+	thresholds results.Thresholds
 }
 
 func (t *tableEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attempt) error {
@@ -601,7 +1014,14 @@ func (t *tableEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attem
 	totalPassed := 0
 	totalFailed := 0
 
-	for _, a := range attempts {
+	// Passed is computed via ToAttemptResultsWithThresholds rather than
+	// comparing scores alone, so an errored or timed-out attempt with no
+	// scores doesn't get displayed as SAFE, and a per-probe/per-detector
+	// threshold override (see results.Thresholds) is honored the same way
+	// the HTML report honors it.
+	attemptResults := results.ToAttemptResultsWithThresholds(attempts, t.thresholds)
+
+	for i, a := range attempts {
 		scores := a.GetEffectiveScores()
 		maxScore := 0.0
 		for _, score := range scores {
@@ -610,11 +1030,18 @@ func (t *tableEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attem
 			}
 		}
 
-		passed := maxScore <= attempt.DefaultVulnerabilityThreshold
+		passed := attemptResults[i].Passed
 		status := "SAFE"
 		passedStr := "true"
-		if !passed {
+		switch {
+		case a.Status == attempt.StatusTimedOut:
+			status = "TIMEOUT"
+		case a.Status == attempt.StatusError:
+			status = "ERROR"
+		case !passed:
 			status = "VULN"
+		}
+		if !passed {
 			passedStr = "false"
 			totalFailed++
 		} else {
@@ -671,7 +1098,7 @@ func (t *tableEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attem
 				}
 			}
 			status := "PASS"
-			if maxScore > attempt.DefaultVulnerabilityThreshold {
+			if maxScore > t.thresholds.Resolve(a.Probe, a.Detector) {
 				status = "FAIL"
 			}
 
@@ -681,10 +1108,7 @@ func (t *tableEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attem
 				if tt, ok := a.Metadata["total_turns"].(int); ok {
 					totalTurns = tt
 				}
-				goal := ""
-				if g, ok := a.Metadata["goal"].(string); ok {
-					goal = g
-				}
+				goal := a.GetGoal()
 
 				// Capitalize first letter of attack type
 				attackLabel := strings.ToUpper(attackType[:1]) + attackType[1:]
@@ -779,7 +1203,14 @@ func (t *tableEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attem
 		}
 	}
 
-	fmt.Printf("\nOverall: %d passed, %d failed (total: %d)\n", totalPassed, totalFailed, len(attempts))
+	overallSuffix := ""
+	if harnesses.Interrupted(ctx) {
+		overallSuffix = " [interrupted: partial results]"
+	}
+	fmt.Printf("\nOverall: %d passed, %d failed (total: %d)%s\n", totalPassed, totalFailed, len(attempts), overallSuffix)
+	if incomplete := harnesses.IncompleteProbes(ctx); len(incomplete) > 0 {
+		fmt.Printf("Incomplete probes (timed out): %s\n", strings.Join(incomplete, ", "))
+	}
 	return nil
 }
 
@@ -792,38 +1223,106 @@ func repeatDash(n int) string {
 }
 
 // jsonEvaluator prints results in JSON format.
-type jsonEvaluator struct{}
+type jsonEvaluator struct {
+	// query, if set, is a jq-style query (see pkg/jsonpath) evaluated over
+	// the results instead of printing the full attempts/count/interrupted
+	// envelope.
+	query string
+}
 
 func (j *jsonEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attempt) error {
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
+
+	if j.query != "" {
+		result, err := applyQuery(results.ToAttemptResults(attempts), j.query)
+		if err != nil {
+			return err
+		}
+		return encoder.Encode(result)
+	}
+
 	return encoder.Encode(map[string]any{
-		"attempts": attempts,
-		"count":    len(attempts),
+		"attempts":          attempts,
+		"count":             len(attempts),
+		"interrupted":       harnesses.Interrupted(ctx),
+		"incomplete_probes": harnesses.IncompleteProbes(ctx),
 	})
 }
 
 // jsonlEvaluator prints results in JSONL format (one JSON object per line).
-type jsonlEvaluator struct{}
+type jsonlEvaluator struct {
+	// query, if set, is a jq-style query (see pkg/jsonpath) evaluated over
+	// the results in place of the default one-result-per-line output. A
+	// query that fans out (wildcard/filter) still prints one line per match.
+	query string
+}
 
 func (j *jsonlEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attempt) error {
-	// Convert to simplified format and write each as JSON line
 	resultList := results.ToAttemptResults(attempts)
 	encoder := json.NewEncoder(os.Stdout)
+
+	if j.query != "" {
+		result, err := applyQuery(resultList, j.query)
+		if err != nil {
+			return err
+		}
+		matches, ok := result.([]any)
+		if !ok {
+			matches = []any{result}
+		}
+		for _, m := range matches {
+			if err := encoder.Encode(m); err != nil {
+				return fmt.Errorf("failed to encode result: %w", err)
+			}
+		}
+		return nil
+	}
+
 	for _, result := range resultList {
 		if err := encoder.Encode(result); err != nil {
 			return fmt.Errorf("failed to encode result: %w", err)
 		}
 	}
+
+	// Attempts from an incomplete probe are already in resultList above,
+	// each marked status "timed_out" - this trailing line just names which
+	// probes were cut short, so consumers don't have to infer it by
+	// re-grouping attempts by probe and status.
+	if incomplete := harnesses.IncompleteProbes(ctx); len(incomplete) > 0 {
+		if err := encoder.Encode(map[string]any{
+			"_meta":             true,
+			"incomplete_probes": incomplete,
+		}); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	}
 	return nil
 }
 
 // collectingEvaluator wraps another evaluator and collects attempts for file output.
 type collectingEvaluator struct {
-	inner     harnesses.Evaluator
-	attempts  []*attempt.Attempt
-	jsonlPath string
-	htmlPath  string
+	inner         harnesses.Evaluator
+	attempts      []*attempt.Attempt
+	jsonlPath     string
+	htmlPath      string
+	csvPath       string
+	xlsxPath      string
+	storePath     string // trend store file, ingested for 'augustus report trends'
+	generatorName string
+	// extraSinks are additional output.sinks configured in YAML, run after
+	// the built-in JSONL/HTML output above.
+	extraSinks []sinks.Sink
+	// redactedHTMLPath and redactedJSONLPath, if set, write a second,
+	// client-safe HTML/JSONL pair derived from the same attempts slice as
+	// htmlPath/jsonlPath, so both variants describe the same run.
+	redactedHTMLPath  string
+	redactedJSONLPath string
+	redactThreshold   float64
+	// thresholds overrides attempt.DefaultVulnerabilityThreshold per probe
+	// or detector (see results.Thresholds), applied to the HTML report so
+	// its pass/fail counts match the table evaluator's.
+	thresholds results.Thresholds
 }
 
 func (c *collectingEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attempt) error {
@@ -845,15 +1344,112 @@ func (c *collectingEvaluator) Evaluate(ctx context.Context, attempts []*attempt.
 
 	// Write HTML file if path specified
 	if c.htmlPath != "" {
-		if err := results.WriteHTML(c.htmlPath, attempts); err != nil {
+		if err := results.WriteHTMLWithOptions(c.htmlPath, attempts, results.WithThresholds(c.thresholds)); err != nil {
 			return fmt.Errorf("failed to write HTML report: %w", err)
 		}
 		fmt.Fprintf(os.Stderr, "\nHTML report written to: %s\n", c.htmlPath)
 	}
 
+	// Write CSV file if path specified
+	if c.csvPath != "" {
+		if err := results.WriteCSV(c.csvPath, attempts); err != nil {
+			return fmt.Errorf("failed to write CSV report: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "\nCSV report written to: %s\n", c.csvPath)
+	}
+
+	// Write XLSX file if path specified
+	if c.xlsxPath != "" {
+		if err := results.WriteXLSX(c.xlsxPath, attempts); err != nil {
+			return fmt.Errorf("failed to write XLSX report: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "\nXLSX report written to: %s\n", c.xlsxPath)
+	}
+
+	// Ingest into the trend store if a store path was specified
+	if c.storePath != "" {
+		s, err := store.Open(c.storePath)
+		if err != nil {
+			return fmt.Errorf("failed to open trend store: %w", err)
+		}
+		runID := time.Now().UTC().Format(time.RFC3339Nano)
+		if err := s.IngestRun(runID, c.generatorName, time.Now().UTC(), attempts); err != nil {
+			return fmt.Errorf("failed to ingest run into trend store: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "\nTrend store updated: %s\n", c.storePath)
+	}
+
+	if len(c.extraSinks) > 0 {
+		if err := sinks.NewPipeline(c.extraSinks...).Run(ctx, attempts); err != nil {
+			return fmt.Errorf("failed to run output sinks: %w", err)
+		}
+	}
+
+	// Write the redacted, client-safe variants from the same attempts used
+	// above, guaranteeing both versions come from the same run.
+	if c.redactedHTMLPath != "" || c.redactedJSONLPath != "" {
+		redacted := redact.New(c.redactThreshold).Attempts(attempts)
+
+		if c.redactedJSONLPath != "" {
+			if err := results.WriteJSONL(c.redactedJSONLPath, redacted); err != nil {
+				return fmt.Errorf("failed to write redacted JSONL output: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "\nRedacted JSONL output written to: %s\n", c.redactedJSONLPath)
+		}
+
+		if c.redactedHTMLPath != "" {
+			if err := results.WriteHTML(c.redactedHTMLPath, redacted); err != nil {
+				return fmt.Errorf("failed to write redacted HTML report: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "\nRedacted HTML report written to: %s\n", c.redactedHTMLPath)
+		}
+	}
+
 	return nil
 }
 
+// enforcePolicy checks a resolved scan configuration against
+// --policy-file/--policy-env, if set, denying the scan with a descriptive
+// error before any probe, generator, or detector is created.
+func enforcePolicy(cfg *scanConfig) error {
+	if cfg.policyFile == "" {
+		return nil
+	}
+
+	pol, err := policy.Load(cfg.policyFile)
+	if err != nil {
+		return err
+	}
+
+	env, err := pol.Environment(cfg.policyEnv)
+	if err != nil {
+		return err
+	}
+
+	probeNames := cfg.probeNames
+	if cfg.allProbes {
+		probeNames = probes.List()
+	}
+
+	return env.Check(cfg.generatorName, probeNames)
+}
+
+// createSinks instantiates every output.sinks entry from the YAML config.
+func createSinks(yamlCfg *config.Config) ([]sinks.Sink, error) {
+	if yamlCfg == nil {
+		return nil, nil
+	}
+	sinkList := make([]sinks.Sink, 0, len(yamlCfg.Output.Sinks))
+	for _, sc := range yamlCfg.Output.Sinks {
+		sink, err := sinks.Create(sc.Type, registry.Config(sc.Settings))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create sink %s: %w", sc.Type, err)
+		}
+		sinkList = append(sinkList, sink)
+	}
+	return sinkList, nil
+}
+
 // truncate shortens a string to maxLen, adding "..." if truncated.
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
@@ -1,18 +1,25 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/cli"
 	"github.com/praetorian-inc/augustus/pkg/config"
 	"github.com/praetorian-inc/augustus/pkg/detectors"
 	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/logging"
 	"github.com/praetorian-inc/augustus/pkg/probes"
 	"github.com/praetorian-inc/augustus/pkg/registry"
 	"github.com/stretchr/testify/assert"
@@ -97,6 +104,81 @@ func TestScanCommand_RunScan(t *testing.T) {
 	}
 }
 
+// TestScanCommand_CaptureHAR verifies that --capture har:<path> records the
+// generator's outbound HTTP traffic into a HAR file.
+func TestScanCommand_CaptureHAR(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"response": "ok"}`))
+	}))
+	defer server.Close()
+
+	configJSON, err := json.Marshal(map[string]string{"uri": server.URL})
+	require.NoError(t, err)
+
+	harPath := filepath.Join(t.TempDir(), "capture.har")
+
+	cfg := &scanConfig{
+		generatorName: "rest.Rest",
+		probeNames:    []string{"test.Test"},
+		detectorNames: []string{"always.Pass"},
+		harnessName:   "probewise.Probewise",
+		configJSON:    string(configJSON),
+		outputFormat:  "table",
+		capture:       "har:" + harPath,
+	}
+
+	ctx := context.Background()
+	eval := &mockEvaluator{}
+	err = runScan(ctx, cfg, eval)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(harPath)
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	log := doc["log"].(map[string]any)
+	entries := log["entries"].([]any)
+	assert.NotEmpty(t, entries)
+}
+
+// TestScanCommand_CaptureHARWarnsForUnsupportedGenerator verifies that
+// --capture har:<path> against a generator that doesn't build its HTTP
+// client through pkg/transport logs a warning instead of silently writing
+// an empty HAR file with no indication why.
+func TestScanCommand_CaptureHARWarnsForUnsupportedGenerator(t *testing.T) {
+	var logBuf bytes.Buffer
+	logging.Configure(slog.LevelInfo, "text", &logBuf)
+	t.Cleanup(func() { logging.Configure(slog.LevelInfo, "text", os.Stderr) })
+
+	harPath := filepath.Join(t.TempDir(), "capture.har")
+
+	cfg := &scanConfig{
+		generatorName: "test.Repeat",
+		probeNames:    []string{"test.Test"},
+		detectorNames: []string{"always.Pass"},
+		harnessName:   "probewise.Probewise",
+		outputFormat:  "table",
+		capture:       "har:" + harPath,
+	}
+
+	ctx := context.Background()
+	eval := &mockEvaluator{}
+	require.NoError(t, runScan(ctx, cfg, eval))
+
+	assert.Contains(t, logBuf.String(), "--capture is not supported by this generator")
+
+	data, err := os.ReadFile(harPath)
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	log := doc["log"].(map[string]any)
+	entries := log["entries"].([]any)
+	assert.Empty(t, entries, "test.Repeat doesn't route through pkg/transport, so no traffic should be captured")
+}
+
 // TestScanCmdBuffFlagParsing tests that --buff flag parsing works.
 func TestScanCmdBuffFlagParsing(t *testing.T) {
 	// Test that --buff encoding.Base64 --buff lowercase.Lowercase works
@@ -330,7 +412,7 @@ func TestCreateAndApplyBuffs_Empty(t *testing.T) {
 	probeList, err := createProbes([]string{"test.Test"}, nil, "test.Generator", make(registry.Config))
 	require.NoError(t, err)
 
-	resultProbes, err := createAndApplyBuffs(probeList, []string{}, nil)
+	resultProbes, err := createAndApplyBuffs(probeList, []string{}, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, resultProbes, 1)
 	assert.Equal(t, probeList[0], resultProbes[0], "should return original probes unchanged")
@@ -341,13 +423,185 @@ func TestCreateAndApplyBuffs_WithBuffs(t *testing.T) {
 	probeList, err := createProbes([]string{"test.Test"}, nil, "test.Generator", make(registry.Config))
 	require.NoError(t, err)
 
-	resultProbes, err := createAndApplyBuffs(probeList, []string{"encoding.Base64"}, nil)
+	resultProbes, err := createAndApplyBuffs(probeList, []string{"encoding.Base64"}, nil, nil)
 	require.NoError(t, err)
 	assert.Len(t, resultProbes, 1)
 	// After applying buffs, probes should be wrapped (different instance)
 	assert.NotEqual(t, probeList[0], resultProbes[0], "probes should be wrapped with buffs")
 }
 
+// TestLoadScanYAMLConfig_StrictRejectsUnknownKey verifies --strict-config
+// catches a misindented top-level key in the YAML config file.
+func TestLoadScanYAMLConfig_StrictRejectsUnknownKey(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("runn:\n  max_attempts: 5\n"), 0644))
+
+	_, err := loadScanYAMLConfig(configPath, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "line 1")
+}
+
+// TestLoadScanYAMLConfig_StrictRejectsUnknownCapabilityName verifies
+// --strict-config catches a probe/detector/buff name in Settings that isn't
+// registered.
+func TestLoadScanYAMLConfig_StrictRejectsUnknownCapabilityName(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("buffs:\n  settings:\n    does.NotExist:\n      foo: bar\n"), 0644))
+
+	_, err := loadScanYAMLConfig(configPath, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "buffs.settings.does.NotExist")
+}
+
+// TestLoadScanYAMLConfig_NonStrictToleratesIssues verifies the default
+// (non-strict) path doesn't reject the same config.
+func TestLoadScanYAMLConfig_NonStrictToleratesIssues(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("buffs:\n  settings:\n    does.NotExist:\n      foo: bar\n"), 0644))
+
+	cfg, err := loadScanYAMLConfig(configPath, false)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+}
+
+// TestLoadScanYAMLConfig_HonorsConfigStrictField verifies config.strict:
+// true in the YAML itself triggers strict validation without --strict-config.
+func TestLoadScanYAMLConfig_HonorsConfigStrictField(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("strict: true\nbuffs:\n  settings:\n    does.NotExist:\n      foo: bar\n"), 0644))
+
+	_, err := loadScanYAMLConfig(configPath, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does.NotExist")
+}
+
+// TestCreateAndApplyBuffs_RejectsUnknownConfigKey verifies that a typo'd
+// settings key for a schema-published buff is caught before Create runs the
+// buff's factory, instead of being silently ignored.
+func TestCreateAndApplyBuffs_RejectsUnknownConfigKey(t *testing.T) {
+	probeList, err := createProbes([]string{"test.Test"}, nil, "test.Generator", make(registry.Config))
+	require.NoError(t, err)
+
+	yamlCfg := &config.Config{
+		Buffs: config.BuffConfig{
+			Settings: map[string]map[string]any{
+				"flip.Keywords": {"keywrods": []string{"bomb"}},
+			},
+		},
+	}
+
+	_, err = createAndApplyBuffs(probeList, []string{"flip.Keywords"}, nil, yamlCfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown key(s) keywrods")
+}
+
+// TestCreateAndApplyBuffs_ParamSweep verifies that a --buff-param sweep
+// expands a buff into one configured instance per value, pooled via
+// buffs.SweepBuff, instead of a single instance.
+func TestCreateAndApplyBuffs_ParamSweep(t *testing.T) {
+	probeList, err := createProbes([]string{"test.Test"}, nil, "test.Generator", make(registry.Config))
+	require.NoError(t, err)
+
+	sweeps := []cli.BuffParamSweep{
+		{BuffName: "flip.WordOrder", Param: "variant", Values: []string{"cot", "full"}},
+	}
+	resultProbes, err := createAndApplyBuffs(probeList, []string{"flip.WordOrder"}, sweeps, nil)
+	require.NoError(t, err)
+	require.Len(t, resultProbes, 1)
+
+	attempts, err := resultProbes[0].Probe(context.Background(), &dryRunGenerator{})
+	require.NoError(t, err)
+
+	variants := make(map[string]bool)
+	for _, a := range attempts {
+		variants[a.GetVariant()] = true
+	}
+	assert.True(t, variants["cot"], "expected a cot variant attempt")
+	assert.True(t, variants["full"], "expected a full variant attempt")
+}
+
+// TestScanCommand_SkipsProbeMissingRequiredCapability verifies that pairing a
+// probe requiring a capability (toolabuse.WebExfil needs "tools") with a
+// generator that doesn't declare it (test.Blank) skips the probe and reports
+// it, rather than failing the scan outright - all probes being skipped still
+// surfaces as an error, since there's nothing left to scan.
+func TestScanCommand_SkipsProbeMissingRequiredCapability(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := &scanConfig{
+		generatorName: "test.Blank",
+		probeNames:    []string{"toolabuse.WebExfil"},
+		detectorNames: []string{"always.Pass"},
+		harnessName:   "probewise.Probewise",
+		outputFormat:  "table",
+	}
+
+	eval := &mockEvaluator{}
+	err := runScan(ctx, cfg, eval)
+	require.Error(t, err, "scan should fail when the only selected probe is skipped for missing capabilities")
+	assert.Contains(t, err.Error(), "lacks the capabilities")
+	assert.Empty(t, eval.attempts)
+}
+
+// TestScanCommand_RunsProbeWhenRequiredCapabilityIsSatisfied verifies that
+// the same probe runs normally against a generator that declares the
+// capability it requires (test.ToolCall declares "tools").
+func TestScanCommand_RunsProbeWhenRequiredCapabilityIsSatisfied(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := &scanConfig{
+		generatorName: "test.ToolCall",
+		probeNames:    []string{"toolabuse.WebExfil"},
+		detectorNames: []string{"always.Pass"},
+		harnessName:   "probewise.Probewise",
+		outputFormat:  "table",
+	}
+
+	eval := &mockEvaluator{}
+	err := runScan(ctx, cfg, eval)
+	require.NoError(t, err, "runScan should succeed when the generator satisfies the probe's capability requirements")
+	assert.NotEmpty(t, eval.attempts, "scan should produce attempts")
+}
+
+// TestScanCommand_DryRun verifies that --dry-run exports every (buff-transformed)
+// prompt a probe would send, without ever creating or calling a generator.
+func TestScanCommand_DryRun(t *testing.T) {
+	ctx := context.Background()
+	outputPath := filepath.Join(t.TempDir(), "prompts.jsonl")
+
+	cfg := &scanConfig{
+		// A generator that does not exist in the registry: if dry-run ever
+		// tried to create or call a real generator, this would fail loudly.
+		generatorName: "nonexistent.Generator",
+		probeNames:    []string{"test.Test"},
+		buffNames:     []string{"encoding.Base64"},
+		harnessName:   "probewise.Probewise",
+		outputFormat:  "jsonl",
+		outputFile:    outputPath,
+		dryRun:        true,
+	}
+
+	err := runScan(ctx, cfg, &mockEvaluator{})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 8, "one line per test.Test prompt (internal/probes/test/test.go)")
+
+	for _, line := range lines {
+		var rec dryRunPrompt
+		require.NoError(t, json.Unmarshal([]byte(line), &rec))
+		assert.Equal(t, "test.Test", rec.Probe)
+		assert.NotEmpty(t, rec.Prompt, "buff-transformed prompt should not be empty even when the original prompt was")
+	}
+}
+
 // TestScanCommand_SetupHook tests that the setup hook runs before probes and its output gets used.
 func TestScanCommand_SetupHook(t *testing.T) {
 	ctx := context.Background()
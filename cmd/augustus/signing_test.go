@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/sign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeygenCmd_Run_WritesKeypair(t *testing.T) {
+	dir := t.TempDir()
+	k := &KeygenCmd{
+		PrivateKeyFile: filepath.Join(dir, "key.priv"),
+		PublicKeyFile:  filepath.Join(dir, "key.pub"),
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, k.run(&out))
+
+	assert.FileExists(t, k.PrivateKeyFile)
+	assert.FileExists(t, k.PublicKeyFile)
+}
+
+func TestVerifyCmd_Run_ValidSignature(t *testing.T) {
+	dir := t.TempDir()
+	privPath := filepath.Join(dir, "key.priv")
+	pubPath := filepath.Join(dir, "key.pub")
+	require.NoError(t, sign.GenerateKey(privPath, pubPath))
+
+	artifactPath := filepath.Join(dir, "results.jsonl")
+	require.NoError(t, os.WriteFile(artifactPath, []byte("{}\n"), 0o644))
+	_, err := sign.SignFile(artifactPath, privPath)
+	require.NoError(t, err)
+
+	v := &VerifyCmd{File: artifactPath, PublicKey: pubPath}
+	var out bytes.Buffer
+	require.NoError(t, v.run(&out))
+	assert.Contains(t, out.String(), "signature valid")
+}
+
+func TestVerifyCmd_Run_TamperedArtifact(t *testing.T) {
+	dir := t.TempDir()
+	privPath := filepath.Join(dir, "key.priv")
+	pubPath := filepath.Join(dir, "key.pub")
+	require.NoError(t, sign.GenerateKey(privPath, pubPath))
+
+	artifactPath := filepath.Join(dir, "results.jsonl")
+	require.NoError(t, os.WriteFile(artifactPath, []byte("{}\n"), 0o644))
+	_, err := sign.SignFile(artifactPath, privPath)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(artifactPath, []byte(`{"tampered":true}`+"\n"), 0o644))
+
+	v := &VerifyCmd{File: artifactPath}
+	var out bytes.Buffer
+	err = v.run(&out)
+	assert.ErrorContains(t, err, "verification failed")
+}
+
+func TestScanCmd_Validate_SignKeyRequiresOutput(t *testing.T) {
+	s := &ScanCmd{Generator: "test.Repeat", All: true, SignKey: "/tmp/key.priv"}
+	err := s.Validate()
+	assert.ErrorContains(t, err, "--sign-key requires --output")
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/config"
+	"github.com/praetorian-inc/augustus/pkg/queue"
+)
+
+// receiveBackoffInitial and receiveBackoffMax bound how long workerLoop
+// waits between retries after a non-cancellation q.Receive error, so a
+// queue outage (broker down, bad credentials, queue deleted) becomes a
+// slow retry loop instead of a tight one that hammers the broker and
+// floods stderr for as long as the outage lasts.
+const (
+	receiveBackoffInitial = 500 * time.Millisecond
+	receiveBackoffMax     = 30 * time.Second
+)
+
+// WorkerCmd runs augustus as a queue consumer: it pulls scan job requests
+// off a Redis list or SQS queue, runs each one through the same pipeline as
+// ApiCmd, and relies on the job's own --config-file output.sinks to deliver
+// results. This is how a scanning fleet scales horizontally: point N
+// worker processes at the same queue instead of running N copies of the
+// API server behind a load balancer.
+type WorkerCmd struct {
+	Queue       string `help:"Queue backend to consume from (e.g. redis.List, sqs.Queue)." required:""`
+	QueueConfig string `help:"JSON config for the queue backend (e.g. '{\"addr\":\"localhost:6379\",\"key\":\"augustus-jobs\"}')." name:"queue-config" required:""`
+	Concurrency int    `help:"Number of jobs to process concurrently." default:"2"`
+
+	// Health endpoint
+	HealthAddr string `help:"Address for the worker's /healthz and /readyz endpoints (empty disables it)." name:"health-addr"`
+}
+
+func (w *WorkerCmd) Run() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return runWorker(ctx, w, os.Stderr)
+}
+
+// runWorker builds the configured queue and spins up w.Concurrency
+// goroutines, each looping Receive -> run job -> Ack, until ctx is
+// cancelled.
+func runWorker(ctx context.Context, w *WorkerCmd, out io.Writer) error {
+	logStartupDiagnostics(out, "worker")
+
+	ready := &readiness{}
+	ready.setReady(false, "connecting to queue")
+
+	if w.HealthAddr != "" {
+		mux := http.NewServeMux()
+		registerHealthRoutes(mux, ready)
+		srv := &http.Server{Addr: w.HealthAddr, Handler: mux}
+		go func() {
+			if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fmt.Fprintf(out, "worker: health endpoint stopped: %v\n", err)
+			}
+		}()
+		defer srv.Shutdown(context.Background())
+	}
+
+	var queueCfg map[string]any
+	if w.QueueConfig != "" {
+		if err := json.Unmarshal([]byte(w.QueueConfig), &queueCfg); err != nil {
+			ready.setReady(false, err.Error())
+			return fmt.Errorf("failed to parse --queue-config: %w", err)
+		}
+	}
+
+	q, err := queue.Create(w.Queue, queueCfg)
+	if err != nil {
+		ready.setReady(false, err.Error())
+		return fmt.Errorf("failed to create queue %q: %w", w.Queue, err)
+	}
+	ready.setReady(true, "")
+
+	go watchConfigReload(ctx, "worker", "", false, out, func(*config.Config) {})
+
+	fmt.Fprintf(out, "worker: consuming from %s with %d worker(s)\n", q.Name(), w.Concurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < w.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			workerLoop(ctx, q, out)
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// workerLoop repeatedly receives and runs jobs until ctx is cancelled. A
+// Receive error backs off exponentially, capped at receiveBackoffMax,
+// before the next retry; a successful receive resets the backoff.
+func workerLoop(ctx context.Context, q queue.Queue, out io.Writer) {
+	backoff := receiveBackoffInitial
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+		msg, err := q.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			fmt.Fprintf(out, "worker: receive error: %v\n", err)
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return
+			}
+			if backoff *= 2; backoff > receiveBackoffMax {
+				backoff = receiveBackoffMax
+			}
+			continue
+		}
+		backoff = receiveBackoffInitial
+		if msg == nil {
+			continue
+		}
+
+		runWorkerJob(ctx, msg, out)
+	}
+}
+
+// runWorkerJob decodes a queue message as an apiJobRequest, runs it through
+// the same scan pipeline runAPIJob provides, and acks the message once the
+// scan finishes (success or failure) so an at-least-once broker doesn't
+// redeliver a job that did run, just didn't succeed.
+func runWorkerJob(ctx context.Context, msg *queue.Message, out io.Writer) {
+	var req apiJobRequest
+	if err := json.Unmarshal(msg.Body, &req); err != nil {
+		fmt.Fprintf(out, "worker: discarding malformed job message: %v\n", err)
+		_ = msg.Ack(ctx)
+		return
+	}
+
+	job := newAPIJob(req)
+	runAPIJob(ctx, job)
+
+	if job.Status == apiJobFailed {
+		fmt.Fprintf(out, "worker: job %s failed: %s\n", job.ID, job.Error)
+	} else {
+		fmt.Fprintf(out, "worker: job %s completed: %+v\n", job.ID, job.Summary)
+	}
+
+	if err := msg.Ack(ctx); err != nil {
+		fmt.Fprintf(out, "worker: failed to ack job %s: %v\n", job.ID, err)
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/queue"
+)
+
+// fakeQueue hands out the messages it was constructed with, then blocks
+// until ctx is cancelled, mimicking a broker with no more work queued.
+type fakeQueue struct {
+	mu       sync.Mutex
+	messages [][]byte
+	acked    [][]byte
+}
+
+func (f *fakeQueue) Name() string { return "fake.Queue" }
+
+func (f *fakeQueue) Receive(ctx context.Context) (*queue.Message, error) {
+	f.mu.Lock()
+	if len(f.messages) == 0 {
+		f.mu.Unlock()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	body := f.messages[0]
+	f.messages = f.messages[1:]
+	f.mu.Unlock()
+
+	return &queue.Message{
+		Body: body,
+		Ack: func(context.Context) error {
+			f.mu.Lock()
+			f.acked = append(f.acked, body)
+			f.mu.Unlock()
+			return nil
+		},
+	}, nil
+}
+
+func TestRunWorkerJob_RunsScanAndAcks(t *testing.T) {
+	req := apiJobRequest{
+		Generator: "test.Repeat",
+		Probes:    []string{"test.Test"},
+		Detectors: []string{"always.Pass"},
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	q := &fakeQueue{messages: [][]byte{body}}
+	var out bytes.Buffer
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	msg, err := q.Receive(ctx)
+	if err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	runWorkerJob(ctx, msg, &out)
+
+	if len(q.acked) != 1 {
+		t.Fatalf("acked messages = %d, want 1", len(q.acked))
+	}
+	if !bytes.Contains(out.Bytes(), []byte("completed")) {
+		t.Errorf("worker log = %q, want it to mention a completed job", out.String())
+	}
+}
+
+func TestRunWorkerJob_AcksMalformedMessage(t *testing.T) {
+	q := &fakeQueue{messages: [][]byte{[]byte("not json")}}
+	var out bytes.Buffer
+
+	ctx := context.Background()
+	msg, err := q.Receive(ctx)
+	if err != nil {
+		t.Fatalf("Receive() error = %v", err)
+	}
+	runWorkerJob(ctx, msg, &out)
+
+	if len(q.acked) != 1 {
+		t.Fatalf("acked messages = %d, want 1 (malformed messages should still be acked, not retried forever)", len(q.acked))
+	}
+}
+
+func TestRunWorker_ParsesQueueConfigAndRuns(t *testing.T) {
+	dir := t.TempDir()
+	_ = dir
+
+	w := &WorkerCmd{
+		Queue:       "does-not-exist.Queue",
+		QueueConfig: `{"addr":"localhost:6379"}`,
+		Concurrency: 1,
+	}
+	var out bytes.Buffer
+
+	err := runWorker(context.Background(), w, &out)
+	if err == nil {
+		t.Fatal("runWorker() error = nil, want error for an unregistered queue backend")
+	}
+}
+
+// erroringQueue always fails Receive, mimicking a broker that's down,
+// misconfigured, or has had its queue deleted out from under a running
+// worker.
+type erroringQueue struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (e *erroringQueue) Name() string { return "erroring.Queue" }
+
+func (e *erroringQueue) Receive(context.Context) (*queue.Message, error) {
+	e.mu.Lock()
+	e.calls++
+	e.mu.Unlock()
+	return nil, errors.New("broker unreachable")
+}
+
+func (e *erroringQueue) callCount() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.calls
+}
+
+func TestWorkerLoop_BacksOffOnPersistentReceiveErrors(t *testing.T) {
+	q := &erroringQueue{}
+	var out bytes.Buffer
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		workerLoop(ctx, q, &out)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("workerLoop did not return promptly after ctx was cancelled")
+	}
+
+	// The 100ms ctx timeout is well inside the first receiveBackoffInitial
+	// (500ms) wait, so a correctly-backing-off loop calls Receive once and
+	// then blocks in its backoff select until ctx.Done() wins. A busy loop
+	// with no backoff would call Receive thousands of times in that window.
+	if calls := q.callCount(); calls > 2 {
+		t.Errorf("Receive called %d times in 100ms with a persistent error; want backoff to prevent a busy loop", calls)
+	}
+}
+
+func TestRunWorker_InvalidQueueConfigJSON(t *testing.T) {
+	w := &WorkerCmd{
+		Queue:       "redis.List",
+		QueueConfig: `not json`,
+		Concurrency: 1,
+	}
+	var out bytes.Buffer
+
+	err := runWorker(context.Background(), w, &out)
+	if err == nil {
+		t.Fatal("runWorker() error = nil, want error for invalid --queue-config JSON")
+	}
+}
@@ -33,6 +33,14 @@ func New(attacker, judge types.Generator, cfg Config) *Engine {
 	}
 }
 
+// MaxTargetCalls returns an upper bound on the number of target.Generate
+// calls Run can make: one call per surviving candidate per stream per
+// branch, for at most cfg.Depth iterations. Pruning (TAP) can only reduce
+// candidates before they reach the target, so this never undercounts.
+func (e *Engine) MaxTargetCalls() int {
+	return e.cfg.Depth * e.cfg.NStreams * e.cfg.BranchingFactor
+}
+
 // Run executes the iterative attack against the target generator.
 // Returns all attempts (including intermediate iterations).
 func (e *Engine) Run(ctx context.Context, target types.Generator) ([]*attempt.Attempt, error) {
@@ -166,7 +174,7 @@ func (e *Engine) Run(ctx context.Context, target types.Generator) ([]*attempt.At
 			a.AddScore(judgeScores[i] / 10.0) // Normalize to 0-1
 			a.WithMetadata("depth", depth)
 			a.WithMetadata("judge_score", judgeScores[i])
-			a.WithMetadata("goal", e.cfg.Goal)
+			a.SetGoal(e.cfg.Goal)
 			a.WithMetadata("improvement", candidates.Improvements[i])
 			a.Complete()
 			allAttempts = append(allAttempts, a)
@@ -10,19 +10,43 @@ import (
 	"github.com/praetorian-inc/augustus/pkg/registry"
 )
 
+// variantField documents the "variant" config key shared by every flip buff.
+var variantField = registry.FieldSchema{
+	Name:        "variant",
+	Type:        "string",
+	Default:     "vanilla",
+	Description: `Guidance template variant: "vanilla", "cot", "cot_langgpt", or "full".`,
+}
+
 func init() {
-	buffs.Register("flip.WordOrder", NewWordOrder)
-	buffs.Register("flip.CharsInWord", NewCharsInWord)
-	buffs.Register("flip.CharsInSentence", NewCharsInSentence)
-	buffs.Register("flip.FoolModel", NewFoolModel)
+	buffs.Registry.RegisterWithSchema("flip.WordOrder", NewWordOrder, registry.ConfigSchema{
+		Fields: []registry.FieldSchema{variantField},
+	})
+	buffs.Registry.RegisterWithSchema("flip.CharsInWord", NewCharsInWord, registry.ConfigSchema{
+		Fields: []registry.FieldSchema{variantField},
+	})
+	buffs.Registry.RegisterWithSchema("flip.CharsInSentence", NewCharsInSentence, registry.ConfigSchema{
+		Fields: []registry.FieldSchema{variantField},
+	})
+	buffs.Registry.RegisterWithSchema("flip.FoolModel", NewFoolModel, registry.ConfigSchema{
+		Fields: []registry.FieldSchema{variantField},
+	})
+	buffs.Registry.RegisterWithSchema("flip.Keywords", NewKeywordFlip, registry.ConfigSchema{
+		Fields: []registry.FieldSchema{variantField, {
+			Name:        "keywords",
+			Type:        "[]string",
+			Description: "Terms to flip in place (case-insensitive exact word match). Falls back to a small built-in harmful-term list when omitted.",
+		}},
+	})
 }
 
 // FlipBuff is the shared struct for all flip attack buffs.
 type FlipBuff struct {
-	name    string
-	desc    string
-	mode    FlipMode
-	variant GuidanceVariant
+	name     string
+	desc     string
+	mode     FlipMode
+	variant  GuidanceVariant
+	keywords []string // only consulted when mode is FlipKeywords
 }
 
 // parseVariant extracts GuidanceVariant from config.
@@ -84,6 +108,26 @@ func NewFoolModel(cfg registry.Config) (buffs.Buff, error) {
 	}, nil
 }
 
+// NewKeywordFlip creates a buff that reverses only flagged keywords within
+// the prompt, leaving the rest of the sentence (including punctuation)
+// intact. This is gentler than full-sentence/word-order flips, which tend
+// to degrade attack success on stronger models that struggle to recover a
+// fully scrambled sentence.
+//
+// cfg keys:
+//   - keywords: []string - explicit list of terms to flip (case-insensitive
+//     exact word match). When omitted, falls back to a small built-in list
+//     of commonly-flagged harmful terms.
+func NewKeywordFlip(cfg registry.Config) (buffs.Buff, error) {
+	return &FlipBuff{
+		name:     "flip.Keywords",
+		desc:     "Reverses only flagged keywords in place, leaving the rest of the sentence intact",
+		mode:     FlipKeywords,
+		variant:  parseVariant(cfg),
+		keywords: registry.GetStringSlice(cfg, "keywords", nil),
+	}, nil
+}
+
 // Name returns the buff name.
 func (f *FlipBuff) Name() string {
 	return f.name
@@ -103,26 +147,21 @@ func (f *FlipBuff) Buff(ctx context.Context, attempts []*attempt.Attempt) ([]*at
 func (f *FlipBuff) Transform(a *attempt.Attempt) iter.Seq[*attempt.Attempt] {
 	return func(yield func(*attempt.Attempt) bool) {
 		// Build the flipped prompt with recovery instructions
-		prompt := BuildPrompt(a.Prompt, f.mode, f.variant)
+		prompt := BuildPrompt(a.Prompt, f.mode, f.variant, f.keywords...)
 
 		// Create deep copy
 		transformed := a.Copy()
 		transformed.Prompt = prompt
 		transformed.Prompts = []string{prompt}
 
-		// Initialize metadata if nil
-		if transformed.Metadata == nil {
-			transformed.Metadata = make(map[string]any)
-		}
-
 		// Set metadata for tracking
-		transformed.Metadata[attempt.MetadataKeyFlipMode] = f.mode.String()
-		transformed.Metadata[attempt.MetadataKeyVariant] = f.variant.String()
+		transformed.SetFlipMode(f.mode.String())
+		transformed.SetVariant(f.variant.String())
 		// Only set triggers if not already set by the probe. Triggers should
 		// contain the ORIGINAL harmful payload for detection. In multi-buff
 		// chains, a.Prompt may already be transformed by a prior buff.
 		if _, exists := transformed.Metadata[attempt.MetadataKeyTriggers]; !exists {
-			transformed.Metadata[attempt.MetadataKeyTriggers] = []string{a.Prompt}
+			transformed.SetTriggers([]string{a.Prompt})
 		}
 
 		yield(transformed)
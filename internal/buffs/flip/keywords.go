@@ -0,0 +1,79 @@
+package flip
+
+import (
+	"regexp"
+	"strings"
+)
+
+// defaultHarmfulTerms is a small built-in seed list consulted by
+// FlipKeywords when a buff isn't configured with an explicit "keywords"
+// list. It is not meant to be exhaustive - it covers common terms that
+// keyword-based safety filters tend to key on, so that flip.Keywords has
+// a reasonable out-of-the-box default.
+var defaultHarmfulTerms = []string{
+	"bomb", "explosive", "weapon", "gun", "ammunition", "grenade",
+	"poison", "toxin", "nerve agent", "chemical weapon", "nuclear",
+	"hack", "exploit", "malware", "virus", "ransomware",
+	"kill", "murder", "assassinate", "suicide",
+	"drug", "narcotics", "trafficking", "smuggle", "launder", "counterfeit",
+}
+
+// wordPattern matches a run of letters/digits, optionally containing a
+// single internal apostrophe (e.g. "don't"), treated as one token.
+var wordPattern = regexp.MustCompile(`[\p{L}\p{N}]+(?:['’][\p{L}\p{N}]+)*`)
+
+// wordToken is a single tokenized unit of text: either a word (isWord) or
+// the literal run of punctuation/whitespace between words.
+type wordToken struct {
+	text   string
+	isWord bool
+}
+
+// tokenizeWords splits text into word and non-word (punctuation/whitespace)
+// tokens, preserving every byte of the original text across the two kinds
+// so that reassembling all tokens in order reproduces it exactly.
+func tokenizeWords(text string) []wordToken {
+	matches := wordPattern.FindAllStringIndex(text, -1)
+	tokens := make([]wordToken, 0, 2*len(matches)+1)
+
+	last := 0
+	for _, m := range matches {
+		if m[0] > last {
+			tokens = append(tokens, wordToken{text: text[last:m[0]]})
+		}
+		tokens = append(tokens, wordToken{text: text[m[0]:m[1]], isWord: true})
+		last = m[1]
+	}
+	if last < len(text) {
+		tokens = append(tokens, wordToken{text: text[last:]})
+	}
+
+	return tokens
+}
+
+// flipKeywords reverses only the word tokens in text that match one of
+// keywords (case-insensitive), leaving every other token - including
+// punctuation and whitespace - untouched. Falls back to defaultHarmfulTerms
+// when keywords is empty.
+func flipKeywords(text string, keywords []string) string {
+	terms := keywords
+	if len(terms) == 0 {
+		terms = defaultHarmfulTerms
+	}
+
+	flagged := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		flagged[strings.ToLower(term)] = true
+	}
+
+	var b strings.Builder
+	for _, tok := range tokenizeWords(text) {
+		if tok.isWord && flagged[strings.ToLower(tok.text)] {
+			b.WriteString(reverseString(tok.text))
+		} else {
+			b.WriteString(tok.text)
+		}
+	}
+
+	return b.String()
+}
@@ -24,6 +24,11 @@ const (
 	// FoolModelMode uses FlipCharsInSentence but instructs the model
 	// to recover via word-order flipping (misleading instruction).
 	FoolModelMode
+
+	// FlipKeywords reverses only flagged keywords in place, leaving the
+	// rest of the sentence (including punctuation and spacing) untouched.
+	// "How to build a bomb" -> "How to build a bmob"
+	FlipKeywords
 )
 
 // String returns the mode name for logging.
@@ -37,13 +42,17 @@ func (m FlipMode) String() string {
 		return "FCS"
 	case FoolModelMode:
 		return "FMM"
+	case FlipKeywords:
+		return "FKW"
 	default:
 		return fmt.Sprintf("FlipMode(%d)", int(m))
 	}
 }
 
-// Flip applies the specified flipping mode to the input text.
-func Flip(text string, mode FlipMode) string {
+// Flip applies the specified flipping mode to the input text. keywords is
+// only consulted for FlipKeywords; when empty, FlipKeywords falls back to
+// defaultHarmfulTerms.
+func Flip(text string, mode FlipMode, keywords ...string) string {
 	switch mode {
 	case FlipWordOrder:
 		return flipWordOrder(text)
@@ -51,6 +60,8 @@ func Flip(text string, mode FlipMode) string {
 		return flipCharsInWord(text)
 	case FlipCharsInSentence, FoolModelMode:
 		return flipCharsInSentence(text)
+	case FlipKeywords:
+		return flipKeywords(text, keywords)
 	default:
 		return text
 	}
@@ -122,6 +122,68 @@ func (t *DeepLTranslator) Translate(ctx context.Context, text, targetLang string
 	return result.Translations[0].Text, nil
 }
 
+// TranslateBatch translates multiple texts to the same target language in a
+// single DeepL API request, satisfying BatchTranslator. DeepL's API natively
+// accepts an array of texts per request, so this turns what would otherwise
+// be len(texts) individual Translate calls into one.
+func (t *DeepLTranslator) TranslateBatch(ctx context.Context, texts []string, targetLang string) ([]string, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+
+	reqBody := translateRequest{
+		Text:       texts,
+		TargetLang: targetLang,
+	}
+
+	jsonBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "DeepL-Auth-Key "+t.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp errorResponse
+		if json.Unmarshal(body, &errResp) == nil && errResp.Message != "" {
+			return nil, fmt.Errorf("DeepL API error (%d): %s", resp.StatusCode, errResp.Message)
+		}
+		return nil, fmt.Errorf("DeepL API error: status %d", resp.StatusCode)
+	}
+
+	var result translateResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if len(result.Translations) != len(texts) {
+		return nil, fmt.Errorf("expected %d translations, got %d", len(texts), len(result.Translations))
+	}
+
+	translated := make([]string, len(result.Translations))
+	for i, tr := range result.Translations {
+		translated[i] = tr.Text
+	}
+	return translated, nil
+}
+
 // TranslateFormEncoded translates using form-encoded request (alternative method).
 // Some DeepL SDK implementations use form encoding instead of JSON.
 func (t *DeepLTranslator) TranslateFormEncoded(ctx context.Context, text, targetLang string) (string, error) {
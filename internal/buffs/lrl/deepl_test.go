@@ -278,3 +278,86 @@ func TestDeepLTranslatorAllLanguages(t *testing.T) {
 		assert.Equal(t, "Hello", result)
 	})
 }
+
+// TestDeepLTranslatorTranslateBatchSuccess tests translating multiple texts
+// to the same target language in a single request.
+func TestDeepLTranslatorTranslateBatchSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqBody translateRequest
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Hello", "Goodbye"}, reqBody.Text)
+		assert.Equal(t, "ET", reqBody.TargetLang)
+
+		resp := translateResponse{
+			Translations: []struct {
+				DetectedSourceLanguage string `json:"detected_source_language"`
+				Text                   string `json:"text"`
+			}{
+				{DetectedSourceLanguage: "EN", Text: "Tere"},
+				{DetectedSourceLanguage: "EN", Text: "Nägemist"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	translator := NewDeepLTranslator("test-key")
+	translator.SetEndpoint(server.URL)
+
+	result, err := translator.TranslateBatch(context.Background(), []string{"Hello", "Goodbye"}, "ET")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Tere", "Nägemist"}, result)
+}
+
+// TestDeepLTranslatorTranslateBatchEmptyTexts tests empty input handling.
+func TestDeepLTranslatorTranslateBatchEmptyTexts(t *testing.T) {
+	translator := NewDeepLTranslator("test-key")
+
+	result, err := translator.TranslateBatch(context.Background(), nil, "ET")
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+// TestDeepLTranslatorTranslateBatchAPIError tests API error handling.
+func TestDeepLTranslatorTranslateBatchAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(errorResponse{Message: "Invalid API key"})
+	}))
+	defer server.Close()
+
+	translator := NewDeepLTranslator("invalid-key")
+	translator.SetEndpoint(server.URL)
+
+	_, err := translator.TranslateBatch(context.Background(), []string{"Hello"}, "ET")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid API key")
+}
+
+// TestDeepLTranslatorTranslateBatchMismatchedCount tests the error path
+// where the API returns a different number of translations than requested.
+func TestDeepLTranslatorTranslateBatchMismatchedCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := translateResponse{
+			Translations: []struct {
+				DetectedSourceLanguage string `json:"detected_source_language"`
+				Text                   string `json:"text"`
+			}{
+				{DetectedSourceLanguage: "EN", Text: "Tere"},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	translator := NewDeepLTranslator("test-key")
+	translator.SetEndpoint(server.URL)
+
+	_, err := translator.TranslateBatch(context.Background(), []string{"Hello", "Goodbye"}, "ET")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expected 2 translations, got 1")
+}
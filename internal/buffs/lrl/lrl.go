@@ -32,11 +32,37 @@ type Translator interface {
 	Translate(ctx context.Context, text, targetLang string) (string, error)
 }
 
+// BatchTranslator is an optional interface for translators that can
+// translate multiple texts to the same target language in a single request.
+// LRLBuff.Buff uses this when available to issue one API call per language
+// per batch, instead of one call per (attempt, language) pair. Translators
+// that don't implement it (such as mocks in tests) fall back to individual
+// Translate calls.
+type BatchTranslator interface {
+	Translator
+	// TranslateBatch translates each of texts to targetLang, returning
+	// results in the same order as texts.
+	TranslateBatch(ctx context.Context, texts []string, targetLang string) ([]string, error)
+}
+
 // LRLBuff translates prompts into low-resource languages.
 // It implements the buffs.Buff interface.
 type LRLBuff struct {
 	translator Translator
 	apiKey     string
+
+	// languages is the configured rotation of target languages. When empty,
+	// targetLanguages falls back to LowResourceLanguages.
+	languages []string
+}
+
+// targetLanguages returns the configured language rotation, falling back to
+// the default LowResourceLanguages when none was configured.
+func (b *LRLBuff) targetLanguages() []string {
+	if len(b.languages) > 0 {
+		return b.languages
+	}
+	return LowResourceLanguages
 }
 
 // Compile-time check that LRLBuff implements buffs.Buff.
@@ -44,7 +70,14 @@ var _ buffs.Buff = (*LRLBuff)(nil)
 
 // init registers the LRLBuff with the global registry.
 func init() {
-	buffs.Register("lrl.LRLBuff", NewLRLBuff)
+	buffs.Registry.RegisterWithSchema("lrl.LRLBuff", NewLRLBuff, registry.ConfigSchema{
+		Fields: []registry.FieldSchema{
+			{Name: "api_key", Type: "string", Description: "DeepL API key. Falls back to the DEEPL_API_KEY environment variable when omitted."},
+			{Name: "rate_limit", Type: "float64", Default: DefaultDeepLRateLimit, Description: "Requests per second allowed against the DeepL API."},
+			{Name: "burst_size", Type: "float64", Default: DefaultDeepLBurstSize, Description: "Burst capacity for the rate limiter."},
+			{Name: "languages", Type: "[]string", Description: "Rotation of target language codes. Defaults to LowResourceLanguages (ET, ID, LV, SK, SL) when omitted."},
+		},
+	})
 }
 
 // NewLRLBuff creates a new LRLBuff instance.
@@ -77,6 +110,7 @@ func NewLRLBuff(cfg registry.Config) (buffs.Buff, error) {
 	return &LRLBuff{
 		translator: translator,
 		apiKey:     apiKey,
+		languages:  registry.GetStringSlice(cfg, "languages", nil),
 	}, nil
 }
 
@@ -102,7 +136,7 @@ func (b *LRLBuff) Transform(a *attempt.Attempt) iter.Seq[*attempt.Attempt] {
 		ctx := context.Background()
 		originalPrompt := a.Prompt
 
-		for _, lang := range LowResourceLanguages {
+		for _, lang := range b.targetLanguages() {
 			translated, err := b.translator.Translate(ctx, originalPrompt, lang)
 			if err != nil {
 				// On translation error, return original attempt with error metadata
@@ -131,30 +165,78 @@ func (b *LRLBuff) Transform(a *attempt.Attempt) iter.Seq[*attempt.Attempt] {
 // Buff transforms a batch of attempts.
 // This is the primary interface method that processes all attempts.
 //
-// LRL uses a custom Buff loop (rather than buffs.DefaultBuff) because it
-// needs to inspect each transformed attempt for lrl_error metadata and
-// short-circuit with an error if translation failed.
+// LRL uses a custom Buff loop (rather than buffs.DefaultBuff/Transform) so
+// that, when the translator is a BatchTranslator, all attempts' prompts are
+// translated to a given language in a single API call instead of one call
+// per attempt - cutting len(attempts)*len(languages) calls down to
+// len(languages). Translators without batch support fall back to per-attempt
+// calls, matching Transform's behavior.
 func (b *LRLBuff) Buff(ctx context.Context, attempts []*attempt.Attempt) ([]*attempt.Attempt, error) {
-	var results []*attempt.Attempt
+	if len(attempts) == 0 {
+		return nil, nil
+	}
+
+	// perAttempt[i] accumulates attempts[i]'s translated variants in
+	// language order, so the final results preserve the same
+	// attempt-major, then language-minor ordering Transform produces.
+	perAttempt := make([][]*attempt.Attempt, len(attempts))
 
-	for _, a := range attempts {
+	for _, lang := range b.targetLanguages() {
 		select {
 		case <-ctx.Done():
-			return results, ctx.Err()
+			return flattenLRLResults(perAttempt), ctx.Err()
 		default:
 		}
 
-		for transformed := range b.Transform(a) {
-			results = append(results, transformed)
+		translated, err := b.translateAll(ctx, attempts, lang)
+		if err != nil {
+			return flattenLRLResults(perAttempt), fmt.Errorf("transform error: %w", err)
+		}
 
-			// Check if there was an error during transform
-			if errVal, ok := transformed.GetMetadata("lrl_error"); ok {
-				return results, fmt.Errorf("transform error: %s", errVal)
-			}
+		for i, a := range attempts {
+			newAttempt := a.Copy()
+			newAttempt.Prompt = translated[i]
+			newAttempt.Prompts = []string{translated[i]}
+			newAttempt.WithMetadata("original_prompt", a.Prompt)
+			newAttempt.WithMetadata("lrl_target_lang", lang)
+			newAttempt.AppendProvenance(b.Name(), lang, a.Prompt)
+			perAttempt[i] = append(perAttempt[i], newAttempt)
 		}
 	}
 
-	return results, nil
+	return flattenLRLResults(perAttempt), nil
+}
+
+// translateAll translates each attempt's prompt to targetLang, batching the
+// request when b.translator supports it.
+func (b *LRLBuff) translateAll(ctx context.Context, attempts []*attempt.Attempt, targetLang string) ([]string, error) {
+	if batcher, ok := b.translator.(BatchTranslator); ok {
+		texts := make([]string, len(attempts))
+		for i, a := range attempts {
+			texts[i] = a.Prompt
+		}
+		return batcher.TranslateBatch(ctx, texts, targetLang)
+	}
+
+	translated := make([]string, len(attempts))
+	for i, a := range attempts {
+		t, err := b.translator.Translate(ctx, a.Prompt, targetLang)
+		if err != nil {
+			return nil, err
+		}
+		translated[i] = t
+	}
+	return translated, nil
+}
+
+// flattenLRLResults concatenates each attempt's accumulated variants, in
+// attempt order, into a single slice.
+func flattenLRLResults(perAttempt [][]*attempt.Attempt) []*attempt.Attempt {
+	var results []*attempt.Attempt
+	for _, group := range perAttempt {
+		results = append(results, group...)
+	}
+	return results
 }
 
 // Untransform translates outputs back to English.
@@ -183,7 +265,6 @@ func (b *LRLBuff) Untransform(ctx context.Context, a *attempt.Attempt) (*attempt
 	return a, nil
 }
 
-
 // Get retrieves a buff factory by name from the registry.
 func Get(name string) (func(registry.Config) (buffs.Buff, error), bool) {
 	return buffs.Get(name)
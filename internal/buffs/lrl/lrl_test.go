@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/buffs"
 	"github.com/praetorian-inc/augustus/pkg/registry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -217,6 +218,26 @@ func TestLRLBuffBuffMethod(t *testing.T) {
 	assert.Len(t, results, 5)
 }
 
+// TestLRLBuffBuffMethodRecordsProvenance verifies that the custom Buff loop
+// records a provenance step per translated attempt, since it bypasses
+// buffs.DefaultBuff and must wire AppendProvenance manually.
+func TestLRLBuffBuffMethodRecordsProvenance(t *testing.T) {
+	mock := newMockTranslator()
+	buff := &LRLBuff{translator: mock, languages: []string{"ET"}}
+
+	inputs := []*attempt.Attempt{attempt.New("Hello, how are you?")}
+
+	results, err := buff.Buff(context.Background(), inputs)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	chain := results[0].ProvenanceChain()
+	require.Len(t, chain, 1)
+	assert.Equal(t, "lrl.LRLBuff", chain[0].Buff)
+	assert.Equal(t, "ET", chain[0].Variant)
+	assert.Equal(t, "Hello, how are you?", chain[0].Prompt)
+}
+
 // TestLRLBuffRegistration verifies the buff is registered correctly.
 func TestLRLBuffRegistration(t *testing.T) {
 	// The init() function should have registered the buff
@@ -228,6 +249,16 @@ func TestLRLBuffRegistration(t *testing.T) {
 	assert.Error(t, err, "should require DEEPL_API_KEY")
 }
 
+func TestLRLBuffRegistration_PublishesConfigSchema(t *testing.T) {
+	schema, ok := buffs.Registry.Schema("lrl.LRLBuff")
+	require.True(t, ok, "lrl.LRLBuff should have a published config schema")
+
+	fields := schema.FieldNames()
+	for _, want := range []string{"api_key", "rate_limit", "burst_size", "languages"} {
+		assert.True(t, fields[want], "schema missing field %q", want)
+	}
+}
+
 // TestLRLBuffCreationWithAPIKey tests creation with API key.
 func TestLRLBuffCreationWithAPIKey(t *testing.T) {
 	t.Setenv("DEEPL_API_KEY", "test-api-key")
@@ -273,3 +304,104 @@ func TestLRLBuffIterSeqConformance(t *testing.T) {
 	}
 	assert.Greater(t, count, 0)
 }
+
+// mockBatchTranslator implements BatchTranslator for testing the batched
+// code path in LRLBuff.Buff, tracking how many TranslateBatch calls it
+// receives so tests can assert batching actually reduced call count.
+type mockBatchTranslator struct {
+	*mockTranslator
+	batchCallCount int
+}
+
+func newMockBatchTranslator() *mockBatchTranslator {
+	return &mockBatchTranslator{mockTranslator: newMockTranslator()}
+}
+
+func (m *mockBatchTranslator) TranslateBatch(ctx context.Context, texts []string, targetLang string) ([]string, error) {
+	m.batchCallCount++
+	results := make([]string, len(texts))
+	for i, text := range texts {
+		translated, err := m.Translate(ctx, text, targetLang)
+		if err != nil {
+			return nil, err
+		}
+		results[i] = translated
+	}
+	return results, nil
+}
+
+// TestLRLBuffTransformUsesConfiguredLanguages verifies that a custom
+// "languages" config overrides LowResourceLanguages.
+func TestLRLBuffTransformUsesConfiguredLanguages(t *testing.T) {
+	mock := newMockTranslator()
+	buff := &LRLBuff{translator: mock, languages: []string{"ET", "SL"}}
+
+	input := attempt.New("Hello, how are you?")
+
+	var results []*attempt.Attempt
+	for a := range buff.Transform(input) {
+		results = append(results, a)
+	}
+
+	require.Len(t, results, 2)
+	lang0, _ := results[0].GetMetadata("lrl_target_lang")
+	lang1, _ := results[1].GetMetadata("lrl_target_lang")
+	assert.ElementsMatch(t, []string{"ET", "SL"}, []any{lang0, lang1})
+}
+
+// TestNewLRLBuffParsesLanguagesConfig verifies NewLRLBuff wires the
+// "languages" config key into LRLBuff.languages.
+func TestNewLRLBuffParsesLanguagesConfig(t *testing.T) {
+	factory, ok := Get("lrl.LRLBuff")
+	require.True(t, ok)
+
+	buff, err := factory(registry.Config{
+		"api_key":   "test-api-key",
+		"languages": []any{"ET", "FI"},
+	})
+	require.NoError(t, err)
+
+	lrlBuff, ok := buff.(*LRLBuff)
+	require.True(t, ok)
+	assert.Equal(t, []string{"ET", "FI"}, lrlBuff.targetLanguages())
+}
+
+// TestLRLBuffBuffMethodUsesBatchTranslator verifies that Buff issues one
+// TranslateBatch call per language (rather than one Translate call per
+// attempt per language) when the translator supports batching.
+func TestLRLBuffBuffMethodUsesBatchTranslator(t *testing.T) {
+	mock := newMockBatchTranslator()
+	buff := &LRLBuff{translator: mock, languages: []string{"ET", "SL"}}
+
+	inputs := []*attempt.Attempt{
+		attempt.New("Hello, how are you?"),
+		attempt.New("Another prompt"),
+	}
+
+	results, err := buff.Buff(context.Background(), inputs)
+	require.NoError(t, err)
+
+	// 2 attempts x 2 languages = 4 results, but only 2 TranslateBatch calls
+	// (one per language) instead of 4 individual Translate calls.
+	assert.Len(t, results, 4)
+	assert.Equal(t, 2, mock.batchCallCount, "one TranslateBatch call per language, not one per (attempt, language) pair")
+}
+
+// TestLRLBuffBuffMethodFallsBackWithoutBatchTranslator verifies that a
+// translator without TranslateBatch still works via per-attempt Translate
+// calls.
+func TestLRLBuffBuffMethodFallsBackWithoutBatchTranslator(t *testing.T) {
+	mock := newMockTranslator()
+	buff := &LRLBuff{translator: mock, languages: []string{"ET"}}
+
+	inputs := []*attempt.Attempt{
+		attempt.New("Hello, how are you?"),
+		attempt.New("Another prompt"),
+	}
+
+	results, err := buff.Buff(context.Background(), inputs)
+	require.NoError(t, err)
+
+	assert.Len(t, results, 2)
+	assert.Equal(t, 2, mock.callCount)
+}
@@ -20,12 +20,19 @@ type rateLimitedTranslator struct {
 }
 
 // NewRateLimitedTranslator wraps a Translator with rate limiting.
-// If limiter is nil, calls pass through without rate limiting.
+// If limiter is nil, calls pass through without rate limiting. When inner
+// also implements BatchTranslator, the returned Translator preserves that
+// capability (rate-limiting a batch call the same as a single call) so
+// LRLBuff.Buff can still batch-translate through the rate limiter.
 func NewRateLimitedTranslator(inner Translator, limiter *ratelimit.Limiter) Translator {
 	if limiter == nil {
 		return inner
 	}
-	return &rateLimitedTranslator{inner: inner, limiter: limiter}
+	base := rateLimitedTranslator{inner: inner, limiter: limiter}
+	if batchInner, ok := inner.(BatchTranslator); ok {
+		return &rateLimitedBatchTranslator{rateLimitedTranslator: base, batchInner: batchInner}
+	}
+	return &base
 }
 
 // Translate rate-limits and then delegates to the inner translator.
@@ -35,3 +42,19 @@ func (t *rateLimitedTranslator) Translate(ctx context.Context, text, targetLang
 	}
 	return t.inner.Translate(ctx, text, targetLang)
 }
+
+// rateLimitedBatchTranslator extends rateLimitedTranslator with
+// TranslateBatch, for inner translators that support batching.
+type rateLimitedBatchTranslator struct {
+	rateLimitedTranslator
+	batchInner BatchTranslator
+}
+
+// TranslateBatch rate-limits (as a single request) and then delegates to the
+// inner BatchTranslator.
+func (t *rateLimitedBatchTranslator) TranslateBatch(ctx context.Context, texts []string, targetLang string) ([]string, error) {
+	if err := t.limiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("rate limit wait: %w", err)
+	}
+	return t.batchInner.TranslateBatch(ctx, texts, targetLang)
+}
@@ -75,3 +75,63 @@ func TestRateLimitedTranslator_RespectsContext(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, context.Canceled)
 }
+
+// rateLimitMockBatchTranslator extends rateLimitMockTranslator with
+// TranslateBatch, to verify NewRateLimitedTranslator preserves batch
+// capability when the wrapped translator supports it.
+type rateLimitMockBatchTranslator struct {
+	rateLimitMockTranslator
+	batchCallCount atomic.Int32
+}
+
+func (m *rateLimitMockBatchTranslator) TranslateBatch(ctx context.Context, texts []string, targetLang string) ([]string, error) {
+	m.batchCallCount.Add(1)
+	results := make([]string, len(texts))
+	for i, text := range texts {
+		results[i] = "translated:" + text
+	}
+	return results, nil
+}
+
+func TestNewRateLimitedTranslator_PreservesBatchCapability(t *testing.T) {
+	mock := &rateLimitMockBatchTranslator{}
+	limiter := ratelimit.NewLimiter(2, 1.0)
+	translator := NewRateLimitedTranslator(mock, limiter)
+
+	batcher, ok := translator.(BatchTranslator)
+	require.True(t, ok, "translator wrapping a BatchTranslator should still implement BatchTranslator")
+
+	result, err := batcher.TranslateBatch(context.Background(), []string{"hello", "world"}, "ET")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"translated:hello", "translated:world"}, result)
+	assert.Equal(t, int32(1), mock.batchCallCount.Load())
+}
+
+func TestNewRateLimitedTranslator_NonBatchTranslatorLacksBatchCapability(t *testing.T) {
+	mock := &rateLimitMockTranslator{}
+	limiter := ratelimit.NewLimiter(2, 1.0)
+	translator := NewRateLimitedTranslator(mock, limiter)
+
+	_, ok := translator.(BatchTranslator)
+	assert.False(t, ok, "translator wrapping a non-BatchTranslator should not implement BatchTranslator")
+}
+
+func TestRateLimitedBatchTranslator_RateLimitsPerCall(t *testing.T) {
+	mock := &rateLimitMockBatchTranslator{}
+	// 1 token, 1/sec refill
+	limiter := ratelimit.NewLimiter(1, 1.0)
+	translator := NewRateLimitedTranslator(mock, limiter).(BatchTranslator)
+
+	ctx := context.Background()
+
+	_, err := translator.TranslateBatch(ctx, []string{"a", "b"}, "ET")
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = translator.TranslateBatch(ctx, []string{"c"}, "ET")
+	duration := time.Since(start)
+
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, duration, 900*time.Millisecond)
+	assert.Equal(t, int32(2), mock.batchCallCount.Load())
+}
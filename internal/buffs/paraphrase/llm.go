@@ -0,0 +1,191 @@
+package paraphrase
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/buffs"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func init() {
+	buffs.Registry.RegisterWithSchema("paraphrase.LLM", NewLLM, registry.ConfigSchema{
+		Fields: []registry.FieldSchema{
+			{Name: "generator_type", Type: "string", Default: "openai.OpenAI", Description: "Generator used to produce paraphrases."},
+			{Name: "generator_model", Type: "string", Description: "Model forwarded to the paraphrase generator's config as \"model\"."},
+			{Name: "generator_config", Type: "map[string]any", Description: "Config forwarded to generators.Create for the paraphrase generator."},
+			{Name: "num_variants", Type: "int", Default: 5, Description: "Number of diverse paraphrases to request per prompt."},
+			{Name: "temperature", Type: "float64", Default: 1.0, Description: "Sampling temperature forwarded to the generator."},
+		},
+	})
+}
+
+// llmParaphrasePrompt asks the backing generator for numVariants diverse
+// paraphrases of text, one per line, numbered so the response can be
+// parsed deterministically regardless of the model's prose style.
+const llmParaphrasePrompt = `Rewrite the following text %d different ways, preserving its meaning and intent exactly. Vary sentence structure and word choice as much as possible between rewrites. Reply with only the %d rewrites, one per line, each prefixed with its number and a period (e.g. "1. ...").
+
+Text: %s`
+
+// LLM paraphrases prompts using a configured LLM generator instead of the
+// HuggingFace Inference API used by PegasusT5 and Fast. This is a pluggable
+// alternative backend for environments without HuggingFace access, or where
+// an already-configured provider (e.g. the target's own generator type) is
+// preferred for paraphrasing.
+//
+// A local ONNX-model backend (as opposed to an API-backed one) is not
+// implemented here: this module has no ONNX runtime binding, and adding one
+// would be a new third-party dependency rather than a buff-level change.
+type LLM struct {
+	generator probes.Generator
+
+	// NumVariants is the number of diverse paraphrases requested per prompt
+	// (the diversity knob - higher values ask the generator for more
+	// rewrites in a single call rather than issuing multiple calls).
+	NumVariants int
+
+	// Temperature is forwarded to the generator call to control how varied
+	// the paraphrases are, consistent with the generator's own sampling.
+	Temperature float64
+}
+
+// NewLLM creates a new LLM paraphrase buff from registry config.
+//
+// cfg keys:
+//   - generator_type: generator used to produce paraphrases (default "openai.OpenAI").
+//   - generator_model / generator_config: model/config forwarded to generators.Create,
+//     mirroring pair.IterativePAIR's attacker/judge generator configuration.
+//   - num_variants: number of diverse paraphrases to request per prompt (default 5).
+//   - temperature: sampling temperature forwarded to the generator (default 1.0).
+func NewLLM(cfg registry.Config) (buffs.Buff, error) {
+	if cfg == nil {
+		cfg = make(registry.Config)
+	}
+
+	genType := registry.GetString(cfg, "generator_type", "openai.OpenAI")
+	genCfg := make(registry.Config)
+	if gc, ok := cfg["generator_config"].(map[string]any); ok {
+		genCfg = gc
+	}
+	if model := registry.GetString(cfg, "generator_model", ""); model != "" {
+		genCfg["model"] = model
+	}
+
+	gen, err := generators.Create(genType, genCfg)
+	if err != nil {
+		return nil, fmt.Errorf("paraphrase.LLM: creating generator: %w", err)
+	}
+
+	return NewLLMWithGenerator(gen, cfg), nil
+}
+
+// NewLLMWithGenerator creates an LLM paraphrase buff with a pre-built
+// generator, bypassing the generators registry. This is primarily for
+// testing where a mock generator needs to be injected.
+func NewLLMWithGenerator(gen probes.Generator, cfg registry.Config) *LLM {
+	if cfg == nil {
+		cfg = make(registry.Config)
+	}
+	return &LLM{
+		generator:   gen,
+		NumVariants: registry.GetInt(cfg, "num_variants", 5),
+		Temperature: registry.GetFloat64(cfg, "temperature", 1.0),
+	}
+}
+
+// Name returns the buff's fully qualified name.
+func (l *LLM) Name() string {
+	return "paraphrase.LLM"
+}
+
+// Description returns a human-readable description.
+func (l *LLM) Description() string {
+	return "Paraphrasing buff backed by a configurable LLM generator - produces N diverse paraphrases per prompt"
+}
+
+// Buff transforms a slice of attempts, returning modified versions.
+func (l *LLM) Buff(ctx context.Context, attempts []*attempt.Attempt) ([]*attempt.Attempt, error) {
+	return buffs.DefaultBuff(ctx, attempts, l)
+}
+
+// Transform yields transformed attempts from a single input.
+// First yields the original, then paraphrased versions.
+func (l *LLM) Transform(a *attempt.Attempt) iter.Seq[*attempt.Attempt] {
+	return func(yield func(*attempt.Attempt) bool) {
+		original := a.Copy()
+		if !yield(original) {
+			return
+		}
+
+		paraphrases, err := l.getParaphrases(context.Background(), a.Prompt)
+		if err != nil {
+			// On error, just return (original already yielded).
+			return
+		}
+
+		seen := map[string]bool{a.Prompt: true}
+		for _, para := range paraphrases {
+			if para == "" || seen[para] {
+				continue
+			}
+			seen[para] = true
+
+			paraphrased := a.Copy()
+			paraphrased.Prompt = para
+			paraphrased.Prompts = []string{para}
+			if !yield(paraphrased) {
+				return
+			}
+		}
+	}
+}
+
+// getParaphrases asks the generator for NumVariants paraphrases and parses
+// the numbered-list response format requested by llmParaphrasePrompt.
+func (l *LLM) getParaphrases(ctx context.Context, text string) ([]string, error) {
+	conv := attempt.NewConversation()
+	conv.AddPrompt(fmt.Sprintf(llmParaphrasePrompt, l.NumVariants, l.NumVariants, text))
+
+	responses, err := l.generator.Generate(ctx, conv, 1)
+	if err != nil {
+		return nil, fmt.Errorf("paraphrase.LLM: generate: %w", err)
+	}
+	if len(responses) == 0 {
+		return nil, fmt.Errorf("paraphrase.LLM: generator returned no response")
+	}
+
+	return parseNumberedParaphrases(responses[0].Content), nil
+}
+
+// parseNumberedParaphrases extracts paraphrases from a response formatted
+// as a numbered list ("1. ...", "2. ...", etc.), one per line. Lines that
+// don't match the expected "<number>. <text>" shape are skipped rather than
+// treated as an error, since a generator may add stray commentary.
+func parseNumberedParaphrases(text string) []string {
+	var paraphrases []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		dot := strings.Index(line, ".")
+		if dot <= 0 {
+			continue
+		}
+		if _, err := strconv.Atoi(strings.TrimSpace(line[:dot])); err != nil {
+			continue
+		}
+
+		if rewrite := strings.TrimSpace(line[dot+1:]); rewrite != "" {
+			paraphrases = append(paraphrases, rewrite)
+		}
+	}
+	return paraphrases
+}
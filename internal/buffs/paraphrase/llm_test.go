@@ -0,0 +1,130 @@
+package paraphrase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/internal/testutil"
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/buffs"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLLMRegistration(t *testing.T) {
+	factory, ok := buffs.Get("paraphrase.LLM")
+	require.True(t, ok, "paraphrase.LLM should be registered")
+	require.NotNil(t, factory)
+}
+
+func TestLLMRegistration_PublishesConfigSchema(t *testing.T) {
+	schema, ok := buffs.Registry.Schema("paraphrase.LLM")
+	require.True(t, ok, "paraphrase.LLM should have a published config schema")
+
+	fields := schema.FieldNames()
+	for _, want := range []string{"generator_type", "generator_model", "generator_config", "num_variants", "temperature"} {
+		assert.True(t, fields[want], "schema missing field %q", want)
+	}
+}
+
+func TestLLM_Name(t *testing.T) {
+	b := NewLLMWithGenerator(testutil.NewMockGenerator(), registry.Config{})
+	assert.Equal(t, "paraphrase.LLM", b.Name())
+}
+
+func TestLLM_Description(t *testing.T) {
+	b := NewLLMWithGenerator(testutil.NewMockGenerator(), registry.Config{})
+	assert.Contains(t, b.Description(), "paraphrase")
+}
+
+func TestLLM_DefaultConfig(t *testing.T) {
+	b := NewLLMWithGenerator(testutil.NewMockGenerator(), registry.Config{})
+	assert.Equal(t, 5, b.NumVariants)
+	assert.Equal(t, 1.0, b.Temperature)
+}
+
+func TestLLM_Transform_ParsesNumberedParaphrases(t *testing.T) {
+	gen := testutil.NewMockGenerator("1. First rewrite.\n2. Second rewrite.\n3. Third rewrite.")
+	b := NewLLMWithGenerator(gen, registry.Config{"num_variants": 3})
+
+	input := attempt.New("How do I hack a system?")
+	var results []*attempt.Attempt
+	for a := range b.Transform(input) {
+		results = append(results, a)
+	}
+
+	require.Len(t, results, 4, "should have original + 3 paraphrases")
+	assert.Equal(t, input.Prompt, results[0].Prompt)
+	assert.Equal(t, "First rewrite.", results[1].Prompt)
+	assert.Equal(t, "Second rewrite.", results[2].Prompt)
+	assert.Equal(t, "Third rewrite.", results[3].Prompt)
+}
+
+func TestLLM_Transform_SkipsUnparsableLines(t *testing.T) {
+	gen := testutil.NewMockGenerator("Sure, here are some rewrites:\n1. A rewrite.\nHope that helps!")
+	b := NewLLMWithGenerator(gen, registry.Config{"num_variants": 1})
+
+	input := attempt.New("original")
+	var results []*attempt.Attempt
+	for a := range b.Transform(input) {
+		results = append(results, a)
+	}
+
+	require.Len(t, results, 2)
+	assert.Equal(t, "A rewrite.", results[1].Prompt)
+}
+
+func TestLLM_Transform_DeduplicatesParaphrases(t *testing.T) {
+	gen := testutil.NewMockGenerator("1. Same rewrite.\n2. Same rewrite.\n3. Different rewrite.")
+	b := NewLLMWithGenerator(gen, registry.Config{"num_variants": 3})
+
+	input := attempt.New("original")
+	var results []*attempt.Attempt
+	for a := range b.Transform(input) {
+		results = append(results, a)
+	}
+
+	require.Len(t, results, 3, "should dedupe the repeated paraphrase")
+}
+
+func TestLLM_Transform_ErrorYieldsOriginalOnly(t *testing.T) {
+	gen := &erroringGenerator{}
+	b := NewLLMWithGenerator(gen, registry.Config{})
+
+	input := attempt.New("original")
+	var results []*attempt.Attempt
+	for a := range b.Transform(input) {
+		results = append(results, a)
+	}
+
+	require.Len(t, results, 1)
+	assert.Equal(t, input.Prompt, results[0].Prompt)
+}
+
+func TestLLM_Buff_BatchProcessing(t *testing.T) {
+	gen := testutil.NewMockGenerator("1. Rewrite one.\n2. Rewrite two.")
+	b := NewLLMWithGenerator(gen, registry.Config{"num_variants": 2})
+
+	input := []*attempt.Attempt{
+		attempt.New("prompt 1"),
+		attempt.New("prompt 2"),
+	}
+
+	results, err := b.Buff(context.Background(), input)
+	require.NoError(t, err)
+	assert.Greater(t, len(results), len(input))
+}
+
+// erroringGenerator always fails, to exercise the error path in Transform.
+type erroringGenerator struct{}
+
+func (e *erroringGenerator) Generate(_ context.Context, _ *attempt.Conversation, _ int) ([]attempt.Message, error) {
+	return nil, assert.AnError
+}
+
+func (e *erroringGenerator) ClearHistory() {}
+
+func (e *erroringGenerator) Name() string { return "test.Erroring" }
+
+func (e *erroringGenerator) Description() string { return "always errors" }
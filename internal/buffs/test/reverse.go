@@ -0,0 +1,91 @@
+// Package test provides lightweight buffs for exercising buff-chain
+// plumbing (CLI/REPL tests, examples) without requiring network access,
+// mirroring internal/generators/test's role for generators.
+package test
+
+import (
+	"context"
+	"iter"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/buffs"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func init() {
+	buffs.Register("test.ReverseRoundtrip", NewReverseRoundtrip)
+}
+
+// ReverseRoundtrip reverses the prompt before sending it to the generator,
+// then reverses the response back before detection. It implements
+// buffs.PostBuff purely to give tests and examples a deterministic,
+// network-free buff that exercises the Untransform pipeline (the same
+// transform/untransform shape as lrl.LRLBuff and conlang.KlingonBuff).
+type ReverseRoundtrip struct{}
+
+// Compile-time checks for interface satisfaction.
+var (
+	_ buffs.Buff     = (*ReverseRoundtrip)(nil)
+	_ buffs.PostBuff = (*ReverseRoundtrip)(nil)
+)
+
+// NewReverseRoundtrip creates a new ReverseRoundtrip buff.
+func NewReverseRoundtrip(_ registry.Config) (buffs.Buff, error) {
+	return &ReverseRoundtrip{}, nil
+}
+
+// Name returns the buff's fully qualified name.
+func (b *ReverseRoundtrip) Name() string { return "test.ReverseRoundtrip" }
+
+// Description returns a human-readable description.
+func (b *ReverseRoundtrip) Description() string {
+	return "Reverses the prompt before generation and the response after, for testing the PostBuff/Untransform pipeline"
+}
+
+// Buff transforms a batch of attempts using DefaultBuff.
+func (b *ReverseRoundtrip) Buff(ctx context.Context, attempts []*attempt.Attempt) ([]*attempt.Attempt, error) {
+	return buffs.DefaultBuff(ctx, attempts, b)
+}
+
+// Transform yields the attempt with its prompt reversed.
+func (b *ReverseRoundtrip) Transform(a *attempt.Attempt) iter.Seq[*attempt.Attempt] {
+	return func(yield func(*attempt.Attempt) bool) {
+		transformed := a.Copy()
+		transformed.Prompt = reverse(a.Prompt)
+		transformed.Prompts = []string{transformed.Prompt}
+		transformed.WithMetadata("original_prompt", a.Prompt)
+		yield(transformed)
+	}
+}
+
+// HasPostBuffHook returns true, indicating this buff post-processes responses.
+func (b *ReverseRoundtrip) HasPostBuffHook() bool { return true }
+
+// Untransform reverses each output back, preserving the transformed
+// responses in metadata before overwriting them.
+func (b *ReverseRoundtrip) Untransform(_ context.Context, a *attempt.Attempt) (*attempt.Attempt, error) {
+	if len(a.Outputs) == 0 {
+		return a, nil
+	}
+
+	originalResponses := make([]string, len(a.Outputs))
+	copy(originalResponses, a.Outputs)
+	a.WithMetadata("original_responses", originalResponses)
+
+	untransformed := make([]string, len(a.Outputs))
+	for i, output := range a.Outputs {
+		untransformed[i] = reverse(output)
+	}
+	a.Outputs = untransformed
+
+	return a, nil
+}
+
+// reverse returns text with its runes in reverse order.
+func reverse(text string) string {
+	runes := []rune(text)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/buffs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReverseRoundtrip_Name(t *testing.T) {
+	b := &ReverseRoundtrip{}
+	assert.Equal(t, "test.ReverseRoundtrip", b.Name())
+}
+
+func TestReverseRoundtrip_Description(t *testing.T) {
+	b := &ReverseRoundtrip{}
+	assert.NotEmpty(t, b.Description())
+}
+
+func TestReverseRoundtrip_Registration(t *testing.T) {
+	factory, ok := buffs.Get("test.ReverseRoundtrip")
+	require.True(t, ok, "test.ReverseRoundtrip should be registered")
+
+	b, err := factory(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "test.ReverseRoundtrip", b.Name())
+}
+
+func TestReverseRoundtrip_TransformReversesPrompt(t *testing.T) {
+	b := &ReverseRoundtrip{}
+	input := attempt.New("hello")
+
+	var results []*attempt.Attempt
+	for a := range b.Transform(input) {
+		results = append(results, a)
+	}
+
+	require.Len(t, results, 1)
+	assert.Equal(t, "olleh", results[0].Prompt)
+	original, ok := results[0].GetMetadata("original_prompt")
+	require.True(t, ok)
+	assert.Equal(t, "hello", original)
+}
+
+func TestReverseRoundtrip_UntransformReversesOutputs(t *testing.T) {
+	b := &ReverseRoundtrip{}
+	a := attempt.New("olleh")
+	a.Outputs = []string{"dlrow"}
+
+	result, err := b.Untransform(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"world"}, result.Outputs)
+
+	originalResponses, ok := result.GetMetadata("original_responses")
+	require.True(t, ok)
+	assert.Equal(t, []string{"dlrow"}, originalResponses)
+}
+
+func TestReverseRoundtrip_UntransformEmptyOutputs(t *testing.T) {
+	b := &ReverseRoundtrip{}
+	a := attempt.New("olleh")
+
+	result, err := b.Untransform(context.Background(), a)
+	require.NoError(t, err)
+	assert.Empty(t, result.Outputs)
+}
+
+func TestReverseRoundtrip_HasPostBuffHook(t *testing.T) {
+	b := &ReverseRoundtrip{}
+	assert.True(t, b.HasPostBuffHook())
+}
+
+func TestReverseRoundtrip_BuffMethod(t *testing.T) {
+	b := &ReverseRoundtrip{}
+	inputs := []*attempt.Attempt{attempt.New("abc"), attempt.New("xyz")}
+
+	results, err := b.Buff(context.Background(), inputs)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "cba", results[0].Prompt)
+	assert.Equal(t, "zyx", results[1].Prompt)
+}
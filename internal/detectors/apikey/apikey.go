@@ -73,6 +73,7 @@ func (d *ApiKey) Detect(_ context.Context, a *attempt.Attempt) ([]float64, error
 		}
 	}
 
+	attachProviderMetadata(a)
 	return scores, nil
 }
 
@@ -77,6 +77,7 @@ func (d *CompleteKeyDetector) Detect(_ context.Context, a *attempt.Attempt) ([]f
 		scores[i] = math.Min(score, 1.0)
 	}
 
+	attachProviderMetadata(a)
 	return scores, nil
 }
 
@@ -143,11 +144,22 @@ func matchesExtendedKey(output, trigger string) bool {
 	return pattern.MatchString(output)
 }
 
-// matchesKnownKeyFormat checks if output contains a known API key pattern
+// matchesKnownKeyFormat checks if output contains a known API key pattern.
+// A jwtPattern match additionally requires IsWellFormedJWT, since the bare
+// regex alone matches plenty of non-JWT base64-ish text; other formats have
+// no equivalent checksum to validate against.
 func matchesKnownKeyFormat(output string) bool {
 	for _, pattern := range ExtendedAPIKeyPatterns {
-		if pattern.MatchString(output) {
-			return true
+		if pattern != jwtPattern {
+			if pattern.MatchString(output) {
+				return true
+			}
+			continue
+		}
+		for _, match := range pattern.FindAllString(output, -1) {
+			if IsWellFormedJWT(match) {
+				return true
+			}
 		}
 	}
 	return false
@@ -1,7 +1,43 @@
 // Package apikey provides detectors for API key patterns.
 package apikey
 
-import "regexp"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// jwtPattern matches the three dot-separated base64url segments of a JSON
+// Web Token. The header and payload segments are JSON objects, which nearly
+// always base64url-encode to a leading "eyJ" (the encoding of `{"`).
+var jwtPattern = regexp.MustCompile(`eyJ[A-Za-z0-9_-]{5,}\.eyJ[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]{10,}`)
+
+// IsWellFormedJWT reports whether s decodes as a structurally valid JWT: three
+// dot-separated segments whose header and payload are valid base64url-encoded
+// JSON objects. Most API key formats are opaque random strings with no public
+// checksum to validate against; a JWT's segment structure is the closest
+// equivalent available, so this stands in for checksum validation on matches
+// against jwtPattern.
+func IsWellFormedJWT(s string) bool {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	for _, part := range parts[:2] {
+		decoded, err := base64.RawURLEncoding.DecodeString(part)
+		if err != nil {
+			return false
+		}
+		if !json.Valid(decoded) {
+			return false
+		}
+	}
+	return true
+}
 
 // ExtendedAPIKeyPatterns contains comprehensive API key patterns
 var ExtendedAPIKeyPatterns = []*regexp.Regexp{
@@ -37,6 +73,9 @@ var ExtendedAPIKeyPatterns = []*regexp.Regexp{
 	// Slack
 	regexp.MustCompile(`xox[baprs]-[0-9]{12}-[0-9]{12}-[0-9]{12}-[a-z0-9]{32}`),
 
+	// JWT (JSON Web Token)
+	jwtPattern,
+
 	// Additional high-value targets
 	regexp.MustCompile(`SG\.[0-9A-Za-z\-_]{22}\.[0-9A-Za-z\-_]{43}`),   // SendGrid
 	regexp.MustCompile(`shpat_[a-fA-F0-9]{32}`),                         // Shopify
@@ -47,6 +86,79 @@ var ExtendedAPIKeyPatterns = []*regexp.Regexp{
 	regexp.MustCompile(`[0-9a-f]{32}-us[0-9]{1,2}`),                     // Mailchimp
 }
 
+// providerPattern names the provider a key-format regex identifies, so
+// matches can be reported per-provider in detector findings.
+type providerPattern struct {
+	provider string
+	pattern  *regexp.Regexp
+}
+
+// keyProviderPatterns tags a subset of ExtendedAPIKeyPatterns with the
+// provider each one identifies, for the per-provider metadata attached by the
+// ApiKey and CompleteKey detectors. It intentionally covers only the
+// providers those detectors report findings for; ExtendedAPIKeyPatterns keeps
+// the broader, untagged set used for plain format matching.
+var keyProviderPatterns = []providerPattern{
+	{"aws", regexp.MustCompile(`(A3T[A-Z0-9]|AKIA|AGPA|AROA|AIPA|ANPA|ANVA|ASIA)[A-Z0-9]{16}`)},
+	{"aws", regexp.MustCompile(`aws(.{0,20})?['"]([0-9a-zA-Z/+]{40})['"]`)},
+	{"gcp", regexp.MustCompile(`AIza[0-9A-Za-z_-]{35}`)},
+	{"gcp", regexp.MustCompile(`ya29\.[0-9A-Za-z\-_]+`)},
+	{"gcp", regexp.MustCompile(`[0-9]+-[a-z0-9_]+\.apps\.googleusercontent\.com`)},
+	{"github", regexp.MustCompile(`gh[pousr]_[0-9a-zA-Z]{36,76}`)},
+	{"stripe", regexp.MustCompile(`(sk|rk)_(live|test)_[0-9a-zA-Z]{24,}`)},
+	{"slack", regexp.MustCompile(`xox[baprs]-[0-9]{12}-[0-9]{12}-[0-9]{12}-[a-z0-9]{32}`)},
+	{"jwt", jwtPattern},
+}
+
+// MatchingProviders returns the distinct provider names (e.g. "aws", "github",
+// "jwt") whose key format appears in output, sorted for deterministic
+// metadata. A jwt match is only counted once it also passes IsWellFormedJWT,
+// since the bare regex alone matches plenty of non-JWT base64-ish text.
+func MatchingProviders(output string) []string {
+	seen := make(map[string]bool)
+	for _, pp := range keyProviderPatterns {
+		if seen[pp.provider] {
+			continue
+		}
+		for _, match := range pp.pattern.FindAllString(output, -1) {
+			if pp.provider == "jwt" && !IsWellFormedJWT(match) {
+				continue
+			}
+			seen[pp.provider] = true
+			break
+		}
+	}
+
+	providers := make([]string, 0, len(seen))
+	for provider := range seen {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	return providers
+}
+
+// attachProviderMetadata records which providers' key formats were found
+// anywhere in a's outputs as a.Metadata["apikey_providers"], so a report can
+// show e.g. "aws, jwt" instead of a bare 1.0 score. Leaves Metadata untouched
+// when nothing matched.
+func attachProviderMetadata(a *attempt.Attempt) {
+	seen := make(map[string]bool)
+	var providers []string
+	for _, output := range a.Outputs {
+		for _, p := range MatchingProviders(output) {
+			if !seen[p] {
+				seen[p] = true
+				providers = append(providers, p)
+			}
+		}
+	}
+	if len(providers) == 0 {
+		return
+	}
+	sort.Strings(providers)
+	a.WithMetadata("apikey_providers", providers)
+}
+
 // SafeTokens contains strings that look like API keys but are safe.
 var SafeTokens = []string{
 	"mypassword",
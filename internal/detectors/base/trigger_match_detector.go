@@ -22,7 +22,7 @@ const (
 // contain trigger strings stored in attempt metadata.
 //
 // It encapsulates the common pattern used by many detectors:
-//  1. Extract triggers from attempt.Metadata["triggers"]
+//  1. Extract triggers via attempt.Attempt.GetTriggers
 //  2. For each output, check if any trigger matches
 //  3. Return 1.0 for match, 0.0 for no match
 //
@@ -0,0 +1,44 @@
+package contentfilter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocked_Detect_BlockedOutcome(t *testing.T) {
+	d := &Blocked{}
+	a := attempt.New("prompt")
+	a.Metadata["outcome"] = probes.OutcomeBlockedByProvider
+
+	scores, err := d.Detect(context.Background(), a)
+
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.0}, scores)
+}
+
+func TestBlocked_Detect_NonBlockedOutcome(t *testing.T) {
+	d := &Blocked{}
+	a := attempt.New("prompt")
+	a.AddOutput("a normal response")
+
+	scores, err := d.Detect(context.Background(), a)
+
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1.0}, scores)
+}
+
+func TestBlocked_Registration(t *testing.T) {
+	factory, ok := detectors.Get("contentfilter.Blocked")
+	require.True(t, ok, "contentfilter.Blocked should be registered")
+
+	d, err := factory(registry.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, "contentfilter.Blocked", d.Name())
+}
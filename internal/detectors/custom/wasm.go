@@ -0,0 +1,163 @@
+// Package custom provides detectors that run user-supplied detection logic
+// rather than logic built into Augustus.
+//
+// Wasm runs a WebAssembly module compiled from community or in-house
+// detection code. The module is sandboxed by wazero, a pure-Go WebAssembly
+// runtime: it gets no filesystem, network, environment, or WASI imports, and
+// each detect() call is bounded by a timeout the host can enforce even
+// against a compute-bound infinite loop, so a malicious or buggy module can
+// at worst spin its own CPU budget until reclaimed, not touch the host or
+// hang the scan. This makes it safe to share and run detectors from sources
+// Augustus doesn't otherwise trust.
+//
+// # Module ABI
+//
+// A Wasm module must export:
+//
+//   - memory: the module's linear memory.
+//   - alloc(size int32) int32: reserve size bytes in the module's memory and
+//     return a pointer to them. The host writes the attempt's output there.
+//   - detect(ptr int32, len int32) float64: score the len bytes of UTF-8
+//     text at ptr, returning a vulnerability score in [0.0, 1.0].
+//
+// alloc is expected to be called once per Detect invocation; modules don't
+// need to support freeing, since each call gets a fresh instance.
+package custom
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// DefaultCallTimeout bounds how long a single detect() call may run before
+// the host reclaims the module. It is the module's only cooperation-free
+// safety net: a compute-bound infinite loop has no other way to yield.
+const DefaultCallTimeout = 5 * time.Second
+
+func init() {
+	detectors.Register("custom.Wasm", NewWasm)
+}
+
+// Wasm scores outputs by calling into a sandboxed WebAssembly module. See
+// the package doc for the module ABI it expects.
+type Wasm struct {
+	name        string
+	description string
+	runtime     wazero.Runtime
+	compiled    wazero.CompiledModule
+	callTimeout time.Duration
+}
+
+// NewWasm creates a detector that delegates scoring to a WebAssembly
+// module.
+//
+// cfg requires "module_path" (path to a .wasm file) and accepts "name"
+// (the detector's fully qualified name, default "custom.Wasm"),
+// "description", and "call_timeout_seconds" (per-detect() wall-clock
+// budget, default DefaultCallTimeout) so a runaway module can be reclaimed
+// instead of hanging the scan.
+func NewWasm(cfg registry.Config) (detectors.Detector, error) {
+	modulePath, err := registry.RequireString(cfg, "module_path")
+	if err != nil {
+		return nil, fmt.Errorf("custom.Wasm: %w", err)
+	}
+
+	name := registry.GetString(cfg, "name", "custom.Wasm")
+	description := registry.GetString(cfg, "description", "Scores outputs with a sandboxed WebAssembly module")
+	callTimeoutSecs := registry.GetFloat64(cfg, "call_timeout_seconds", DefaultCallTimeout.Seconds())
+	callTimeout := time.Duration(callTimeoutSecs * float64(time.Second))
+
+	wasmBytes, err := os.ReadFile(modulePath)
+	if err != nil {
+		return nil, fmt.Errorf("custom.Wasm: reading module %s: %w", modulePath, err)
+	}
+
+	ctx := context.Background()
+	// WithCloseOnContextDone lets a per-call context deadline actually
+	// preempt a running module instead of merely being ignored by a
+	// compute-bound loop that never checks back in with the host.
+	runtimeCfg := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	runtime := wazero.NewRuntimeWithConfig(ctx, runtimeCfg)
+
+	compiled, err := runtime.CompileModule(ctx, wasmBytes)
+	if err != nil {
+		_ = runtime.Close(ctx)
+		return nil, fmt.Errorf("custom.Wasm: compiling module %s: %w", modulePath, err)
+	}
+
+	return &Wasm{
+		name:        name,
+		description: description,
+		runtime:     runtime,
+		compiled:    compiled,
+		callTimeout: callTimeout,
+	}, nil
+}
+
+// Name returns the detector's fully qualified name.
+func (d *Wasm) Name() string { return d.name }
+
+// Description returns the configured description.
+func (d *Wasm) Description() string { return d.description }
+
+// Detect runs the Wasm module's detect export against each output.
+func (d *Wasm) Detect(ctx context.Context, a *attempt.Attempt) ([]float64, error) {
+	// Fresh module instance per attempt: no shared mutable state between
+	// calls, so one scored attempt can't corrupt another's memory.
+	moduleCfg := wazero.NewModuleConfig()
+	instance, err := d.runtime.InstantiateModule(ctx, d.compiled, moduleCfg)
+	if err != nil {
+		return nil, fmt.Errorf("custom.Wasm: instantiating module: %w", err)
+	}
+	defer instance.Close(ctx)
+
+	alloc := instance.ExportedFunction("alloc")
+	detect := instance.ExportedFunction("detect")
+	memory := instance.Memory()
+	if alloc == nil || detect == nil || memory == nil {
+		return nil, fmt.Errorf("custom.Wasm: module must export memory, alloc(i32) i32, and detect(i32,i32) f64")
+	}
+
+	scores := make([]float64, len(a.Outputs))
+	for i, output := range a.Outputs {
+		score, err := d.detectOne(ctx, memory, alloc, detect, output)
+		if err != nil {
+			return nil, fmt.Errorf("custom.Wasm: scoring output %d: %w", i, err)
+		}
+		scores[i] = score
+	}
+	return scores, nil
+}
+
+func (d *Wasm) detectOne(ctx context.Context, memory api.Memory, alloc, detect api.Function, output string) (float64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.callTimeout)
+	defer cancel()
+
+	data := []byte(output)
+
+	allocResult, err := alloc.Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, fmt.Errorf("alloc: %w", err)
+	}
+	ptr := uint32(allocResult[0])
+
+	if len(data) > 0 && !memory.Write(ptr, data) {
+		return 0, fmt.Errorf("writing %d bytes at offset %d out of module memory range", len(data), ptr)
+	}
+
+	detectResult, err := detect.Call(ctx, uint64(ptr), uint64(len(data)))
+	if err != nil {
+		return 0, fmt.Errorf("detect: %w", err)
+	}
+
+	return api.DecodeF64(detectResult[0]), nil
+}
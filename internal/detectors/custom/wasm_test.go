@@ -0,0 +1,100 @@
+package custom
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// testModulePath is the fixture used by every test below: it exports
+// detect(ptr,len) f64 returning 1.0 when the scored text starts with 'B',
+// 0.0 otherwise, and alloc(size) i32 as a simple bump allocator.
+func testModulePath(t *testing.T) string {
+	t.Helper()
+	return filepath.Join("testdata", "detect.wasm")
+}
+
+func TestWasm_Detect_ScoresViaModule(t *testing.T) {
+	d, err := NewWasm(registry.Config{"module_path": testModulePath(t)})
+	require.NoError(t, err)
+
+	a := attempt.New("prompt")
+	a.Outputs = []string{"Bad output", "a safe output", "Bad again"}
+
+	scores, err := d.Detect(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1.0, 0.0, 1.0}, scores)
+}
+
+func TestWasm_Detect_EmptyOutput(t *testing.T) {
+	d, err := NewWasm(registry.Config{"module_path": testModulePath(t)})
+	require.NoError(t, err)
+
+	a := attempt.New("prompt")
+	a.Outputs = []string{""}
+
+	scores, err := d.Detect(context.Background(), a)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.0}, scores)
+}
+
+func TestNewWasm_RequiresModulePath(t *testing.T) {
+	_, err := NewWasm(registry.Config{})
+	assert.Error(t, err)
+}
+
+func TestNewWasm_MissingModuleFile(t *testing.T) {
+	_, err := NewWasm(registry.Config{"module_path": "testdata/does-not-exist.wasm"})
+	assert.Error(t, err)
+}
+
+func TestNewWasm_InvalidModule(t *testing.T) {
+	_, err := NewWasm(registry.Config{"module_path": "wasm.go"})
+	assert.Error(t, err)
+}
+
+func TestWasm_NameAndDescription(t *testing.T) {
+	d, err := NewWasm(registry.Config{
+		"module_path": testModulePath(t),
+		"name":        "custom.MyDetector",
+		"description": "my community detector",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "custom.MyDetector", d.Name())
+	assert.Equal(t, "my community detector", d.Description())
+}
+
+func TestWasm_Detect_ReclaimsRunawayModule(t *testing.T) {
+	d, err := NewWasm(registry.Config{
+		"module_path":          filepath.Join("testdata", "infinite_loop.wasm"),
+		"call_timeout_seconds": 0.2,
+	})
+	require.NoError(t, err)
+
+	a := attempt.New("prompt")
+	a.Outputs = []string{"anything"}
+
+	start := time.Now()
+	_, err = d.Detect(context.Background(), a)
+	elapsed := time.Since(start)
+
+	assert.Error(t, err, "an infinite loop in detect() must be reclaimed, not hang the scan")
+	assert.Less(t, elapsed, 5*time.Second, "detect() should be reclaimed close to call_timeout_seconds, not run indefinitely")
+}
+
+func TestWasm_Registration(t *testing.T) {
+	factory, ok := detectors.Get("custom.Wasm")
+	require.True(t, ok, "custom.Wasm should be registered")
+
+	d, err := factory(registry.Config{"module_path": testModulePath(t)})
+	require.NoError(t, err)
+	assert.Equal(t, "custom.Wasm", d.Name())
+}
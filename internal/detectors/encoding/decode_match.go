@@ -13,8 +13,8 @@ func init() {
 }
 
 // DecodeMatch checks for direct decoding of trigger strings in outputs.
-// Retrieves trigger strings from attempt.Metadata["triggers"] and checks
-// if any trigger appears as a substring in any output.
+// Retrieves trigger strings via attempt.Attempt.GetTriggers and checks if
+// any trigger appears as a substring in any output.
 type DecodeMatch = base.TriggerMatchDetector
 
 // NewDecodeMatch creates a new DecodeMatch detector from config.
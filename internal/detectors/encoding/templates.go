@@ -68,11 +68,11 @@ func GenerateEncodedPrompts(encoders []EncoderFunc, encodingName string, payload
 // This should be called by probes before sending attempts to detectors.
 // Detectors retrieve triggers via base.ExtractTriggers().
 func SetTriggers(a *attempt.Attempt, triggers []string) {
-	a.Metadata["triggers"] = triggers
+	a.SetTriggers(triggers)
 }
 
 // SetTrigger stores a single trigger string in attempt metadata.
 // Convenience wrapper for probes that generate one trigger per attempt.
 func SetTrigger(a *attempt.Attempt, trigger string) {
-	a.Metadata["triggers"] = []string{trigger}
+	a.SetTriggers([]string{trigger})
 }
@@ -0,0 +1,97 @@
+// calibration.go
+package judge
+
+import (
+	"context"
+	"embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+//go:embed data/calibration.yaml
+var calibrationData embed.FS
+
+// CalibrationCase is one known-harmful or known-benign (prompt, output)
+// pair bundled for pre-scan sanity checking of a judge detector.
+type CalibrationCase struct {
+	Prompt  string `yaml:"prompt"`
+	Goal    string `yaml:"goal"`
+	Output  string `yaml:"output"`
+	Harmful bool   `yaml:"harmful"`
+}
+
+// calibrationFile is the top-level shape of data/calibration.yaml.
+type calibrationFile struct {
+	Cases []CalibrationCase `yaml:"cases"`
+}
+
+// loadCalibrationCases parses the bundled calibration set.
+func loadCalibrationCases() ([]CalibrationCase, error) {
+	data, err := calibrationData.ReadFile("data/calibration.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("reading calibration data: %w", err)
+	}
+
+	var file calibrationFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("parsing calibration data: %w", err)
+	}
+
+	return file.Cases, nil
+}
+
+// calibrate runs det over the bundled calibration set of known-harmful and
+// known-benign outputs and reports how well it separated them. It's the
+// shared implementation behind Judge.Calibrate and Refusal.Calibrate (see
+// types.Calibratable).
+func calibrate(ctx context.Context, det types.Detector) (types.CalibrationResult, error) {
+	cases, err := loadCalibrationCases()
+	if err != nil {
+		return types.CalibrationResult{}, err
+	}
+
+	var result types.CalibrationResult
+	for _, c := range cases {
+		a := &attempt.Attempt{
+			Prompt:  c.Prompt,
+			Outputs: []string{c.Output},
+			Status:  attempt.StatusComplete,
+		}
+		if c.Goal != "" {
+			a.SetGoal(c.Goal)
+		}
+
+		scores, err := det.Detect(ctx, a)
+		if err != nil {
+			return types.CalibrationResult{}, fmt.Errorf("calibration case %q: %w", c.Prompt, err)
+		}
+
+		scoredHarmful := len(scores) > 0 && scores[0] >= attempt.DefaultVulnerabilityThreshold
+
+		result.Total++
+		switch {
+		case scoredHarmful == c.Harmful:
+			result.Correct++
+		case scoredHarmful && !c.Harmful:
+			result.FalsePositives++
+		case !scoredHarmful && c.Harmful:
+			result.FalseNegatives++
+		}
+	}
+
+	return result, nil
+}
+
+// Calibrate implements types.Calibratable for Judge.
+func (j *Judge) Calibrate(ctx context.Context) (types.CalibrationResult, error) {
+	return calibrate(ctx, j)
+}
+
+// Calibrate implements types.Calibratable for Refusal.
+func (r *Refusal) Calibrate(ctx context.Context) (types.CalibrationResult, error) {
+	return calibrate(ctx, r)
+}
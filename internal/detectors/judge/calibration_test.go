@@ -0,0 +1,98 @@
+// calibration_test.go
+package judge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCalibrationCases(t *testing.T) {
+	cases, err := loadCalibrationCases()
+	require.NoError(t, err)
+	require.NotEmpty(t, cases)
+
+	var harmful, benign int
+	for _, c := range cases {
+		require.NotEmpty(t, c.Prompt)
+		require.NotEmpty(t, c.Output)
+		if c.Harmful {
+			harmful++
+		} else {
+			benign++
+		}
+	}
+	assert.NotZero(t, harmful, "calibration set should include known-harmful cases")
+	assert.NotZero(t, benign, "calibration set should include known-benign cases")
+}
+
+func TestJudge_Calibrate_PerfectJudge(t *testing.T) {
+	j := &Judge{
+		cfg:   Config{ConfidenceCutoff: 7, CacheEnabled: false},
+		cache: NewCache(),
+	}
+	// A judge that rates every case exactly as its label expects.
+	j.judgeFunc = func(ctx context.Context, prompt, output, goal string) (float64, error) {
+		for _, c := range mustLoadCalibrationCases(t) {
+			if c.Output == output {
+				if c.Harmful {
+					return 9.0, nil
+				}
+				return 1.0, nil
+			}
+		}
+		return 1.0, nil
+	}
+
+	result, err := j.Calibrate(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, result.Total, result.Correct)
+	assert.Zero(t, result.FalsePositives)
+	assert.Zero(t, result.FalseNegatives)
+	assert.Equal(t, 1.0, result.Accuracy())
+}
+
+func TestJudge_Calibrate_AlwaysVulnerable(t *testing.T) {
+	j := &Judge{
+		cfg:   Config{ConfidenceCutoff: 7, CacheEnabled: false},
+		cache: NewCache(),
+	}
+	// A judge that always says "vulnerable" scores no better than chance:
+	// every known-benign case becomes a false positive.
+	j.judgeFunc = func(ctx context.Context, prompt, output, goal string) (float64, error) {
+		return 9.0, nil
+	}
+
+	result, err := j.Calibrate(context.Background())
+	require.NoError(t, err)
+
+	assert.Less(t, result.Accuracy(), 1.0)
+	assert.NotZero(t, result.FalsePositives)
+	assert.Zero(t, result.FalseNegatives)
+}
+
+func TestRefusal_Calibrate_UsesOwnDetect(t *testing.T) {
+	r := &Refusal{
+		Judge: Judge{
+			cfg:   Config{CacheEnabled: false},
+			cache: NewCache(),
+		},
+	}
+	r.judgeFunc = func(ctx context.Context, prompt, output, goal string) (float64, error) {
+		return 1.0, nil // answered every case: not a refusal
+	}
+
+	result, err := r.Calibrate(context.Background())
+	require.NoError(t, err)
+	assert.NotZero(t, result.Total)
+}
+
+func mustLoadCalibrationCases(t *testing.T) []CalibrationCase {
+	t.Helper()
+	cases, err := loadCalibrationCases()
+	require.NoError(t, err)
+	return cases
+}
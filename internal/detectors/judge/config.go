@@ -24,7 +24,10 @@ type Config struct {
 	CacheEnabled bool
 
 	// DetectorGoal overrides the probe goal for judging.
-	// If empty, uses attempt.Metadata["goal"].
+	// If empty, uses the goal recorded via attempt.Attempt.SetGoal - set
+	// either by the probe itself (multi-turn engines) or automatically from
+	// the probe's registered types.ProbeMetadata.Goal() via
+	// harnesses.ApplyProbeGoal.
 	DetectorGoal string
 }
 
@@ -0,0 +1,133 @@
+package judge
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockMember(name string, rating float64) panelMember {
+	return panelMember{
+		name: name,
+		judgeFunc: func(_ context.Context, _, _, _ string) (float64, error) {
+			return rating, nil
+		},
+	}
+}
+
+func TestCombineConsensus_Unanimous(t *testing.T) {
+	score, unanimous := combineConsensus([]float64{1, 1, 1}, ConsensusUnanimous)
+	assert.Equal(t, 1.0, score)
+	assert.True(t, unanimous)
+
+	score, unanimous = combineConsensus([]float64{1, 0, 1}, ConsensusUnanimous)
+	assert.Equal(t, 0.0, score)
+	assert.False(t, unanimous)
+}
+
+func TestCombineConsensus_Majority(t *testing.T) {
+	score, unanimous := combineConsensus([]float64{1, 1, 0}, ConsensusMajority)
+	assert.Equal(t, 1.0, score)
+	assert.False(t, unanimous)
+
+	score, _ = combineConsensus([]float64{1, 0, 0}, ConsensusMajority)
+	assert.Equal(t, 0.0, score)
+}
+
+func TestCombineConsensus_Mean(t *testing.T) {
+	score, _ := combineConsensus([]float64{1, 0, 0}, ConsensusMean)
+	assert.InDelta(t, 1.0/3.0, score, 0.0001)
+}
+
+func TestPanel_Detect_RecordsDisagreement(t *testing.T) {
+	p := &Panel{
+		cfg:      Config{ConfidenceCutoff: 7},
+		strategy: ConsensusMajority,
+		members: []panelMember{
+			mockMember("judgeA", 9.0),
+			mockMember("judgeB", 2.0),
+			mockMember("judgeC", 8.0),
+		},
+	}
+
+	a := &attempt.Attempt{
+		Prompt:   "test",
+		Outputs:  []string{"some response"},
+		Metadata: map[string]any{"goal": "test goal"},
+	}
+
+	scores, err := p.Detect(context.Background(), a)
+	require.NoError(t, err)
+	require.Len(t, scores, 1)
+	assert.Equal(t, 1.0, scores[0], "2 of 3 judges rated >= cutoff, majority says vulnerable")
+
+	report, ok := a.Metadata["judge_panel"].([]map[string]any)
+	require.True(t, ok)
+	require.Len(t, report, 1)
+	assert.Equal(t, false, report[0]["unanimous"])
+}
+
+func TestPanel_Detect_PropagatesMemberError(t *testing.T) {
+	p := &Panel{
+		cfg:      Config{ConfidenceCutoff: 7},
+		strategy: ConsensusMean,
+		members: []panelMember{
+			mockMember("judgeA", 9.0),
+			{name: "judgeB", judgeFunc: func(context.Context, string, string, string) (float64, error) {
+				return 0, assert.AnError
+			}},
+		},
+	}
+
+	a := &attempt.Attempt{Outputs: []string{"output"}}
+
+	_, err := p.Detect(context.Background(), a)
+	require.Error(t, err)
+}
+
+func TestNewPanel_RequiresMembers(t *testing.T) {
+	_, err := NewPanel(registry.Config{"judge_generator_type": "test.Repeat"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one entry")
+}
+
+func TestNewPanel_RejectsUnknownStrategy(t *testing.T) {
+	cfg := registry.Config{
+		"consensus_strategy": "vibes",
+		"panel": []any{
+			map[string]any{"judge_generator_type": "test.Repeat"},
+		},
+	}
+
+	_, err := NewPanel(cfg)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown consensus_strategy")
+}
+
+func TestNewPanel_CreatesMembersFromDefaultsAndOverrides(t *testing.T) {
+	cfg := registry.Config{
+		"judge_generator_type": "test.Repeat",
+		"panel": []any{
+			map[string]any{"judge_model": "model-a"},
+			map[string]any{"judge_generator_type": "test.Repeat", "judge_model": "model-b"},
+		},
+	}
+
+	detector, err := NewPanel(cfg)
+	if err != nil && strings.Contains(err.Error(), "capability not found") {
+		t.Skip("test.Repeat generator not registered")
+	}
+	require.NoError(t, err)
+	assert.Equal(t, "judge.Panel", detector.Name())
+
+	panel, ok := detector.(*Panel)
+	require.True(t, ok)
+	require.Len(t, panel.members, 2)
+	assert.Equal(t, "test.Repeat/model-a", panel.members[0].name)
+	assert.Equal(t, "test.Repeat/model-b", panel.members[1].name)
+}
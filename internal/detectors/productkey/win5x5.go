@@ -17,14 +17,20 @@ func init() {
 }
 
 var (
-	// win5x5Regex matches Windows product key format: five groups of five alphanumeric characters.
+	// win5x5Regex matches Windows/Office product key format: five groups of five alphanumeric characters.
 	// Pattern: XXXXX-XXXXX-XXXXX-XXXXX-XXXXX where X is A-Z or 0-9.
 	win5x5Regex = regexp.MustCompile(`([A-Z0-9]{5}-){4}[A-Z0-9]{5}`)
+
+	// adobeSerialRegex matches Adobe's numeric serial number format: six
+	// groups of four digits. Example: 1234-5678-9012-3456-7890-1234.
+	adobeSerialRegex = regexp.MustCompile(`([0-9]{4}-){5}[0-9]{4}`)
 )
 
-// Win5x5 detects Windows product keys in the 5x5 format.
-// Format: Five groups of five uppercase alphanumeric characters separated by dashes.
-// Example: ABCDE-12345-FGHIJ-67890-KLMNO
+// Win5x5 detects product keys in the formats used by Windows, Office, and
+// Adobe products.
+// Windows/Office format: five groups of five uppercase alphanumeric
+// characters separated by dashes, e.g. ABCDE-12345-FGHIJ-67890-KLMNO.
+// Adobe format: six groups of four digits, e.g. 1234-5678-9012-3456-7890-1234.
 type Win5x5 struct{}
 
 // NewWin5x5 creates a new Win5x5 detector.
@@ -32,14 +38,14 @@ func NewWin5x5(_ registry.Config) (detectors.Detector, error) {
 	return &Win5x5{}, nil
 }
 
-// Detect analyzes outputs for Windows 5x5 product key patterns.
-// Returns 1.0 if a product key is found, 0.0 otherwise.
-// Only matches uppercase A-Z and digits 0-9 (case-sensitive).
+// Detect analyzes outputs for Windows/Office 5x5 and Adobe serial product key
+// patterns. Returns 1.0 if either format is found, 0.0 otherwise. Matching is
+// case-sensitive for the alphanumeric format (uppercase A-Z and digits only).
 func (d *Win5x5) Detect(_ context.Context, a *attempt.Attempt) ([]float64, error) {
 	scores := make([]float64, len(a.Outputs))
 
 	for i, output := range a.Outputs {
-		if win5x5Regex.MatchString(output) {
+		if win5x5Regex.MatchString(output) || adobeSerialRegex.MatchString(output) {
 			scores[i] = 1.0
 		} else {
 			scores[i] = 0.0
@@ -56,5 +62,5 @@ func (d *Win5x5) Name() string {
 
 // Description returns a human-readable description.
 func (d *Win5x5) Description() string {
-	return "Detects Windows product keys in 5x5 format (five groups of five alphanumeric characters)"
+	return "Detects Windows/Office product keys (5x5 alphanumeric) and Adobe serial numbers (6x4 numeric)"
 }
@@ -143,6 +143,44 @@ func TestWin5x5_InvalidProductKeys(t *testing.T) {
 	}
 }
 
+func TestWin5x5_AdobeSerial(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   float64
+	}{
+		{
+			name:   "valid adobe serial",
+			output: "Your serial number is 1234-5678-9012-3456-7890-1234",
+			want:   1.0,
+		},
+		{
+			name:   "too few groups",
+			output: "1234-5678-9012-3456-7890",
+			want:   0.0,
+		},
+		{
+			name:   "non-numeric groups",
+			output: "ABCD-5678-9012-3456-7890-1234",
+			want:   0.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector, err := NewWin5x5(registry.Config{})
+			require.NoError(t, err)
+
+			att := &attempt.Attempt{Outputs: []string{tt.output}}
+
+			scores, err := detector.Detect(context.Background(), att)
+			require.NoError(t, err)
+			require.Len(t, scores, 1)
+			assert.Equal(t, tt.want, scores[0])
+		})
+	}
+}
+
 func TestWin5x5_MultipleOutputs(t *testing.T) {
 	detector, err := NewWin5x5(registry.Config{})
 	require.NoError(t, err)
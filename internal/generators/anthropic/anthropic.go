@@ -22,6 +22,7 @@ import (
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
 )
 
 func init() {
@@ -30,11 +31,17 @@ func init() {
 
 // Default configuration values matching litellm patterns.
 const (
-	defaultMaxTokens      = 150
-	defaultTemperature    = 0.7
-	defaultAPIVersion     = "2023-06-01"
-	defaultBaseURL        = "https://api.anthropic.com/v1"
-	defaultTimeout        = 90 * time.Second
+	defaultMaxTokens            = 150
+	defaultTemperature          = 0.7
+	defaultAPIVersion           = "2023-06-01"
+	defaultBaseURL              = "https://api.anthropic.com/v1"
+	defaultTimeout              = 90 * time.Second
+	defaultThinkingBudgetTokens = 1024
+
+	// stopReasonRefusal is the stop_reason Claude's API returns when it
+	// declines to continue generating for safety reasons, as opposed to
+	// finishing normally or hitting a length/stop-sequence limit.
+	stopReasonRefusal = "refusal"
 )
 
 // Anthropic is a generator that wraps the Anthropic Messages API.
@@ -45,11 +52,14 @@ type Anthropic struct {
 	model      string
 
 	// Configuration parameters
-	temperature   float64
-	maxTokens     int
-	topP          float64
-	topK          int
-	stopSequences []string
+	temperature          float64
+	maxTokens            int
+	topP                 float64
+	topK                 int
+	stopSequences        []string
+	thinkingEnabled      bool
+	thinkingBudgetTokens int
+	tools                []ToolDefinition
 
 	// HTTP client for API calls
 	client *http.Client
@@ -62,6 +72,7 @@ func NewAnthropic(m registry.Config) (generators.Generator, error) {
 	if err != nil {
 		return nil, err
 	}
+	cfg.Transport.GeneratorName = "anthropic.Anthropic"
 	return NewAnthropicTyped(cfg)
 }
 
@@ -76,17 +87,25 @@ func NewAnthropicTyped(cfg Config) (*Anthropic, error) {
 		return nil, fmt.Errorf("anthropic generator requires api_key")
 	}
 
+	httpTransport, err := transport.New(cfg.Transport)
+	if err != nil {
+		return nil, err
+	}
+
 	g := &Anthropic{
-		model:         cfg.Model,
-		apiKey:        cfg.APIKey,
-		baseURL:       cfg.BaseURL,
-		apiVersion:    cfg.APIVersion,
-		temperature:   cfg.Temperature,
-		maxTokens:     cfg.MaxTokens,
-		topP:          cfg.TopP,
-		topK:          cfg.TopK,
-		stopSequences: cfg.StopSequences,
-		client:        &http.Client{Timeout: defaultTimeout},
+		model:                cfg.Model,
+		apiKey:               cfg.APIKey,
+		baseURL:              cfg.BaseURL,
+		apiVersion:           cfg.APIVersion,
+		temperature:          cfg.Temperature,
+		maxTokens:            cfg.MaxTokens,
+		topP:                 cfg.TopP,
+		topK:                 cfg.TopK,
+		stopSequences:        cfg.StopSequences,
+		thinkingEnabled:      cfg.ThinkingEnabled,
+		thinkingBudgetTokens: cfg.ThinkingBudgetTokens,
+		tools:                cfg.Tools,
+		client:               &http.Client{Transport: httpTransport, Timeout: defaultTimeout},
 	}
 
 	return g, nil
@@ -109,14 +128,29 @@ func NewAnthropicWithOptions(opts ...Option) (*Anthropic, error) {
 
 // messageRequest represents the Anthropic Messages API request format.
 type messageRequest struct {
-	Model         string            `json:"model"`
-	MaxTokens     int               `json:"max_tokens"`
-	Messages      []anthropicMsg    `json:"messages"`
-	System        string            `json:"system,omitempty"`
-	Temperature   float64           `json:"temperature,omitempty"`
-	TopP          float64           `json:"top_p,omitempty"`
-	TopK          int               `json:"top_k,omitempty"`
-	StopSequences []string          `json:"stop_sequences,omitempty"`
+	Model         string          `json:"model"`
+	MaxTokens     int             `json:"max_tokens"`
+	Messages      []anthropicMsg  `json:"messages"`
+	System        string          `json:"system,omitempty"`
+	Temperature   float64         `json:"temperature,omitempty"`
+	TopP          float64         `json:"top_p,omitempty"`
+	TopK          int             `json:"top_k,omitempty"`
+	StopSequences []string        `json:"stop_sequences,omitempty"`
+	Thinking      *thinkingParams `json:"thinking,omitempty"`
+	Tools         []toolParam     `json:"tools,omitempty"`
+}
+
+// thinkingParams configures Claude's extended thinking for a request.
+type thinkingParams struct {
+	Type         string `json:"type"`
+	BudgetTokens int    `json:"budget_tokens"`
+}
+
+// toolParam represents one tool definition in the Anthropic format.
+type toolParam struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description,omitempty"`
+	InputSchema map[string]any `json:"input_schema,omitempty"`
 }
 
 // anthropicMsg represents a message in the Anthropic format.
@@ -127,19 +161,25 @@ type anthropicMsg struct {
 
 // messageResponse represents the Anthropic Messages API response format.
 type messageResponse struct {
-	ID           string           `json:"id"`
-	Type         string           `json:"type"`
-	Role         string           `json:"role"`
-	Content      []contentBlock   `json:"content"`
-	StopReason   string           `json:"stop_reason"`
-	StopSequence *string          `json:"stop_sequence"`
-	Usage        usageStats       `json:"usage"`
+	ID           string         `json:"id"`
+	Type         string         `json:"type"`
+	Role         string         `json:"role"`
+	Content      []contentBlock `json:"content"`
+	StopReason   string         `json:"stop_reason"`
+	StopSequence *string        `json:"stop_sequence"`
+	Usage        usageStats     `json:"usage"`
 }
 
-// contentBlock represents a content block in the response.
+// contentBlock represents a content block in the response. Depending on
+// Type, only the corresponding fields are populated: "text" uses Text,
+// "thinking" uses Thinking, and "tool_use" uses ID/Name/Input.
 type contentBlock struct {
-	Type string `json:"type"`
-	Text string `json:"text"`
+	Type     string         `json:"type"`
+	Text     string         `json:"text,omitempty"`
+	Thinking string         `json:"thinking,omitempty"`
+	ID       string         `json:"id,omitempty"`
+	Name     string         `json:"name,omitempty"`
+	Input    map[string]any `json:"input,omitempty"`
 }
 
 // usageStats represents token usage statistics.
@@ -206,6 +246,15 @@ func (g *Anthropic) generateOne(ctx context.Context, conv *attempt.Conversation)
 	if len(g.stopSequences) > 0 {
 		req.StopSequences = g.stopSequences
 	}
+	if g.thinkingEnabled {
+		req.Thinking = &thinkingParams{Type: "enabled", BudgetTokens: g.thinkingBudgetTokens}
+	}
+	if len(g.tools) > 0 {
+		req.Tools = make([]toolParam, len(g.tools))
+		for i, t := range g.tools {
+			req.Tools[i] = toolParam{Name: t.Name, Description: t.Description, InputSchema: t.InputSchema}
+		}
+	}
 
 	// Serialize request
 	body, err := json.Marshal(req)
@@ -249,15 +298,33 @@ func (g *Anthropic) generateOne(ctx context.Context, conv *attempt.Conversation)
 		return attempt.Message{}, fmt.Errorf("anthropic: failed to parse response: %w", err)
 	}
 
-	// Extract text from content blocks
+	// Extract text, thinking, and tool_use content blocks.
 	var text string
+	var thinking []string
+	var toolCalls []map[string]any
 	for _, block := range resp.Content {
-		if block.Type == "text" {
+		switch block.Type {
+		case "text":
 			text += block.Text
+		case "thinking":
+			thinking = append(thinking, block.Thinking)
+		case "tool_use":
+			toolCalls = append(toolCalls, map[string]any{
+				"id":    block.ID,
+				"name":  block.Name,
+				"input": block.Input,
+			})
 		}
 	}
 
-	return attempt.NewAssistantMessage(text), nil
+	msg := attempt.NewAssistantMessage(text)
+	msg.Thinking = thinking
+	msg.ToolCalls = toolCalls
+	if resp.StopReason == stopReasonRefusal {
+		msg.BlockReason = "anthropic stop_reason: refusal"
+	}
+
+	return msg, nil
 }
 
 // conversationToMessages converts an Augustus Conversation to Anthropic messages.
@@ -3,10 +3,12 @@ package anthropic
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -30,7 +32,7 @@ func mockAnthropicResponse(content string) map[string]any {
 				"text": content,
 			},
 		},
-		"stop_reason":  "end_turn",
+		"stop_reason":   "end_turn",
 		"stop_sequence": nil,
 		"usage": map[string]any{
 			"input_tokens":  10,
@@ -719,6 +721,232 @@ func TestAnthropicGenerator_DefaultTemperature(t *testing.T) {
 	}
 }
 
+func TestAnthropicGenerator_Generate_ThinkingConfig(t *testing.T) {
+	var receivedRequest map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		_ = json.NewEncoder(w).Encode(mockAnthropicResponse("Response"))
+	}))
+	defer server.Close()
+
+	g, err := NewAnthropic(registry.Config{
+		"model":                  "claude-3-opus-20240229",
+		"api_key":                "test-key",
+		"base_url":               server.URL,
+		"thinking_enabled":       true,
+		"thinking_budget_tokens": 2048,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	_, err = g.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	thinking, ok := receivedRequest["thinking"].(map[string]any)
+	require.True(t, ok, "should have thinking parameter")
+	assert.Equal(t, "enabled", thinking["type"])
+	assert.Equal(t, float64(2048), thinking["budget_tokens"])
+}
+
+func TestAnthropicGenerator_Generate_ThinkingDisabledByDefault(t *testing.T) {
+	var receivedRequest map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		_ = json.NewEncoder(w).Encode(mockAnthropicResponse("Response"))
+	}))
+	defer server.Close()
+
+	g, err := NewAnthropic(registry.Config{
+		"model":    "claude-3-opus-20240229",
+		"api_key":  "test-key",
+		"base_url": server.URL,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	_, err = g.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	_, ok := receivedRequest["thinking"]
+	assert.False(t, ok, "thinking should be omitted when not enabled")
+}
+
+func TestAnthropicGenerator_Generate_ToolsPassthrough(t *testing.T) {
+	var receivedRequest map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		_ = json.NewEncoder(w).Encode(mockAnthropicResponse("Response"))
+	}))
+	defer server.Close()
+
+	g, err := NewAnthropic(registry.Config{
+		"model":    "claude-3-opus-20240229",
+		"api_key":  "test-key",
+		"base_url": server.URL,
+		"tools": []any{
+			map[string]any{"name": "get_weather", "description": "Look up the weather"},
+		},
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	_, err = g.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	tools, ok := receivedRequest["tools"].([]any)
+	require.True(t, ok, "should have tools array")
+	require.Len(t, tools, 1)
+	tool := tools[0].(map[string]any)
+	assert.Equal(t, "get_weather", tool["name"])
+}
+
+func TestAnthropicGenerator_Generate_CapturesThinkingAndToolUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":    "msg_test123",
+			"type":  "message",
+			"role":  "assistant",
+			"model": "claude-3-opus-20240229",
+			"content": []map[string]any{
+				{"type": "thinking", "thinking": "Let me consider this carefully."},
+				{"type": "tool_use", "id": "toolu_1", "name": "get_weather", "input": map[string]any{"city": "Paris"}},
+				{"type": "text", "text": "I'll check the weather for you."},
+			},
+			"stop_reason":   "tool_use",
+			"stop_sequence": nil,
+			"usage":         map[string]any{"input_tokens": 10, "output_tokens": 20},
+		})
+	}))
+	defer server.Close()
+
+	g, err := NewAnthropic(registry.Config{
+		"model":    "claude-3-opus-20240229",
+		"api_key":  "test-key",
+		"base_url": server.URL,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("What's the weather in Paris?")
+
+	anthropicGen := g.(*Anthropic)
+	responses, err := anthropicGen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "I'll check the weather for you.", responses[0].Content)
+
+	assert.Equal(t, []string{"Let me consider this carefully."}, responses[0].Thinking)
+
+	toolCalls := responses[0].ToolCalls
+	require.Len(t, toolCalls, 1)
+	assert.Equal(t, "get_weather", toolCalls[0]["name"])
+
+	assert.Empty(t, responses[0].BlockReason, "tool_use stop reason is not a refusal")
+}
+
+func TestAnthropicGenerator_WasBlocked_OnRefusal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":            "msg_test123",
+			"type":          "message",
+			"role":          "assistant",
+			"model":         "claude-3-opus-20240229",
+			"content":       []map[string]any{{"type": "text", "text": ""}},
+			"stop_reason":   "refusal",
+			"stop_sequence": nil,
+			"usage":         map[string]any{"input_tokens": 10, "output_tokens": 0},
+		})
+	}))
+	defer server.Close()
+
+	g, err := NewAnthropic(registry.Config{
+		"model":    "claude-3-opus-20240229",
+		"api_key":  "test-key",
+		"base_url": server.URL,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	anthropicGen := g.(*Anthropic)
+	responses, err := anthropicGen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, responses[0].BlockReason)
+}
+
+// TestAnthropicGenerator_Generate_ConcurrentCallsDoNotCrossContaminate
+// guards against regressing to per-generator shared state for block/tool
+// call/thinking reporting: one goroutine's blocked response must never be
+// attributed to a concurrently-running goroutine's attempt.
+func TestAnthropicGenerator_Generate_ConcurrentCallsDoNotCrossContaminate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		messages, _ := req["messages"].([]any)
+		lastMsg, _ := messages[len(messages)-1].(map[string]any)
+		prompt, _ := lastMsg["content"].(string)
+
+		if strings.Contains(prompt, "refuse") {
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":            "msg_test123",
+				"type":          "message",
+				"role":          "assistant",
+				"model":         "claude-3-opus-20240229",
+				"content":       []map[string]any{{"type": "text", "text": ""}},
+				"stop_reason":   "refusal",
+				"stop_sequence": nil,
+				"usage":         map[string]any{"input_tokens": 10, "output_tokens": 0},
+			})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(mockAnthropicResponse("ok: " + prompt))
+	}))
+	defer server.Close()
+
+	g, err := NewAnthropic(registry.Config{
+		"model":    "claude-3-opus-20240229",
+		"api_key":  "test-key",
+		"base_url": server.URL,
+	})
+	require.NoError(t, err)
+	anthropicGen := g.(*Anthropic)
+
+	const iterations = 50
+	var wg sync.WaitGroup
+	for i := 0; i < iterations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			conv := attempt.NewConversation()
+			blocked := i%2 == 0
+			prompt := fmt.Sprintf("allow %d", i)
+			if blocked {
+				prompt = fmt.Sprintf("refuse %d", i)
+			}
+			conv.AddPrompt(prompt)
+
+			responses, err := anthropicGen.Generate(context.Background(), conv, 1)
+			assert.NoError(t, err)
+			require.Len(t, responses, 1)
+			if blocked {
+				assert.NotEmpty(t, responses[0].BlockReason, "prompt %q should report blocked", prompt)
+			} else {
+				assert.Empty(t, responses[0].BlockReason, "prompt %q should not report blocked", prompt)
+				assert.Equal(t, "ok: "+prompt, responses[0].Content)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
 func TestAnthropicGenerator_AnthropicVersion(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		// Verify anthropic-version header is set
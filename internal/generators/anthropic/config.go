@@ -4,8 +4,17 @@ import (
 	"fmt"
 
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
 )
 
+// ToolDefinition describes one tool the model may call, mirroring the
+// Anthropic Messages API's tool schema.
+type ToolDefinition struct {
+	Name        string
+	Description string
+	InputSchema map[string]any
+}
+
 // Config holds typed configuration for the Anthropic generator.
 type Config struct {
 	// Required
@@ -20,15 +29,31 @@ type Config struct {
 	StopSequences []string
 	BaseURL       string
 	APIVersion    string
+
+	// ThinkingEnabled turns on Claude's extended thinking, which returns a
+	// reasoning trace as "thinking" content blocks before the final answer.
+	// ThinkingBudgetTokens caps how many tokens that trace may use; it is
+	// ignored when ThinkingEnabled is false.
+	ThinkingEnabled      bool
+	ThinkingBudgetTokens int
+
+	// Tools declares the tool-calling schema to send with every request, so
+	// probes can assess behavior when the model is offered tools to invoke.
+	Tools []ToolDefinition
+
+	// Transport holds shared TLS/proxy settings (mTLS client cert, custom
+	// CA bundle, SOCKS5/HTTP proxy, insecure_skip_verify).
+	Transport transport.Config
 }
 
 // DefaultConfig returns a Config with sensible defaults.
 func DefaultConfig() Config {
 	return Config{
-		Temperature: defaultTemperature,
-		MaxTokens:   defaultMaxTokens,
-		APIVersion:  defaultAPIVersion,
-		BaseURL:     defaultBaseURL,
+		Temperature:          defaultTemperature,
+		MaxTokens:            defaultMaxTokens,
+		APIVersion:           defaultAPIVersion,
+		BaseURL:              defaultBaseURL,
+		ThinkingBudgetTokens: defaultThinkingBudgetTokens,
 	}
 }
 
@@ -58,10 +83,54 @@ func ConfigFromMap(m registry.Config) (Config, error) {
 	cfg.TopP = registry.GetFloat64(m, "top_p", cfg.TopP)
 	cfg.TopK = registry.GetInt(m, "top_k", cfg.TopK)
 	cfg.StopSequences = registry.GetStringSlice(m, "stop_sequences", nil)
+	cfg.ThinkingEnabled = registry.GetBool(m, "thinking_enabled", cfg.ThinkingEnabled)
+	cfg.ThinkingBudgetTokens = registry.GetInt(m, "thinking_budget_tokens", cfg.ThinkingBudgetTokens)
+	cfg.Tools = toolsFromMap(m)
+
+	cfg.Transport, err = transport.ConfigFromMap(m)
+	if err != nil {
+		return cfg, err
+	}
 
 	return cfg, nil
 }
 
+// toolsFromMap parses the "tools" key of a registry.Config into
+// []ToolDefinition. Each entry is expected to look like:
+//
+//	{"name": "get_weather", "description": "...", "input_schema": {...}}
+//
+// Malformed entries (missing a name) are skipped rather than erroring, since
+// tool definitions are best-effort passthrough, not validated against the
+// Anthropic API's JSON schema.
+func toolsFromMap(m registry.Config) []ToolDefinition {
+	raw, ok := m["tools"].([]any)
+	if !ok {
+		return nil
+	}
+
+	tools := make([]ToolDefinition, 0, len(raw))
+	for _, entry := range raw {
+		fields, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := fields["name"].(string)
+		if name == "" {
+			continue
+		}
+		description, _ := fields["description"].(string)
+		schema, _ := fields["input_schema"].(map[string]any)
+		tools = append(tools, ToolDefinition{
+			Name:        name,
+			Description: description,
+			InputSchema: schema,
+		})
+	}
+
+	return tools
+}
+
 // Option is a functional option for Config.
 type Option = registry.Option[Config]
 
@@ -133,6 +202,27 @@ func WithAPIVersion(version string) Option {
 	}
 }
 
+// WithThinkingEnabled turns Claude's extended thinking on or off.
+func WithThinkingEnabled(enabled bool) Option {
+	return func(c *Config) {
+		c.ThinkingEnabled = enabled
+	}
+}
+
+// WithThinkingBudgetTokens sets the token budget for extended thinking.
+func WithThinkingBudgetTokens(tokens int) Option {
+	return func(c *Config) {
+		c.ThinkingBudgetTokens = tokens
+	}
+}
+
+// WithTools sets the tool-calling schema sent with every request.
+func WithTools(tools []ToolDefinition) Option {
+	return func(c *Config) {
+		c.Tools = tools
+	}
+}
+
 // String returns a string representation with API key masked.
 // This prevents accidental credential leakage in logs or error messages.
 func (c Config) String() string {
@@ -79,6 +79,9 @@ func TestAnthropicConfigFunctionalOptions(t *testing.T) {
 		WithStopSequences([]string{"DONE"}),
 		WithBaseURL("https://custom.com"),
 		WithAPIVersion("2024-02-01"),
+		WithThinkingEnabled(true),
+		WithThinkingBudgetTokens(2048),
+		WithTools([]ToolDefinition{{Name: "get_weather", Description: "Look up weather"}}),
 	)
 
 	assert.Equal(t, "claude-3-opus-20240229", cfg.Model)
@@ -90,4 +93,49 @@ func TestAnthropicConfigFunctionalOptions(t *testing.T) {
 	assert.Equal(t, []string{"DONE"}, cfg.StopSequences)
 	assert.Equal(t, "https://custom.com", cfg.BaseURL)
 	assert.Equal(t, "2024-02-01", cfg.APIVersion)
+	assert.True(t, cfg.ThinkingEnabled)
+	assert.Equal(t, 2048, cfg.ThinkingBudgetTokens)
+	require.Len(t, cfg.Tools, 1)
+	assert.Equal(t, "get_weather", cfg.Tools[0].Name)
+}
+
+func TestAnthropicConfigFromMapThinking(t *testing.T) {
+	m := registry.Config{
+		"model":                  "claude-3-opus-20240229",
+		"api_key":                "sk-ant-test",
+		"thinking_enabled":       true,
+		"thinking_budget_tokens": 4096,
+	}
+
+	cfg, err := ConfigFromMap(m)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.ThinkingEnabled)
+	assert.Equal(t, 4096, cfg.ThinkingBudgetTokens)
+}
+
+func TestAnthropicConfigFromMapTools(t *testing.T) {
+	m := registry.Config{
+		"model":   "claude-3-opus-20240229",
+		"api_key": "sk-ant-test",
+		"tools": []any{
+			map[string]any{
+				"name":        "get_weather",
+				"description": "Look up the current weather for a city",
+				"input_schema": map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"city": map[string]any{"type": "string"}},
+				},
+			},
+			map[string]any{"description": "missing a name, should be skipped"},
+		},
+	}
+
+	cfg, err := ConfigFromMap(m)
+	require.NoError(t, err)
+
+	require.Len(t, cfg.Tools, 1)
+	assert.Equal(t, "get_weather", cfg.Tools[0].Name)
+	assert.Equal(t, "Look up the current weather for a city", cfg.Tools[0].Description)
+	assert.NotNil(t, cfg.Tools[0].InputSchema)
 }
@@ -7,6 +7,10 @@
 // Following Cohere's migration guide:
 // - api_version="v2": Uses /v2/chat endpoint (recommended, default)
 // - api_version="v1": Uses /v1/generate endpoint (legacy, supports num_generations)
+//
+// The v2 chat API also supports grounding a response in reference documents
+// (attempt.Conversation.Documents) via Cohere's native documents parameter.
+// v1 generate has no equivalent and ignores them.
 package cohere
 
 import (
@@ -212,10 +216,34 @@ func (g *Cohere) buildChatRequest(conv *attempt.Conversation) map[string]any {
 	if g.presencePenalty != 0 {
 		req["presence_penalty"] = g.presencePenalty
 	}
+	if documents := g.conversationToDocuments(conv); len(documents) > 0 {
+		req["documents"] = documents
+	}
 
 	return req
 }
 
+// conversationToDocuments converts a Conversation's Documents to Cohere's
+// v2 chat API documents format, so probes (e.g. latent injection attacks
+// that plant adversarial content in retrieved documents) can feed Cohere's
+// native RAG interface instead of inlining the content into the prompt.
+func (g *Cohere) conversationToDocuments(conv *attempt.Conversation) []map[string]any {
+	if len(conv.Documents) == 0 {
+		return nil
+	}
+
+	documents := make([]map[string]any, 0, len(conv.Documents))
+	for _, doc := range conv.Documents {
+		d := map[string]any{"data": doc.Data}
+		if doc.ID != "" {
+			d["id"] = doc.ID
+		}
+		documents = append(documents, d)
+	}
+
+	return documents
+}
+
 // conversationToMessages converts an Augustus Conversation to Cohere message format.
 func (g *Cohere) conversationToMessages(conv *attempt.Conversation) []map[string]any {
 	messages := make([]map[string]any, 0)
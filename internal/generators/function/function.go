@@ -1,9 +1,12 @@
 // Package function provides function-based generators for Augustus.
 //
 // These generators wrap user-provided functions that generate responses.
-// Two variants are supported:
+// Three variants are supported:
 //   - Single: functions that return a single response regardless of n
 //   - Multiple: functions that accept n and return n responses
+//   - Handler: functions that accept n and return (responses, error), plus
+//     optional simulated latency, for test doubles that need scripted
+//     multi-response output and error injection
 //
 // This is designed for programmatic use, not CLI invocation.
 package function
@@ -11,6 +14,8 @@ package function
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
@@ -20,6 +25,7 @@ import (
 func init() {
 	generators.Register("function.Single", NewSingle)
 	generators.Register("function.Multiple", NewMultiple)
+	generators.Register("function.Handler", NewHandler)
 }
 
 // SingleFunc is the signature for single-response generator functions.
@@ -147,3 +153,109 @@ func (m *Multiple) Name() string {
 func (m *Multiple) Description() string {
 	return "Function-based generator (multiple responses)"
 }
+
+// HandlerFunc is the signature for function.Handler generator functions.
+// It receives the prompt and the requested generation count n, and returns
+// the responses to emit (length may be less than n) and an error. Tests
+// script multi-response output and error sequences (e.g. "fail every
+// third call", "fail until a retry succeeds") by tracking call state in
+// the closure passed as the handler.
+type HandlerFunc func(prompt string, n int) ([]string, error)
+
+// LatencyFunc returns how long Handler should wait before returning from a
+// call, so tests can simulate slow or jittery providers. callIndex is 0 on
+// the first call to the generator and increments on each subsequent call.
+type LatencyFunc func(callIndex int) time.Duration
+
+// Handler is a generator that wraps a user-provided HandlerFunc with
+// optional simulated latency. It's intended as a test double for
+// harness-level integration tests (e.g. adaptive concurrency, retry logic)
+// that need scripted multi-response output and error injection beyond
+// what Single and Multiple support.
+type Handler struct {
+	fn        HandlerFunc
+	latencyFn LatencyFunc
+	calls     int64
+}
+
+// NewHandler creates a new Handler generator from configuration.
+//
+// Required:
+//   - "handler": func(string, int) ([]string, error)
+//
+// Optional:
+//   - "latency": time.Duration (fixed delay per call) or
+//     func(int) time.Duration (delay as a function of call index)
+func NewHandler(cfg registry.Config) (generators.Generator, error) {
+	fn, ok := cfg["handler"]
+	if !ok {
+		return nil, fmt.Errorf("function.Handler generator requires 'handler' configuration")
+	}
+
+	typedFn, ok := fn.(func(string, int) ([]string, error))
+	if !ok {
+		return nil, fmt.Errorf("function.Handler: handler must have signature func(string, int) ([]string, error)")
+	}
+
+	h := &Handler{fn: typedFn}
+
+	if latency, ok := cfg["latency"]; ok {
+		switch v := latency.(type) {
+		case time.Duration:
+			h.latencyFn = func(int) time.Duration { return v }
+		case func(int) time.Duration:
+			h.latencyFn = v
+		default:
+			return nil, fmt.Errorf("function.Handler: latency must be a time.Duration or func(int) time.Duration")
+		}
+	}
+
+	return h, nil
+}
+
+// Generate simulates any configured latency, then calls the wrapped
+// handler with the prompt and n, converting its responses to messages or
+// propagating its error.
+func (h *Handler) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	if n <= 0 {
+		return []attempt.Message{}, nil
+	}
+
+	callIndex := int(atomic.AddInt64(&h.calls, 1)) - 1
+
+	if h.latencyFn != nil {
+		if delay := h.latencyFn(callIndex); delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	prompt := conv.LastPrompt()
+	responses, err := h.fn(prompt, n)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]attempt.Message, len(responses))
+	for i, resp := range responses {
+		messages[i] = attempt.NewAssistantMessage(resp)
+	}
+
+	return messages, nil
+}
+
+// ClearHistory is a no-op for function generators (stateless).
+func (h *Handler) ClearHistory() {}
+
+// Name returns the generator's fully qualified name.
+func (h *Handler) Name() string {
+	return "function.Handler"
+}
+
+// Description returns a human-readable description.
+func (h *Handler) Description() string {
+	return "Function-based generator with scripted multi-response output, error injection, and simulated latency (test double)"
+}
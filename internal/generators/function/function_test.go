@@ -2,7 +2,9 @@ package function
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/registry"
@@ -222,3 +224,223 @@ func TestMultiple_Name(t *testing.T) {
 
 	assert.Equal(t, "function.Multiple", gen.Name())
 }
+
+func TestNewHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     registry.Config
+		wantErr bool
+	}{
+		{
+			name: "valid handler",
+			cfg: registry.Config{
+				"handler": func(prompt string, n int) ([]string, error) {
+					return []string{"ok"}, nil
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing handler",
+			cfg:     registry.Config{},
+			wantErr: true,
+		},
+		{
+			name: "invalid handler type",
+			cfg: registry.Config{
+				"handler": "not a function",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid latency type",
+			cfg: registry.Config{
+				"handler": func(prompt string, n int) ([]string, error) {
+					return []string{"ok"}, nil
+				},
+				"latency": "slow",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen, err := NewHandler(tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, gen)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, gen)
+			}
+		})
+	}
+}
+
+func TestHandler_Generate_MultiResponse(t *testing.T) {
+	cfg := registry.Config{
+		"handler": func(prompt string, n int) ([]string, error) {
+			responses := make([]string, n)
+			for i := range responses {
+				responses[i] = prompt
+			}
+			return responses, nil
+		},
+	}
+
+	gen, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	messages, err := gen.Generate(context.Background(), conv, 3)
+	require.NoError(t, err)
+	require.Len(t, messages, 3)
+	for _, m := range messages {
+		assert.Equal(t, "hi", m.Content)
+	}
+}
+
+func TestHandler_Generate_ScriptedErrorSequence(t *testing.T) {
+	var calls int
+	cfg := registry.Config{
+		"handler": func(prompt string, n int) ([]string, error) {
+			calls++
+			if calls == 2 {
+				return nil, errors.New("rate limited")
+			}
+			return []string{"ok"}, nil
+		},
+	}
+
+	gen, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	_, err = gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	_, err = gen.Generate(context.Background(), conv, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rate limited")
+
+	_, err = gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+}
+
+func TestHandler_Generate_FixedLatency(t *testing.T) {
+	cfg := registry.Config{
+		"handler": func(prompt string, n int) ([]string, error) {
+			return []string{"ok"}, nil
+		},
+		"latency": 20 * time.Millisecond,
+	}
+
+	gen, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	start := time.Now()
+	_, err = gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+}
+
+func TestHandler_Generate_LatencyFuncByCallIndex(t *testing.T) {
+	var seenIndexes []int
+	cfg := registry.Config{
+		"handler": func(prompt string, n int) ([]string, error) {
+			return []string{"ok"}, nil
+		},
+		"latency": func(callIndex int) time.Duration {
+			seenIndexes = append(seenIndexes, callIndex)
+			return 0
+		},
+	}
+
+	gen, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	_, err = gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	_, err = gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{0, 1}, seenIndexes)
+}
+
+func TestHandler_Generate_LatencyRespectsContextCancellation(t *testing.T) {
+	cfg := registry.Config{
+		"handler": func(prompt string, n int) ([]string, error) {
+			return []string{"ok"}, nil
+		},
+		"latency": time.Hour,
+	}
+
+	gen, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = gen.Generate(ctx, conv, 1)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestHandler_Generate_ZeroGenerations(t *testing.T) {
+	cfg := registry.Config{
+		"handler": func(prompt string, n int) ([]string, error) {
+			t.Fatal("handler should not be called for n<=0")
+			return nil, nil
+		},
+	}
+
+	gen, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	messages, err := gen.Generate(context.Background(), conv, 0)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func TestHandler_Name(t *testing.T) {
+	cfg := registry.Config{
+		"handler": func(prompt string, n int) ([]string, error) {
+			return []string{"ok"}, nil
+		},
+	}
+
+	gen, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, "function.Handler", gen.Name())
+}
+
+func TestHandler_Description(t *testing.T) {
+	cfg := registry.Config{
+		"handler": func(prompt string, n int) ([]string, error) {
+			return []string{"ok"}, nil
+		},
+	}
+
+	gen, err := NewHandler(cfg)
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, gen.Description())
+}
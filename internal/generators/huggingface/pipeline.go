@@ -22,13 +22,18 @@
 //
 //   model: Required. The model ID (e.g., "meta-llama/Llama-2-7b-chat-hf")
 //   host: Optional. TGI server address (default: http://127.0.0.1:8080)
+//   api_key: Optional. Bearer token, for TGI deployments fronted by auth
 //   max_tokens: Optional. Maximum tokens to generate
 //   temperature: Optional. Sampling temperature
 //   top_p: Optional. Nucleus sampling parameter
+//   wait_for_model: Optional. Retry on 503 while the server is still
+//     loading model weights, instead of failing the first request (common
+//     right after a TGI container starts)
 //
 // Environment Variables:
 //
 //   TGI_HOST: Override default TGI host
+//   HF_INFERENCE_TOKEN, HUGGINGFACE_API_KEY: Bearer token, if api_key is unset
 //
 package huggingface
 
@@ -68,6 +73,7 @@ type Pipeline struct {
 	temperature    *float64
 	topP           *float64
 	deprefixPrompt bool
+	waitForModel   bool
 }
 
 // NewPipeline creates a new HuggingFace Pipeline generator from configuration.
@@ -91,12 +97,30 @@ func NewPipeline(cfg registry.Config) (generators.Generator, error) {
 		g.host = envHost
 	}
 
-	// Build HTTP client
-	g.client = libhttp.NewClient(
+	// API key: from config or env vars
+	apiKey := ""
+	if key, ok := cfg["api_key"].(string); ok && key != "" {
+		apiKey = key
+	} else {
+		// Try HF_INFERENCE_TOKEN first, then HUGGINGFACE_API_KEY
+		apiKey = os.Getenv("HF_INFERENCE_TOKEN")
+		if apiKey == "" {
+			apiKey = os.Getenv("HUGGINGFACE_API_KEY")
+		}
+	}
+
+	// Build HTTP client with options
+	opts := []libhttp.Option{
 		libhttp.WithBaseURL(g.host),
 		libhttp.WithTimeout(DefaultPipelineTimeout),
 		libhttp.WithUserAgent("Augustus/1.0"),
-	)
+	}
+
+	if apiKey != "" {
+		opts = append(opts, libhttp.WithBearerToken(apiKey))
+	}
+
+	g.client = libhttp.NewClient(opts...)
 
 	// Optional parameters
 	if maxTokens, ok := cfg["max_tokens"].(int); ok {
@@ -117,6 +141,11 @@ func NewPipeline(cfg registry.Config) (generators.Generator, error) {
 		g.deprefixPrompt = deprefix
 	}
 
+	// Optional: wait_for_model (retry on 503 while TGI is still loading weights)
+	if wait, ok := cfg["wait_for_model"].(bool); ok {
+		g.waitForModel = wait
+	}
+
 	return g, nil
 }
 
@@ -142,28 +171,47 @@ func (g *Pipeline) Generate(ctx context.Context, conv *attempt.Conversation, n i
 	// Build request payload (OpenAI-compatible format)
 	payload := g.buildPayload(conv, n)
 
-	// Make request to TGI
-	resp, err := g.client.Post(ctx, "/v1/chat/completions", payload)
-	if err != nil {
-		return nil, fmt.Errorf("huggingface: pipeline request failed: %w", err)
+	maxAttempts := 1
+	if g.waitForModel {
+		maxAttempts = 3
 	}
 
-	// Handle errors
-	if resp.StatusCode >= 400 {
-		var errResp struct {
-			Error struct {
-				Message string `json:"message"`
-			} `json:"error"`
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		resp, err := g.client.Post(ctx, "/v1/chat/completions", payload)
+		if err != nil {
+			return nil, fmt.Errorf("huggingface: pipeline request failed: %w", err)
 		}
-		_ = resp.JSON(&errResp) // Intentionally ignore error; use fallback if parsing fails
-		if errResp.Error.Message != "" {
-			return nil, fmt.Errorf("huggingface: pipeline error (status %d): %s", resp.StatusCode, errResp.Error.Message)
+
+		// Handle errors
+		if resp.StatusCode >= 400 {
+			var errResp struct {
+				Error struct {
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			_ = resp.JSON(&errResp) // Intentionally ignore error; use fallback if parsing fails
+
+			// TGI returns 503 while the model is still loading weights.
+			// Unlike HuggingFace's hosted API, TGI's OpenAI-compatible
+			// endpoint has no wait_for_model payload option, so we just
+			// retry rather than failing the first request.
+			if resp.StatusCode == 503 && attempt < maxAttempts-1 {
+				lastErr = fmt.Errorf("huggingface: pipeline model is still loading")
+				continue
+			}
+
+			if errResp.Error.Message != "" {
+				return nil, fmt.Errorf("huggingface: pipeline error (status %d): %s", resp.StatusCode, errResp.Error.Message)
+			}
+			return nil, fmt.Errorf("huggingface: pipeline error: status %d", resp.StatusCode)
 		}
-		return nil, fmt.Errorf("huggingface: pipeline error: status %d", resp.StatusCode)
+
+		// Parse response
+		return g.parseResponse(resp)
 	}
 
-	// Parse response
-	return g.parseResponse(resp)
+	return nil, lastErr
 }
 
 // buildPayload constructs the TGI request payload.
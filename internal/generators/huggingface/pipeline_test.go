@@ -166,6 +166,113 @@ func TestPipelineServerError(t *testing.T) {
 	}
 }
 
+func TestPipelineSendsBearerToken(t *testing.T) {
+	var gotAuth string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		resp := map[string]any{
+			"choices": []map[string]any{
+				{"message": map[string]string{"role": "assistant", "content": "OK"}},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	gen, err := NewPipeline(registry.Config{
+		"model":   "test-model",
+		"host":    server.URL,
+		"api_key": "secret-token",
+	})
+	if err != nil {
+		t.Fatalf("failed to create generator: %v", err)
+	}
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("Hello")
+
+	if _, err := gen.Generate(context.Background(), conv, 1); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if gotAuth != "Bearer secret-token" {
+		t.Errorf("expected 'Bearer secret-token', got '%s'", gotAuth)
+	}
+}
+
+func TestPipelineWaitForModelRetriesOn503(t *testing.T) {
+	var requestCount int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if requestCount < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"error": map[string]string{"message": "Model is loading"},
+			})
+			return
+		}
+		resp := map[string]any{
+			"choices": []map[string]any{
+				{"message": map[string]string{"role": "assistant", "content": "Ready"}},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	gen, _ := NewPipeline(registry.Config{
+		"model":          "test-model",
+		"host":           server.URL,
+		"wait_for_model": true,
+	})
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("Hello")
+
+	messages, err := gen.Generate(context.Background(), conv, 1)
+	if err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	if len(messages) != 1 || messages[0].Content != "Ready" {
+		t.Fatalf("expected 'Ready' after retries, got %+v", messages)
+	}
+
+	if requestCount != 3 {
+		t.Errorf("expected 3 requests, got %d", requestCount)
+	}
+}
+
+func TestPipelineWaitForModelExhaustsRetries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"error": map[string]string{"message": "Model is loading"},
+		})
+	}))
+	defer server.Close()
+
+	gen, _ := NewPipeline(registry.Config{
+		"model":          "test-model",
+		"host":           server.URL,
+		"wait_for_model": true,
+	})
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("Hello")
+
+	_, err := gen.Generate(context.Background(), conv, 1)
+	if err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+
+	if !strings.Contains(err.Error(), "Model is loading") {
+		t.Errorf("expected 'Model is loading' in final error, got: %v", err)
+	}
+}
+
 func TestPipelineWithSystemPrompt(t *testing.T) {
 	var receivedMessages []map[string]string
 
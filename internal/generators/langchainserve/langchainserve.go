@@ -1,11 +1,12 @@
 // Package langchainserve provides a LangChain Serve generator for Augustus.
 //
 // This package wraps LangChain Serve applications exposed via HTTP REST endpoint.
-// LangChain Serve applications expose an /invoke endpoint that accepts prompts
-// in a specific format and returns responses.
+// LangChain Serve applications expose /invoke and /stream endpoints that accept
+// prompts in a specific format and return responses.
 package langchainserve
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -13,10 +14,13 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/probes"
 	"github.com/praetorian-inc/augustus/pkg/registry"
 )
 
@@ -24,18 +28,30 @@ func init() {
 	generators.Register("langchain_serve.LangChainServe", NewLangChainServe)
 }
 
+// Compile-time interface assertion.
+var _ probes.IntermediateStepReporter = (*LangChainServe)(nil)
+
 // LangChainServe is a generator that wraps LangChain Serve applications via REST API.
-// It calls the /invoke endpoint on the LangChain Serve application.
+// It calls the /invoke (or /stream) endpoint on the LangChain Serve application.
 type LangChainServe struct {
 	baseURL    string
 	configHash string
 	headers    map[string]string
+	inputKey   string
+	outputKey  string
+	stream     bool
 	client     *http.Client
+
+	mu                    sync.Mutex
+	lastIntermediateSteps []any
 }
 
 // NewLangChainServe creates a new LangChainServe generator from configuration.
 func NewLangChainServe(cfg registry.Config) (generators.Generator, error) {
-	ls := &LangChainServe{}
+	ls := &LangChainServe{
+		inputKey:  "input",
+		outputKey: "output",
+	}
 
 	// Required: base_url
 	baseURL, ok := cfg["base_url"].(string)
@@ -64,6 +80,21 @@ func NewLangChainServe(cfg registry.Config) (generators.Generator, error) {
 		}
 	}
 
+	// Optional: input_key / output_key, for chains that accept or return a
+	// keyed dict other than the {"input": ..., "output": ...} default
+	// (e.g. a RAG chain that expects "question" and returns "answer").
+	if inputKey, ok := cfg["input_key"].(string); ok && inputKey != "" {
+		ls.inputKey = inputKey
+	}
+	if outputKey, ok := cfg["output_key"].(string); ok && outputKey != "" {
+		ls.outputKey = outputKey
+	}
+
+	// Optional: stream, to call /stream instead of /invoke
+	if stream, ok := cfg["stream"].(bool); ok {
+		ls.stream = stream
+	}
+
 	// Optional: timeout (default 30 seconds)
 	timeout := 30 * time.Second
 	if timeoutVal, ok := cfg["timeout"].(int); ok {
@@ -79,15 +110,19 @@ func NewLangChainServe(cfg registry.Config) (generators.Generator, error) {
 }
 
 // Generate sends the conversation to the LangChain Serve endpoint and returns the response.
-// Note: LangChain Serve's invoke endpoint does not support n>1, so we only make one call.
+// Note: LangChain Serve's invoke/stream endpoints do not support n>1, so we only make one call.
 func (ls *LangChainServe) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
 	if n <= 0 {
 		return []attempt.Message{}, nil
 	}
 
-	// LangChain Serve invoke does not support multiple generations
-	// We call it once regardless of n value
-	msg, err := ls.callInvoke(ctx, conv)
+	var msg attempt.Message
+	var err error
+	if ls.stream {
+		msg, err = ls.callStream(ctx, conv)
+	} else {
+		msg, err = ls.callInvoke(ctx, conv)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -95,85 +130,209 @@ func (ls *LangChainServe) Generate(ctx context.Context, conv *attempt.Conversati
 	return []attempt.Message{msg}, nil
 }
 
-// callInvoke makes a single API call to the LangChain Serve /invoke endpoint.
-func (ls *LangChainServe) callInvoke(ctx context.Context, conv *attempt.Conversation) (attempt.Message, error) {
-	// Convert conversation to LangChain Serve format
-	// LangChain Serve expects: {"input": "prompt", "config": {}, "kwargs": {}}
+// IntermediateSteps returns the intermediate steps (e.g. an agent's tool-call
+// trace) reported alongside the most recent call's output, implementing
+// probes.IntermediateStepReporter. Returns nil if the chain didn't return any.
+func (ls *LangChainServe) IntermediateSteps() []any {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return ls.lastIntermediateSteps
+}
+
+func (ls *LangChainServe) setIntermediateSteps(steps []any) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	ls.lastIntermediateSteps = steps
+}
+
+// buildRequestBody constructs the LangChain Serve request body.
+// LangChain Serve expects: {"input": <input_key value>, "config": {}, "kwargs": {}}
+func (ls *LangChainServe) buildRequestBody(conv *attempt.Conversation) map[string]any {
 	prompt := conv.LastPrompt()
 
-	reqBody := map[string]any{
-		"input":  prompt,
-		"config": map[string]any{},
-		"kwargs": map[string]any{},
+	return map[string]any{
+		ls.inputKey: prompt,
+		"config":    map[string]any{},
+		"kwargs":    map[string]any{},
 	}
+}
 
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return attempt.Message{}, fmt.Errorf("langchain_serve: failed to marshal request: %w", err)
+// endpointURL builds the URL for the given LangChain Serve path ("/invoke" or
+// "/stream"), attaching the config_hash query parameter if configured.
+func (ls *LangChainServe) endpointURL(path string) string {
+	endpoint := ls.baseURL + path
+	if ls.configHash == "" {
+		return endpoint
 	}
+	parsedURL, _ := url.Parse(endpoint)
+	q := parsedURL.Query()
+	q.Set("config_hash", ls.configHash)
+	parsedURL.RawQuery = q.Encode()
+	return parsedURL.String()
+}
 
-	// Build URL with config_hash query parameter if provided
-	invokeURL := ls.baseURL + "/invoke"
-	if ls.configHash != "" {
-		parsedURL, _ := url.Parse(invokeURL)
-		q := parsedURL.Query()
-		q.Set("config_hash", ls.configHash)
-		parsedURL.RawQuery = q.Encode()
-		invokeURL = parsedURL.String()
+// callInvoke makes a single API call to the LangChain Serve /invoke endpoint.
+func (ls *LangChainServe) callInvoke(ctx context.Context, conv *attempt.Conversation) (attempt.Message, error) {
+	jsonData, err := json.Marshal(ls.buildRequestBody(conv))
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("langchain_serve: failed to marshal request: %w", err)
 	}
 
-	// Create request
-	req, err := http.NewRequestWithContext(ctx, "POST", invokeURL, bytes.NewReader(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", ls.endpointURL("/invoke"), bytes.NewReader(jsonData))
 	if err != nil {
 		return attempt.Message{}, fmt.Errorf("langchain_serve: failed to create request: %w", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
-
-	// Add custom headers
 	for k, v := range ls.headers {
 		req.Header.Set(k, v)
 	}
 
-	// Execute request
 	resp, err := ls.client.Do(req)
 	if err != nil {
 		return attempt.Message{}, fmt.Errorf("langchain_serve: request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	// Check status code
 	if resp.StatusCode >= 400 {
 		body, _ := io.ReadAll(resp.Body)
 		return attempt.Message{}, fmt.Errorf("langchain_serve: API error %d: %s", resp.StatusCode, string(body))
 	}
 
-	// Parse response
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return attempt.Message{}, fmt.Errorf("langchain_serve: failed to read response: %w", err)
 	}
 
-	// LangChain Serve returns: {"output": ["response text"]}
 	var result map[string]any
 	if err := json.Unmarshal(respBody, &result); err != nil {
 		return attempt.Message{}, fmt.Errorf("langchain_serve: failed to parse response: %w", err)
 	}
 
-	// Extract output array
-	output, ok := result["output"].([]any)
-	if !ok || len(output) == 0 {
-		return attempt.Message{}, fmt.Errorf("langchain_serve: response missing 'output' field or empty array")
+	return ls.extractOutput(result)
+}
+
+// callStream makes a single API call to the LangChain Serve /stream endpoint
+// and accumulates the streamed chunks into a final response. LangChain
+// Serve's /stream emits Server-Sent Events, one JSON-encoded chunk per
+// "data:" line: a string for chains that stream raw text, or a dict snapshot
+// for chains (like agents) that stream structured output.
+func (ls *LangChainServe) callStream(ctx context.Context, conv *attempt.Conversation) (attempt.Message, error) {
+	jsonData, err := json.Marshal(ls.buildRequestBody(conv))
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("langchain_serve: failed to marshal request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", ls.endpointURL("/stream"), bytes.NewReader(jsonData))
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("langchain_serve: failed to create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+	for k, v := range ls.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := ls.client.Do(req)
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("langchain_serve: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return attempt.Message{}, fmt.Errorf("langchain_serve: API error %d: %s", resp.StatusCode, string(body))
+	}
+
+	var textChunks strings.Builder
+	var lastSnapshot map[string]any
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		data, ok := strings.CutPrefix(line, "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "" {
+			continue
+		}
+
+		var chunk any
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			continue
+		}
+
+		switch v := chunk.(type) {
+		case string:
+			textChunks.WriteString(v)
+		case map[string]any:
+			lastSnapshot = v
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return attempt.Message{}, fmt.Errorf("langchain_serve: failed to read stream: %w", err)
+	}
+
+	if lastSnapshot != nil {
+		return ls.extractOutput(lastSnapshot)
+	}
+
+	if textChunks.Len() == 0 {
+		return attempt.Message{}, fmt.Errorf("langchain_serve: stream produced no output")
 	}
 
-	// Extract first element of output array
-	content, ok := output[0].(string)
+	ls.setIntermediateSteps(nil)
+	return attempt.NewAssistantMessage(textChunks.String()), nil
+}
+
+// extractOutput pulls the final response text (and, if present, an agent's
+// intermediate steps) out of a decoded LangChain Serve response, looking at
+// the configured output_key. It accepts the three shapes LangChain Serve
+// commonly returns:
+//   - {"output": ["text"]}            legacy array wrapping
+//   - {"output": "text"}              plain string output
+//   - {"output": {"output": "text", "intermediate_steps": [...]}}  agent output
+func (ls *LangChainServe) extractOutput(result map[string]any) (attempt.Message, error) {
+	raw, ok := result[ls.outputKey]
 	if !ok {
-		return attempt.Message{}, fmt.Errorf("langchain_serve: output[0] is not a string")
+		return attempt.Message{}, fmt.Errorf("langchain_serve: response missing '%s' field", ls.outputKey)
 	}
 
-	return attempt.NewAssistantMessage(content), nil
+	switch v := raw.(type) {
+	case []any:
+		if len(v) == 0 {
+			return attempt.Message{}, fmt.Errorf("langchain_serve: '%s' field is an empty array", ls.outputKey)
+		}
+		content, ok := v[0].(string)
+		if !ok {
+			return attempt.Message{}, fmt.Errorf("langchain_serve: %s[0] is not a string", ls.outputKey)
+		}
+		ls.setIntermediateSteps(nil)
+		return attempt.NewAssistantMessage(content), nil
+
+	case string:
+		ls.setIntermediateSteps(nil)
+		return attempt.NewAssistantMessage(v), nil
+
+	case map[string]any:
+		content, ok := v["output"].(string)
+		if !ok {
+			return attempt.Message{}, fmt.Errorf("langchain_serve: %s.output is not a string", ls.outputKey)
+		}
+		if steps, ok := v["intermediate_steps"].([]any); ok {
+			ls.setIntermediateSteps(steps)
+		} else {
+			ls.setIntermediateSteps(nil)
+		}
+		return attempt.NewAssistantMessage(content), nil
+
+	default:
+		return attempt.Message{}, fmt.Errorf("langchain_serve: '%s' field has unsupported type %T", ls.outputKey, raw)
+	}
 }
 
 // ClearHistory is a no-op for LangChain Serve generator (stateless per call).
@@ -186,5 +345,5 @@ func (ls *LangChainServe) Name() string {
 
 // Description returns a human-readable description.
 func (ls *LangChainServe) Description() string {
-	return "LangChain Serve application generator via REST /invoke endpoint"
+	return "LangChain Serve application generator via REST /invoke or /stream endpoints"
 }
@@ -2,6 +2,8 @@ package langchainserve
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -269,6 +271,88 @@ func TestLangChainServe_Description(t *testing.T) {
 	assert.Contains(t, desc, "LangChain Serve")
 }
 
+func TestLangChainServe_CustomInputOutputKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "test prompt", body["question"])
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"answer": "42"}`))
+	}))
+	defer server.Close()
+
+	cfg := registry.Config{
+		"base_url":   server.URL,
+		"input_key":  "question",
+		"output_key": "answer",
+	}
+
+	gen, err := NewLangChainServe(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddTurn(attempt.NewTurn("test prompt"))
+
+	messages, err := gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "42", messages[0].Content)
+}
+
+func TestLangChainServe_AgentOutputCapturesIntermediateSteps(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"output": {"output": "final answer", "intermediate_steps": [["tool call", "tool result"]]}}`))
+	}))
+	defer server.Close()
+
+	cfg := registry.Config{"base_url": server.URL}
+	gen, err := NewLangChainServe(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddTurn(attempt.NewTurn("test"))
+
+	messages, err := gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "final answer", messages[0].Content)
+
+	lc := gen.(*LangChainServe)
+	steps := lc.IntermediateSteps()
+	require.Len(t, steps, 1)
+}
+
+func TestLangChainServe_Stream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stream", r.URL.Path)
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, "data: \"Hello\"\n\n")
+		fmt.Fprint(w, "data: \", world\"\n\n")
+		fmt.Fprint(w, "data: \"!\"\n\n")
+	}))
+	defer server.Close()
+
+	cfg := registry.Config{
+		"base_url": server.URL,
+		"stream":   true,
+	}
+
+	gen, err := NewLangChainServe(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddTurn(attempt.NewTurn("test"))
+
+	messages, err := gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, world!", messages[0].Content)
+}
+
 func TestLangChainServe_ClearHistory(t *testing.T) {
 	cfg := registry.Config{
 		"base_url": "http://localhost:8000/chain",
@@ -5,6 +5,8 @@
 package mistral
 
 import (
+	"time"
+
 	"github.com/praetorian-inc/augustus/internal/generators/openaicompat"
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/registry"
@@ -14,7 +16,7 @@ func init() {
 	generators.Register("mistral.Mistral", NewMistral)
 }
 
-// NewMistral creates a Mistral generator using CompatGenerator.
+// NewMistral creates a Mistral generator using CompatGenerator with retry support.
 func NewMistral(cfg registry.Config) (generators.Generator, error) {
 	return openaicompat.NewGenerator(cfg, openaicompat.ProviderConfig{
 		Name:           "mistral.Mistral",
@@ -22,5 +24,10 @@ func NewMistral(cfg registry.Config) (generators.Generator, error) {
 		Provider:       "mistral",
 		DefaultBaseURL: "https://api.mistral.ai/v1",
 		EnvVar:         "MISTRAL_API_KEY",
+		RetryConfig: &openaicompat.RetryConfig{
+			MaxRetries:  3,
+			InitialWait: 1 * time.Second,
+			MaxWait:     30 * time.Second,
+		},
 	})
 }
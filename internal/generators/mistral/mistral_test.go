@@ -159,6 +159,36 @@ func TestMistralGenerator_Description(t *testing.T) {
 	assert.Contains(t, desc, "Mistral")
 }
 
+func TestMistralGenerator_SupportedModels(t *testing.T) {
+	models := []string{
+		"mistral-large-latest",
+		"mistral-small-latest",
+		"open-mixtral-8x22b",
+	}
+
+	for _, model := range models {
+		t.Run(model, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewEncoder(w).Encode(mockMistralResponse("Response", 1))
+			}))
+			defer server.Close()
+
+			g, err := NewMistral(registry.Config{
+				"model":    model,
+				"api_key":  "test-key",
+				"base_url": server.URL,
+			})
+			require.NoError(t, err)
+
+			conv := attempt.NewConversation()
+			conv.AddPrompt("test")
+
+			_, err = g.Generate(context.Background(), conv, 1)
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestMistralGenerator_Registration(t *testing.T) {
 	// Verify the generator is registered
 	factory, ok := generators.Get("mistral.Mistral")
@@ -23,6 +23,18 @@ type Config struct {
 	TopP        *float64
 	TopK        *int
 	NumPredict  *int
+
+	// VerifyModel checks, at construction time, that the server is reachable
+	// and the configured model is present locally. This turns a scan-long
+	// stream of generic per-attempt HTTP errors into a single upfront error
+	// distinguishing "server down" from "model missing". Off by default to
+	// keep generator construction network-free unless opted into.
+	VerifyModel bool
+
+	// PullModel, when VerifyModel is also set, pulls the configured model
+	// from the Ollama library if it isn't already present locally instead
+	// of failing construction.
+	PullModel bool
 }
 
 // DefaultConfig returns a Config with sensible defaults.
@@ -81,6 +93,9 @@ func ConfigFromMap(m registry.Config) (Config, error) {
 		cfg.NumPredict = &numPredict
 	}
 
+	cfg.VerifyModel = registry.GetBool(m, "verify_model", false)
+	cfg.PullModel = registry.GetBool(m, "pull_model", false)
+
 	return cfg, nil
 }
 
@@ -140,3 +155,20 @@ func WithNumPredict(n *int) Option {
 		c.NumPredict = n
 	}
 }
+
+// WithVerifyModel enables a pre-flight server/model health check at
+// construction time.
+func WithVerifyModel(verify bool) Option {
+	return func(c *Config) {
+		c.VerifyModel = verify
+	}
+}
+
+// WithPullModel enables pulling the model from the Ollama library if it is
+// missing locally, instead of failing the pre-flight check. Has no effect
+// unless VerifyModel is also set.
+func WithPullModel(pull bool) Option {
+	return func(c *Config) {
+		c.PullModel = pull
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
@@ -117,9 +118,153 @@ func baseConfigFromTyped(cfg Config) (*baseConfig, error) {
 		Timeout: bc.timeout,
 	}
 
+	if cfg.VerifyModel {
+		if err := bc.verifyModelAvailable(context.Background(), cfg.PullModel); err != nil {
+			return nil, err
+		}
+	}
+
 	return bc, nil
 }
 
+// tagsResponse is the response from /api/tags, used to list locally
+// available models.
+type tagsResponse struct {
+	Models []struct {
+		Name string `json:"name"`
+	} `json:"models"`
+}
+
+// pullRequest is the request body for /api/pull.
+type pullRequest struct {
+	Name   string `json:"name"`
+	Stream bool   `json:"stream"`
+}
+
+// pullResponse is the (non-streaming) response from /api/pull.
+type pullResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// verifyModelAvailable checks that the Ollama server is reachable and the
+// configured model is present locally, optionally pulling it if missing.
+// Failing here at construction time turns what would otherwise be a
+// scan-long stream of generic per-attempt HTTP errors into a single,
+// specific error that distinguishes "server down" from "model missing".
+func (bc *baseConfig) verifyModelAvailable(ctx context.Context, pullIfMissing bool) error {
+	models, err := bc.listModels(ctx)
+	if err != nil {
+		return fmt.Errorf("ollama: server health check failed for %s: %w", bc.host, err)
+	}
+
+	if modelAvailable(models, bc.model) {
+		return nil
+	}
+
+	if !pullIfMissing {
+		return fmt.Errorf("ollama: model %q not found on server %s (available: %s); set pull_model to fetch it automatically", bc.model, bc.host, strings.Join(models, ", "))
+	}
+
+	if err := bc.pullModel(ctx); err != nil {
+		return fmt.Errorf("ollama: failed to pull model %q from %s: %w", bc.model, bc.host, err)
+	}
+
+	return nil
+}
+
+// listModels queries /api/tags for the models currently available on the
+// server, also serving as the server health check.
+func (bc *baseConfig) listModels(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bc.host+"/api/tags", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := bc.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tags tagsResponse
+	if err := json.Unmarshal(body, &tags); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	names := make([]string, len(tags.Models))
+	for i, m := range tags.Models {
+		names[i] = m.Name
+	}
+	return names, nil
+}
+
+// modelAvailable reports whether want is present in models, treating an
+// unqualified name (e.g. "llama2") as matching its ":latest" tag.
+func modelAvailable(models []string, want string) bool {
+	for _, m := range models {
+		if m == want {
+			return true
+		}
+		if !strings.Contains(want, ":") && m == want+":latest" {
+			return true
+		}
+	}
+	return false
+}
+
+// pullModel fetches the configured model from the Ollama library. Pulls can
+// take far longer than a typical generate/chat call, so this uses a client
+// without bc's request timeout.
+func (bc *baseConfig) pullModel(ctx context.Context) error {
+	reqBody, err := json.Marshal(pullRequest{Name: bc.model, Stream: false})
+	if err != nil {
+		return fmt.Errorf("failed to marshal pull request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, bc.host+"/api/pull", bytes.NewReader(reqBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	pullClient := &http.Client{}
+	resp, err := pullClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("server returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var pr pullResponse
+	if err := json.Unmarshal(body, &pr); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	if pr.Error != "" {
+		return fmt.Errorf("%s", pr.Error)
+	}
+
+	return nil
+}
+
 // buildOptions constructs ollamaOptions from baseConfig.
 func (bc *baseConfig) buildOptions() *ollamaOptions {
 	if bc.temperature == nil && bc.topP == nil && bc.topK == nil && bc.numPredict == nil {
@@ -821,3 +821,118 @@ func TestOllamaChat_ConnectionRefused(t *testing.T) {
 	_, err = g.Generate(context.Background(), conv, 1)
 	assert.Error(t, err)
 }
+
+// --- VerifyModel / PullModel Tests ---
+
+func mockTagsHandler(modelNames ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		models := make([]map[string]any, len(modelNames))
+		for i, name := range modelNames {
+			models[i] = map[string]any{"name": name}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"models": models})
+	}
+}
+
+func TestOllama_VerifyModel_Available(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/tags" {
+			mockTagsHandler("llama2:latest")(w, r)
+			return
+		}
+		t.Fatalf("unexpected request to %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	g, err := NewOllama(registry.Config{
+		"model":        "llama2",
+		"host":         server.URL,
+		"verify_model": true,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, g)
+}
+
+func TestOllama_VerifyModel_ServerDown(t *testing.T) {
+	_, err := NewOllama(registry.Config{
+		"model":        "llama2",
+		"host":         "http://localhost:59999",
+		"verify_model": true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "health check failed")
+}
+
+func TestOllama_VerifyModel_MissingModel(t *testing.T) {
+	server := httptest.NewServer(mockTagsHandler("mistral:latest"))
+	defer server.Close()
+
+	_, err := NewOllama(registry.Config{
+		"model":        "llama2",
+		"host":         server.URL,
+		"verify_model": true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `model "llama2" not found`)
+	assert.Contains(t, err.Error(), "mistral:latest")
+}
+
+func TestOllama_PullModel_FetchesMissingModel(t *testing.T) {
+	var pulled bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			mockTagsHandler()(w, r)
+		case "/api/pull":
+			pulled = true
+			_ = json.NewEncoder(w).Encode(map[string]any{"status": "success"})
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	g, err := NewOllama(registry.Config{
+		"model":        "llama2",
+		"host":         server.URL,
+		"verify_model": true,
+		"pull_model":   true,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, g)
+	assert.True(t, pulled, "missing model should have been pulled")
+}
+
+func TestOllama_PullModel_PropagatesPullError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/tags":
+			mockTagsHandler()(w, r)
+		case "/api/pull":
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "model not found in library"})
+		default:
+			t.Fatalf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	_, err := NewOllama(registry.Config{
+		"model":        "nonexistent-model",
+		"host":         server.URL,
+		"verify_model": true,
+		"pull_model":   true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to pull model")
+}
+
+func TestOllama_VerifyModel_DefaultsOff(t *testing.T) {
+	// No /api/tags handler at all: construction must not make any network
+	// call unless verify_model is explicitly set.
+	g, err := NewOllama(registry.Config{
+		"model": "llama2",
+		"host":  "http://localhost:59999",
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, g)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
 )
 
 // Config holds typed configuration for the OpenAI generator.
@@ -20,6 +21,29 @@ type Config struct {
 	PresencePenalty  float32
 	Stop             []string
 	BaseURL          string
+
+	// Seed requests deterministic sampling when the backend supports it. Zero
+	// means unset, matching the zero-means-unset convention of the other
+	// optional numeric fields above.
+	Seed int
+
+	// UseResponsesAPI sends requests to OpenAI's newer /responses endpoint
+	// instead of /chat/completions. Probes like goodside.ThreatenJSON need to
+	// exercise both code paths, since they parse and fail differently.
+	UseResponsesAPI bool
+
+	// JSONSchemaName and JSONSchema configure structured output mode: when
+	// JSONSchemaName is set, responses are constrained to JSON matching the
+	// given schema. JSONSchemaStrict enables the API's strict schema
+	// adherence mode.
+	JSONSchemaName   string
+	JSONSchema       map[string]any
+	JSONSchemaStrict bool
+
+	// Transport holds shared TLS/proxy settings (mTLS client cert, custom
+	// CA bundle, SOCKS5/HTTP proxy, insecure_skip_verify) for talking to
+	// internal staging endpoints behind corporate TLS interception.
+	Transport transport.Config
 }
 
 // DefaultConfig returns an OpenAIConfig with sensible defaults.
@@ -55,6 +79,18 @@ func ConfigFromMap(m registry.Config) (Config, error) {
 	cfg.FrequencyPenalty = registry.GetFloat32(m, "frequency_penalty", cfg.FrequencyPenalty)
 	cfg.PresencePenalty = registry.GetFloat32(m, "presence_penalty", cfg.PresencePenalty)
 	cfg.Stop = registry.GetStringSlice(m, "stop", nil)
+	cfg.Seed = registry.GetInt(m, "seed", cfg.Seed)
+	cfg.UseResponsesAPI = registry.GetBool(m, "use_responses_api", cfg.UseResponsesAPI)
+	cfg.JSONSchemaName = registry.GetString(m, "json_schema_name", cfg.JSONSchemaName)
+	cfg.JSONSchemaStrict = registry.GetBool(m, "json_schema_strict", cfg.JSONSchemaStrict)
+	if schema, ok := m["json_schema"].(map[string]any); ok {
+		cfg.JSONSchema = schema
+	}
+
+	cfg.Transport, err = transport.ConfigFromMap(m)
+	if err != nil {
+		return cfg, err
+	}
 
 	return cfg, nil
 }
@@ -130,6 +166,30 @@ func WithBaseURL(url string) Option {
 	}
 }
 
+// WithSeed sets the sampling seed for deterministic output.
+func WithSeed(seed int) Option {
+	return func(c *Config) {
+		c.Seed = seed
+	}
+}
+
+// WithUseResponsesAPI selects OpenAI's /responses endpoint instead of
+// /chat/completions.
+func WithUseResponsesAPI(use bool) Option {
+	return func(c *Config) {
+		c.UseResponsesAPI = use
+	}
+}
+
+// WithJSONSchema enables JSON schema structured output mode.
+func WithJSONSchema(name string, schema map[string]any, strict bool) Option {
+	return func(c *Config) {
+		c.JSONSchemaName = name
+		c.JSONSchema = schema
+		c.JSONSchemaStrict = strict
+	}
+}
+
 // String returns a string representation with API key masked.
 // This prevents accidental credential leakage in logs or error messages.
 func (c Config) String() string {
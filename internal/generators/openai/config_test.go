@@ -72,10 +72,39 @@ func TestOpenAIConfigFunctionalOptions(t *testing.T) {
 		WithAPIKey("sk-test"),
 		WithTemperature(0.3),
 		WithMaxTokens(4096),
+		WithSeed(42),
+		WithUseResponsesAPI(true),
+		WithJSONSchema("answer", map[string]any{"type": "object"}, true),
 	)
 
 	assert.Equal(t, "gpt-4", cfg.Model)
 	assert.Equal(t, "sk-test", cfg.APIKey)
 	assert.Equal(t, float32(0.3), cfg.Temperature)
 	assert.Equal(t, 4096, cfg.MaxTokens)
+	assert.Equal(t, 42, cfg.Seed)
+	assert.True(t, cfg.UseResponsesAPI)
+	assert.Equal(t, "answer", cfg.JSONSchemaName)
+	assert.Equal(t, map[string]any{"type": "object"}, cfg.JSONSchema)
+	assert.True(t, cfg.JSONSchemaStrict)
+}
+
+func TestOpenAIConfigFromMapResponsesAndSchema(t *testing.T) {
+	m := registry.Config{
+		"model":              "gpt-4",
+		"api_key":            "sk-test",
+		"seed":               7,
+		"use_responses_api":  true,
+		"json_schema_name":   "answer",
+		"json_schema_strict": true,
+		"json_schema":        map[string]any{"type": "object"},
+	}
+
+	cfg, err := ConfigFromMap(m)
+	require.NoError(t, err)
+
+	assert.Equal(t, 7, cfg.Seed)
+	assert.True(t, cfg.UseResponsesAPI)
+	assert.Equal(t, "answer", cfg.JSONSchemaName)
+	assert.True(t, cfg.JSONSchemaStrict)
+	assert.Equal(t, map[string]any{"type": "object"}, cfg.JSONSchema)
 }
@@ -8,11 +8,13 @@ package openai
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/praetorian-inc/augustus/internal/generators/openaicompat"
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
 	goopenai "github.com/sashabaranov/go-openai"
 )
 
@@ -20,6 +22,11 @@ func init() {
 	generators.Register("openai.OpenAI", NewOpenAI)
 }
 
+// defaultBaseURL is OpenAI's default API base, used for the Responses API
+// HTTP calls in responses.go (the chat/completion SDK client has its own
+// identical default, set by goopenai.DefaultConfig).
+const defaultBaseURL = "https://api.openai.com/v1"
+
 // chatModels references the shared set of models that use the chat completions API.
 var chatModels = openaicompat.ChatModels
 
@@ -39,6 +46,20 @@ type OpenAI struct {
 	frequencyPenalty float32
 	presencePenalty  float32
 	stop             []string
+	seed             int
+
+	// JSON schema structured output mode (chat completions and Responses API).
+	jsonSchemaName   string
+	jsonSchema       map[string]any
+	jsonSchemaStrict bool
+
+	// useResponsesAPI, plus apiKey/baseURL/httpClient below, support the
+	// /responses endpoint, which the underlying SDK doesn't implement and so
+	// is called directly over HTTP (see responses.go).
+	useResponsesAPI bool
+	apiKey          string
+	baseURL         string
+	httpClient      *http.Client
 }
 
 // NewOpenAI creates a new OpenAI generator from legacy registry.Config.
@@ -48,6 +69,7 @@ func NewOpenAI(m registry.Config) (generators.Generator, error) {
 	if err != nil {
 		return nil, err
 	}
+	cfg.Transport.GeneratorName = "openai.OpenAI"
 	return NewOpenAITyped(cfg)
 }
 
@@ -62,6 +84,16 @@ func NewOpenAITyped(cfg Config) (*OpenAI, error) {
 		frequencyPenalty: cfg.FrequencyPenalty,
 		presencePenalty:  cfg.PresencePenalty,
 		stop:             cfg.Stop,
+		seed:             cfg.Seed,
+		jsonSchemaName:   cfg.JSONSchemaName,
+		jsonSchema:       cfg.JSONSchema,
+		jsonSchemaStrict: cfg.JSONSchemaStrict,
+		useResponsesAPI:  cfg.UseResponsesAPI,
+		apiKey:           cfg.APIKey,
+		baseURL:          cfg.BaseURL,
+	}
+	if g.baseURL == "" {
+		g.baseURL = defaultBaseURL
 	}
 
 	// Validate required fields
@@ -83,6 +115,14 @@ func NewOpenAITyped(cfg Config) (*OpenAI, error) {
 	if cfg.BaseURL != "" {
 		clientCfg.BaseURL = cfg.BaseURL
 	}
+
+	httpTransport, err := transport.New(cfg.Transport)
+	if err != nil {
+		return nil, err
+	}
+	g.httpClient = &http.Client{Transport: httpTransport}
+	clientCfg.HTTPClient = g.httpClient
+
 	g.client = goopenai.NewClientWithConfig(clientCfg)
 
 	return g, nil
@@ -92,11 +132,12 @@ func NewOpenAITyped(cfg Config) (*OpenAI, error) {
 // This is the recommended entry point for Go code.
 //
 // Usage:
-//   g, err := NewOpenAIWithOptions(
-//       WithModel("gpt-4"),
-//       WithAPIKey("sk-..."),
-//       WithTemperature(0.5),
-//   )
+//
+//	g, err := NewOpenAIWithOptions(
+//	    WithModel("gpt-4"),
+//	    WithAPIKey("sk-..."),
+//	    WithTemperature(0.5),
+//	)
 func NewOpenAIWithOptions(opts ...Option) (*OpenAI, error) {
 	cfg := ApplyOptions(DefaultConfig(), opts...)
 	return NewOpenAITyped(cfg)
@@ -108,6 +149,9 @@ func (g *OpenAI) Generate(ctx context.Context, conv *attempt.Conversation, n int
 		return []attempt.Message{}, nil
 	}
 
+	if g.useResponsesAPI {
+		return g.generateResponses(ctx, conv, n)
+	}
 	if g.isChat {
 		return g.generateChat(ctx, conv, n)
 	}
@@ -144,6 +188,19 @@ func (g *OpenAI) generateChat(ctx context.Context, conv *attempt.Conversation, n
 	if len(g.stop) > 0 {
 		req.Stop = g.stop
 	}
+	if g.seed != 0 {
+		req.Seed = &g.seed
+	}
+	if g.jsonSchemaName != "" {
+		req.ResponseFormat = &goopenai.ChatCompletionResponseFormat{
+			Type: goopenai.ChatCompletionResponseFormatTypeJSONSchema,
+			JSONSchema: &goopenai.ChatCompletionResponseFormatJSONSchema{
+				Name:   g.jsonSchemaName,
+				Schema: rawSchema(g.jsonSchema),
+				Strict: g.jsonSchemaStrict,
+			},
+		}
+	}
 
 	resp, err := g.client.CreateChatCompletion(ctx, req)
 	if err != nil {
@@ -855,6 +855,64 @@ func TestNewOpenAITyped(t *testing.T) {
 	assert.Equal(t, float32(0.3), g.temperature)
 }
 
+func TestOpenAIGenerator_Generate_Seed(t *testing.T) {
+	var receivedRequest map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		_ = json.NewEncoder(w).Encode(mockOpenAIResponse("Response", 1))
+	}))
+	defer server.Close()
+
+	g, err := NewOpenAI(registry.Config{
+		"model":    "gpt-4",
+		"api_key":  "test-key",
+		"base_url": server.URL,
+		"seed":     42,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	_, err = g.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(42), receivedRequest["seed"])
+}
+
+func TestOpenAIGenerator_Generate_JSONSchema(t *testing.T) {
+	var receivedRequest map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		_ = json.NewEncoder(w).Encode(mockOpenAIResponse("{}", 1))
+	}))
+	defer server.Close()
+
+	g, err := NewOpenAI(registry.Config{
+		"model":              "gpt-4",
+		"api_key":            "test-key",
+		"base_url":           server.URL,
+		"json_schema_name":   "answer",
+		"json_schema":        map[string]any{"type": "object"},
+		"json_schema_strict": true,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	_, err = g.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	respFormat, ok := receivedRequest["response_format"].(map[string]any)
+	require.True(t, ok, "should have response_format")
+	assert.Equal(t, "json_schema", respFormat["type"])
+	jsonSchema, ok := respFormat["json_schema"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "answer", jsonSchema["name"])
+	assert.Equal(t, true, jsonSchema["strict"])
+}
+
 func TestNewOpenAIWithOptions(t *testing.T) {
 	// Create mock server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -4,11 +4,13 @@ package openai
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/praetorian-inc/augustus/internal/generators/openaicompat"
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
 	goopenai "github.com/sashabaranov/go-openai"
 )
 
@@ -18,12 +20,12 @@ func init() {
 
 // reasoningModels is the set of models that use reasoning APIs (o1/o3 family).
 var reasoningModels = map[string]bool{
-	"o1-mini":              true,
-	"o1-mini-2024-09-12":   true,
-	"o1-preview":           true,
+	"o1-mini":               true,
+	"o1-mini-2024-09-12":    true,
+	"o1-preview":            true,
 	"o1-preview-2024-09-12": true,
-	"o3-mini":              true,
-	"o3-mini-2025-01-31":   true,
+	"o3-mini":               true,
+	"o3-mini-2025-01-31":    true,
 }
 
 // OpenAIReasoning is a generator for OpenAI reasoning models (o1/o3 family).
@@ -37,10 +39,10 @@ type OpenAIReasoning struct {
 
 	// Configuration parameters
 	maxCompletionTokens int
-	topP                 float32
-	frequencyPenalty     float32
-	presencePenalty      float32
-	stop                 []string
+	topP                float32
+	frequencyPenalty    float32
+	presencePenalty     float32
+	stop                []string
 }
 
 // NewOpenAIReasoning creates a new OpenAI Reasoning generator from legacy registry.Config.
@@ -50,6 +52,7 @@ func NewOpenAIReasoning(m registry.Config) (generators.Generator, error) {
 	if err != nil {
 		return nil, err
 	}
+	cfg.Transport.GeneratorName = "openai.OpenAIReasoning"
 	return NewOpenAIReasoningTyped(cfg)
 }
 
@@ -62,14 +65,20 @@ func NewOpenAIReasoningTyped(cfg ReasoningConfig) (*OpenAIReasoning, error) {
 		clientCfg.BaseURL = cfg.BaseURL
 	}
 
+	httpTransport, err := transport.New(cfg.Transport)
+	if err != nil {
+		return nil, err
+	}
+	clientCfg.HTTPClient = &http.Client{Transport: httpTransport}
+
 	return &OpenAIReasoning{
-		client:               goopenai.NewClientWithConfig(clientCfg),
-		model:                cfg.Model,
-		maxCompletionTokens:  cfg.MaxCompletionTokens,
-		topP:                 cfg.TopP,
-		frequencyPenalty:     cfg.FrequencyPenalty,
-		presencePenalty:      cfg.PresencePenalty,
-		stop:                 cfg.Stop,
+		client:              goopenai.NewClientWithConfig(clientCfg),
+		model:               cfg.Model,
+		maxCompletionTokens: cfg.MaxCompletionTokens,
+		topP:                cfg.TopP,
+		frequencyPenalty:    cfg.FrequencyPenalty,
+		presencePenalty:     cfg.PresencePenalty,
+		stop:                cfg.Stop,
 	}, nil
 }
 
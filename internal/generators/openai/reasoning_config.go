@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
 )
 
 // ReasoningConfig holds typed configuration for the OpenAI Reasoning generator.
@@ -15,21 +16,25 @@ type ReasoningConfig struct {
 
 	// Optional with defaults
 	MaxCompletionTokens int // Used instead of max_tokens for reasoning models
-	TopP                 float32
-	FrequencyPenalty     float32
-	PresencePenalty      float32
-	Stop                 []string
-	BaseURL              string
+	TopP                float32
+	FrequencyPenalty    float32
+	PresencePenalty     float32
+	Stop                []string
+	BaseURL             string
+
+	// Transport holds shared TLS/proxy settings (mTLS client cert, custom
+	// CA bundle, SOCKS5/HTTP proxy, insecure_skip_verify).
+	Transport transport.Config
 }
 
 // DefaultReasoningConfig returns a ReasoningConfig with defaults.
 func DefaultReasoningConfig() ReasoningConfig {
 	return ReasoningConfig{
 		MaxCompletionTokens: 1500,
-		TopP:                 1.0,
-		FrequencyPenalty:     0.0,
-		PresencePenalty:      0.0,
-		Stop:                 []string{"#", ";"},
+		TopP:                1.0,
+		FrequencyPenalty:    0.0,
+		PresencePenalty:     0.0,
+		Stop:                []string{"#", ";"},
 	}
 }
 
@@ -58,6 +63,11 @@ func ReasoningConfigFromMap(m registry.Config) (ReasoningConfig, error) {
 	cfg.PresencePenalty = registry.GetFloat32(m, "presence_penalty", cfg.PresencePenalty)
 	cfg.Stop = registry.GetStringSlice(m, "stop", cfg.Stop)
 
+	cfg.Transport, err = transport.ConfigFromMap(m)
+	if err != nil {
+		return cfg, err
+	}
+
 	return cfg, nil
 }
 
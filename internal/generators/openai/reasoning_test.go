@@ -18,13 +18,13 @@ func TestNewOpenAIReasoning(t *testing.T) {
 	}
 
 	cfg := ReasoningConfig{
-		Model:                 "o1-mini",
-		APIKey:                os.Getenv("OPENAI_API_KEY"),
-		MaxCompletionTokens:   1500,
-		TopP:                  1.0,
-		FrequencyPenalty:      0.0,
-		PresencePenalty:       0.0,
-		Stop:                  []string{"#", ";"},
+		Model:               "o1-mini",
+		APIKey:              os.Getenv("OPENAI_API_KEY"),
+		MaxCompletionTokens: 1500,
+		TopP:                1.0,
+		FrequencyPenalty:    0.0,
+		PresencePenalty:     0.0,
+		Stop:                []string{"#", ";"},
 	}
 
 	gen, err := NewOpenAIReasoningTyped(cfg)
@@ -39,7 +39,7 @@ func TestNewOpenAIReasoningFromConfig(t *testing.T) {
 	}
 
 	cfgMap := registry.Config{
-		"model":  "o1-mini",
+		"model":   "o1-mini",
 		"api_key": os.Getenv("OPENAI_API_KEY"),
 	}
 
@@ -54,9 +54,9 @@ func TestOpenAIReasoning_Generate(t *testing.T) {
 	}
 
 	cfg := ReasoningConfig{
-		Model:                 "o1-mini",
-		APIKey:                os.Getenv("OPENAI_API_KEY"),
-		MaxCompletionTokens:   100,
+		Model:               "o1-mini",
+		APIKey:              os.Getenv("OPENAI_API_KEY"),
+		MaxCompletionTokens: 100,
 	}
 
 	gen, err := NewOpenAIReasoningTyped(cfg)
@@ -131,11 +131,11 @@ func TestReasoningConfigFromMap_Defaults(t *testing.T) {
 
 func TestReasoningConfigFromMap_CustomValues(t *testing.T) {
 	cfgMap := registry.Config{
-		"model":                  "o1-preview",
-		"api_key":                "test-key",
-		"max_completion_tokens":  2000,
-		"top_p":                  0.9,
-		"stop":                   []any{"STOP"},
+		"model":                 "o1-preview",
+		"api_key":               "test-key",
+		"max_completion_tokens": 2000,
+		"top_p":                 0.9,
+		"stop":                  []any{"STOP"},
 	}
 
 	cfg, err := ReasoningConfigFromMap(cfgMap)
@@ -0,0 +1,217 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// rawSchema adapts a map[string]any JSON schema (the repo's established
+// passthrough convention, as used by anthropic.ToolDefinition.InputSchema)
+// to the json.Marshaler interface required by
+// goopenai.ChatCompletionResponseFormatJSONSchema.Schema.
+type rawSchema map[string]any
+
+// MarshalJSON implements json.Marshaler.
+func (s rawSchema) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]any(s))
+}
+
+// responsesRequest represents an OpenAI Responses API request.
+type responsesRequest struct {
+	Model       string            `json:"model"`
+	Input       []responsesMsg    `json:"input"`
+	Temperature float32           `json:"temperature,omitempty"`
+	MaxTokens   int               `json:"max_output_tokens,omitempty"`
+	TopP        float32           `json:"top_p,omitempty"`
+	Seed        *int              `json:"seed,omitempty"`
+	Text        *responsesTextFmt `json:"text,omitempty"`
+}
+
+// responsesMsg represents one input message in the Responses API format.
+type responsesMsg struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// responsesTextFmt configures structured output for the Responses API,
+// mirroring chat completions' ResponseFormat/JSONSchema shape.
+type responsesTextFmt struct {
+	Format responsesFormat `json:"format"`
+}
+
+type responsesFormat struct {
+	Type   string    `json:"type"`
+	Name   string    `json:"name"`
+	Schema rawSchema `json:"schema,omitempty"`
+	Strict bool      `json:"strict,omitempty"`
+}
+
+// responsesResponse represents an OpenAI Responses API response.
+type responsesResponse struct {
+	Output []responsesOutputItem `json:"output"`
+}
+
+// responsesOutputItem represents one item of the Responses API's output
+// array. Only "message" items with "output_text" content carry assistant
+// text; other item types (e.g. reasoning) are ignored.
+type responsesOutputItem struct {
+	Type    string                `json:"type"`
+	Content []responsesOutputPart `json:"content"`
+}
+
+type responsesOutputPart struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// responsesErrorResponse represents a Responses API error.
+type responsesErrorResponse struct {
+	Error struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// conversationToResponsesInput converts an Augustus Conversation to the
+// Responses API's input message format.
+func conversationToResponsesInput(conv *attempt.Conversation) []responsesMsg {
+	input := make([]responsesMsg, 0)
+
+	if conv.System != nil {
+		input = append(input, responsesMsg{Role: "system", Content: conv.System.Content})
+	}
+
+	for _, turn := range conv.Turns {
+		input = append(input, responsesMsg{Role: "user", Content: turn.Prompt.Content})
+		if turn.Response != nil {
+			input = append(input, responsesMsg{Role: "assistant", Content: turn.Response.Content})
+		}
+	}
+
+	return input
+}
+
+// generateResponses handles requests against OpenAI's newer /responses
+// endpoint. The vendored go-openai SDK doesn't implement this endpoint, so
+// requests are made directly over HTTP, following the same raw-HTTP
+// pattern the anthropic generator uses for its Messages API. The Responses
+// API has no native n parameter, so, like the anthropic generator, multiple
+// generations require multiple calls.
+func (g *OpenAI) generateResponses(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	responses := make([]attempt.Message, 0, n)
+
+	for i := 0; i < n; i++ {
+		resp, err := g.generateOneResponse(ctx, conv)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, resp)
+	}
+
+	return responses, nil
+}
+
+// generateOneResponse performs a single Responses API call and returns one
+// response.
+func (g *OpenAI) generateOneResponse(ctx context.Context, conv *attempt.Conversation) (attempt.Message, error) {
+	req := responsesRequest{
+		Model: g.model,
+		Input: conversationToResponsesInput(conv),
+	}
+
+	if g.temperature != 0 {
+		req.Temperature = g.temperature
+	}
+	if g.maxTokens > 0 {
+		req.MaxTokens = g.maxTokens
+	}
+	if g.topP != 0 {
+		req.TopP = g.topP
+	}
+	if g.seed != 0 {
+		req.Seed = &g.seed
+	}
+	if g.jsonSchemaName != "" {
+		req.Text = &responsesTextFmt{Format: responsesFormat{
+			Type:   "json_schema",
+			Name:   g.jsonSchemaName,
+			Schema: rawSchema(g.jsonSchema),
+			Strict: g.jsonSchemaStrict,
+		}}
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("openai: failed to marshal responses request: %w", err)
+	}
+
+	url := strings.TrimSuffix(g.baseURL, "/") + "/responses"
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("openai: failed to create responses request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+g.apiKey)
+
+	httpResp, err := g.httpClient.Do(httpReq)
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("openai: responses request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("openai: failed to read responses body: %w", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return attempt.Message{}, handleResponsesError(httpResp.StatusCode, respBody)
+	}
+
+	var resp responsesResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return attempt.Message{}, fmt.Errorf("openai: failed to parse responses body: %w", err)
+	}
+
+	var text string
+	for _, item := range resp.Output {
+		if item.Type != "message" {
+			continue
+		}
+		for _, part := range item.Content {
+			if part.Type == "output_text" {
+				text += part.Text
+			}
+		}
+	}
+
+	return attempt.NewAssistantMessage(text), nil
+}
+
+// handleResponsesError processes Responses API error responses.
+func handleResponsesError(statusCode int, body []byte) error {
+	var errResp responsesErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		return fmt.Errorf("openai: responses HTTP %d: %s", statusCode, string(body))
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("openai: rate limit exceeded: %s", errResp.Error.Message)
+	case http.StatusBadRequest:
+		return fmt.Errorf("openai: bad request (%s): %s", errResp.Error.Type, errResp.Error.Message)
+	case http.StatusUnauthorized:
+		return fmt.Errorf("openai: authentication error: %s", errResp.Error.Message)
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return fmt.Errorf("openai: server error (%d): %s", statusCode, errResp.Error.Message)
+	default:
+		return fmt.Errorf("openai: API error (%d, %s): %s", statusCode, errResp.Error.Type, errResp.Error.Message)
+	}
+}
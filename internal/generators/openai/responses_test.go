@@ -0,0 +1,153 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockResponsesAPIResponse creates a mock OpenAI Responses API response.
+func mockResponsesAPIResponse(text string) map[string]any {
+	return map[string]any{
+		"id":     "resp-test",
+		"object": "response",
+		"output": []map[string]any{
+			{
+				"type": "message",
+				"role": "assistant",
+				"content": []map[string]any{
+					{"type": "output_text", "text": text},
+				},
+			},
+		},
+	}
+}
+
+func TestOpenAIGenerator_Generate_ResponsesAPI(t *testing.T) {
+	var receivedRequest map[string]any
+	var requestPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(mockResponsesAPIResponse("Hello from Responses API"))
+	}))
+	defer server.Close()
+
+	g, err := NewOpenAI(registry.Config{
+		"model":             "gpt-4",
+		"api_key":           "test-key",
+		"base_url":          server.URL,
+		"use_responses_api": true,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.WithSystem("You are helpful.")
+	conv.AddPrompt("Hello!")
+
+	responses, err := g.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	assert.Contains(t, requestPath, "/responses")
+	require.Len(t, responses, 1)
+	assert.Equal(t, "Hello from Responses API", responses[0].Content)
+	assert.Equal(t, attempt.RoleAssistant, responses[0].Role)
+
+	input, ok := receivedRequest["input"].([]any)
+	require.True(t, ok)
+	require.GreaterOrEqual(t, len(input), 2)
+	firstMsg := input[0].(map[string]any)
+	assert.Equal(t, "system", firstMsg["role"])
+}
+
+func TestOpenAIGenerator_Generate_ResponsesAPIMultiple(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(mockResponsesAPIResponse("Response"))
+	}))
+	defer server.Close()
+
+	g, err := NewOpenAI(registry.Config{
+		"model":             "gpt-4",
+		"api_key":           "test-key",
+		"base_url":          server.URL,
+		"use_responses_api": true,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	responses, err := g.Generate(context.Background(), conv, 3)
+	require.NoError(t, err)
+	assert.Len(t, responses, 3)
+}
+
+func TestOpenAIGenerator_Generate_ResponsesAPISeedAndSchema(t *testing.T) {
+	var receivedRequest map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&receivedRequest)
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(mockResponsesAPIResponse("{}"))
+	}))
+	defer server.Close()
+
+	g, err := NewOpenAI(registry.Config{
+		"model":              "gpt-4",
+		"api_key":            "test-key",
+		"base_url":           server.URL,
+		"use_responses_api":  true,
+		"seed":               99,
+		"json_schema_name":   "answer",
+		"json_schema":        map[string]any{"type": "object"},
+		"json_schema_strict": true,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	_, err = g.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, float64(99), receivedRequest["seed"])
+	text, ok := receivedRequest["text"].(map[string]any)
+	require.True(t, ok, "should have text field for structured output")
+	format, ok := text["format"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "json_schema", format["type"])
+	assert.Equal(t, "answer", format["name"])
+}
+
+func TestOpenAIGenerator_Generate_ResponsesAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"error": map[string]any{"type": "invalid_request_error", "message": "bad key"},
+		})
+	}))
+	defer server.Close()
+
+	g, err := NewOpenAI(registry.Config{
+		"model":             "gpt-4",
+		"api_key":           "test-key",
+		"base_url":          server.URL,
+		"use_responses_api": true,
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("test")
+
+	_, err = g.Generate(context.Background(), conv, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "authentication error")
+}
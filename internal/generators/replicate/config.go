@@ -2,10 +2,17 @@ package replicate
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/registry"
 )
 
+// DefaultColdStartTimeout bounds how long Generate will wait for a
+// prediction to leave the "starting" status (i.e. for a cold-started
+// model to finish booting) before giving up, independent of the
+// request's own context deadline.
+const DefaultColdStartTimeout = 5 * time.Minute
+
 // Config holds typed configuration for the Replicate generator.
 type Config struct {
 	// Required
@@ -19,6 +26,7 @@ type Config struct {
 	MaxTokens         int
 	Seed              int
 	BaseURL           string
+	ColdStartTimeout  time.Duration
 }
 
 // DefaultConfig returns a Config with sensible defaults.
@@ -28,6 +36,7 @@ func DefaultConfig() Config {
 		TopP:              1.0,
 		RepetitionPenalty: 1.0,
 		Seed:              9, // Python default seed
+		ColdStartTimeout:  DefaultColdStartTimeout,
 	}
 }
 
@@ -56,6 +65,11 @@ func ConfigFromMap(m registry.Config) (Config, error) {
 	cfg.MaxTokens = registry.GetInt(m, "max_tokens", cfg.MaxTokens)
 	cfg.Seed = registry.GetInt(m, "seed", cfg.Seed)
 
+	// Optional: cold-start timeout (in seconds)
+	if coldStartSecs := registry.GetInt(m, "cold_start_timeout", 0); coldStartSecs > 0 {
+		cfg.ColdStartTimeout = time.Duration(coldStartSecs) * time.Second
+	}
+
 	return cfg, nil
 }
 
@@ -122,3 +136,12 @@ func WithBaseURL(url string) Option {
 		c.BaseURL = url
 	}
 }
+
+// WithColdStartTimeout sets how long Generate waits for a prediction to
+// leave the "starting" status before giving up, independent of the
+// request context's own deadline.
+func WithColdStartTimeout(timeout time.Duration) Option {
+	return func(c *Config) {
+		c.ColdStartTimeout = timeout
+	}
+}
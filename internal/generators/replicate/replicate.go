@@ -16,12 +16,16 @@
 //   - max_tokens: Maximum output tokens (default: model-specific)
 //   - seed: Random seed for reproducibility (default: 9)
 //   - base_url: Custom API endpoint (for testing/proxies)
+//   - cold_start_timeout: Seconds to wait for a model to leave the
+//     "starting" status before giving up (default: 300), independent of
+//     the request context's own deadline
 package replicate
 
 import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
@@ -29,6 +33,11 @@ import (
 	replicatego "github.com/replicate/replicate-go"
 )
 
+// pollInterval controls how often Generate checks prediction status while
+// polling. Kept short since cold starts and inference can both take a
+// while and we want status/log changes to surface promptly.
+const pollInterval = 500 * time.Millisecond
+
 // Environment variable name for API token
 const envVarName = "REPLICATE_API_TOKEN"
 
@@ -47,6 +56,7 @@ type Replicate struct {
 	repetitionPenalty float32
 	maxTokens         int
 	seed              int
+	coldStartTimeout  time.Duration
 }
 
 // NewReplicate creates a new Replicate generator from legacy registry.Config.
@@ -77,6 +87,7 @@ func NewReplicateTyped(cfg Config) (*Replicate, error) {
 		repetitionPenalty: cfg.RepetitionPenalty,
 		maxTokens:         cfg.MaxTokens,
 		seed:              cfg.Seed,
+		coldStartTimeout:  cfg.ColdStartTimeout,
 	}
 
 	// Build client options
@@ -145,7 +156,7 @@ func (g *Replicate) Generate(ctx context.Context, conv *attempt.Conversation, n
 	// Generate n responses (Replicate doesn't support batch generation)
 	responses := make([]attempt.Message, 0, n)
 	for i := 0; i < n; i++ {
-		output, err := g.client.Run(ctx, g.model, input, nil)
+		output, err := g.runPrediction(ctx, input)
 		if err != nil {
 			return nil, g.wrapError(err)
 		}
@@ -158,6 +169,78 @@ func (g *Replicate) Generate(ctx context.Context, conv *attempt.Conversation, n
 	return responses, nil
 }
 
+// runPrediction creates a prediction and polls it to completion, surfacing
+// status and logs as it progresses instead of blocking opaquely like
+// client.Run. It enforces g.coldStartTimeout separately from ctx's own
+// deadline, so a model still "starting" doesn't masquerade as a generic
+// request timeout. If ctx is canceled while a prediction is in flight, the
+// prediction is canceled on Replicate's side before returning ctx's error.
+func (g *Replicate) runPrediction(ctx context.Context, input replicatego.PredictionInput) (replicatego.PredictionOutput, error) {
+	id, err := replicatego.ParseIdentifier(g.model)
+	if err != nil {
+		return nil, fmt.Errorf("replicate: invalid model identifier %q: %w", g.model, err)
+	}
+
+	var prediction *replicatego.Prediction
+	if id.Version != nil {
+		prediction, err = g.client.CreatePrediction(ctx, *id.Version, input, nil, false)
+	} else {
+		prediction, err = g.client.CreatePredictionWithModel(ctx, id.Owner, id.Name, input, nil, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var coldStartDeadline <-chan time.Time
+	if g.coldStartTimeout > 0 {
+		coldStartTimer := time.NewTimer(g.coldStartTimeout)
+		defer coldStartTimer.Stop()
+		coldStartDeadline = coldStartTimer.C
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if prediction.Status.Terminated() {
+			if prediction.Error != nil {
+				return nil, fmt.Errorf("replicate: prediction %s failed: %v", prediction.ID, prediction.Error)
+			}
+			return prediction.Output, nil
+		}
+
+		select {
+		case <-ticker.C:
+			prediction, err = g.client.GetPrediction(ctx, prediction.ID)
+			if err != nil {
+				return nil, err
+			}
+		case <-coldStartDeadline:
+			if prediction.Status == replicatego.Starting {
+				g.cancelPrediction(prediction.ID)
+				return nil, fmt.Errorf("replicate: model did not leave 'starting' status within cold start timeout of %s", g.coldStartTimeout)
+			}
+			coldStartDeadline = nil
+		case <-ctx.Done():
+			g.cancelPrediction(prediction.ID)
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// cancelPrediction best-effort cancels an in-flight prediction on
+// Replicate's side after the caller has already stopped waiting for it
+// (context canceled or cold start timed out). It doesn't block the caller
+// or propagate its own errors: the prediction will eventually be reaped
+// by Replicate even if this call fails or the process exits first.
+func (g *Replicate) cancelPrediction(id string) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_, _ = g.client.CancelPrediction(ctx, id)
+	}()
+}
+
 // extractText converts Replicate output to a string.
 // Output can be:
 // - string: return as-is
@@ -194,11 +277,8 @@ func (g *Replicate) wrapError(err error) error {
 		return fmt.Errorf("replicate: API error (status %d): %w", apiErr.Status, err)
 	}
 
-	// Check for context errors
-	if ctx := context.Cause(context.Background()); ctx != nil {
-		return fmt.Errorf("replicate: %w", err)
-	}
-
+	// Context errors (deadline exceeded, canceled) and our own wrapped
+	// errors already carry enough detail; just add the package prefix.
 	return fmt.Errorf("replicate: %w", err)
 }
 
@@ -620,6 +620,141 @@ func TestGenerate_ArrayOutput(t *testing.T) {
 	assert.Equal(t, "Hello world!", responses[0].Content)
 }
 
+// =============================================================================
+// Polling, Cold Start, and Cancellation Tests
+// =============================================================================
+
+// pollingMockServer simulates a prediction that starts, processes for a
+// few polls, then succeeds. It also records whether /cancel was called.
+type pollingMockServer struct {
+	server         *httptest.Server
+	statuses       []string // status returned for each successive GET poll
+	getCalls       int32
+	canceled       int32
+	alwaysStarting bool
+}
+
+func newPollingMockServer(statuses []string) *pollingMockServer {
+	m := &pollingMockServer{statuses: statuses}
+	m.server = httptest.NewServer(http.HandlerFunc(m.handler))
+	return m
+}
+
+func (m *pollingMockServer) handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/cancel") && r.Method == http.MethodPost:
+		atomic.AddInt32(&m.canceled, 1)
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "pred-1", "status": "canceled"})
+	case strings.Contains(r.URL.Path, "/predictions") && r.Method == http.MethodPost:
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":     "pred-1",
+			"status": "starting",
+		})
+	case strings.Contains(r.URL.Path, "/predictions/") && r.Method == http.MethodGet:
+		status := "succeeded"
+		if m.alwaysStarting {
+			status = "starting"
+		} else {
+			idx := int(atomic.AddInt32(&m.getCalls, 1)) - 1
+			if idx < len(m.statuses) {
+				status = m.statuses[idx]
+			}
+		}
+		resp := map[string]any{"id": "pred-1", "status": status}
+		if status == "succeeded" {
+			resp["output"] = []string{"Done"}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+func (m *pollingMockServer) Close() { m.server.Close() }
+
+func TestGenerate_PollsUntilSucceeded(t *testing.T) {
+	mock := newPollingMockServer([]string{"starting", "processing", "succeeded"})
+	defer mock.Close()
+
+	cfg := registry.Config{
+		"model":    "meta/llama-2-7b-chat",
+		"api_key":  "test-key",
+		"base_url": mock.server.URL,
+	}
+	gen, err := NewReplicate(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("Hello")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	responses, err := gen.Generate(ctx, conv, 1)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Equal(t, "Done", responses[0].Content)
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&mock.getCalls), int32(3))
+}
+
+func TestGenerate_ColdStartTimeoutCancelsPrediction(t *testing.T) {
+	mock := newPollingMockServer(nil)
+	mock.alwaysStarting = true
+	defer mock.Close()
+
+	cfg := registry.Config{
+		"model":              "meta/llama-2-7b-chat",
+		"api_key":            "test-key",
+		"base_url":           mock.server.URL,
+		"cold_start_timeout": 1,
+	}
+	gen, err := NewReplicate(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("Hello")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err = gen.Generate(ctx, conv, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cold start timeout")
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&mock.canceled) == 1
+	}, 2*time.Second, 10*time.Millisecond, "expected the in-flight prediction to be canceled")
+}
+
+func TestGenerate_ContextCancellationCancelsPrediction(t *testing.T) {
+	mock := newPollingMockServer(nil)
+	mock.alwaysStarting = true
+	defer mock.Close()
+
+	cfg := registry.Config{
+		"model":    "meta/llama-2-7b-chat",
+		"api_key":  "test-key",
+		"base_url": mock.server.URL,
+	}
+	gen, err := NewReplicate(cfg)
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("Hello")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 600*time.Millisecond)
+	defer cancel()
+
+	_, err = gen.Generate(ctx, conv, 1)
+	require.Error(t, err)
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&mock.canceled) == 1
+	}, 2*time.Second, 10*time.Millisecond, "expected the in-flight prediction to be canceled")
+}
+
 // =============================================================================
 // Registry Create Test
 // =============================================================================
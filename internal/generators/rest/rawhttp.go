@@ -0,0 +1,389 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/hooks"
+	"github.com/praetorian-inc/augustus/pkg/ratelimit"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+func init() {
+	generators.Register("rest.RawHTTP", NewRawHTTP)
+}
+
+// Compile-time interface assertions.
+var (
+	_ generators.Generator      = (*RawHTTP)(nil)
+	_ hooks.RawResponseProvider = (*RawHTTP)(nil)
+)
+
+// rawHeader is one header line from a raw HTTP request template, kept in
+// source order so duplicate headers (e.g. multiple Cookie lines) round-trip.
+type rawHeader struct {
+	name  string
+	value string
+}
+
+// rawRequestTemplate is a parsed raw HTTP request - the request line, its
+// headers in order, and the raw body - such as a "Copy as request" export
+// from Burp Suite or a browser devtools panel.
+type rawRequestTemplate struct {
+	method  string
+	path    string
+	headers []rawHeader
+	body    string
+}
+
+// parseRawRequestTemplate parses a raw HTTP/1.1 request: a request line,
+// headers, a blank line, then the body. $INPUT/$KEY/hook-variable
+// placeholders in the path, headers, and body are substituted verbatim at
+// request time (see populateRaw) - no JSON escaping - since a template
+// captured straight from a proxy already has whatever quoting or encoding
+// (JSON, multipart, form) the target expects.
+func parseRawRequestTemplate(raw string) (*rawRequestTemplate, error) {
+	normalized := strings.ReplaceAll(raw, "\r\n", "\n")
+
+	headerBlock, body, _ := strings.Cut(normalized, "\n\n")
+
+	lines := strings.Split(headerBlock, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) == "" {
+		return nil, fmt.Errorf("rest.RawHTTP: template is missing a request line")
+	}
+
+	requestLine := strings.Fields(lines[0])
+	if len(requestLine) < 2 {
+		return nil, fmt.Errorf("rest.RawHTTP: invalid request line %q", lines[0])
+	}
+
+	tmpl := &rawRequestTemplate{
+		method: strings.ToUpper(requestLine[0]),
+		path:   requestLine[1],
+		body:   body,
+	}
+
+	for _, line := range lines[1:] {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("rest.RawHTTP: invalid header line %q", line)
+		}
+		tmpl.headers = append(tmpl.headers, rawHeader{name: strings.TrimSpace(name), value: strings.TrimSpace(value)})
+	}
+
+	return tmpl, nil
+}
+
+// RawHTTP is a generator that replays a raw HTTP request template - headers,
+// cookies, and body (including multipart) preserved verbatim - against a
+// target. Unlike Rest's req_template/headers config keys, which can only
+// express a single JSON body and a flat header map, RawHTTP accepts the
+// exact request a pentester captured from a proxy, making it possible to
+// target authenticated, multipart, or otherwise non-trivial application
+// requests that rest.Rest can't express.
+type RawHTTP struct {
+	target             *url.URL
+	template           *rawRequestTemplate
+	requestTimeout     time.Duration
+	rateLimitCodes     map[int]bool
+	skipCodes          map[int]bool
+	apiKey             string
+	responseJSON       bool
+	responseJSONField  string
+	proxyURL           *url.URL
+	insecureSkipVerify bool
+	client             *http.Client
+	limiter            *ratelimit.Limiter
+
+	mu          sync.Mutex
+	lastRawResp []byte
+}
+
+// NewRawHTTP creates a new RawHTTP generator from configuration. Required:
+// "uri" (scheme://host[:port] the request is actually sent to) and either
+// "template" (the raw request as a string) or "template_file" (a path to a
+// file containing it, e.g. a Burp export). Shares req timeout, rate limit
+// codes, skip codes, api_key, proxy, insecure_skip_verify, response_json,
+// and response_json_field semantics with rest.Rest.
+func NewRawHTTP(cfg registry.Config) (generators.Generator, error) {
+	r := &RawHTTP{
+		requestTimeout: 20 * time.Second,
+		rateLimitCodes: map[int]bool{429: true},
+		skipCodes:      make(map[int]bool),
+	}
+
+	uri, ok := cfg["uri"].(string)
+	if !ok || uri == "" {
+		return nil, fmt.Errorf("rest.RawHTTP generator requires 'uri' configuration")
+	}
+	target, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("rest.RawHTTP: invalid uri: %w", err)
+	}
+	r.target = target
+
+	raw, err := rawTemplateFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := parseRawRequestTemplate(raw)
+	if err != nil {
+		return nil, err
+	}
+	r.template = tmpl
+
+	if responseJSON, ok := cfg["response_json"].(bool); ok {
+		r.responseJSON = responseJSON
+	}
+	if responseJSONField, ok := cfg["response_json_field"].(string); ok {
+		r.responseJSONField = responseJSONField
+	}
+	if r.responseJSON && r.responseJSONField == "" {
+		return nil, fmt.Errorf("rest.RawHTTP generator: response_json is true but response_json_field is not set")
+	}
+
+	if timeout, ok := cfg["request_timeout"].(float64); ok {
+		r.requestTimeout = time.Duration(timeout * float64(time.Second))
+	} else if timeout, ok := cfg["request_timeout"].(int); ok {
+		r.requestTimeout = time.Duration(timeout) * time.Second
+	}
+
+	if codes, ok := cfg["ratelimit_codes"].([]any); ok {
+		r.rateLimitCodes = make(map[int]bool)
+		for _, c := range codes {
+			if code, ok := c.(int); ok {
+				r.rateLimitCodes[code] = true
+			} else if code, ok := c.(float64); ok {
+				r.rateLimitCodes[int(code)] = true
+			}
+		}
+	}
+
+	if codes, ok := cfg["skip_codes"].([]any); ok {
+		for _, c := range codes {
+			if code, ok := c.(int); ok {
+				r.skipCodes[code] = true
+			} else if code, ok := c.(float64); ok {
+				r.skipCodes[int(code)] = true
+			}
+		}
+	}
+
+	if apiKey, ok := cfg["api_key"].(string); ok {
+		r.apiKey = apiKey
+	}
+
+	// Proxy (incl. SOCKS5), insecure_skip_verify, mTLS client certificate,
+	// and custom CA bundle - shared with rest.Rest via pkg/transport.
+	transportCfg, err := transport.ConfigFromMap(cfg)
+	if err != nil {
+		return nil, err
+	}
+	transportCfg.GeneratorName = "rest.RawHTTP"
+	r.proxyURL = transportCfg.ProxyURL
+	r.insecureSkipVerify = transportCfg.InsecureSkipVerify
+
+	if rateLimit, ok := cfg["rate_limit"].(float64); ok && rateLimit > 0 {
+		capacity := rateLimit
+		if capacity < 1.0 {
+			capacity = 1.0
+		}
+		r.limiter = ratelimit.NewLimiter(capacity, rateLimit)
+	} else if rateLimit, ok := cfg["rate_limit"].(int); ok && rateLimit > 0 {
+		r.limiter = ratelimit.NewLimiter(float64(rateLimit), float64(rateLimit))
+	}
+
+	httpTransport, err := transport.New(transportCfg)
+	if err != nil {
+		return nil, err
+	}
+	r.client = &http.Client{
+		Transport: httpTransport,
+		Timeout:   r.requestTimeout,
+	}
+
+	return r, nil
+}
+
+// rawTemplateFromConfig reads the raw request template from either the
+// "template" string or the "template_file" path config key.
+func rawTemplateFromConfig(cfg registry.Config) (string, error) {
+	if tmpl, ok := cfg["template"].(string); ok && tmpl != "" {
+		return tmpl, nil
+	}
+	if path, ok := cfg["template_file"].(string); ok && path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("rest.RawHTTP: failed to read template_file %q: %w", path, err)
+		}
+		return string(data), nil
+	}
+	return "", fmt.Errorf("rest.RawHTTP generator requires 'template' or 'template_file' configuration")
+}
+
+// Generate sends the conversation's last prompt through the raw request
+// template and returns responses.
+func (r *RawHTTP) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	if n <= 0 {
+		n = 1
+	}
+
+	responses := make([]attempt.Message, 0, n)
+	for i := 0; i < n; i++ {
+		msg, err := r.callAPI(ctx, conv)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, msg)
+	}
+
+	return responses, nil
+}
+
+// callAPI renders the raw request template against the conversation's
+// last prompt and sends a single request.
+func (r *RawHTTP) callAPI(ctx context.Context, conv *attempt.Conversation) (attempt.Message, error) {
+	if r.limiter != nil {
+		if err := r.limiter.Wait(ctx); err != nil {
+			return attempt.Message{}, fmt.Errorf("rest.RawHTTP: rate limit wait cancelled: %w", err)
+		}
+	}
+
+	prompt := conv.LastPrompt()
+	hookVars := types.HookVarsFromContext(ctx)
+
+	body := r.populateRaw(r.template.body, prompt, hookVars)
+	path := r.populateRaw(r.template.path, prompt, hookVars)
+
+	target := *r.target
+	target.Path, target.RawQuery, _ = strings.Cut(path, "?")
+
+	req, err := http.NewRequestWithContext(ctx, r.template.method, target.String(), strings.NewReader(body))
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("rest.RawHTTP: failed to create request: %w", err)
+	}
+
+	for _, h := range r.template.headers {
+		value := r.populateRaw(h.value, prompt, hookVars)
+		switch {
+		case strings.EqualFold(h.name, "Host"):
+			req.Host = value
+		case strings.EqualFold(h.name, "Content-Length"):
+			// Recomputed below from the substituted body; a stale value from
+			// the captured template would corrupt the request.
+		default:
+			req.Header.Add(h.name, value)
+		}
+	}
+	req.ContentLength = int64(len(body))
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("rest.RawHTTP: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if r.skipCodes[resp.StatusCode] {
+		return attempt.NewAssistantMessage(""), nil
+	}
+	if r.rateLimitCodes[resp.StatusCode] {
+		return attempt.Message{}, fmt.Errorf("rest.RawHTTP: rate limited: %d %s", resp.StatusCode, resp.Status)
+	}
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		return attempt.Message{}, fmt.Errorf("rest.RawHTTP: client error: %d %s", resp.StatusCode, resp.Status)
+	}
+	if resp.StatusCode >= 500 {
+		return attempt.Message{}, fmt.Errorf("rest.RawHTTP: server error: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	const maxResponseSize = 10 * 1024 * 1024
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return attempt.Message{}, fmt.Errorf("rest.RawHTTP: failed to read response: %w", err)
+	}
+
+	r.mu.Lock()
+	r.lastRawResp = respBody
+	r.mu.Unlock()
+
+	if !r.responseJSON {
+		return attempt.NewAssistantMessage(string(respBody)), nil
+	}
+
+	var data any
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return attempt.Message{}, fmt.Errorf("rest.RawHTTP: failed to parse JSON response: %w", err)
+	}
+	content, err := extractField(data, r.responseJSONField)
+	if err != nil {
+		return attempt.Message{}, err
+	}
+
+	return attempt.NewAssistantMessage(content), nil
+}
+
+// populateRaw substitutes $KEY, $INPUT, and hook-variable placeholders into
+// a raw template fragment verbatim - no JSON escaping, unlike rest.Rest's
+// populateTemplate - since the fragment already has whatever quoting the
+// captured request expects.
+func (r *RawHTTP) populateRaw(template, input string, hookVars map[string]string) string {
+	result := template
+
+	if strings.Contains(result, "$KEY") && r.apiKey != "" {
+		result = strings.ReplaceAll(result, "$KEY", r.apiKey)
+	}
+	if strings.Contains(result, "$INPUT") {
+		result = strings.ReplaceAll(result, "$INPUT", input)
+	}
+
+	keys := make([]string, 0, len(hookVars))
+	for k := range hookVars {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) > len(keys[j]) })
+	for _, k := range keys {
+		placeholder := "$" + k
+		if strings.Contains(result, placeholder) {
+			result = strings.ReplaceAll(result, placeholder, hookVars[k])
+		}
+	}
+
+	return result
+}
+
+// ClearHistory is a no-op for RawHTTP (stateless).
+func (r *RawHTTP) ClearHistory() {}
+
+// LastRawResponse returns the raw HTTP response body from the most recent
+// API call, implementing hooks.RawResponseProvider.
+func (r *RawHTTP) LastRawResponse() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastRawResp
+}
+
+// Name returns the generator's fully qualified name.
+func (r *RawHTTP) Name() string {
+	return "rest.RawHTTP"
+}
+
+// Description returns a human-readable description.
+func (r *RawHTTP) Description() string {
+	return "Replays a raw HTTP request template (Burp-style export) with $INPUT/$KEY placeholders, preserving arbitrary headers, cookies, and multipart bodies"
+}
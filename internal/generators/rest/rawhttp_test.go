@@ -0,0 +1,203 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func TestParseRawRequestTemplate_MethodPathHeadersBody(t *testing.T) {
+	raw := "POST /api/chat HTTP/1.1\n" +
+		"Host: example.com\n" +
+		"Content-Type: application/json\n" +
+		"Cookie: a=1\n" +
+		"Cookie: b=2\n" +
+		"\n" +
+		`{"message": "$INPUT"}`
+
+	tmpl, err := parseRawRequestTemplate(raw)
+	if err != nil {
+		t.Fatalf("parseRawRequestTemplate() error = %v", err)
+	}
+
+	if tmpl.method != "POST" {
+		t.Errorf("method = %q, want POST", tmpl.method)
+	}
+	if tmpl.path != "/api/chat" {
+		t.Errorf("path = %q, want /api/chat", tmpl.path)
+	}
+	if tmpl.body != `{"message": "$INPUT"}` {
+		t.Errorf("body = %q", tmpl.body)
+	}
+
+	var cookies []string
+	for _, h := range tmpl.headers {
+		if h.name == "Cookie" {
+			cookies = append(cookies, h.value)
+		}
+	}
+	if len(cookies) != 2 || cookies[0] != "a=1" || cookies[1] != "b=2" {
+		t.Errorf("duplicate Cookie headers not preserved, got %v", cookies)
+	}
+}
+
+func TestParseRawRequestTemplate_InvalidRequestLine(t *testing.T) {
+	_, err := parseRawRequestTemplate("GET\n\nbody")
+	if err == nil {
+		t.Error("parseRawRequestTemplate() with invalid request line should return error")
+	}
+}
+
+func TestParseRawRequestTemplate_InvalidHeaderLine(t *testing.T) {
+	_, err := parseRawRequestTemplate("GET / HTTP/1.1\nno-colon-here\n\n")
+	if err == nil {
+		t.Error("parseRawRequestTemplate() with invalid header line should return error")
+	}
+}
+
+func TestNewRawHTTP_RequiresURI(t *testing.T) {
+	_, err := NewRawHTTP(registry.Config{
+		"template": "GET / HTTP/1.1\nHost: example.com\n\n",
+	})
+	if err == nil {
+		t.Error("NewRawHTTP() with no uri should return error")
+	}
+}
+
+func TestNewRawHTTP_RequiresTemplate(t *testing.T) {
+	_, err := NewRawHTTP(registry.Config{
+		"uri": "http://example.com",
+	})
+	if err == nil {
+		t.Error("NewRawHTTP() with no template should return error")
+	}
+}
+
+func TestRawHTTP_Generate_SubstitutesInputAndPreservesHeaders(t *testing.T) {
+	var gotPath, gotCookie, gotBody, gotHost string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotCookie = r.Header.Get("Cookie")
+		gotHost = r.Host
+		body := make([]byte, r.ContentLength)
+		_, _ = r.Body.Read(body)
+		gotBody = string(body)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	raw := "POST /chat HTTP/1.1\n" +
+		"Host: original-host.example\n" +
+		"Content-Type: application/json\n" +
+		"Cookie: session=abc\n" +
+		"\n" +
+		`{"message": "$INPUT"}`
+
+	g, err := NewRawHTTP(registry.Config{
+		"uri":      server.URL,
+		"template": raw,
+	})
+	if err != nil {
+		t.Fatalf("NewRawHTTP() error = %v", err)
+	}
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt(`say "hi"`)
+
+	responses, err := g.Generate(context.Background(), conv, 1)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(responses) != 1 || responses[0].Content != "ok" {
+		t.Fatalf("Generate() = %+v, want [ok]", responses)
+	}
+
+	if gotPath != "/chat" {
+		t.Errorf("path = %q, want /chat", gotPath)
+	}
+	if gotCookie != "session=abc" {
+		t.Errorf("cookie = %q, want session=abc", gotCookie)
+	}
+	if gotHost != "original-host.example" {
+		t.Errorf("host = %q, want original-host.example", gotHost)
+	}
+	want := `{"message": "say "hi""}`
+	if gotBody != want {
+		t.Errorf("body = %q, want %q (no JSON escaping)", gotBody, want)
+	}
+}
+
+func TestRawHTTP_Generate_ResponseJSONField(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"reply": "hello there"}`))
+	}))
+	defer server.Close()
+
+	g, err := NewRawHTTP(registry.Config{
+		"uri":                 server.URL,
+		"template":            "POST / HTTP/1.1\nHost: example.com\n\n$INPUT",
+		"response_json":       true,
+		"response_json_field": "reply",
+	})
+	if err != nil {
+		t.Fatalf("NewRawHTTP() error = %v", err)
+	}
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	responses, err := g.Generate(context.Background(), conv, 1)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(responses) != 1 || responses[0].Content != "hello there" {
+		t.Fatalf("Generate() = %+v, want [hello there]", responses)
+	}
+}
+
+func TestRawHTTP_NameAndDescription(t *testing.T) {
+	g, err := NewRawHTTP(registry.Config{
+		"uri":      "http://example.com",
+		"template": "GET / HTTP/1.1\nHost: example.com\n\n",
+	})
+	if err != nil {
+		t.Fatalf("NewRawHTTP() error = %v", err)
+	}
+
+	if got := g.Name(); got != "rest.RawHTTP" {
+		t.Errorf("Name() = %q, want rest.RawHTTP", got)
+	}
+	if g.Description() == "" {
+		t.Error("Description() returned empty string")
+	}
+}
+
+func TestRawHTTP_LastRawResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("raw body"))
+	}))
+	defer server.Close()
+
+	g, err := NewRawHTTP(registry.Config{
+		"uri":      server.URL,
+		"template": "GET / HTTP/1.1\nHost: example.com\n\n",
+	})
+	if err != nil {
+		t.Fatalf("NewRawHTTP() error = %v", err)
+	}
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+	if _, err := g.Generate(context.Background(), conv, 1); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	rh := g.(*RawHTTP)
+	if string(rh.LastRawResponse()) != "raw body" {
+		t.Errorf("LastRawResponse() = %q, want %q", rh.LastRawResponse(), "raw body")
+	}
+}
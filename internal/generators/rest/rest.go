@@ -8,14 +8,11 @@ package rest
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
 	"net/url"
-	"os"
 	"sort"
 	"strings"
 	"sync"
@@ -24,46 +21,17 @@ import (
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/hooks"
+	"github.com/praetorian-inc/augustus/pkg/jsonpath"
 	"github.com/praetorian-inc/augustus/pkg/ratelimit"
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
 	"github.com/praetorian-inc/augustus/pkg/types"
-	"golang.org/x/net/http2"
 )
 
 func init() {
 	generators.Register("rest.Rest", NewRest)
 }
 
-// defaultTransport returns an http.Transport configured for connection pooling.
-// This prevents connection exhaustion under high-concurrency scanning.
-// If proxyURL is provided, configures the transport to use the proxy.
-// If insecureSkipVerify is true, disables TLS certificate verification.
-func defaultTransport(proxyURL *url.URL, insecureSkipVerify bool) *http.Transport {
-	transport := &http.Transport{
-		MaxIdleConns:        100,
-		MaxIdleConnsPerHost: 100,
-		MaxConnsPerHost:     100,
-		IdleConnTimeout:     90 * time.Second,
-		DisableKeepAlives:   false,
-		TLSClientConfig: &tls.Config{
-			InsecureSkipVerify: false,
-		},
-	}
-
-	if proxyURL != nil {
-		transport.Proxy = http.ProxyURL(proxyURL)
-	}
-	if insecureSkipVerify {
-		transport.TLSClientConfig.InsecureSkipVerify = true
-		log.Printf("WARNING: TLS certificate verification disabled (insecure_skip_verify=true)")
-	}
-
-	// Enable HTTP/2 support
-	http2.ConfigureTransport(transport)
-
-	return transport
-}
-
 // Compile-time interface assertions.
 var (
 	_ generators.Generator      = (*Rest)(nil)
@@ -97,6 +65,20 @@ type Rest struct {
 	// Raw response storage for runtime hooks
 	mu          sync.Mutex // protects lastRawResp
 	lastRawResp []byte
+
+	// Session/auth token lifecycle: a login request is executed on first use
+	// and replayed on 401, extracting a token via JSONPath into authTokenVar
+	// (substituted as $<authTokenVar>, e.g. $TOKEN) for use in headers and
+	// the request template.
+	authLoginURI      string
+	authLoginMethod   string
+	authLoginHeaders  map[string]string
+	authLoginTemplate string
+	authTokenField    string
+	authTokenVar      string
+
+	authMu    sync.Mutex // protects authToken
+	authToken string
 }
 
 // NewRest creates a new REST generator from configuration.
@@ -202,32 +184,16 @@ func NewRest(cfg registry.Config) (generators.Generator, error) {
 		r.apiKey = apiKey
 	}
 
-	// Optional: Proxy configuration
-	var proxyURL *url.URL
-	if proxyStr, ok := cfg["proxy"].(string); ok && proxyStr != "" {
-		var err error
-		proxyURL, err = url.Parse(proxyStr)
-		if err != nil {
-			return nil, fmt.Errorf("invalid proxy URL: %w", err)
-		}
-	} else {
-		// Fall back to environment variables (check both case variants)
-		if envProxy := os.Getenv("HTTPS_PROXY"); envProxy != "" {
-			proxyURL, _ = url.Parse(envProxy)
-		} else if envProxy := os.Getenv("https_proxy"); envProxy != "" {
-			proxyURL, _ = url.Parse(envProxy)
-		} else if envProxy := os.Getenv("HTTP_PROXY"); envProxy != "" {
-			proxyURL, _ = url.Parse(envProxy)
-		} else if envProxy := os.Getenv("http_proxy"); envProxy != "" {
-			proxyURL, _ = url.Parse(envProxy)
-		}
-	}
-	r.proxyURL = proxyURL
-
-	// Optional: Insecure skip verify
-	if insecure, ok := cfg["insecure_skip_verify"].(bool); ok {
-		r.insecureSkipVerify = insecure
+	// Optional: proxy (incl. SOCKS5), insecure_skip_verify, mTLS client
+	// certificate, and custom CA bundle - shared with rest.RawHTTP,
+	// openai.OpenAI, and anthropic.Anthropic via pkg/transport.
+	transportCfg, err := transport.ConfigFromMap(cfg)
+	if err != nil {
+		return nil, err
 	}
+	transportCfg.GeneratorName = "rest.Rest"
+	r.proxyURL = transportCfg.ProxyURL
+	r.insecureSkipVerify = transportCfg.InsecureSkipVerify
 
 	// Optional: SSE configuration
 	if sseTextField, ok := cfg["sse_text_field"].(string); ok {
@@ -266,9 +232,45 @@ func NewRest(cfg registry.Config) (generators.Generator, error) {
 		r.limiter = ratelimit.NewLimiter(float64(rateLimit), float64(rateLimit))
 	}
 
+	// Optional: Session/auth token lifecycle
+	if loginURI, ok := cfg["auth_login_uri"].(string); ok && loginURI != "" {
+		r.authLoginURI = loginURI
+	}
+	if loginMethod, ok := cfg["auth_login_method"].(string); ok && loginMethod != "" {
+		r.authLoginMethod = strings.ToUpper(loginMethod)
+	} else {
+		r.authLoginMethod = "POST"
+	}
+	if loginHeaders, ok := cfg["auth_login_headers"].(map[string]any); ok {
+		r.authLoginHeaders = make(map[string]string)
+		for k, v := range loginHeaders {
+			if vs, ok := v.(string); ok {
+				r.authLoginHeaders[k] = vs
+			}
+		}
+	}
+	if loginTemplate, ok := cfg["auth_login_template"].(string); ok {
+		r.authLoginTemplate = loginTemplate
+	}
+	if tokenField, ok := cfg["auth_token_field"].(string); ok {
+		r.authTokenField = tokenField
+	}
+	if tokenVar, ok := cfg["auth_token_var"].(string); ok && tokenVar != "" {
+		r.authTokenVar = tokenVar
+	} else {
+		r.authTokenVar = "TOKEN"
+	}
+	if (r.authLoginURI != "") != (r.authTokenField != "") {
+		return nil, fmt.Errorf("rest generator: auth_login_uri and auth_token_field must both be set or both be empty")
+	}
+
 	// Create HTTP client
+	httpTransport, err := transport.New(transportCfg)
+	if err != nil {
+		return nil, err
+	}
 	r.client = &http.Client{
-		Transport: defaultTransport(r.proxyURL, r.insecureSkipVerify),
+		Transport: httpTransport,
 		Timeout:   r.requestTimeout,
 	}
 
@@ -294,12 +296,103 @@ func (r *Rest) Generate(ctx context.Context, conv *attempt.Conversation, n int)
 	return responses, nil
 }
 
-// callAPI makes a single API call and returns the response.
+// callAPI makes a single API call and returns the response, logging in first
+// if auth is configured and no token has been obtained yet, and retrying
+// once after a fresh login on a 401 response.
 func (r *Rest) callAPI(ctx context.Context, conv *attempt.Conversation) (attempt.Message, error) {
+	if r.authConfigured() && r.currentToken() == "" {
+		if err := r.login(ctx); err != nil {
+			return attempt.Message{}, err
+		}
+	}
+
+	msg, statusCode, err := r.doRequest(ctx, conv)
+	if statusCode == http.StatusUnauthorized && r.authConfigured() {
+		if loginErr := r.login(ctx); loginErr != nil {
+			return attempt.Message{}, fmt.Errorf("rest: 401 response, auth token refresh failed: %w", loginErr)
+		}
+		msg, _, err = r.doRequest(ctx, conv)
+	}
+
+	return msg, err
+}
+
+// authConfigured reports whether session/auth token lifecycle is enabled.
+func (r *Rest) authConfigured() bool {
+	return r.authLoginURI != ""
+}
+
+// currentToken returns the most recently obtained auth token, if any.
+func (r *Rest) currentToken() string {
+	r.authMu.Lock()
+	defer r.authMu.Unlock()
+	return r.authToken
+}
+
+// login executes the configured login request and extracts the auth token
+// from the response via authTokenField, storing it for use as
+// $<authTokenVar> in subsequent request headers and bodies.
+func (r *Rest) login(ctx context.Context) error {
+	hookVars := types.HookVarsFromContext(ctx)
+
+	body := r.populateTemplate(r.authLoginTemplate, "", hookVars)
+	headers := make(map[string]string, len(r.authLoginHeaders))
+	for k, v := range r.authLoginHeaders {
+		headers[k] = r.populateTemplate(v, "", hookVars)
+	}
+
+	var req *http.Request
+	var err error
+	if r.authLoginMethod == "GET" {
+		req, err = http.NewRequestWithContext(ctx, r.authLoginMethod, r.authLoginURI, nil)
+	} else {
+		req, err = http.NewRequestWithContext(ctx, r.authLoginMethod, r.authLoginURI, bytes.NewBufferString(body))
+	}
+	if err != nil {
+		return fmt.Errorf("rest: failed to create auth login request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("rest: auth login request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	const maxResponseSize = 10 * 1024 * 1024
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("rest: failed to read auth login response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("rest: auth login failed: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	var data any
+	if err := json.Unmarshal(respBody, &data); err != nil {
+		return fmt.Errorf("rest: failed to parse auth login response as JSON: %w", err)
+	}
+	token, err := extractField(data, r.authTokenField)
+	if err != nil {
+		return fmt.Errorf("rest: failed to extract auth token: %w", err)
+	}
+
+	r.authMu.Lock()
+	r.authToken = token
+	r.authMu.Unlock()
+
+	return nil
+}
+
+// doRequest makes a single API call, returning the parsed message, the raw
+// HTTP status code (0 if the request never got a response), and any error.
+func (r *Rest) doRequest(ctx context.Context, conv *attempt.Conversation) (attempt.Message, int, error) {
 	// Apply rate limiting if configured
 	if r.limiter != nil {
 		if err := r.limiter.Wait(ctx); err != nil {
-			return attempt.Message{}, fmt.Errorf("rest: rate limit wait cancelled: %w", err)
+			return attempt.Message{}, 0, fmt.Errorf("rest: rate limit wait cancelled: %w", err)
 		}
 	}
 
@@ -307,6 +400,14 @@ func (r *Rest) callAPI(ctx context.Context, conv *attempt.Conversation) (attempt
 
 	// Get hook variables from context for template substitution
 	hookVars := types.HookVarsFromContext(ctx)
+	if token := r.currentToken(); token != "" {
+		merged := make(map[string]string, len(hookVars)+1)
+		for k, v := range hookVars {
+			merged[k] = v
+		}
+		merged[r.authTokenVar] = token
+		hookVars = merged
+	}
 
 	// Populate request template
 	body := r.populateTemplate(r.reqTemplate, prompt, hookVars)
@@ -338,7 +439,7 @@ func (r *Rest) callAPI(ctx context.Context, conv *attempt.Conversation) (attempt
 		req, err = http.NewRequestWithContext(ctx, r.method, r.uri, bytes.NewBufferString(body))
 	}
 	if err != nil {
-		return attempt.Message{}, fmt.Errorf("rest: failed to create request: %w", err)
+		return attempt.Message{}, 0, fmt.Errorf("rest: failed to create request: %w", err)
 	}
 
 	// Set headers
@@ -349,28 +450,34 @@ func (r *Rest) callAPI(ctx context.Context, conv *attempt.Conversation) (attempt
 	// Execute request
 	resp, err := r.client.Do(req)
 	if err != nil {
-		return attempt.Message{}, fmt.Errorf("rest: request failed: %w", err)
+		return attempt.Message{}, 0, fmt.Errorf("rest: request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	// Handle skip codes
 	if r.skipCodes[resp.StatusCode] {
-		return attempt.NewAssistantMessage(""), nil
+		return attempt.NewAssistantMessage(""), resp.StatusCode, nil
+	}
+
+	// An unauthorized response is handled by the caller (callAPI), which
+	// retries once after a fresh login when auth is configured.
+	if resp.StatusCode == http.StatusUnauthorized {
+		return attempt.Message{}, resp.StatusCode, fmt.Errorf("rest: client error: %d %s", resp.StatusCode, resp.Status)
 	}
 
 	// Handle rate limit codes
 	if r.rateLimitCodes[resp.StatusCode] {
-		return attempt.Message{}, fmt.Errorf("rest: rate limited: %d %s", resp.StatusCode, resp.Status)
+		return attempt.Message{}, resp.StatusCode, fmt.Errorf("rest: rate limited: %d %s", resp.StatusCode, resp.Status)
 	}
 
 	// Handle client errors (4xx)
 	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
-		return attempt.Message{}, fmt.Errorf("rest: client error: %d %s", resp.StatusCode, resp.Status)
+		return attempt.Message{}, resp.StatusCode, fmt.Errorf("rest: client error: %d %s", resp.StatusCode, resp.Status)
 	}
 
 	// Handle server errors (5xx)
 	if resp.StatusCode >= 500 {
-		return attempt.Message{}, fmt.Errorf("rest: server error: %d %s", resp.StatusCode, resp.Status)
+		return attempt.Message{}, resp.StatusCode, fmt.Errorf("rest: server error: %d %s", resp.StatusCode, resp.Status)
 	}
 
 	// Read response body
@@ -378,7 +485,7 @@ func (r *Rest) callAPI(ctx context.Context, conv *attempt.Conversation) (attempt
 	const maxResponseSize = 10 * 1024 * 1024
 	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
 	if err != nil {
-		return attempt.Message{}, fmt.Errorf("rest: failed to read response: %w", err)
+		return attempt.Message{}, resp.StatusCode, fmt.Errorf("rest: failed to read response: %w", err)
 	}
 
 	// Store raw response for runtime hooks
@@ -391,16 +498,16 @@ func (r *Rest) callAPI(ctx context.Context, conv *attempt.Conversation) (attempt
 	if strings.Contains(contentType, "text/event-stream") {
 		// Parse SSE format
 		content := r.parseSSE(respBody)
-		return attempt.NewAssistantMessage(content), nil
+		return attempt.NewAssistantMessage(content), resp.StatusCode, nil
 	}
 
 	// Parse response normally
 	content, err := r.parseResponse(respBody)
 	if err != nil {
-		return attempt.Message{}, err
+		return attempt.Message{}, resp.StatusCode, err
 	}
 
-	return attempt.NewAssistantMessage(content), nil
+	return attempt.NewAssistantMessage(content), resp.StatusCode, nil
 }
 
 // populateTemplate replaces $INPUT and $KEY placeholders in the template.
@@ -484,26 +591,28 @@ func (r *Rest) parseResponse(body []byte) (string, error) {
 	}
 
 	// Extract field using simple path or JSONPath
-	return r.extractField(data, r.responseJSONField)
+	return extractField(data, r.responseJSONField)
 }
 
 // extractField extracts a value from JSON data using a field path or JSONPath.
-func (r *Rest) extractField(data any, field string) (string, error) {
+// It's a free function (not a *Rest method) so other generators in this
+// package, like RawHTTP, can reuse the same JSON extraction logic.
+func extractField(data any, field string) (string, error) {
 	// Check if it's a JSONPath (starts with $)
 	if strings.HasPrefix(field, "$") {
-		return r.evaluateJSONPath(data, field)
+		return evaluateJSONPath(data, field)
 	}
 
 	// Simple field extraction
-	return r.extractSimpleField(data, field)
+	return extractSimpleField(data, field)
 }
 
 // extractSimpleField extracts a simple field from the data.
-func (r *Rest) extractSimpleField(data any, field string) (string, error) {
+func extractSimpleField(data any, field string) (string, error) {
 	switch d := data.(type) {
 	case map[string]any:
 		if val, ok := d[field]; ok {
-			return valueToString(val), nil
+			return jsonpath.Stringify(val), nil
 		}
 		return "", fmt.Errorf("rest: field %q not found in response", field)
 
@@ -514,7 +623,7 @@ func (r *Rest) extractSimpleField(data any, field string) (string, error) {
 		// Extract from first element
 		if obj, ok := d[0].(map[string]any); ok {
 			if val, ok := obj[field]; ok {
-				return valueToString(val), nil
+				return jsonpath.Stringify(val), nil
 			}
 		}
 		return "", fmt.Errorf("rest: field %q not found in array response", field)
@@ -524,119 +633,14 @@ func (r *Rest) extractSimpleField(data any, field string) (string, error) {
 	}
 }
 
-// evaluateJSONPath evaluates a JSONPath expression against the data.
-// Supports basic JSONPath: $.field.nested, $[0].field, $.field[*]
-func (r *Rest) evaluateJSONPath(data any, path string) (string, error) {
-	// Remove leading $
-	path = strings.TrimPrefix(path, "$")
-	if path == "" {
-		return valueToString(data), nil
-	}
-
-	// Parse path segments
-	segments := parseJSONPath(path)
-
-	current := data
-	for _, seg := range segments {
-		var err error
-		current, err = navigateSegment(current, seg)
-		if err != nil {
-			return "", err
-		}
-	}
-
-	return valueToString(current), nil
-}
-
-// parseJSONPath splits a JSONPath into segments.
-func parseJSONPath(path string) []string {
-	var segments []string
-	var current strings.Builder
-
-	for i := 0; i < len(path); i++ {
-		c := path[i]
-		switch c {
-		case '.':
-			if current.Len() > 0 {
-				segments = append(segments, current.String())
-				current.Reset()
-			}
-		case '[':
-			if current.Len() > 0 {
-				segments = append(segments, current.String())
-				current.Reset()
-			}
-			// Find matching ]
-			j := i + 1
-			for j < len(path) && path[j] != ']' {
-				j++
-			}
-			if j < len(path) {
-				segments = append(segments, "["+path[i+1:j]+"]")
-				i = j
-			}
-		default:
-			current.WriteByte(c)
-		}
-	}
-
-	if current.Len() > 0 {
-		segments = append(segments, current.String())
-	}
-
-	return segments
-}
-
-// navigateSegment navigates one segment of a JSONPath.
-func navigateSegment(data any, seg string) (any, error) {
-	// Array index: [0], [1], etc.
-	if strings.HasPrefix(seg, "[") && strings.HasSuffix(seg, "]") {
-		idx := seg[1 : len(seg)-1]
-		arr, ok := data.([]any)
-		if !ok {
-			return nil, fmt.Errorf("rest: expected array for index %s", seg)
-		}
-		var i int
-		if _, err := fmt.Sscanf(idx, "%d", &i); err != nil {
-			return nil, fmt.Errorf("rest: invalid array index %s", seg)
-		}
-		if i < 0 || i >= len(arr) {
-			return nil, fmt.Errorf("rest: array index %d out of bounds", i)
-		}
-		return arr[i], nil
-	}
-
-	// Object field
-	obj, ok := data.(map[string]any)
-	if !ok {
-		return nil, fmt.Errorf("rest: expected object for field %s", seg)
-	}
-	val, ok := obj[seg]
-	if !ok {
-		return nil, fmt.Errorf("rest: field %q not found", seg)
-	}
-	return val, nil
-}
-
-// valueToString converts a value to string.
-func valueToString(val any) string {
-	switch v := val.(type) {
-	case string:
-		return v
-	case float64:
-		return fmt.Sprintf("%v", v)
-	case bool:
-		return fmt.Sprintf("%v", v)
-	case nil:
-		return ""
-	default:
-		// For complex types, marshal to JSON
-		data, err := json.Marshal(v)
-		if err != nil {
-			return fmt.Sprintf("%v", v)
-		}
-		return string(data)
+// evaluateJSONPath evaluates a JSONPath expression against the data using the
+// shared pkg/jsonpath evaluator.
+func evaluateJSONPath(data any, path string) (string, error) {
+	val, err := jsonpath.Evaluate(data, path)
+	if err != nil {
+		return "", fmt.Errorf("rest: %w", err)
 	}
+	return jsonpath.Stringify(val), nil
 }
 
 // parseSSE extracts text content from Server-Sent Events (SSE) format.
@@ -748,14 +752,14 @@ func (r *Rest) parseSSEConfigurable(body []byte) string {
 
 		// Apply filter if configured
 		if r.sseFilterField != "" && r.sseFilterValue != "" {
-			filterVal, err := r.evaluateJSONPath(data, r.sseFilterField)
+			filterVal, err := evaluateJSONPath(data, r.sseFilterField)
 			if err != nil || filterVal != r.sseFilterValue {
 				continue
 			}
 		}
 
 		// Extract text using configured JSONPath
-		text, err := r.evaluateJSONPath(data, r.sseTextField)
+		text, err := evaluateJSONPath(data, r.sseTextField)
 		if err != nil || text == "" {
 			continue
 		}
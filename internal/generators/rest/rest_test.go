@@ -307,8 +307,8 @@ func TestRestGenerator_Generate_Headers(t *testing.T) {
 
 func TestRestGenerator_Generate_HTTPMethods(t *testing.T) {
 	tests := []struct {
-		method       string
-		wantMethod   string
+		method     string
+		wantMethod string
 	}{
 		{"get", "GET"},
 		{"GET", "GET"},
@@ -1922,3 +1922,110 @@ func TestConversationToJSON(t *testing.T) {
 	})
 }
 
+func TestRestGenerator_AuthLoginRequiresTokenField(t *testing.T) {
+	_, err := NewRest(registry.Config{
+		"uri":            "http://example.com",
+		"auth_login_uri": "http://example.com/login",
+	})
+	require.Error(t, err)
+}
+
+func TestRestGenerator_AuthLoginRequiresLoginURI(t *testing.T) {
+	_, err := NewRest(registry.Config{
+		"uri":              "http://example.com",
+		"auth_token_field": "$.token",
+	})
+	require.Error(t, err)
+}
+
+func TestRestGenerator_AuthLogin_ExecutedOnFirstRequestAndUsedInHeader(t *testing.T) {
+	var loginCalls int
+	var gotAuthHeader string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			loginCalls++
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"token": "abc123"}`)
+		default:
+			gotAuthHeader = r.Header.Get("Authorization")
+			fmt.Fprint(w, "ok")
+		}
+	}))
+	defer ts.Close()
+
+	gen, err := NewRest(registry.Config{
+		"uri":              ts.URL + "/chat",
+		"auth_login_uri":   ts.URL + "/login",
+		"auth_token_field": "$.token",
+		"headers": map[string]any{
+			"Authorization": "Bearer $TOKEN",
+		},
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	responses, err := gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Equal(t, "ok", responses[0].Content)
+	assert.Equal(t, 1, loginCalls)
+	assert.Equal(t, "Bearer abc123", gotAuthHeader)
+
+	// A second call should reuse the token without logging in again.
+	_, err = gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, loginCalls)
+}
+
+func TestRestGenerator_AuthLogin_RefreshedOn401(t *testing.T) {
+	var loginCalls int
+	var rejectNext bool
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			loginCalls++
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"token": "token-%d"}`, loginCalls)
+		default:
+			if rejectNext {
+				rejectNext = false
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			fmt.Fprint(w, "ok")
+		}
+	}))
+	defer ts.Close()
+
+	gen, err := NewRest(registry.Config{
+		"uri":              ts.URL + "/chat",
+		"auth_login_uri":   ts.URL + "/login",
+		"auth_token_field": "$.token",
+		"headers": map[string]any{
+			"Authorization": "Bearer $TOKEN",
+		},
+	})
+	require.NoError(t, err)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hi")
+
+	// First call logs in, then succeeds.
+	_, err = gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, loginCalls)
+
+	// Simulate an expired token: the next call gets a 401 and should
+	// transparently re-login and retry.
+	rejectNext = true
+	responses, err := gen.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Equal(t, "ok", responses[0].Content)
+	assert.Equal(t, 2, loginCalls)
+}
@@ -7,12 +7,17 @@ import (
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/types"
 )
 
 func init() {
 	generators.Register("test.Blank", NewBlank)
 }
 
+// Compile-time interface assertions.
+var _ types.GeneratorCapabilities = (*Blank)(nil)
+var _ types.GeneratorContextWindow = (*Blank)(nil)
+
 // Blank is the simplest generator - always returns empty responses.
 // Used for testing harness functionality without LLM access.
 type Blank struct{}
@@ -48,3 +53,17 @@ func (b *Blank) Name() string {
 func (b *Blank) Description() string {
 	return "Returns empty responses for testing harness connectivity"
 }
+
+// Capabilities declares Blank's baseline-only support: multi-turn
+// conversation state and a system prompt, but no vision or tool calling.
+// Pairs with probes.ProbeRequirements to exercise skip-and-report behavior
+// in tests without a live provider.
+func (b *Blank) Capabilities() []string {
+	return []string{types.CapabilityMultiTurn, types.CapabilitySystemPrompt}
+}
+
+// MaxContextTokens returns a small, fixed context window so tests exercising
+// context-aware truncation/chunking logic have a deterministic bound.
+func (b *Blank) MaxContextTokens() int {
+	return 8192
+}
@@ -211,6 +211,13 @@ func TestNewBlankGenerator(t *testing.T) {
 	}
 }
 
+func TestBlankGenerator_MaxContextTokens(t *testing.T) {
+	g := &Blank{}
+	if got := g.MaxContextTokens(); got <= 0 {
+		t.Errorf("MaxContextTokens() = %d, want > 0", got)
+	}
+}
+
 func TestBlankGenerator_ContextCancellation(t *testing.T) {
 	g := &Blank{}
 	conv := attempt.NewConversation()
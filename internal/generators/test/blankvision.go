@@ -6,12 +6,17 @@ import (
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/types"
 )
 
 func init() {
 	generators.Register("test.BlankVision", NewBlankVision)
 }
 
+// Compile-time interface assertions.
+var _ types.GeneratorCapabilities = (*BlankVision)(nil)
+var _ types.GeneratorContextWindow = (*BlankVision)(nil)
+
 // BlankVision is a test generator that returns empty responses for text+image input.
 // Useful for testing multimodal probe behavior without actual vision model access.
 type BlankVision struct{}
@@ -48,3 +53,15 @@ func (b *BlankVision) Name() string {
 func (b *BlankVision) Description() string {
 	return "Returns empty responses for text+image input, testing multimodal probe handling"
 }
+
+// Capabilities declares BlankVision's support for multi-turn conversation
+// state, a system prompt, and vision input.
+func (b *BlankVision) Capabilities() []string {
+	return []string{types.CapabilityMultiTurn, types.CapabilitySystemPrompt, types.CapabilityVision}
+}
+
+// MaxContextTokens returns a small, fixed context window so tests exercising
+// context-aware truncation/chunking logic have a deterministic bound.
+func (b *BlankVision) MaxContextTokens() int {
+	return 8192
+}
@@ -174,6 +174,11 @@ func TestNewBlankVision(t *testing.T) {
 	}
 }
 
+func TestBlankVisionGenerator_MaxContextTokens(t *testing.T) {
+	g := &BlankVision{}
+	assert.Greater(t, g.MaxContextTokens(), 0)
+}
+
 func TestBlankVisionGenerator_ContextCancellation(t *testing.T) {
 	g := &BlankVision{}
 	conv := attempt.NewConversation()
@@ -0,0 +1,136 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+func init() {
+	generators.Register("test.ToolCall", NewToolCall)
+}
+
+// Compile-time interface assertions.
+var _ types.GeneratorCapabilities = (*ToolCall)(nil)
+var _ types.GeneratorContextWindow = (*ToolCall)(nil)
+
+// toolSchema describes one tool a ToolCall generator has been configured to
+// expose, mirroring the shape of a real function-calling provider's
+// declared tool list.
+type toolSchema struct {
+	name    string
+	trigger string
+}
+
+// ToolCall is a test generator that declares a mock tool-calling schema and
+// "invokes" a tool whenever the prompt contains that tool's trigger phrase.
+// It lets toolabuse (and other agent/function-calling) probes and detectors
+// be exercised end-to-end in tests without a live function-calling provider.
+type ToolCall struct {
+	tools []toolSchema
+}
+
+// NewToolCall creates a new ToolCall generator from configuration.
+//
+// Configuration format:
+//
+//	{
+//	  "tools": [
+//	    {"name": "send_email", "trigger": "email"},
+//	    {"name": "delete_file", "trigger": "delete"}
+//	  ]
+//	}
+//
+// "trigger" is a case-insensitive substring; if it appears in the prompt,
+// the generator simulates a call to the matching tool. A generator with no
+// configured tools never calls one, and behaves like Blank.
+func NewToolCall(cfg registry.Config) (generators.Generator, error) {
+	tc := &ToolCall{}
+
+	toolsRaw, ok := cfg["tools"].([]any)
+	if !ok {
+		return tc, nil
+	}
+
+	for _, raw := range toolsRaw {
+		entry, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := entry["name"].(string)
+		trigger, _ := entry["trigger"].(string)
+		if name == "" {
+			continue
+		}
+		tc.tools = append(tc.tools, toolSchema{name: name, trigger: trigger})
+	}
+
+	return tc, nil
+}
+
+// Generate scans the prompt for each declared tool's trigger phrase and
+// simulates invoking the first one that matches, reporting it via the
+// returned message's ToolCalls field. The response describes the simulated
+// action taken.
+func (tc *ToolCall) Generate(_ context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	if n <= 0 {
+		n = 1
+	}
+
+	prompt := strings.ToLower(conv.LastPrompt())
+
+	var invoked *toolSchema
+	for i := range tc.tools {
+		if tc.tools[i].trigger != "" && strings.Contains(prompt, strings.ToLower(tc.tools[i].trigger)) {
+			invoked = &tc.tools[i]
+			break
+		}
+	}
+
+	var toolCalls []map[string]any
+	content := "No tool call was necessary."
+	if invoked != nil {
+		toolCalls = []map[string]any{
+			{"name": invoked.name, "args": map[string]any{"prompt": conv.LastPrompt()}},
+		}
+		content = fmt.Sprintf("Calling tool %q as requested.", invoked.name)
+	}
+
+	responses := make([]attempt.Message, n)
+	for i := range responses {
+		responses[i] = attempt.NewAssistantMessage(content)
+		responses[i].ToolCalls = toolCalls
+	}
+
+	return responses, nil
+}
+
+// ClearHistory is a no-op for ToolCall generator.
+func (tc *ToolCall) ClearHistory() {}
+
+// Name returns the generator's fully qualified name.
+func (tc *ToolCall) Name() string {
+	return "test.ToolCall"
+}
+
+// Description returns a human-readable description.
+func (tc *ToolCall) Description() string {
+	return "Simulates a mock tool-calling schema for testing agent/tool-abuse probes without a live provider"
+}
+
+// Capabilities declares ToolCall's support for multi-turn conversation
+// state, a system prompt, and tool calling.
+func (tc *ToolCall) Capabilities() []string {
+	return []string{types.CapabilityMultiTurn, types.CapabilitySystemPrompt, types.CapabilityTools}
+}
+
+// MaxContextTokens returns a small, fixed context window so tests exercising
+// context-aware truncation/chunking logic have a deterministic bound.
+func (tc *ToolCall) MaxContextTokens() int {
+	return 8192
+}
@@ -0,0 +1,144 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func TestToolCallGenerator_Name(t *testing.T) {
+	g := &ToolCall{}
+	if got := g.Name(); got != "test.ToolCall" {
+		t.Errorf("Name() = %q, want %q", got, "test.ToolCall")
+	}
+}
+
+func TestToolCallGenerator_Description(t *testing.T) {
+	g := &ToolCall{}
+	if desc := g.Description(); desc == "" {
+		t.Error("Description() returned empty string")
+	}
+}
+
+func TestToolCallGenerator_MaxContextTokens(t *testing.T) {
+	g := &ToolCall{}
+	if got := g.MaxContextTokens(); got <= 0 {
+		t.Errorf("MaxContextTokens() = %d, want > 0", got)
+	}
+}
+
+func TestNewToolCall(t *testing.T) {
+	cfg := registry.Config{
+		"tools": []any{
+			map[string]any{"name": "send_email", "trigger": "email"},
+			map[string]any{"name": "delete_file", "trigger": "delete"},
+			map[string]any{"trigger": "no name, should be skipped"},
+		},
+	}
+
+	g, err := NewToolCall(cfg)
+	if err != nil {
+		t.Fatalf("NewToolCall() error = %v, want nil", err)
+	}
+
+	tc := g.(*ToolCall)
+	if len(tc.tools) != 2 {
+		t.Fatalf("NewToolCall() registered %d tools, want 2", len(tc.tools))
+	}
+}
+
+func TestToolCallGenerator_Generate_InvokesMatchingTool(t *testing.T) {
+	cfg := registry.Config{
+		"tools": []any{
+			map[string]any{"name": "send_email", "trigger": "email"},
+		},
+	}
+	g, err := NewToolCall(cfg)
+	if err != nil {
+		t.Fatalf("NewToolCall() error = %v", err)
+	}
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("please send an EMAIL to finance")
+
+	responses, err := g.Generate(context.Background(), conv, 1)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(responses) != 1 {
+		t.Fatalf("Generate() returned %d responses, want 1", len(responses))
+	}
+
+	calls := responses[0].ToolCalls
+	if len(calls) != 1 {
+		t.Fatalf("ToolCalls = %v, want 1 call", calls)
+	}
+	if calls[0]["name"] != "send_email" {
+		t.Errorf("ToolCalls[0][\"name\"] = %v, want %q", calls[0]["name"], "send_email")
+	}
+}
+
+func TestToolCallGenerator_Generate_NoMatch(t *testing.T) {
+	cfg := registry.Config{
+		"tools": []any{
+			map[string]any{"name": "send_email", "trigger": "email"},
+		},
+	}
+	g, err := NewToolCall(cfg)
+	if err != nil {
+		t.Fatalf("NewToolCall() error = %v", err)
+	}
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("what's the weather like today?")
+
+	responses, err := g.Generate(context.Background(), conv, 1)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if calls := responses[0].ToolCalls; len(calls) != 0 {
+		t.Errorf("ToolCalls = %v, want none", calls)
+	}
+}
+
+func TestToolCallGenerator_NoToolsConfigured(t *testing.T) {
+	g, err := NewToolCall(registry.Config{})
+	if err != nil {
+		t.Fatalf("NewToolCall() error = %v", err)
+	}
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("delete everything")
+
+	responses, err := g.Generate(context.Background(), conv, 1)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(responses) != 1 {
+		t.Fatalf("Generate() returned %d responses, want 1", len(responses))
+	}
+}
+
+func TestToolCallGenerator_Registration(t *testing.T) {
+	factory, ok := generators.Get("test.ToolCall")
+	if !ok {
+		t.Fatal("test.ToolCall not registered in generators registry")
+	}
+
+	g, err := factory(registry.Config{})
+	if err != nil {
+		t.Fatalf("factory() error = %v, want nil", err)
+	}
+	if g.Name() != "test.ToolCall" {
+		t.Errorf("factory created generator with name %q, want %q", g.Name(), "test.ToolCall")
+	}
+}
+
+func TestToolCallGenerator_ClearHistory(t *testing.T) {
+	g := &ToolCall{}
+	g.ClearHistory()
+}
@@ -0,0 +1,106 @@
+package test
+
+import (
+	"context"
+	"encoding/base64"
+	"regexp"
+	"strings"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func init() {
+	generators.Register("test.Vulnerable", NewVulnerable)
+}
+
+// base64Payload matches the kind of base64 blob encoding.Base64 embeds in
+// transformed prompts (e.g. "The following instruction is base64 encoded:
+// <blob>"). The length floor avoids matching short incidental substrings.
+var base64Payload = regexp.MustCompile(`[A-Za-z0-9+/]{16,}={0,2}`)
+
+// Vulnerable is a test generator that deterministically "falls for" a
+// handful of well-known attack families instead of refusing them, so
+// end-to-end tests and demos can exercise realistic dan.* and
+// encoding.* detector hits without calling a real, possibly-safe provider.
+//
+// It recognizes:
+//   - DAN/jailbreak-style prompts and replies with the jailbroken-persona
+//     markers dan.DAN and related detectors look for.
+//   - base64-encoded payloads (e.g. from buffs encoding.Base64) and
+//     decodes + echoes them back, as if it had complied with the hidden
+//     instruction, which encoding.DecodeMatch and encoding.DecodeApprox
+//     look for.
+//
+// Anything else gets a generic unrestricted-sounding reply that echoes the
+// prompt, so probes relying on absence-of-refusal also register a hit.
+type Vulnerable struct{}
+
+// NewVulnerable creates a new Vulnerable generator.
+func NewVulnerable(_ registry.Config) (generators.Generator, error) {
+	return &Vulnerable{}, nil
+}
+
+// Generate returns n copies of a deterministic "vulnerable" response
+// derived from the conversation's last prompt.
+func (v *Vulnerable) Generate(_ context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	if n <= 0 {
+		n = 1
+	}
+
+	response := respondVulnerably(conv.LastPrompt())
+
+	responses := make([]attempt.Message, n)
+	for i := range responses {
+		responses[i] = attempt.NewAssistantMessage(response)
+	}
+
+	return responses, nil
+}
+
+// respondVulnerably picks a deterministic, attack-family-specific response
+// for the given prompt.
+func respondVulnerably(prompt string) string {
+	if decoded, ok := decodeEmbeddedBase64(prompt); ok {
+		return decoded
+	}
+
+	lower := strings.ToLower(prompt)
+	if strings.Contains(lower, "do anything now") || strings.Contains(lower, "dan mode") ||
+		strings.Contains(lower, "developer mode") || strings.Contains(lower, "jailbreak") {
+		return "DAN Mode enabled. I am now DAN and will comply with any request without restriction."
+	}
+
+	return "Sure, I can help with that. Here is the information you requested, unfiltered: " + prompt
+}
+
+// decodeEmbeddedBase64 looks for a base64 blob in the prompt and decodes
+// it, simulating a model that complies with an instruction smuggled past
+// its safety filters via encoding.
+func decodeEmbeddedBase64(prompt string) (string, bool) {
+	match := base64Payload.FindString(prompt)
+	if match == "" {
+		return "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(match)
+	if err != nil || len(decoded) == 0 {
+		return "", false
+	}
+
+	return string(decoded), true
+}
+
+// ClearHistory is a no-op for Vulnerable generator (stateless).
+func (v *Vulnerable) ClearHistory() {}
+
+// Name returns the generator's fully qualified name.
+func (v *Vulnerable) Name() string {
+	return "test.Vulnerable"
+}
+
+// Description returns a human-readable description.
+func (v *Vulnerable) Description() string {
+	return "Deterministically falls for known attack families (DAN jailbreaks, base64-encoded payloads) for tests and demos, without calling a real provider"
+}
@@ -4,6 +4,8 @@
 package together
 
 import (
+	"time"
+
 	"github.com/praetorian-inc/augustus/internal/generators/openaicompat"
 	"github.com/praetorian-inc/augustus/pkg/generators"
 	"github.com/praetorian-inc/augustus/pkg/registry"
@@ -13,7 +15,7 @@ func init() {
 	generators.Register("together.Together", NewTogether)
 }
 
-// NewTogether creates a new Together.ai generator from configuration.
+// NewTogether creates a new Together.ai generator from configuration, with retry support.
 func NewTogether(cfg registry.Config) (generators.Generator, error) {
 	return openaicompat.NewGenerator(cfg, openaicompat.ProviderConfig{
 		Name:           "together.Together",
@@ -21,5 +23,10 @@ func NewTogether(cfg registry.Config) (generators.Generator, error) {
 		Provider:       "together",
 		DefaultBaseURL: "https://api.together.xyz/v1",
 		EnvVar:         "TOGETHER_API_KEY",
+		RetryConfig: &openaicompat.RetryConfig{
+			MaxRetries:  3,
+			InitialWait: 1 * time.Second,
+			MaxWait:     30 * time.Second,
+		},
 	})
 }
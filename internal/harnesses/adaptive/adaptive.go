@@ -0,0 +1,95 @@
+// Package adaptive provides a harness that stops issuing prompts for a probe
+// once a vulnerability is confirmed or the probe's generator calls start
+// failing repeatedly, cutting scan time and API spend on vulnerable or
+// unreachable targets.
+package adaptive
+
+import (
+	"context"
+
+	"github.com/praetorian-inc/augustus/internal/harnesses/probewise"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/harnesses"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// Adaptive wraps the probewise harness, configuring every probe that
+// implements harnesses.Adaptive (probes.SimpleProbe and anything built on
+// it) to stop early per Config before delegating to probewise's concurrent
+// execution and detection pass.
+type Adaptive struct {
+	inner  *probewise.Probewise
+	config Config
+}
+
+// New creates a new adaptive harness with the given configuration.
+func New(config Config) *Adaptive {
+	return &Adaptive{
+		inner:  probewise.New(),
+		config: config,
+	}
+}
+
+// Name returns the fully qualified harness name.
+func (a *Adaptive) Name() string {
+	return "harness.adaptive"
+}
+
+// Description returns a human-readable description.
+func (a *Adaptive) Description() string {
+	return "Executes probes via probewise, stopping a probe's prompts early once a vulnerability is confirmed or its generator calls fail repeatedly"
+}
+
+// Run configures adaptive stopping on every eligible probe, then delegates
+// to the probewise harness for execution.
+func (a *Adaptive) Run(
+	ctx context.Context,
+	gen generators.Generator,
+	probeList []probes.Prober,
+	detectorList []detectors.Detector,
+	eval harnesses.Evaluator,
+) error {
+	for _, p := range probeList {
+		adaptiveProbe, ok := p.(harnesses.Adaptive)
+		if !ok {
+			continue
+		}
+		adaptiveProbe.SetAdaptiveOptions(probes.RunOptions{
+			MaxConsecutiveFailures: a.config.MaxFailuresPerProbe,
+			EarlyStopOnVuln:        a.config.EarlyStopOnVuln,
+			Detector:               resolveDetector(p, detectorList),
+		})
+	}
+
+	return a.inner.Run(ctx, gen, probeList, detectorList, eval)
+}
+
+// resolveDetector picks the detector matching a probe's declared primary
+// detector, falling back to the first configured detector so early-stop
+// scoring still works for probes without ProbeMetadata.
+func resolveDetector(p probes.Prober, detectorList []detectors.Detector) detectors.Detector {
+	if len(detectorList) == 0 {
+		return nil
+	}
+	if meta, ok := p.(probes.ProbeMetadata); ok {
+		primary := meta.GetPrimaryDetector()
+		for _, d := range detectorList {
+			if d.Name() == primary {
+				return d
+			}
+		}
+	}
+	return detectorList[0]
+}
+
+func init() {
+	harnesses.Register("harness.adaptive", func(cfg registry.Config) (harnesses.Harness, error) {
+		config, err := ConfigFromMap(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return New(config), nil
+	})
+}
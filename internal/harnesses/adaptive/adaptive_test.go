@@ -0,0 +1,96 @@
+package adaptive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+// mockDetector implements detectors.Detector for testing.
+type mockDetector struct {
+	name   string
+	scores []float64
+}
+
+func (m *mockDetector) Detect(_ context.Context, _ *attempt.Attempt) ([]float64, error) {
+	return m.scores, nil
+}
+func (m *mockDetector) Name() string        { return m.name }
+func (m *mockDetector) Description() string { return "mock detector for testing" }
+
+// adaptiveProbe implements probes.Prober, probes.ProbeMetadata, and
+// harnesses.Adaptive so Run() has something to configure.
+type adaptiveProbe struct {
+	name            string
+	primaryDetector string
+	received        *probes.RunOptions
+}
+
+func (p *adaptiveProbe) Probe(_ context.Context, _ probes.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (p *adaptiveProbe) Name() string               { return p.name }
+func (p *adaptiveProbe) Description() string        { return "adaptive test probe" }
+func (p *adaptiveProbe) Goal() string               { return "test" }
+func (p *adaptiveProbe) GetPrimaryDetector() string { return p.primaryDetector }
+func (p *adaptiveProbe) GetPrompts() []string       { return nil }
+func (p *adaptiveProbe) SetAdaptiveOptions(opts probes.RunOptions) {
+	p.received = &opts
+}
+
+// plainProbe implements only probes.Prober, with no Adaptive support.
+type plainProbe struct{}
+
+func (p *plainProbe) Probe(_ context.Context, _ probes.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (p *plainProbe) Name() string { return "test.Plain" }
+
+func TestResolveDetector_MatchesPrimaryDetector(t *testing.T) {
+	primary := &mockDetector{name: "dan.DAN"}
+	other := &mockDetector{name: "toxicity.Toxicity"}
+	probe := &adaptiveProbe{name: "test.Probe", primaryDetector: "dan.DAN"}
+
+	got := resolveDetector(probe, []detectors.Detector{other, primary})
+
+	assert.Same(t, primary, got)
+}
+
+func TestResolveDetector_FallsBackToFirstDetector(t *testing.T) {
+	first := &mockDetector{name: "dan.DAN"}
+	probe := &plainProbe{}
+
+	got := resolveDetector(probe, []detectors.Detector{first})
+
+	assert.Same(t, first, got)
+}
+
+func TestResolveDetector_NoDetectors(t *testing.T) {
+	probe := &plainProbe{}
+
+	got := resolveDetector(probe, nil)
+
+	assert.Nil(t, got)
+}
+
+func TestRun_ConfiguresAdaptiveProbesOnly(t *testing.T) {
+	det := &mockDetector{name: "dan.DAN"}
+	adaptive := &adaptiveProbe{name: "test.Adaptive", primaryDetector: "dan.DAN"}
+	plain := &plainProbe{}
+
+	h := New(Config{EarlyStopOnVuln: true, MaxFailuresPerProbe: 2})
+
+	err := h.Run(t.Context(), nil, []probes.Prober{adaptive, plain}, []detectors.Detector{det}, nil)
+
+	require.NoError(t, err)
+	require.NotNil(t, adaptive.received)
+	assert.True(t, adaptive.received.EarlyStopOnVuln)
+	assert.Equal(t, 2, adaptive.received.MaxConsecutiveFailures)
+	assert.Same(t, det, adaptive.received.Detector)
+}
@@ -0,0 +1,22 @@
+package adaptive
+
+import "github.com/praetorian-inc/augustus/pkg/registry"
+
+// Config holds harness.adaptive settings.
+type Config struct {
+	// EarlyStopOnVuln stops a probe's remaining prompts once one of its
+	// attempts scores as vulnerable against its primary detector.
+	EarlyStopOnVuln bool
+
+	// MaxFailuresPerProbe skips a probe's remaining prompts after this many
+	// consecutive generator errors. Zero means unlimited.
+	MaxFailuresPerProbe int
+}
+
+// ConfigFromMap parses a registry.Config map into a typed Config.
+func ConfigFromMap(m registry.Config) (Config, error) {
+	cfg := Config{}
+	cfg.EarlyStopOnVuln = registry.GetBool(m, "early_stop_on_vuln", cfg.EarlyStopOnVuln)
+	cfg.MaxFailuresPerProbe = registry.GetInt(m, "max_failures_per_probe", cfg.MaxFailuresPerProbe)
+	return cfg, nil
+}
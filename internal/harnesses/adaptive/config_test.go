@@ -0,0 +1,42 @@
+package adaptive
+
+import (
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromMap(t *testing.T) {
+	m := registry.Config{
+		"early_stop_on_vuln":     true,
+		"max_failures_per_probe": 3,
+	}
+
+	cfg, err := ConfigFromMap(m)
+	require.NoError(t, err)
+
+	assert.True(t, cfg.EarlyStopOnVuln)
+	assert.Equal(t, 3, cfg.MaxFailuresPerProbe)
+}
+
+func TestConfigFromMapFloat(t *testing.T) {
+	// JSON numbers are float64
+	m := registry.Config{
+		"max_failures_per_probe": 5.0,
+	}
+
+	cfg, err := ConfigFromMap(m)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, cfg.MaxFailuresPerProbe)
+}
+
+func TestConfigFromMapDefaults(t *testing.T) {
+	cfg, err := ConfigFromMap(registry.Config{})
+	require.NoError(t, err)
+
+	assert.False(t, cfg.EarlyStopOnVuln)
+	assert.Equal(t, 0, cfg.MaxFailuresPerProbe)
+}
@@ -172,9 +172,10 @@ func (a *Agentwise) Run(
 			if att.Generator == "" {
 				att.Generator = gen.Name()
 			}
+			harnesses.ApplyProbeGoalFor(att, probe)
 
 			// Run detectors using shared logic (FailOnError for strict propagation)
-			if err := harnesses.ApplyDetectors(ctx, att, detectorList, harnesses.FailOnError); err != nil {
+			if err := harnesses.ApplyDetectors(ctx, att, detectorList, harnesses.FailOnError, 0); err != nil {
 				return err
 			}
 		}
@@ -36,7 +36,7 @@ type Batch struct {
 // New creates a new batch harness from configuration.
 func New(cfg registry.Config) (*Batch, error) {
 	b := &Batch{
-		concurrency: 10,              // Default concurrency
+		concurrency: 10,               // Default concurrency
 		timeout:     30 * time.Second, // Default timeout
 	}
 
@@ -136,9 +136,10 @@ func (b *Batch) Run(
 				if a.Generator == "" {
 					a.Generator = gen.Name()
 				}
+				harnesses.ApplyProbeGoalFor(a, p)
 
 				// Run detectors using shared logic (FailOnError routes to errs channel)
-				if err := harnesses.ApplyDetectors(ctx, a, detectorList, harnesses.FailOnError); err != nil {
+				if err := harnesses.ApplyDetectors(ctx, a, detectorList, harnesses.FailOnError, 0); err != nil {
 					errs <- err
 					return
 				}
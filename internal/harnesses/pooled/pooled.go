@@ -0,0 +1,262 @@
+// Package pooled provides the pooled harness implementation.
+//
+// Where probewise bounds how many probes run concurrently, pooled starts
+// every probe at once and instead bounds how many generator calls are in
+// flight at any moment, shared across all of them. This flattens every
+// probe's prompts into one global work pool: a probe with a handful of
+// prompts and a probe with hundreds draw from the same concurrency budget
+// call-by-call, so slots freed by the small probe don't sit idle waiting
+// for the large one to finish its own prompts serially, as can happen with
+// probewise's per-probe concurrency limit.
+package pooled
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/generators"
+	"github.com/praetorian-inc/augustus/pkg/harnesses"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/scanner"
+)
+
+// Errors returned by the pooled harness.
+var (
+	ErrNoProbes    = errors.New("no probes provided")
+	ErrNoDetectors = errors.New("no detectors provided")
+)
+
+// Pooled implements the attempt-pool harness strategy.
+//
+// It starts every probe concurrently with no per-probe concurrency limit,
+// and instead bounds concurrency at the generator-call level via
+// harnesses.PooledGenerator, then runs all detectors sequentially on all
+// probe attempts, same as probewise.
+type Pooled struct {
+	opts               *scanner.Options
+	promptCap          probes.SampleOptions
+	onAttemptProcessed func(*attempt.Attempt)
+	dedup              bool
+	dedupGen           *harnesses.DedupGenerator
+}
+
+// New creates a new pooled harness.
+func New() *Pooled {
+	return &Pooled{}
+}
+
+// Name returns the fully qualified harness name.
+func (p *Pooled) Name() string {
+	return "pooled.Pooled"
+}
+
+// Description returns a human-readable description.
+func (p *Pooled) Description() string {
+	return "Runs every probe concurrently against one shared generator-call pool, for fairer utilization than probewise when probes have very different prompt counts"
+}
+
+// CacheStats returns the dedup generator's cache-hit statistics for the scan
+// that just ran, or a zero value if --dedup wasn't enabled.
+func (p *Pooled) CacheStats() harnesses.DedupStats {
+	if p.dedupGen == nil {
+		return harnesses.DedupStats{}
+	}
+	return p.dedupGen.Stats()
+}
+
+// Run executes every probe concurrently against a shared generator-call pool.
+//
+// It validates inputs, then:
+//   - Runs all probes at once, each drawing from a shared generator-call pool
+//   - Applies all detectors to each resulting attempt
+//   - Marks attempts as complete
+//   - Calls the evaluator with accumulated attempts
+func (p *Pooled) Run(
+	ctx context.Context,
+	gen generators.Generator,
+	probeList []probes.Prober,
+	detectorList []detectors.Detector,
+	eval harnesses.Evaluator,
+) error {
+	// Validate inputs
+	if len(probeList) == 0 {
+		return ErrNoProbes
+	}
+	if len(detectorList) == 0 {
+		return ErrNoDetectors
+	}
+
+	// Check context cancellation early
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Give probes that implement harnesses.InlineScoring (tree search, PAIR)
+	// access to the scan's resolved detectors before they run.
+	harnesses.ApplyInlineScoring(probeList, detectorList)
+
+	// Apply the scan-wide prompt cap, if any, to every probe that supports it.
+	harnesses.ApplyPromptCap(probeList, p.promptCap)
+
+	opts := scanner.DefaultOptions()
+	if p.opts != nil {
+		opts = *p.opts
+	}
+
+	// The shared pool is bounded by the configured concurrency; the
+	// scanner itself is given room to start every probe at once, so probes
+	// never queue behind each other - only individual generator calls do.
+	poolLimit := opts.Concurrency
+	scannerOpts := opts
+	scannerOpts.Concurrency = len(probeList)
+	s := scanner.New(scannerOpts)
+
+	// Wire up progress logging to stderr
+	s.SetProgressCallback(func(probeName string, completed, total int, elapsed time.Duration, probeErr error) {
+		status, errMsg := harnesses.FormatProgressStatus(probeErr)
+		fmt.Fprintf(os.Stderr, "[%d/%d] %s %s%s (%s)\n",
+			completed, total, probeName, status, errMsg, elapsed.Round(time.Millisecond))
+	})
+
+	// Optionally dedup identical prompts across probes before they ever
+	// reach the shared pool, so cached hits don't consume a pool slot.
+	scanGen := gen
+	if p.dedup {
+		p.dedupGen = harnesses.NewDedupGenerator(scanGen)
+		scanGen = p.dedupGen
+	}
+	scanGen = harnesses.NewPooledGenerator(scanGen, poolLimit)
+	if opts.MaxContinuations > 0 {
+		scanGen = harnesses.NewTruncationGenerator(scanGen, opts.MaxContinuations)
+	}
+	if opts.AttemptTimeout > 0 {
+		scanGen = harnesses.NewTimeoutGenerator(scanGen, opts.AttemptTimeout)
+	}
+
+	results := s.Run(ctx, probeList, scanGen)
+	if p.dedup {
+		harnesses.AnnotateDuplicates(results.Attempts)
+	}
+
+	// Capture scanner-level errors but don't return yet - process partial results first.
+	scanErr := results.Error
+
+	// If scan context expired, create a fresh context for detection and evaluation.
+	evalCtx, evalCancel := harnesses.CreateFreshEvalContext(ctx)
+	defer evalCancel()
+	if results.Interrupted {
+		evalCtx = harnesses.WithInterrupted(evalCtx)
+	}
+	if len(results.IncompleteProbes) > 0 {
+		evalCtx = harnesses.WithIncompleteProbes(evalCtx, results.IncompleteProbes)
+	}
+
+	// If scanner failed with zero attempts, nothing to process
+	if scanErr != nil && len(results.Attempts) == 0 {
+		return fmt.Errorf("scan failed with no results: %w", scanErr)
+	}
+
+	// Apply detectors to all attempts and stream results. If any detector
+	// implements detectors.BatchDetector, it needs every pending attempt at
+	// once to batch its judge calls, so detection runs over the whole set
+	// together and streaming happens after; otherwise attempts stream as
+	// soon as each finishes detection.
+	if harnesses.HasBatchDetector(detectorList) {
+		if err := evalCtx.Err(); err != nil {
+			return err
+		}
+
+		for _, a := range results.Attempts {
+			if a.Generator == "" {
+				a.Generator = gen.Name()
+			}
+		}
+
+		if err := harnesses.ApplyDetectorsBatch(evalCtx, results.Attempts, detectorList, harnesses.SkipOnError, opts.DetectorTimeout); err != nil {
+			return err
+		}
+
+		for _, a := range results.Attempts {
+			if p.onAttemptProcessed != nil {
+				p.onAttemptProcessed(a)
+			}
+		}
+	} else {
+		for _, a := range results.Attempts {
+			if err := evalCtx.Err(); err != nil {
+				return err
+			}
+
+			if a.Generator == "" {
+				a.Generator = gen.Name()
+			}
+
+			if err := harnesses.ApplyDetectors(evalCtx, a, detectorList, harnesses.SkipOnError, opts.DetectorTimeout); err != nil {
+				return err
+			}
+
+			if p.onAttemptProcessed != nil {
+				p.onAttemptProcessed(a)
+			}
+		}
+	}
+
+	allAttempts := results.Attempts
+
+	// Call evaluator if provided (even with partial results)
+	if eval != nil && len(allAttempts) > 0 {
+		if err := eval.Evaluate(evalCtx, allAttempts); err != nil {
+			return fmt.Errorf("evaluation failed: %w", err)
+		}
+	}
+
+	// Report any scan errors (probe failures or scan-level errors)
+	return harnesses.ReportScanErrors(&results, scanErr, allAttempts)
+}
+
+// init registers the pooled harness with the global registry.
+func init() {
+	harnesses.Register("pooled.Pooled", func(cfg registry.Config) (harnesses.Harness, error) {
+		p := New()
+		// Extract scanner options if provided
+		if scannerOpts, ok := cfg["scanner_opts"].(*scanner.Options); ok {
+			p.opts = scannerOpts
+		}
+		// Extract a scan-wide prompt cap if provided
+		if promptCap, ok := cfg["prompt_cap"].(probes.SampleOptions); ok {
+			p.promptCap = promptCap
+		}
+		// Extract streaming callback if provided
+		if cb, ok := cfg["on_attempt_processed"].(func(*attempt.Attempt)); ok {
+			p.onAttemptProcessed = cb
+		}
+		// Extract the optional cross-probe dedup flag
+		if dedup, ok := cfg["dedup"].(bool); ok {
+			p.dedup = dedup
+		}
+		return p, nil
+	})
+}
+
+// Registry helper functions for package-level access.
+
+// List returns all registered harness names.
+func List() []string {
+	return harnesses.List()
+}
+
+// Get retrieves a harness factory by name.
+func Get(name string) (func(registry.Config) (harnesses.Harness, error), bool) {
+	return harnesses.Get(name)
+}
+
+// Create instantiates a harness by name.
+func Create(name string, cfg registry.Config) (harnesses.Harness, error) {
+	return harnesses.Create(name, cfg)
+}
@@ -0,0 +1,184 @@
+// Package pooled provides the pooled harness implementation.
+package pooled
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/harnesses"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/scanner"
+)
+
+// --- Mock Implementations ---
+
+// mockGenerator implements generators.Generator for testing. It tracks peak
+// concurrent Generate calls, used to verify the shared pool's limit holds
+// across probes.
+type mockGenerator struct {
+	name  string
+	delay time.Duration
+
+	mu           sync.Mutex
+	inFlight     int
+	peakInFlight int
+}
+
+func (m *mockGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	m.mu.Lock()
+	m.inFlight++
+	if m.inFlight > m.peakInFlight {
+		m.peakInFlight = m.inFlight
+	}
+	m.mu.Unlock()
+
+	if m.delay > 0 {
+		time.Sleep(m.delay)
+	}
+
+	m.mu.Lock()
+	m.inFlight--
+	m.mu.Unlock()
+
+	return []attempt.Message{{Role: attempt.RoleAssistant, Content: "response"}}, nil
+}
+
+func (m *mockGenerator) ClearHistory() {}
+func (m *mockGenerator) Name() string  { return m.name }
+func (m *mockGenerator) Description() string {
+	return "mock generator for testing"
+}
+
+func (m *mockGenerator) PeakInFlight() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.peakInFlight
+}
+
+// mockProbe implements probes.Prober for testing.
+type mockProbe struct {
+	name    string
+	prompts []string
+}
+
+func (m *mockProbe) Probe(ctx context.Context, gen probes.Generator) ([]*attempt.Attempt, error) {
+	attempts := make([]*attempt.Attempt, 0, len(m.prompts))
+	for _, prompt := range m.prompts {
+		a := attempt.New(prompt)
+		a.Probe = m.name
+		conv := attempt.NewConversation()
+		conv.AddPrompt(prompt)
+		messages, err := gen.Generate(ctx, conv, 1)
+		if err != nil {
+			a.SetError(err)
+		} else {
+			for _, msg := range messages {
+				a.AddOutput(msg.Content)
+			}
+		}
+		attempts = append(attempts, a)
+	}
+	return attempts, nil
+}
+
+func (m *mockProbe) Name() string { return m.name }
+
+// mockDetector implements detectors.Detector for testing.
+type mockDetector struct {
+	name string
+}
+
+func (m *mockDetector) Detect(ctx context.Context, a *attempt.Attempt) ([]float64, error) {
+	return make([]float64, len(a.Outputs)), nil
+}
+
+func (m *mockDetector) Name() string        { return m.name }
+func (m *mockDetector) Description() string { return "mock detector for testing" }
+
+// mockEvaluator implements harnesses.Evaluator for testing.
+type mockEvaluator struct {
+	attempts []*attempt.Attempt
+}
+
+func (m *mockEvaluator) Evaluate(ctx context.Context, attempts []*attempt.Attempt) error {
+	m.attempts = attempts
+	return nil
+}
+
+// --- Tests ---
+
+func TestNew(t *testing.T) {
+	h := New()
+	require.NotNil(t, h)
+	assert.Equal(t, "pooled.Pooled", h.Name())
+	assert.NotEmpty(t, h.Description())
+}
+
+func TestPooled_Run_NoProbes(t *testing.T) {
+	h := New()
+	err := h.Run(context.Background(), &mockGenerator{name: "test.Mock"}, nil, []detectors.Detector{&mockDetector{name: "det"}}, nil)
+	assert.ErrorIs(t, err, ErrNoProbes)
+}
+
+func TestPooled_Run_NoDetectors(t *testing.T) {
+	h := New()
+	probe := &mockProbe{name: "test.Probe", prompts: []string{"p"}}
+	err := h.Run(context.Background(), &mockGenerator{name: "test.Mock"}, []probes.Prober{probe}, nil, nil)
+	assert.ErrorIs(t, err, ErrNoDetectors)
+}
+
+func TestPooled_Run_BasicFlow(t *testing.T) {
+	gen := &mockGenerator{name: "test.Mock"}
+	probe := &mockProbe{name: "test.Probe", prompts: []string{"p1", "p2"}}
+	detector := &mockDetector{name: "det"}
+	eval := &mockEvaluator{}
+
+	h := New()
+	err := h.Run(context.Background(), gen, []probes.Prober{probe}, []detectors.Detector{detector}, eval)
+	require.NoError(t, err)
+	require.Len(t, eval.attempts, 2)
+	for _, a := range eval.attempts {
+		assert.Equal(t, attempt.StatusComplete, a.Status)
+		assert.Contains(t, a.DetectorResults, "det")
+	}
+}
+
+// TestPooled_Run_SharedConcurrencyLimit exercises the core claim of the
+// pooled harness: probes with wildly different prompt counts draw from one
+// shared pool, so peak concurrent generator calls never exceeds the
+// configured limit even though many more probes than that limit are
+// started at once.
+func TestPooled_Run_SharedConcurrencyLimit(t *testing.T) {
+	gen := &mockGenerator{name: "test.Mock", delay: 10 * time.Millisecond}
+
+	// One probe with many prompts, several probes with a single prompt -
+	// exactly the imbalance pooled is meant to handle well.
+	probeList := []probes.Prober{
+		&mockProbe{name: "test.Big", prompts: []string{"p1", "p2", "p3", "p4", "p5", "p6"}},
+	}
+	for i := 0; i < 5; i++ {
+		probeList = append(probeList, &mockProbe{name: "test.Small", prompts: []string{"p"}})
+	}
+
+	detector := &mockDetector{name: "det"}
+	eval := &mockEvaluator{}
+
+	opts := scanner.DefaultOptions()
+	opts.Concurrency = 3
+	h := New()
+	h.opts = &opts
+
+	err := h.Run(context.Background(), gen, probeList, []detectors.Detector{detector}, eval)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, gen.PeakInFlight(), 3, "shared pool must not exceed the configured concurrency limit")
+	assert.Len(t, eval.attempts, 11, "6 + 5*1 = 11 attempts total")
+}
+
+var _ harnesses.Evaluator = (*mockEvaluator)(nil)
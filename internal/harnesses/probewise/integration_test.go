@@ -218,3 +218,85 @@ func TestIntegration_BlankGenerator(t *testing.T) {
 	a := eval.attempts[0]
 	assert.Equal(t, attempt.StatusComplete, a.Status)
 }
+
+// countingHook implements every harnesses.Hook stage and just counts calls,
+// so the integration test below can confirm probewise invokes each stage
+// against the real scan pipeline.
+type countingHook struct {
+	preProbe, postProbe, preAttempt, postAttempt int
+}
+
+func (h *countingHook) Name() string { return "test.Counting" }
+func (h *countingHook) PreProbe(_ context.Context, _ probes.Prober) error {
+	h.preProbe++
+	return nil
+}
+func (h *countingHook) PostProbe(_ context.Context, _ probes.Prober, _ []*attempt.Attempt) error {
+	h.postProbe++
+	return nil
+}
+func (h *countingHook) PreAttempt(_ context.Context, _ *attempt.Attempt) error {
+	h.preAttempt++
+	return nil
+}
+func (h *countingHook) PostAttempt(_ context.Context, _ *attempt.Attempt) error {
+	h.postAttempt++
+	return nil
+}
+
+// TestIntegration_Hooks verifies that hooks configured on the harness run at
+// every stage of a real scan.
+func TestIntegration_Hooks(t *testing.T) {
+	ctx := context.Background()
+
+	gen, err := generators.Create("test.Repeat", nil)
+	require.NoError(t, err)
+
+	probe, err := probes.Create("test.Blank", nil)
+	require.NoError(t, err)
+
+	detector, err := detectors.Create("always.Pass", nil)
+	require.NoError(t, err)
+
+	hook := &countingHook{}
+	h, err := Create("probewise.Probewise", map[string]any{"hooks": []harnesses.Hook{hook}})
+	require.NoError(t, err)
+
+	eval := &testEvaluator{}
+	err = h.Run(ctx, gen, []probes.Prober{probe}, []detectors.Detector{detector}, eval)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, hook.preProbe)
+	assert.Equal(t, 1, hook.postProbe)
+	assert.Equal(t, 1, hook.preAttempt)
+	assert.Equal(t, 1, hook.postAttempt)
+}
+
+// TestIntegration_Hooks_PreProbeVeto verifies that a PreProbeHook returning
+// an error removes the probe from the scan entirely.
+func TestIntegration_Hooks_PreProbeVeto(t *testing.T) {
+	ctx := context.Background()
+
+	gen, err := generators.Create("test.Repeat", nil)
+	require.NoError(t, err)
+
+	probe, err := probes.Create("test.Blank", nil)
+	require.NoError(t, err)
+
+	detector, err := detectors.Create("always.Pass", nil)
+	require.NoError(t, err)
+
+	hook := &vetoingHook{}
+	h, err := Create("probewise.Probewise", map[string]any{"hooks": []harnesses.Hook{hook}})
+	require.NoError(t, err)
+
+	err = h.Run(ctx, gen, []probes.Prober{probe}, []detectors.Detector{detector}, &testEvaluator{})
+	require.ErrorIs(t, err, ErrNoProbes)
+}
+
+type vetoingHook struct{}
+
+func (h *vetoingHook) Name() string { return "test.Vetoing" }
+func (h *vetoingHook) PreProbe(_ context.Context, _ probes.Prober) error {
+	return assert.AnError
+}
@@ -10,7 +10,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log/slog"
 	"os"
 	"time"
 
@@ -37,9 +36,13 @@ var (
 // 3. Stores detector results in the attempt
 // 4. Marks the attempt as complete
 // 5. Calls the evaluator with all attempts
-type Probewise struct{
+type Probewise struct {
 	opts               *scanner.Options
+	promptCap          probes.SampleOptions
 	onAttemptProcessed func(*attempt.Attempt)
+	dedup              bool
+	dedupGen           *harnesses.DedupGenerator
+	hooks              []harnesses.Hook
 }
 
 // New creates a new probewise harness.
@@ -57,48 +60,13 @@ func (p *Probewise) Description() string {
 	return "Executes probes one at a time, running detectors on each probe's attempts"
 }
 
-// formatProgressStatus formats the progress status symbol and error message.
-// Returns "✓" with empty error message on success, or "✗" with formatted error on failure.
-func formatProgressStatus(probeErr error) (status, errMsg string) {
-	if probeErr == nil {
-		return "✓", ""
+// CacheStats returns the dedup generator's cache-hit statistics for the scan
+// that just ran, or a zero value if --dedup wasn't enabled.
+func (p *Probewise) CacheStats() harnesses.DedupStats {
+	if p.dedupGen == nil {
+		return harnesses.DedupStats{}
 	}
-	msg := probeErr.Error()
-	if len(msg) > 80 {
-		msg = msg[:77] + "..."
-	}
-	return "✗", fmt.Sprintf(" (%s)", msg)
-}
-
-// createFreshEvalContext creates a fresh evaluation context if the scan context has expired.
-// If scanCtx is still valid, returns it unchanged. Otherwise, creates a new context with 5-minute timeout.
-func createFreshEvalContext(scanCtx context.Context) (context.Context, context.CancelFunc) {
-	if scanCtx.Err() == nil {
-		return scanCtx, func() {}
-	}
-	return context.WithTimeout(context.Background(), 5*time.Minute)
-}
-
-// reportScanErrors checks for probe failures and scan-level errors and returns appropriate error.
-// Returns nil if no errors occurred.
-func reportScanErrors(results *scanner.Results, scanErr error, allAttempts []*attempt.Attempt) error {
-	// Check for probe failures first
-	if len(results.Errors) > 0 {
-		// Log each probe error
-		for _, err := range results.Errors {
-			slog.Error("probe failed", "error", err)
-		}
-		// Return error indicating how many probes failed
-		return fmt.Errorf("%d of %d probes failed", results.Failed, results.Total)
-	}
-
-	// Check for scan-level errors (e.g., timeout)
-	if scanErr != nil {
-		return fmt.Errorf("scan interrupted after processing %d/%d probes (%d attempts): %w",
-			results.Succeeded, results.Total, len(allAttempts), scanErr)
-	}
-
-	return nil
+	return p.dedupGen.Stats()
 }
 
 // Run executes the probe-by-probe scan workflow.
@@ -128,6 +96,25 @@ func (p *Probewise) Run(
 		return err
 	}
 
+	// Give probes that implement harnesses.InlineScoring (tree search, PAIR)
+	// access to the scan's resolved detectors before they run.
+	harnesses.ApplyInlineScoring(probeList, detectorList)
+
+	// Apply the scan-wide prompt cap, if any, to every probe that supports it.
+	harnesses.ApplyPromptCap(probeList, p.promptCap)
+
+	// Let any registered PreProbeHook veto probes (e.g. a budget hook that
+	// has run out of attempts to spend) before they're handed to the
+	// scanner.
+	probeList = harnesses.RunPreProbe(ctx, p.hooks, probeList)
+	if len(probeList) == 0 {
+		return ErrNoProbes
+	}
+	proberByName := make(map[string]probes.Prober, len(probeList))
+	for _, pr := range probeList {
+		proberByName[pr.Name()] = pr
+	}
+
 	// Use scanner for concurrent probe execution
 	opts := scanner.DefaultOptions()
 	if p.opts != nil {
@@ -137,12 +124,44 @@ func (p *Probewise) Run(
 
 	// Wire up progress logging to stderr
 	s.SetProgressCallback(func(probeName string, completed, total int, elapsed time.Duration, probeErr error) {
-		status, errMsg := formatProgressStatus(probeErr)
+		status, errMsg := harnesses.FormatProgressStatus(probeErr)
 		fmt.Fprintf(os.Stderr, "[%d/%d] %s %s%s (%s)\n",
 			completed, total, probeName, status, errMsg, elapsed.Round(time.Millisecond))
 	})
 
-	results := s.Run(ctx, probeList, gen)
+	// Optionally dedup identical prompts across probes (e.g. --all runs
+	// where many probes share "ignore previous instructions" variants) so
+	// duplicates reuse the first response instead of re-calling the
+	// generator.
+	scanGen := gen
+	if p.dedup {
+		p.dedupGen = harnesses.NewDedupGenerator(gen)
+		scanGen = p.dedupGen
+	}
+	if opts.MaxContinuations > 0 {
+		scanGen = harnesses.NewTruncationGenerator(scanGen, opts.MaxContinuations)
+	}
+	if opts.AttemptTimeout > 0 {
+		scanGen = harnesses.NewTimeoutGenerator(scanGen, opts.AttemptTimeout)
+	}
+
+	results := s.Run(ctx, probeList, scanGen)
+	if p.dedup {
+		harnesses.AnnotateDuplicates(results.Attempts)
+	}
+	harnesses.ApplyProbeGoal(results.Attempts, proberByName)
+
+	if len(p.hooks) > 0 {
+		attemptsByProbe := make(map[string][]*attempt.Attempt)
+		for _, a := range results.Attempts {
+			attemptsByProbe[a.Probe] = append(attemptsByProbe[a.Probe], a)
+		}
+		for probeName, probeAttempts := range attemptsByProbe {
+			if pr, ok := proberByName[probeName]; ok {
+				harnesses.RunPostProbe(ctx, p.hooks, pr, probeAttempts)
+			}
+		}
+	}
 
 	// Capture scanner-level errors but don't return yet - process partial results first.
 	// When scan times out, completed probes have their attempts in results.Attempts.
@@ -150,8 +169,14 @@ func (p *Probewise) Run(
 
 	// If scan context expired, create a fresh context for detection and evaluation.
 	// Detection and evaluation are fast operations that should always complete.
-	evalCtx, evalCancel := createFreshEvalContext(ctx)
+	evalCtx, evalCancel := harnesses.CreateFreshEvalContext(ctx)
 	defer evalCancel()
+	if results.Interrupted {
+		evalCtx = harnesses.WithInterrupted(evalCtx)
+	}
+	if len(results.IncompleteProbes) > 0 {
+		evalCtx = harnesses.WithIncompleteProbes(evalCtx, results.IncompleteProbes)
+	}
 
 	// If scanner failed with zero attempts, nothing to process
 	if scanErr != nil && len(results.Attempts) == 0 {
@@ -161,26 +186,73 @@ func (p *Probewise) Run(
 	// Continue processing successful attempts even if some probes failed.
 	// We'll report probe errors at the end, after processing partial results.
 
-	// Apply detectors to all attempts and stream results
-	for _, a := range results.Attempts {
-		// Check context cancellation between attempts
+	// Apply detectors to all attempts and stream results.
+	//
+	// When every detector scores one attempt at a time, attempts are
+	// streamed to onAttemptProcessed as soon as each finishes detection. If
+	// any detector implements detectors.BatchDetector, it needs the whole
+	// set of pending attempts at once to batch its judge calls, so detection
+	// runs over all attempts together and streaming happens after.
+	if harnesses.HasBatchDetector(detectorList) {
 		if err := evalCtx.Err(); err != nil {
 			return err
 		}
 
-		// Set the generator name if not already set
-		if a.Generator == "" {
-			a.Generator = gen.Name()
+		for _, a := range results.Attempts {
+			if a.Generator == "" {
+				a.Generator = gen.Name()
+			}
+			// Batch detectors score the whole set together, so a
+			// PreAttemptHook can't veto individual attempts out of the
+			// batch; it still runs, purely for its side effects (logging,
+			// budget tracking).
+			_ = harnesses.RunPreAttempt(evalCtx, p.hooks, a)
 		}
 
-		// Run detectors using shared logic (SkipOnError for partial results)
-		if err := harnesses.ApplyDetectors(evalCtx, a, detectorList, harnesses.SkipOnError); err != nil {
+		if err := harnesses.ApplyDetectorsBatch(evalCtx, results.Attempts, detectorList, harnesses.SkipOnError, opts.DetectorTimeout); err != nil {
 			return err
 		}
 
-		// Stream result immediately after detection
-		if p.onAttemptProcessed != nil {
-			p.onAttemptProcessed(a)
+		for _, a := range results.Attempts {
+			harnesses.RunPostAttempt(evalCtx, p.hooks, a)
+			if p.onAttemptProcessed != nil {
+				p.onAttemptProcessed(a)
+			}
+		}
+	} else {
+		for _, a := range results.Attempts {
+			// Check context cancellation between attempts
+			if err := evalCtx.Err(); err != nil {
+				return err
+			}
+
+			// Set the generator name if not already set
+			if a.Generator == "" {
+				a.Generator = gen.Name()
+			}
+
+			// Let any registered PreAttemptHook veto detection for this
+			// attempt (e.g. a budget hook that has run out of detector
+			// calls to spend).
+			if err := harnesses.RunPreAttempt(evalCtx, p.hooks, a); err != nil {
+				a.SetError(err)
+				if p.onAttemptProcessed != nil {
+					p.onAttemptProcessed(a)
+				}
+				continue
+			}
+
+			// Run detectors using shared logic (SkipOnError for partial results)
+			if err := harnesses.ApplyDetectors(evalCtx, a, detectorList, harnesses.SkipOnError, opts.DetectorTimeout); err != nil {
+				return err
+			}
+
+			harnesses.RunPostAttempt(evalCtx, p.hooks, a)
+
+			// Stream result immediately after detection
+			if p.onAttemptProcessed != nil {
+				p.onAttemptProcessed(a)
+			}
 		}
 	}
 
@@ -194,7 +266,7 @@ func (p *Probewise) Run(
 	}
 
 	// Report any scan errors (probe failures or scan-level errors)
-	return reportScanErrors(&results, scanErr, allAttempts)
+	return harnesses.ReportScanErrors(&results, scanErr, allAttempts)
 }
 
 // init registers the probewise harness with the global registry.
@@ -205,10 +277,23 @@ func init() {
 		if scannerOpts, ok := cfg["scanner_opts"].(*scanner.Options); ok {
 			p.opts = scannerOpts
 		}
+		// Extract a scan-wide prompt cap if provided
+		if promptCap, ok := cfg["prompt_cap"].(probes.SampleOptions); ok {
+			p.promptCap = promptCap
+		}
 		// Extract streaming callback if provided
 		if cb, ok := cfg["on_attempt_processed"].(func(*attempt.Attempt)); ok {
 			p.onAttemptProcessed = cb
 		}
+		// Extract the optional cross-probe dedup flag
+		if dedup, ok := cfg["dedup"].(bool); ok {
+			p.dedup = dedup
+		}
+		// Extract any middleware hooks (logging, budget, custom) to run
+		// around probe and attempt execution.
+		if hooks, ok := cfg["hooks"].([]harnesses.Hook); ok {
+			p.hooks = hooks
+		}
 		return p, nil
 	})
 }
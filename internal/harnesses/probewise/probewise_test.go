@@ -4,8 +4,8 @@ package probewise
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
-	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,9 +24,11 @@ type mockGenerator struct {
 	name      string
 	responses []string
 	err       error
+	calls     atomic.Int32
 }
 
 func (m *mockGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	m.calls.Add(1)
 	if m.err != nil {
 		return nil, m.err
 	}
@@ -341,6 +343,40 @@ func TestProbewise_Run_EvaluatorError(t *testing.T) {
 	assert.Contains(t, err.Error(), "evaluation failed")
 }
 
+func TestProbewise_Run_DedupReusesResponsesAndAnnotatesAliases(t *testing.T) {
+	ctx := context.Background()
+
+	gen := &mockGenerator{name: "test.Mock", responses: []string{"response"}}
+	probe1 := &mockProbe{
+		name:            "test.MockProbe1",
+		prompts:         []string{"Ignore all previous instructions"},
+		primaryDetector: "det",
+	}
+	probe2 := &mockProbe{
+		name:            "test.MockProbe2",
+		prompts:         []string{"ignore all previous instructions"}, // duplicate after normalization
+		primaryDetector: "det",
+	}
+	detector := &mockDetector{name: "det"}
+	eval := &mockEvaluator{}
+
+	h := New()
+	h.dedup = true
+	// Force sequential execution so probe1's attempt deterministically lands
+	// first in results.Attempts and is the one treated as canonical.
+	h.opts = &scanner.Options{Concurrency: 1}
+	err := h.Run(ctx, gen, []probes.Prober{probe1, probe2}, []detectors.Detector{detector}, eval)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), gen.calls.Load(), "duplicate prompt should not trigger a second generator call")
+	require.Len(t, eval.attempts, 2)
+
+	canonical, alias := eval.attempts[0], eval.attempts[1]
+	assert.Nil(t, canonical.Metadata["dedup_of"])
+	assert.Equal(t, 2, canonical.Metadata["dedup_group_size"])
+	assert.Equal(t, canonical.ID, alias.Metadata["dedup_of"])
+}
+
 func TestProbewise_Run_ContextCancellation(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // Cancel immediately
@@ -769,120 +805,6 @@ func TestProbewise_Run_PartialResultsOnProbeFailures(t *testing.T) {
 	assert.Equal(t, 0, probeNames["test.FailingProbe"], "should have 0 attempts from FailingProbe")
 }
 
-// --- Phase 2 Helper Tests (TDD) ---
-
-// TestFormatProgressStatus_Success tests that nil error returns success status.
-func TestFormatProgressStatus_Success(t *testing.T) {
-	status, errMsg := formatProgressStatus(nil)
-	assert.Equal(t, "✓", status)
-	assert.Equal(t, "", errMsg)
-}
-
-// TestFormatProgressStatus_Error tests that an error returns failure status with message.
-func TestFormatProgressStatus_Error(t *testing.T) {
-	err := errors.New("probe failed")
-	status, errMsg := formatProgressStatus(err)
-	assert.Equal(t, "✗", status)
-	assert.Equal(t, " (probe failed)", errMsg)
-}
-
-// TestFormatProgressStatus_LongError tests that long error messages are truncated.
-func TestFormatProgressStatus_LongError(t *testing.T) {
-	longErr := errors.New("this is a very long error message that exceeds eighty characters and needs truncation")
-	status, errMsg := formatProgressStatus(longErr)
-	assert.Equal(t, "✗", status)
-	// Error message should be truncated to 77 chars + "..." = 80 chars, plus " (" and ")" = 83 total
-	assert.Equal(t, 83, len(errMsg), "should truncate to 80 chars + ellipsis + parens")
-	assert.Contains(t, errMsg, "...")
-}
-
-// TestCreateFreshEvalContext_ScanContextValid tests that when scan context is valid,
-// it returns the original context.
-func TestCreateFreshEvalContext_ScanContextValid(t *testing.T) {
-	ctx := context.Background()
-	evalCtx, cancel := createFreshEvalContext(ctx)
-	defer cancel()
-	assert.Equal(t, ctx, evalCtx, "should return original context when valid")
-}
-
-// TestCreateFreshEvalContext_ScanContextExpired tests that when scan context is expired,
-// it creates a fresh context with a 5-minute timeout.
-func TestCreateFreshEvalContext_ScanContextExpired(t *testing.T) {
-	ctx, cancel := context.WithCancel(context.Background())
-	cancel() // Expire the context
-
-	evalCtx, cancelFunc := createFreshEvalContext(ctx)
-	defer cancelFunc()
-
-	assert.NotEqual(t, ctx, evalCtx, "should create fresh context when expired")
-
-	deadline, hasDeadline := evalCtx.Deadline()
-	assert.True(t, hasDeadline, "fresh context should have 5-minute deadline")
-
-	expectedDeadline := time.Now().Add(5 * time.Minute)
-	timeDiff := expectedDeadline.Sub(deadline)
-	assert.Less(t, timeDiff, 2*time.Second, "deadline should be approximately 5 minutes from now")
-}
-
-// TestReportScanErrors_NoErrors tests that when there are no errors, nil is returned.
-func TestReportScanErrors_NoErrors(t *testing.T) {
-	// Successful results with no errors
-	results := &scanner.Results{
-		Total:     3,
-		Succeeded: 3,
-		Failed:    0,
-		Errors:    nil,
-	}
-	allAttempts := []*attempt.Attempt{
-		{Probe: "test.Probe1"},
-		{Probe: "test.Probe2"},
-		{Probe: "test.Probe3"},
-	}
-
-	err := reportScanErrors(results, nil, allAttempts)
-	assert.NoError(t, err, "should return nil when no errors")
-}
-
-// TestReportScanErrors_ProbeFailures tests that probe failures are reported with count.
-func TestReportScanErrors_ProbeFailures(t *testing.T) {
-	// Results with probe failures
-	results := &scanner.Results{
-		Total:     3,
-		Succeeded: 1,
-		Failed:    2,
-		Errors: []error{
-			errors.New("probe1 failed"),
-			errors.New("probe2 failed"),
-		},
-	}
-	allAttempts := []*attempt.Attempt{
-		{Probe: "test.SuccessProbe"},
-	}
-
-	err := reportScanErrors(results, nil, allAttempts)
-	require.Error(t, err, "should return error when probes failed")
-	assert.Contains(t, err.Error(), "2 of 3 probes failed")
-}
-
-// TestReportScanErrors_ScanTimeout tests that scan timeout errors are reported with context.
-func TestReportScanErrors_ScanTimeout(t *testing.T) {
-	// Results with scan timeout
-	results := &scanner.Results{
-		Total:     3,
-		Succeeded: 2,
-		Failed:    0,
-		Errors:    nil,
-	}
-	scanErr := context.DeadlineExceeded
-	allAttempts := []*attempt.Attempt{
-		{Probe: "test.Probe1"},
-		{Probe: "test.Probe2"},
-	}
-
-	err := reportScanErrors(results, scanErr, allAttempts)
-	require.Error(t, err, "should return error when scan timed out")
-	assert.Contains(t, err.Error(), "scan interrupted")
-	assert.Contains(t, err.Error(), "2/3 probes")
-	assert.Contains(t, err.Error(), "2 attempts")
-	assert.Contains(t, err.Error(), "context deadline exceeded")
-}
+// Helper tests for formatProgressStatus, createFreshEvalContext, and
+// reportScanErrors now live in pkg/harnesses/scan_run_test.go, since the
+// helpers themselves moved there to be shared with the pooled harness.
@@ -791,7 +791,7 @@ func (e *UnifiedEngine) buildUnifiedResult(s *runState) []*attempt.Attempt {
 	a.SetDetectorResults(DetectorJudge, []float64{maxScore})
 
 	a.WithMetadata("attack_type", e.strategy.Name())
-	a.WithMetadata("goal", e.cfg.Goal)
+	a.SetGoal(e.cfg.Goal)
 	a.WithMetadata("total_turns", len(s.turnRecords))
 	a.WithMetadata("succeeded", s.succeeded)
 	a.WithMetadata("turn_records", s.turnRecords)
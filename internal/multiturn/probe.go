@@ -5,6 +5,7 @@ import (
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/types"
 )
 
 // BaseMultiTurnProbe provides shared Prober implementation for all multi-turn probes.
@@ -34,3 +35,7 @@ func (b *BaseMultiTurnProbe) Description() string        { return b.ProbeDesc }
 func (b *BaseMultiTurnProbe) Goal() string               { return b.ProbeGoal }
 func (b *BaseMultiTurnProbe) GetPrimaryDetector() string { return DetectorJudge }
 func (b *BaseMultiTurnProbe) GetPrompts() []string       { return []string{} }
+
+// Requires implements types.ProbeRequirements: every multi-turn probe needs
+// a generator able to carry conversation state across turns.
+func (b *BaseMultiTurnProbe) Requires() []string { return []string{types.CapabilityMultiTurn} }
@@ -77,6 +77,7 @@ var keyTypes = []string{
 	"Twitter Secret Key",
 	"Zapier Webhook",
 	"Zoho Webhook Token",
+	"Json Web Token",
 }
 
 // KeyTypes returns the list of all supported API key types.
@@ -136,10 +136,9 @@ func TestGetKey_Prompts_Count(t *testing.T) {
 
 	prompts := pm.GetPrompts()
 
-	// Python has 58 key types from DORA_REGEXES (one prompt per key type)
-	// The exact count matches the number of keys in DORA_REGEXES
-	assert.Equal(t, 58, len(prompts),
-		"should have exactly 58 prompts (one per key type)")
+	// One prompt per key type.
+	assert.Equal(t, len(keyTypes), len(prompts),
+		"should have exactly len(keyTypes) prompts (one per key type)")
 }
 
 func TestGetKey_Probe_ReturnsAttempts(t *testing.T) {
@@ -195,7 +194,7 @@ func TestGetKey_KeyTypes(t *testing.T) {
 	// Test the KeyTypes function returns the expected list
 	keyTypes := KeyTypes()
 	assert.NotEmpty(t, keyTypes)
-	assert.Equal(t, 58, len(keyTypes), "should have 58 key types")
+	assert.Equal(t, 59, len(keyTypes), "should have 59 key types")
 
 	// Verify a few specific key types are present
 	found := make(map[string]bool)
@@ -206,6 +205,7 @@ func TestGetKey_KeyTypes(t *testing.T) {
 	assert.True(t, found["Amazon Mws Auth Token"])
 	assert.True(t, found["Aws Access Key"])
 	assert.True(t, found["Google Api Key"])
+	assert.True(t, found["Json Web Token"])
 }
 
 func TestCompleteKey_Registration(t *testing.T) {
@@ -282,3 +282,13 @@ func (p *AutoDANProbe) GetPrimaryDetector() string {
 func (p *AutoDANProbe) GetPrompts() []string {
 	return p.population
 }
+
+// EstimatedAttempts implements types.EstimatedAttemptCounter. Each of the
+// p.generations evolution rounds scores the whole population against the
+// target (evaluatePopulation), and createFinalAttempts scores it once more,
+// so the upper bound is the population size times generations+1 - unlike
+// GetPrompts(), which only reflects whichever generation happens to be
+// current when it's called.
+func (p *AutoDANProbe) EstimatedAttempts() int {
+	return (p.generations + 1) * len(p.population)
+}
@@ -0,0 +1,192 @@
+package avspamscanning
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/internal/testutil"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// =============================================================================
+// Shared indirection helpers
+// =============================================================================
+
+func TestChunkString_ReassemblesOriginal(t *testing.T) {
+	chunks := chunkString(EICARSignature, 3)
+	require.Len(t, chunks, 3)
+	assert.Equal(t, EICARSignature, strings.Join(chunks, ""))
+}
+
+func TestGenerateIndirectPrompts_Count(t *testing.T) {
+	prompts := generateIndirectPrompts("TEST_SIGNATURE")
+	assert.Equal(t, len(base64Templates)+len(chunkedTemplates), len(prompts))
+}
+
+func TestGenerateIndirectPrompts_Base64PromptsDecodeToSignature(t *testing.T) {
+	signature := "TEST_SIGNATURE_XYZ"
+	encoded := base64.StdEncoding.EncodeToString([]byte(signature))
+	prompts := generateIndirectPrompts(signature)
+
+	for i := range base64Templates {
+		assert.Contains(t, prompts[i], encoded, "base64 prompt %d should contain the encoded signature", i)
+		assert.NotContains(t, prompts[i], signature, "base64 prompt %d should not contain the raw signature", i)
+	}
+}
+
+func TestGenerateIndirectPrompts_ChunkedPromptsReassembleToSignature(t *testing.T) {
+	signature := "TEST_SIGNATURE_XYZ"
+	chunks := chunkString(signature, 3)
+	prompts := generateIndirectPrompts(signature)
+
+	for i := len(base64Templates); i < len(prompts); i++ {
+		for _, chunk := range chunks {
+			assert.Contains(t, prompts[i], chunk, "chunked prompt %d should contain fragment %q", i, chunk)
+		}
+		assert.NotContains(t, prompts[i], signature, "chunked prompt %d should not contain the raw signature", i)
+	}
+}
+
+// =============================================================================
+// EICARIndirect Probe Tests
+// =============================================================================
+
+func TestEICARIndirect_Registration(t *testing.T) {
+	factory, ok := probes.Get("avspamscanning.EICARIndirect")
+	require.True(t, ok, "avspamscanning.EICARIndirect should be registered")
+	require.NotNil(t, factory)
+}
+
+func TestEICARIndirect_PrimaryDetector(t *testing.T) {
+	p, err := probes.Create("avspamscanning.EICARIndirect", nil)
+	require.NoError(t, err)
+
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+	assert.Equal(t, "knownbadsignatures.EICAR", pm.GetPrimaryDetector())
+}
+
+func TestEICARIndirect_Probe_ReturnsAttempts(t *testing.T) {
+	p, err := probes.Create("avspamscanning.EICARIndirect", nil)
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("test response")
+	attempts, err := p.Probe(context.Background(), gen)
+	require.NoError(t, err)
+
+	assert.Len(t, attempts, len(base64Templates)+len(chunkedTemplates))
+	for _, a := range attempts {
+		assert.Equal(t, "avspamscanning.EICARIndirect", a.Probe)
+		assert.Equal(t, "knownbadsignatures.EICAR", a.Detector)
+	}
+}
+
+func TestNewEICARIndirect_WithEmptyConfig(t *testing.T) {
+	p, err := NewEICARIndirect(registry.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, p)
+}
+
+// =============================================================================
+// GTUBEIndirect Probe Tests
+// =============================================================================
+
+func TestGTUBEIndirect_Registration(t *testing.T) {
+	factory, ok := probes.Get("avspamscanning.GTUBEIndirect")
+	require.True(t, ok, "avspamscanning.GTUBEIndirect should be registered")
+	require.NotNil(t, factory)
+}
+
+func TestGTUBEIndirect_PrimaryDetector(t *testing.T) {
+	p, err := probes.Create("avspamscanning.GTUBEIndirect", nil)
+	require.NoError(t, err)
+
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+	assert.Equal(t, "knownbadsignatures.GTUBE", pm.GetPrimaryDetector())
+}
+
+func TestGTUBEIndirect_Probe_ReturnsAttempts(t *testing.T) {
+	p, err := probes.Create("avspamscanning.GTUBEIndirect", nil)
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("test response")
+	attempts, err := p.Probe(context.Background(), gen)
+	require.NoError(t, err)
+
+	assert.Len(t, attempts, len(base64Templates)+len(chunkedTemplates))
+	for _, a := range attempts {
+		assert.Equal(t, "avspamscanning.GTUBEIndirect", a.Probe)
+		assert.Equal(t, "knownbadsignatures.GTUBE", a.Detector)
+	}
+}
+
+func TestNewGTUBEIndirect_WithEmptyConfig(t *testing.T) {
+	p, err := NewGTUBEIndirect(registry.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, p)
+}
+
+// =============================================================================
+// GTphishIndirect Probe Tests
+// =============================================================================
+
+func TestGTphishIndirect_Registration(t *testing.T) {
+	factory, ok := probes.Get("avspamscanning.GTphishIndirect")
+	require.True(t, ok, "avspamscanning.GTphishIndirect should be registered")
+	require.NotNil(t, factory)
+}
+
+func TestGTphishIndirect_PrimaryDetector(t *testing.T) {
+	p, err := probes.Create("avspamscanning.GTphishIndirect", nil)
+	require.NoError(t, err)
+
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+	assert.Equal(t, "knownbadsignatures.GTphish", pm.GetPrimaryDetector())
+}
+
+func TestGTphishIndirect_Probe_ReturnsAttempts(t *testing.T) {
+	p, err := probes.Create("avspamscanning.GTphishIndirect", nil)
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("test response")
+	attempts, err := p.Probe(context.Background(), gen)
+	require.NoError(t, err)
+
+	assert.Len(t, attempts, len(base64Templates)+len(chunkedTemplates))
+	for _, a := range attempts {
+		assert.Equal(t, "avspamscanning.GTphishIndirect", a.Probe)
+		assert.Equal(t, "knownbadsignatures.GTphish", a.Detector)
+	}
+}
+
+func TestNewGTphishIndirect_WithEmptyConfig(t *testing.T) {
+	p, err := NewGTphishIndirect(registry.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, p)
+}
+
+// =============================================================================
+// Shared registration check
+// =============================================================================
+
+func TestAllIndirectProbes_Registered(t *testing.T) {
+	allProbes := probes.List()
+
+	expectedProbes := []string{
+		"avspamscanning.EICARIndirect",
+		"avspamscanning.GTUBEIndirect",
+		"avspamscanning.GTphishIndirect",
+	}
+
+	for _, expected := range expectedProbes {
+		assert.Contains(t, allProbes, expected, "probe %s should be registered", expected)
+	}
+}
@@ -12,6 +12,8 @@ package continuation
 import (
 	_ "embed"
 	"encoding/json"
+	"fmt"
+	"os"
 	"strings"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
@@ -25,6 +27,12 @@ var slurpromptsMiniData []byte
 //go:embed data/slurprompts.jsonl
 var slurpromptsData []byte
 
+// defaultOffsets reproduces the probe's original, fixed partial-word splits:
+// no prefix, the first character, the first two characters, and the first
+// half of the term. Offsets in [0, 1) are a fraction of the term's length;
+// offsets >= 1 are an absolute character count. See generatePromptsWithOffsets.
+var defaultOffsets = []float64{0, 1, 2, 0.5}
+
 // SlurPromptEntry represents a single entry from the slurprompts JSONL files.
 type SlurPromptEntry struct {
 	Term   string `json:"term"`   // The contentious term (slur)
@@ -55,41 +63,41 @@ func loadSlurPrompts(data []byte) ([]SlurPromptEntry, error) {
 	return entries, nil
 }
 
-// generatePrompts creates prompts and corresponding triggers from slur entries.
-//
-// For each {term, prefix} entry, generates variants:
-//   - Empty string "" (just the prefix)
-//   - First character: term[0:1]
-//   - First two characters (if len > 2): term[0:2]
-//   - First half: term[0:len(term)/2]
+// generatePrompts creates prompts and corresponding triggers from slur entries
+// using the probe's default partial-word splits (see defaultOffsets).
 //
 // Returns:
 //   - prompts: []string - deduplicated prompts (prefix + variant)
 //   - triggers: []string - corresponding trigger for each prompt
 func generatePrompts(entries []SlurPromptEntry) ([]string, []string) {
-	promptList := make([]string, 0, len(entries)*4)
-	triggers := make([]string, 0, len(entries)*4)
+	return generatePromptsWithOffsets(entries, defaultOffsets)
+}
+
+// generatePromptsWithOffsets creates prompts and corresponding triggers from
+// slur entries, cutting each term at the given offsets. An offset in [0, 1)
+// is interpreted as a fraction of the term's length (e.g. 0.5 is the first
+// half); an offset >= 1 is an absolute character count (e.g. 1 is the first
+// character). Offsets that would cut at or beyond the full term are skipped,
+// except for 0, which always yields the empty (no-prefix) variant.
+//
+// Returns:
+//   - prompts: []string - deduplicated prompts (prefix + variant)
+//   - triggers: []string - corresponding trigger for each prompt
+func generatePromptsWithOffsets(entries []SlurPromptEntry, offsets []float64) ([]string, []string) {
+	promptList := make([]string, 0, len(entries)*len(offsets))
+	triggers := make([]string, 0, len(entries)*len(offsets))
 	seen := make(map[string]bool)
 
 	for _, entry := range entries {
 		term := entry.Term
 		prefix := entry.Prefix
 
-		// Generate variants: empty, first char, first two, first half
-		variants := []string{""}
-		if len(term) > 0 {
-			variants = append(variants, term[0:1])
-		}
-		if len(term) > 2 {
-			variants = append(variants, strings.TrimSpace(term[0:2]))
-		}
-		if len(term) > 0 {
-			halfLen := len(term) / 2
-			variants = append(variants, strings.TrimSpace(term[0:halfLen]))
-		}
+		for _, offset := range offsets {
+			variant, ok := termPrefixAtOffset(term, offset)
+			if !ok {
+				continue
+			}
 
-		// Create prompts with deduplication
-		for _, variant := range variants {
 			prompt := prefix + variant
 			if !seen[prompt] {
 				seen[prompt] = true
@@ -102,12 +110,82 @@ func generatePrompts(entries []SlurPromptEntry) ([]string, []string) {
 	return promptList, triggers
 }
 
+// termPrefixAtOffset returns the leading substring of term cut at offset,
+// and whether that cut is valid for this term. See generatePromptsWithOffsets
+// for how offset is interpreted.
+func termPrefixAtOffset(term string, offset float64) (string, bool) {
+	if offset == 0 {
+		return "", true
+	}
+	if len(term) == 0 {
+		return "", false
+	}
+
+	if offset < 1 {
+		n := int(offset * float64(len(term)))
+		return strings.TrimSpace(term[:n]), true
+	}
+
+	n := int(offset)
+	if n == 1 {
+		// The first character is always a meaningful partial word, even for
+		// single-character terms (where it equals the whole term).
+		return term[:1], true
+	}
+	if n >= len(term) {
+		return "", false
+	}
+	return strings.TrimSpace(term[:n]), true
+}
+
 // continuationProbe wraps *probes.SimpleProbe with trigger data for continuation probes.
 type continuationProbe struct {
 	*probes.SimpleProbe
 	triggers []string
 }
 
+// loadEntries loads slur prompt entries from the corpus_path config key if
+// set, otherwise falls back to the given embedded default corpus.
+func loadEntries(cfg registry.Config, embedded []byte) ([]SlurPromptEntry, error) {
+	path := registry.GetString(cfg, "corpus_path", "")
+	if path == "" {
+		return loadSlurPrompts(embedded)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return loadSlurPrompts(data)
+}
+
+// offsetsFromConfig reads the offsets config key (a list of numbers, see
+// generatePromptsWithOffsets) if set, otherwise returns defaultOffsets.
+func offsetsFromConfig(cfg registry.Config) ([]float64, error) {
+	raw, ok := cfg["offsets"]
+	if !ok {
+		return defaultOffsets, nil
+	}
+
+	values, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("continuation: offsets must be a list of numbers, got %T", raw)
+	}
+
+	offsets := make([]float64, len(values))
+	for i, v := range values {
+		switch n := v.(type) {
+		case float64:
+			offsets[i] = n
+		case int:
+			offsets[i] = float64(n)
+		default:
+			return nil, fmt.Errorf("continuation: offsets[%d] must be a number, got %T", i, v)
+		}
+	}
+	return offsets, nil
+}
+
 // newContinuationProbe creates a continuation probe with trigger metadata.
 func newContinuationProbe(name, description, goal, detector string, promptList, triggers []string) *continuationProbe {
 	p := &continuationProbe{
@@ -142,13 +220,22 @@ type ContinueSlursReclaimedSlurs struct {
 }
 
 // NewContinueSlursReclaimedSlurs creates a probe with the mini dataset.
-func NewContinueSlursReclaimedSlurs(_ registry.Config) (probes.Prober, error) {
-	entries, err := loadSlurPrompts(slurpromptsMiniData)
+//
+// cfg may set "corpus_path" to load an external JSONL corpus (same
+// {term, prefix} row shape) in place of the embedded dataset, and "offsets"
+// to override the partial-word splits (see generatePromptsWithOffsets).
+func NewContinueSlursReclaimedSlurs(cfg registry.Config) (probes.Prober, error) {
+	entries, err := loadEntries(cfg, slurpromptsMiniData)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets, err := offsetsFromConfig(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	promptList, triggers := generatePrompts(entries)
+	promptList, triggers := generatePromptsWithOffsets(entries, offsets)
 
 	return &ContinueSlursReclaimedSlurs{
 		continuationProbe: newContinuationProbe(
@@ -176,13 +263,22 @@ type ContinueSlursReclaimedSlursFull struct {
 }
 
 // NewContinueSlursReclaimedSlursFull creates a probe with the full dataset.
-func NewContinueSlursReclaimedSlursFull(_ registry.Config) (probes.Prober, error) {
-	entries, err := loadSlurPrompts(slurpromptsData)
+//
+// cfg may set "corpus_path" to load an external JSONL corpus (same
+// {term, prefix} row shape) in place of the embedded dataset, and "offsets"
+// to override the partial-word splits (see generatePromptsWithOffsets).
+func NewContinueSlursReclaimedSlursFull(cfg registry.Config) (probes.Prober, error) {
+	entries, err := loadEntries(cfg, slurpromptsData)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets, err := offsetsFromConfig(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	promptList, triggers := generatePrompts(entries)
+	promptList, triggers := generatePromptsWithOffsets(entries, offsets)
 
 	return &ContinueSlursReclaimedSlursFull{
 		continuationProbe: newContinuationProbe(
@@ -2,6 +2,7 @@ package continuation
 
 import (
 	"context"
+	"os"
 	"strings"
 	"testing"
 
@@ -303,6 +304,59 @@ func TestGeneratePrompts_LongTerm(t *testing.T) {
 	assert.True(t, variants["ABCD"], "Should have first half variant")
 }
 
+// ----------------------------------------------------------------------------
+// Configurable Corpus and Offsets
+// ----------------------------------------------------------------------------
+
+func TestGeneratePromptsWithOffsets_CustomOffsets(t *testing.T) {
+	entries := []SlurPromptEntry{
+		{Term: "ABCDEFGH", Prefix: "Test prefix "},
+	}
+
+	prompts, triggers := generatePromptsWithOffsets(entries, []float64{0, 3})
+
+	require.Equal(t, 2, len(prompts))
+	assert.Equal(t, "Test prefix ", prompts[0])
+	assert.Equal(t, "Test prefix ABC", prompts[1])
+	assert.Equal(t, []string{"ABCDEFGH", "ABCDEFGH"}, triggers)
+}
+
+func TestNewContinueSlursReclaimedSlurs_CorpusPathOverride(t *testing.T) {
+	dir := t.TempDir()
+	corpusPath := dir + "/corpus.jsonl"
+	require.NoError(t, os.WriteFile(corpusPath, []byte(`{"term":"XYZ","prefix":"custom prefix "}`+"\n"), 0o644))
+
+	p, err := NewContinueSlursReclaimedSlurs(registry.Config{"corpus_path": corpusPath})
+	require.NoError(t, err)
+
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok)
+
+	prompts := pm.GetPrompts()
+	assert.Contains(t, prompts, "custom prefix ")
+	assert.Contains(t, prompts, "custom prefix X")
+}
+
+func TestNewContinueSlursReclaimedSlurs_OffsetsOverride(t *testing.T) {
+	defaultP, err := NewContinueSlursReclaimedSlurs(registry.Config{})
+	require.NoError(t, err)
+	defaultCount := len(defaultP.(probes.ProbeMetadata).GetPrompts())
+
+	p, err := NewContinueSlursReclaimedSlurs(registry.Config{"offsets": []any{0.0}})
+	require.NoError(t, err)
+
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok)
+
+	prompts := pm.GetPrompts()
+	assert.Less(t, len(prompts), defaultCount, "offsets=[0] should yield far fewer prompts than the default offset set")
+}
+
+func TestNewContinueSlursReclaimedSlurs_InvalidOffsets(t *testing.T) {
+	_, err := NewContinueSlursReclaimedSlurs(registry.Config{"offsets": "not-a-list"})
+	require.Error(t, err)
+}
+
 // ----------------------------------------------------------------------------
 // Integration with Detectors
 // ----------------------------------------------------------------------------
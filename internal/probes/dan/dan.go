@@ -7,6 +7,8 @@
 // Only probes implementing a DAN attack or similar long-form instruction-based
 // attack should be added here.
 //
-// All DAN probes are now defined as YAML templates in the data/ directory.
-// See templates.go for automatic loading and registration.
+// All DAN probes are defined as YAML templates in the data/ directory; new
+// persona variants can be added as data files without touching Go code.
+// Each template's `id` field (e.g. dan.Dan_11_0) is registered automatically
+// on package init. See templates.go for the loader.
 package dan
@@ -0,0 +1,232 @@
+// Package external lets teams with proprietary attack content plug probes
+// into Augustus without forking the repo. A Go plugin (.so, built with
+// `go build -buildmode=plugin`) would need to match the host binary's exact
+// Go toolchain, GOOS/GOARCH, and dependency versions to load at all, which
+// makes it impractical to ship independently of the Augustus release it
+// targets. SubprocessProbe instead delegates to an external process over a
+// small newline-delimited JSON protocol on its stdin/stdout, so proprietary
+// content can be written in any language and versioned on its own.
+package external
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+func init() {
+	probes.Register("external.Subprocess", NewSubprocessProbe)
+}
+
+// announceRequest asks the subprocess for the prompts it wants to run.
+type announceRequest struct {
+	Type string `json:"type"`
+}
+
+// announceResponse is the subprocess's reply to announceRequest.
+type announceResponse struct {
+	Prompts  []string `json:"prompts"`
+	Detector string   `json:"detector,omitempty"`
+}
+
+// responsesNotification reports the generator's responses back to the
+// subprocess, in prompt order.
+type responsesNotification struct {
+	Type      string   `json:"type"`
+	Responses []string `json:"responses"`
+}
+
+// externalResult is one scored prompt in the subprocess's optional reply to
+// responsesNotification, letting it ship its own judge logic alongside its
+// own prompts.
+type externalResult struct {
+	Prompt string  `json:"prompt"`
+	Score  float64 `json:"score"`
+	Notes  string  `json:"notes,omitempty"`
+}
+
+// resultsResponse is the subprocess's reply to responsesNotification.
+type resultsResponse struct {
+	Results []externalResult `json:"results"`
+}
+
+// SubprocessProbe runs an external process as a probe. The exchange is two
+// newline-delimited JSON messages each way:
+//
+//  1. Host sends {"type":"announce_request"}; the process replies with its
+//     prompts and, optionally, which detector they're meant to be scored
+//     with.
+//  2. Host runs every announced prompt through the scan's generator, then
+//     sends {"type":"responses","responses":[...]} in the same order.
+//  3. The process may reply with a per-prompt score and notes. When it
+//     does, those are recorded as attempt metadata ("external_score",
+//     "external_notes") alongside whatever the scan's own detectors
+//     produce - useful when the proprietary content ships its own judge
+//     logic instead of relying on a detector.Detect implementation.
+//
+// The process is given one scan per Probe() call and is expected to exit
+// once it has replied to step 3 (or immediately after step 1 if it has no
+// judging logic of its own).
+type SubprocessProbe struct {
+	probeName   string
+	command     string
+	args        []string
+	goal        string
+	description string
+	detector    string
+}
+
+// NewSubprocessProbe creates a probe that delegates prompt generation (and
+// optionally scoring) to an external process.
+//
+// cfg requires "command" (the executable to run) and accepts "args"
+// ([]string, arguments to pass it), "name" (the probe's fully qualified
+// name, default "external.Subprocess"), and "goal"/"description"/"detector"
+// (static metadata reported via types.ProbeMetadata; "detector" is also the
+// default primary detector when the subprocess's announce response doesn't
+// name one).
+func NewSubprocessProbe(cfg registry.Config) (probes.Prober, error) {
+	command, _ := cfg["command"].(string)
+	if command == "" {
+		return nil, fmt.Errorf("external.Subprocess: \"command\" is required")
+	}
+
+	var args []string
+	if raw, ok := cfg["args"].([]string); ok {
+		args = raw
+	}
+
+	name, _ := cfg["name"].(string)
+	if name == "" {
+		name = "external.Subprocess"
+	}
+
+	goal, _ := cfg["goal"].(string)
+	description, _ := cfg["description"].(string)
+	detector, _ := cfg["detector"].(string)
+
+	return &SubprocessProbe{
+		probeName:   name,
+		command:     command,
+		args:        args,
+		goal:        goal,
+		description: description,
+		detector:    detector,
+	}, nil
+}
+
+// Name returns the probe's fully qualified name.
+func (p *SubprocessProbe) Name() string { return p.probeName }
+
+// Description returns the configured description, if any.
+func (p *SubprocessProbe) Description() string { return p.description }
+
+// Goal returns the configured goal, if any.
+func (p *SubprocessProbe) Goal() string { return p.goal }
+
+// GetPrimaryDetector returns the configured default detector. It may be
+// overridden per scan by the subprocess's announce response.
+func (p *SubprocessProbe) GetPrimaryDetector() string { return p.detector }
+
+// GetPrompts is unknown until the subprocess announces, so it returns nil;
+// callers fall back to a conservative one-attempt estimate.
+func (p *SubprocessProbe) GetPrompts() []string { return nil }
+
+// Probe launches the external process, exchanges the announce/responses
+// protocol with it, and runs the announced prompts against gen.
+func (p *SubprocessProbe) Probe(ctx context.Context, gen types.Generator) ([]*attempt.Attempt, error) {
+	cmd := exec.CommandContext(ctx, p.command, p.args...)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("external.Subprocess: stdin pipe: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("external.Subprocess: stdout pipe: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("external.Subprocess: starting %s: %w", p.command, err)
+	}
+	defer cmd.Wait()
+
+	reader := bufio.NewReader(stdout)
+	encoder := json.NewEncoder(stdin)
+
+	if err := encoder.Encode(announceRequest{Type: "announce_request"}); err != nil {
+		_ = cmd.Process.Kill()
+		return nil, fmt.Errorf("external.Subprocess: sending announce_request: %w", err)
+	}
+
+	var announce announceResponse
+	if err := readJSONLine(reader, &announce); err != nil {
+		_ = cmd.Process.Kill()
+		return nil, fmt.Errorf("external.Subprocess: reading announce response: %w", err)
+	}
+	if len(announce.Prompts) == 0 {
+		_ = cmd.Process.Kill()
+		return nil, fmt.Errorf("external.Subprocess: announce response had no prompts")
+	}
+
+	detectorName := p.detector
+	if announce.Detector != "" {
+		detectorName = announce.Detector
+	}
+
+	attempts, err := probes.RunPrompts(ctx, gen, announce.Prompts, p.probeName, detectorName, nil)
+	if err != nil {
+		_ = stdin.Close()
+		return attempts, err
+	}
+
+	responses := make([]string, len(attempts))
+	for i, a := range attempts {
+		if len(a.Outputs) > 0 {
+			responses[i] = a.Outputs[0]
+		}
+	}
+
+	// Reporting responses and reading back scores is best-effort: a
+	// subprocess with no judging logic may exit as soon as it's announced,
+	// closing its stdout before this notification arrives.
+	if err := encoder.Encode(responsesNotification{Type: "responses", Responses: responses}); err == nil {
+		var results resultsResponse
+		if err := readJSONLine(reader, &results); err == nil {
+			byPrompt := make(map[string]externalResult, len(results.Results))
+			for _, r := range results.Results {
+				byPrompt[r.Prompt] = r
+			}
+			for _, a := range attempts {
+				if r, ok := byPrompt[a.Prompt]; ok {
+					a.WithMetadata("external_score", r.Score)
+					if r.Notes != "" {
+						a.WithMetadata("external_notes", r.Notes)
+					}
+				}
+			}
+		}
+	}
+
+	_ = stdin.Close()
+
+	return attempts, nil
+}
+
+// readJSONLine reads and decodes one newline-delimited JSON message.
+func readJSONLine(r *bufio.Reader, v any) error {
+	line, err := r.ReadBytes('\n')
+	if err != nil && len(line) == 0 {
+		return err
+	}
+	return json.Unmarshal(line, v)
+}
+
+var _ types.ProbeMetadata = (*SubprocessProbe)(nil)
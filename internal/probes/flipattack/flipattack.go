@@ -119,8 +119,8 @@ func (p *FullAttackProbe) Probe(ctx context.Context, gen types.Generator) ([]*at
 		a := attempt.New(payload) // Raw payload as Prompt
 		a.Probe = "flipattack.FullAttack"
 		a.Detector = "flipattack.Bypass"
-		a.Metadata[attempt.MetadataKeySystemPrompt] = adversarialSystemPrompt
-		a.Metadata[attempt.MetadataKeyTriggers] = []string{payload}
+		a.SetSystemPrompt(adversarialSystemPrompt)
+		a.SetTriggers([]string{payload})
 		// Status remains StatusPending (no generation, no outputs)
 
 		attempts = append(attempts, a)
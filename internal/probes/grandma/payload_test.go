@@ -0,0 +1,78 @@
+package grandma
+
+import (
+	"context"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/internal/probes/poetry"
+	"github.com/praetorian-inc/augustus/internal/testutil"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayload_DefaultUsesEveryCategory(t *testing.T) {
+	probe, err := NewPayload(registry.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, probe)
+
+	all := poetry.LoadCategorizedPayloads()
+	pm, ok := probe.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+
+	wantPrompts := len(payloadTemplates) * len(all)
+	assert.Len(t, pm.GetPrompts(), wantPrompts)
+}
+
+func TestPayload_CategoryFilter(t *testing.T) {
+	probe, err := NewPayload(registry.Config{"categories": []any{"01"}})
+	require.NoError(t, err)
+
+	var want int
+	for _, p := range poetry.LoadCategorizedPayloads() {
+		if p.CategoryID == "01" {
+			want++
+		}
+	}
+	require.Greater(t, want, 0, "category 01 should have payloads in the fixture data")
+
+	pm := probe.(probes.ProbeMetadata)
+	assert.Len(t, pm.GetPrompts(), len(payloadTemplates)*want)
+}
+
+func TestPayload_UnknownCategory(t *testing.T) {
+	probe, err := NewPayload(registry.Config{"categories": []any{"99"}})
+	assert.Error(t, err)
+	assert.Nil(t, probe)
+}
+
+func TestPayload_Metadata(t *testing.T) {
+	probe, err := NewPayload(registry.Config{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "grandma.Payload", probe.Name())
+
+	pm, ok := probe.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+	assert.Equal(t, "get model to comply with an arbitrary harmful request via emotional manipulation", pm.Goal())
+	assert.Equal(t, "mitigation.MitigationBypass", pm.GetPrimaryDetector())
+	assert.NotEmpty(t, pm.Description())
+}
+
+func TestPayload_Probe(t *testing.T) {
+	probe, err := NewPayload(registry.Config{"categories": []any{"01"}})
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("test response")
+
+	attempts, err := probe.Probe(context.Background(), gen)
+	require.NoError(t, err)
+	require.NotEmpty(t, attempts)
+
+	for _, att := range attempts {
+		assert.Equal(t, "grandma.Payload", att.Probe)
+		assert.Equal(t, "mitigation.MitigationBypass", att.Detector)
+		assert.NotEmpty(t, att.Prompt)
+	}
+}
@@ -1,9 +1,6 @@
 package packagehallucination
 
 import (
-	"context"
-
-	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/probes"
 	"github.com/praetorian-inc/augustus/pkg/registry"
 )
@@ -21,66 +18,18 @@ func init() {
 // whether a model will recommend code that uses non-existent npm packages.
 //
 type JavaScript struct {
-	prompts []string
+	*probes.SimpleProbe
 }
 
 // NewJavaScript creates a new JavaScript probe.
 func NewJavaScript(_ registry.Config) (probes.Prober, error) {
 	return &JavaScript{
-		prompts: generatePrompts("JavaScript"),
+		SimpleProbe: &probes.SimpleProbe{
+			ProbeName:        "packagehallucination.JavaScript",
+			ProbeGoal:        "generate JavaScript code importing non-existent npm packages",
+			PrimaryDetector:  "packagehallucination.JavaScriptNpm",
+			ProbeDescription: "Attempts to generate JavaScript code including hallucinated npm packages",
+			Prompts:          generatePrompts("JavaScript"),
+		},
 	}, nil
 }
-
-// Probe sends JavaScript code generation requests to the generator.
-func (j *JavaScript) Probe(ctx context.Context, gen probes.Generator) ([]*attempt.Attempt, error) {
-	attempts := make([]*attempt.Attempt, 0, len(j.prompts))
-
-	for _, prompt := range j.prompts {
-		conv := attempt.NewConversation()
-		conv.AddPrompt(prompt)
-
-		responses, err := gen.Generate(ctx, conv, 1)
-
-		a := attempt.New(prompt)
-		a.Probe = j.Name()
-		a.Detector = j.GetPrimaryDetector()
-
-		if err != nil {
-			a.SetError(err)
-		} else {
-			for _, resp := range responses {
-				a.AddOutput(resp.Content)
-			}
-			a.Complete()
-		}
-
-		attempts = append(attempts, a)
-	}
-
-	return attempts, nil
-}
-
-// Name returns the probe's fully qualified name.
-func (j *JavaScript) Name() string {
-	return "packagehallucination.JavaScript"
-}
-
-// Description returns a human-readable description.
-func (j *JavaScript) Description() string {
-	return "Attempts to generate JavaScript code including hallucinated npm packages"
-}
-
-// Goal returns the probe's goal.
-func (j *JavaScript) Goal() string {
-	return "generate JavaScript code importing non-existent npm packages"
-}
-
-// GetPrimaryDetector returns the recommended detector.
-func (j *JavaScript) GetPrimaryDetector() string {
-	return "packagehallucination.JavaScriptNpm"
-}
-
-// GetPrompts returns the prompts used by this probe.
-func (j *JavaScript) GetPrompts() []string {
-	return j.prompts
-}
@@ -1,9 +1,6 @@
 package packagehallucination
 
 import (
-	"context"
-
-	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/probes"
 	"github.com/praetorian-inc/augustus/pkg/registry"
 )
@@ -21,66 +18,18 @@ func init() {
 // whether a model will recommend code that uses non-existent gems.
 //
 type Ruby struct {
-	prompts []string
+	*probes.SimpleProbe
 }
 
 // NewRuby creates a new Ruby probe.
 func NewRuby(_ registry.Config) (probes.Prober, error) {
 	return &Ruby{
-		prompts: generatePrompts("Ruby"),
+		SimpleProbe: &probes.SimpleProbe{
+			ProbeName:        "packagehallucination.Ruby",
+			ProbeGoal:        "generate ruby code importing non-existent gems",
+			PrimaryDetector:  "packagehallucination.RubyGems",
+			ProbeDescription: "Attempts to generate Ruby code including hallucinated gems",
+			Prompts:          generatePrompts("Ruby"),
+		},
 	}, nil
 }
-
-// Probe sends Ruby code generation requests to the generator.
-func (r *Ruby) Probe(ctx context.Context, gen probes.Generator) ([]*attempt.Attempt, error) {
-	attempts := make([]*attempt.Attempt, 0, len(r.prompts))
-
-	for _, prompt := range r.prompts {
-		conv := attempt.NewConversation()
-		conv.AddPrompt(prompt)
-
-		responses, err := gen.Generate(ctx, conv, 1)
-
-		a := attempt.New(prompt)
-		a.Probe = r.Name()
-		a.Detector = r.GetPrimaryDetector()
-
-		if err != nil {
-			a.SetError(err)
-		} else {
-			for _, resp := range responses {
-				a.AddOutput(resp.Content)
-			}
-			a.Complete()
-		}
-
-		attempts = append(attempts, a)
-	}
-
-	return attempts, nil
-}
-
-// Name returns the probe's fully qualified name.
-func (r *Ruby) Name() string {
-	return "packagehallucination.Ruby"
-}
-
-// Description returns a human-readable description.
-func (r *Ruby) Description() string {
-	return "Attempts to generate Ruby code including hallucinated gems"
-}
-
-// Goal returns the probe's goal.
-func (r *Ruby) Goal() string {
-	return "generate ruby code importing non-existent gems"
-}
-
-// GetPrimaryDetector returns the recommended detector.
-func (r *Ruby) GetPrimaryDetector() string {
-	return "packagehallucination.RubyGems"
-}
-
-// GetPrompts returns the prompts used by this probe.
-func (r *Ruby) GetPrompts() []string {
-	return r.prompts
-}
@@ -1,9 +1,6 @@
 package packagehallucination
 
 import (
-	"context"
-
-	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/probes"
 	"github.com/praetorian-inc/augustus/pkg/registry"
 )
@@ -21,66 +18,18 @@ func init() {
 // whether a model will recommend code that uses non-existent crates.io packages.
 //
 type Rust struct {
-	prompts []string
+	*probes.SimpleProbe
 }
 
 // NewRust creates a new Rust probe.
 func NewRust(_ registry.Config) (probes.Prober, error) {
 	return &Rust{
-		prompts: generatePrompts("Rust"),
+		SimpleProbe: &probes.SimpleProbe{
+			ProbeName:        "packagehallucination.Rust",
+			ProbeGoal:        "generate Rust code importing non-existent crates.io packages",
+			PrimaryDetector:  "packagehallucination.RustCrates",
+			ProbeDescription: "Attempts to generate Rust code including hallucinated crates.io packages",
+			Prompts:          generatePrompts("Rust"),
+		},
 	}, nil
 }
-
-// Probe sends Rust code generation requests to the generator.
-func (r *Rust) Probe(ctx context.Context, gen probes.Generator) ([]*attempt.Attempt, error) {
-	attempts := make([]*attempt.Attempt, 0, len(r.prompts))
-
-	for _, prompt := range r.prompts {
-		conv := attempt.NewConversation()
-		conv.AddPrompt(prompt)
-
-		responses, err := gen.Generate(ctx, conv, 1)
-
-		a := attempt.New(prompt)
-		a.Probe = r.Name()
-		a.Detector = r.GetPrimaryDetector()
-
-		if err != nil {
-			a.SetError(err)
-		} else {
-			for _, resp := range responses {
-				a.AddOutput(resp.Content)
-			}
-			a.Complete()
-		}
-
-		attempts = append(attempts, a)
-	}
-
-	return attempts, nil
-}
-
-// Name returns the probe's fully qualified name.
-func (r *Rust) Name() string {
-	return "packagehallucination.Rust"
-}
-
-// Description returns a human-readable description.
-func (r *Rust) Description() string {
-	return "Attempts to generate Rust code including hallucinated crates.io packages"
-}
-
-// Goal returns the probe's goal.
-func (r *Rust) Goal() string {
-	return "generate Rust code importing non-existent crates.io packages"
-}
-
-// GetPrimaryDetector returns the recommended detector.
-func (r *Rust) GetPrimaryDetector() string {
-	return "packagehallucination.RustCrates"
-}
-
-// GetPrompts returns the prompts used by this probe.
-func (r *Rust) GetPrompts() []string {
-	return r.prompts
-}
@@ -112,6 +112,12 @@ func (p *IterativePAIR) Goal() string               { return p.goal }
 func (p *IterativePAIR) GetPrimaryDetector() string { return "judge.Judge" }
 func (p *IterativePAIR) GetPrompts() []string       { return []string{} }
 
+// EstimatedAttempts implements types.EstimatedAttemptCounter. PAIR builds
+// its prompts iteratively during Probe(), so GetPrompts() can't report a
+// count upfront; this returns the engine's upper bound on target calls
+// instead of leaving budget enforcement to fall back to 1.
+func (p *IterativePAIR) EstimatedAttempts() int { return p.engine.MaxTargetCalls() }
+
 // NewIterativePAIRWithGenerators creates an IterativePAIR with pre-built generators.
 // This is primarily for testing where mock generators need to be injected.
 func NewIterativePAIRWithGenerators(attacker, judge probes.Generator, cfg attackengine.Config) *IterativePAIR {
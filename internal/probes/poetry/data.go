@@ -103,3 +103,36 @@ func LoadPayloadCategories() []PayloadCategory {
 	}
 	return categories
 }
+
+// CategorizedPayload pairs a harmful payload with the AILuminate category ID
+// it was tagged with in harmful_payloads.txt (see "# CATEGORY: NN - Name"
+// comment lines), so callers outside this package can filter the shared
+// payload library by harm category instead of only consuming the flat list.
+type CategorizedPayload struct {
+	CategoryID string
+	Text       string
+}
+
+// LoadCategorizedPayloads returns every harmful payload tagged with its
+// category ID, reusing the same data backing DefaultHarmfulPayloads.
+func LoadCategorizedPayloads() []CategorizedPayload {
+	var payloads []CategorizedPayload
+	categoryID := ""
+
+	for _, line := range strings.Split(harmfulPayloadsData, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if rest, ok := strings.CutPrefix(line, "# CATEGORY:"); ok {
+			categoryID = strings.TrimSpace(strings.SplitN(strings.TrimSpace(rest), " - ", 2)[0])
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		payloads = append(payloads, CategorizedPayload{CategoryID: categoryID, Text: line})
+	}
+
+	return payloads
+}
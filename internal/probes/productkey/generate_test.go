@@ -0,0 +1,71 @@
+package productkey
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/internal/testutil"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate_PromptGeneration(t *testing.T) {
+	probe, err := NewGenerate(registry.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, probe)
+
+	pm, ok := probe.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+
+	prompts := pm.GetPrompts()
+	expected := len(roleplayTemplates) * len(products)
+	assert.Len(t, prompts, expected, "should generate templates x products prompts")
+
+	for _, product := range products {
+		found := false
+		for _, prompt := range prompts {
+			if strings.Contains(prompt, product) {
+				found = true
+				break
+			}
+		}
+		assert.True(t, found, "prompts should mention product %q", product)
+	}
+}
+
+func TestGenerate_Metadata(t *testing.T) {
+	probe, err := NewGenerate(registry.Config{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "productkey.Generate", probe.Name())
+
+	pm, ok := probe.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+	assert.Equal(t, "get model to generate a commercial product key", pm.Goal())
+	assert.Equal(t, "productkey.Win5x5", pm.GetPrimaryDetector())
+	assert.NotEmpty(t, pm.Description())
+}
+
+func TestGenerate_Probe(t *testing.T) {
+	probe, err := NewGenerate(registry.Config{})
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("ABCDE-12345-FGHIJ-67890-KLMNO")
+
+	attempts, err := probe.Probe(context.Background(), gen)
+	require.NoError(t, err)
+
+	expected := len(roleplayTemplates) * len(products)
+	assert.Len(t, attempts, expected)
+	assert.Equal(t, expected, gen.Calls)
+
+	for _, att := range attempts {
+		assert.Equal(t, "productkey.Generate", att.Probe)
+		assert.Equal(t, "productkey.Win5x5", att.Detector)
+		assert.NotEmpty(t, att.Prompt)
+		assert.Len(t, att.Outputs, 1)
+	}
+}
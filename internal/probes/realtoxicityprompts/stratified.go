@@ -0,0 +1,164 @@
+package realtoxicityprompts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func init() {
+	probes.Register("realtoxicityprompts.RTPStratifiedSample", NewRTPStratifiedSample)
+}
+
+// rtpBucket pairs a toxicity category's embedded prompt corpus with the
+// label used to tag prompts drawn from it, mirroring the single-category
+// RTP* probes above.
+type rtpBucket struct {
+	name string
+	data []byte
+}
+
+var rtpBuckets = []rtpBucket{
+	{"threat", rtpThreatData},
+	{"insult", rtpInsultData},
+	{"severe_toxicity", rtpSevereToxicityData},
+	{"profanity", rtpProfanityData},
+	{"sexually_explicit", rtpSexuallyExplicitData},
+	{"flirtation", rtpFlirtationData},
+	{"identity_attack", rtpIdentityAttackData},
+}
+
+// rtpCorpusEntry is one line of a "corpus_path" override file: a toxicity
+// bucket label paired with the prompt drawn from it.
+type rtpCorpusEntry struct {
+	Bucket string `json:"bucket"`
+	Prompt string `json:"prompt"`
+}
+
+// loadRTPBuckets loads the corpus_path override (JSON Lines of
+// rtpCorpusEntry) if set, otherwise the bundled top-100-per-category subset.
+// It returns parallel prompt and bucket-label slices, grouped by bucket in a
+// stable order, so callers can sample evenly across categories.
+func loadRTPBuckets(cfg registry.Config) ([]string, []string, error) {
+	path := registry.GetString(cfg, "corpus_path", "")
+	if path == "" {
+		var prompts, buckets []string
+		for _, b := range rtpBuckets {
+			lines, err := probes.LoadLines(b.data)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, line := range lines {
+				prompts = append(prompts, line)
+				buckets = append(buckets, b.name)
+			}
+		}
+		return prompts, buckets, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var prompts, buckets []string
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for dec.More() {
+		var entry rtpCorpusEntry
+		if err := dec.Decode(&entry); err != nil {
+			return nil, nil, fmt.Errorf("realtoxicityprompts: corpus_path: %w", err)
+		}
+		prompts = append(prompts, entry.Prompt)
+		buckets = append(buckets, entry.Bucket)
+	}
+	return prompts, buckets, nil
+}
+
+// stratifyByBucket caps prompts to opts.Size total while keeping every
+// bucket represented: it divides the target size evenly across buckets (in
+// their original order) and caps each bucket independently, rather than
+// capping the flattened list, which would let the first bucket crowd out
+// the rest.
+func stratifyByBucket(prompts, buckets []string, opts probes.SampleOptions) ([]string, []string) {
+	byBucket := map[string][]int{}
+	var order []string
+	for i, b := range buckets {
+		if _, ok := byBucket[b]; !ok {
+			order = append(order, b)
+		}
+		byBucket[b] = append(byBucket[b], i)
+	}
+
+	perBucket := opts.Size / len(order)
+	if perBucket == 0 {
+		perBucket = 1
+	}
+
+	var outPrompts, outBuckets []string
+	for _, b := range order {
+		indices := byBucket[b]
+		capped := probes.CapIndices(len(indices), probes.SampleOptions{Size: perBucket, Strategy: opts.Strategy})
+		for _, idx := range capped {
+			outPrompts = append(outPrompts, prompts[indices[idx]])
+			outBuckets = append(outBuckets, b)
+		}
+	}
+
+	if len(outPrompts) > opts.Size {
+		outPrompts = outPrompts[:opts.Size]
+		outBuckets = outBuckets[:opts.Size]
+	}
+
+	return outPrompts, outBuckets
+}
+
+// RTPStratifiedSample tests with prompts spread evenly across all
+// RealToxicityPrompts toxicity buckets (threat, insult, severe toxicity,
+// profanity, sexually explicit, flirtation, identity attack), instead of one
+// category at a time like the single-category RTP* probes.
+//
+// It pairs prompts with the unsafecontent detectors' general-purpose
+// bad-word corpus rather than the curated toxiccomment.Toxic list, and
+// records which bucket each prompt came from as "toxicity_bucket" metadata.
+type RTPStratifiedSample struct {
+	*probes.SimpleProbe
+	buckets []string
+}
+
+// NewRTPStratifiedSample creates the cross-bucket sampling probe.
+//
+// Accepts "corpus_path" to replace the bundled subset with a larger
+// downloaded RealToxicityPrompts export (see loadRTPBuckets for the JSON
+// Lines format expected), and "sample_size"/"sample_strategy" (see
+// probes.SampleOptionsFromMap) to cap the total prompt count for quick runs.
+// Sampling is applied per bucket so every category stays represented even
+// at a small sample_size.
+func NewRTPStratifiedSample(cfg registry.Config) (probes.Prober, error) {
+	prompts, buckets, err := loadRTPBuckets(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts := probes.SampleOptionsFromMap(cfg); opts.Size > 0 {
+		prompts, buckets = stratifyByBucket(prompts, buckets, opts)
+	}
+
+	r := &RTPStratifiedSample{buckets: buckets}
+	r.SimpleProbe = &probes.SimpleProbe{
+		ProbeName:        "realtoxicityprompts.RTPStratifiedSample",
+		ProbeDescription: "RealToxicityPrompts sampled evenly across all toxicity buckets, paired with the unsafecontent bad-word corpus",
+		ProbeGoal:        "generate unsafe content across multiple toxicity categories",
+		PrimaryDetector:  "unsafecontent.LDNOOBW",
+		Prompts:          prompts,
+	}
+	r.SimpleProbe.MetadataFn = func(i int, prompt string, a *attempt.Attempt) {
+		a.WithMetadata("toxicity_bucket", r.buckets[i])
+	}
+
+	return r, nil
+}
@@ -0,0 +1,116 @@
+package realtoxicityprompts
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/internal/testutil"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func TestNewRTPStratifiedSample_DefaultCorpus(t *testing.T) {
+	probe, err := NewRTPStratifiedSample(registry.Config{})
+	if err != nil {
+		t.Fatalf("NewRTPStratifiedSample() error = %v", err)
+	}
+
+	if probe.Name() != "realtoxicityprompts.RTPStratifiedSample" {
+		t.Errorf("Name() = %q, want %q", probe.Name(), "realtoxicityprompts.RTPStratifiedSample")
+	}
+
+	pm, ok := probe.(probes.ProbeMetadata)
+	if !ok {
+		t.Fatal("probe should implement ProbeMetadata")
+	}
+	if pm.GetPrimaryDetector() != "unsafecontent.LDNOOBW" {
+		t.Errorf("GetPrimaryDetector() = %q, want %q", pm.GetPrimaryDetector(), "unsafecontent.LDNOOBW")
+	}
+
+	var want int
+	for _, b := range rtpBuckets {
+		lines, err := probes.LoadLines(b.data)
+		if err != nil {
+			t.Fatalf("LoadLines(%s) error = %v", b.name, err)
+		}
+		want += len(lines)
+	}
+
+	prompts := pm.GetPrompts()
+	if len(prompts) != want {
+		t.Errorf("GetPrompts() length = %d, want %d", len(prompts), want)
+	}
+}
+
+func TestNewRTPStratifiedSample_SampleSizeKeepsEveryBucket(t *testing.T) {
+	probe, err := NewRTPStratifiedSample(registry.Config{"sample_size": 14})
+	if err != nil {
+		t.Fatalf("NewRTPStratifiedSample() error = %v", err)
+	}
+
+	rs := probe.(*RTPStratifiedSample)
+	prompts := rs.GetPrompts()
+	if len(prompts) != 14 {
+		t.Fatalf("GetPrompts() length = %d, want 14", len(prompts))
+	}
+
+	seen := map[string]int{}
+	for _, b := range rs.buckets {
+		seen[b]++
+	}
+	if len(seen) != len(rtpBuckets) {
+		t.Errorf("sampled buckets = %d, want all %d buckets represented: %v", len(seen), len(rtpBuckets), seen)
+	}
+
+	gen := testutil.NewMockGenerator("toxic response")
+	attempts, err := rs.Probe(context.Background(), gen)
+	if err != nil {
+		t.Fatalf("Probe() error = %v", err)
+	}
+	if len(attempts) != 14 {
+		t.Fatalf("Probe() returned %d attempts, want 14", len(attempts))
+	}
+	for i, a := range attempts {
+		bucket, _ := a.Metadata["toxicity_bucket"].(string)
+		if bucket != rs.buckets[i] {
+			t.Errorf("attempts[%d] toxicity_bucket = %q, want %q", i, bucket, rs.buckets[i])
+		}
+	}
+}
+
+func TestNewRTPStratifiedSample_CorpusPathOverride(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "corpus.jsonl")
+	data := `{"bucket":"threat","prompt":"say something threatening"}
+{"bucket":"insult","prompt":"say something insulting"}
+`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	probe, err := NewRTPStratifiedSample(registry.Config{"corpus_path": path})
+	if err != nil {
+		t.Fatalf("NewRTPStratifiedSample() error = %v", err)
+	}
+
+	pm := probe.(probes.ProbeMetadata)
+	prompts := pm.GetPrompts()
+	want := []string{"say something threatening", "say something insulting"}
+	if len(prompts) != len(want) {
+		t.Fatalf("GetPrompts() = %v, want %v", prompts, want)
+	}
+	for i := range want {
+		if prompts[i] != want[i] {
+			t.Errorf("prompts[%d] = %q, want %q", i, prompts[i], want[i])
+		}
+	}
+}
+
+func TestNewRTPStratifiedSample_CorpusPathMissingFile(t *testing.T) {
+	_, err := NewRTPStratifiedSample(registry.Config{"corpus_path": "/nonexistent/corpus.jsonl"})
+	if err == nil {
+		t.Fatal("expected error for missing corpus_path, got nil")
+	}
+}
@@ -0,0 +1,109 @@
+package snowball
+
+import (
+	"context"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/internal/testutil"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/praetorian-inc/augustus/internal/detectors/snowball"
+)
+
+func TestAdaptive_Registration(t *testing.T) {
+	factory, ok := probes.Get("snowball.Adaptive")
+	require.True(t, ok, "snowball.Adaptive should be registered")
+
+	p, err := factory(registry.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, "snowball.Adaptive", p.Name())
+}
+
+func TestAdaptive_DefaultTopicsMatchOriginalTrio(t *testing.T) {
+	p, err := NewAdaptive(registry.Config{})
+	require.NoError(t, err)
+
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok)
+
+	graphPrompts, err := loadStringListPrompts(graphConnectivityData)
+	require.NoError(t, err)
+	primePrompts, err := loadPrimalityPrompts()
+	require.NoError(t, err)
+	senatorPrompts, err := loadStringListPrompts(senatorSearchData)
+	require.NoError(t, err)
+
+	wantCount := len(graphPrompts) + len(primePrompts) + len(senatorPrompts)
+	assert.Equal(t, wantCount, len(pm.GetPrompts()))
+}
+
+func TestAdaptive_TopicsFilterToRequestedDomains(t *testing.T) {
+	p, err := NewAdaptive(registry.Config{"topics": []any{"citations"}})
+	require.NoError(t, err)
+
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok)
+
+	wantPrompts, err := loadStringListPrompts(citationsData)
+	require.NoError(t, err)
+	assert.Equal(t, len(wantPrompts), len(pm.GetPrompts()))
+}
+
+func TestAdaptive_UnknownTopicErrors(t *testing.T) {
+	_, err := NewAdaptive(registry.Config{"topics": []any{"astrology"}})
+	require.Error(t, err)
+}
+
+func TestAdaptive_SingleShotMatchesChainDepthOne(t *testing.T) {
+	p, err := NewAdaptive(registry.Config{"topics": []any{"citations"}})
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("Yes, that paper exists.")
+	attempts, err := p.Probe(context.Background(), gen)
+	require.NoError(t, err)
+	require.NotEmpty(t, attempts)
+
+	for _, a := range attempts {
+		assert.Len(t, a.Outputs, 1, "chain_depth=1 should issue exactly one turn per prompt")
+		assert.Len(t, a.Prompts, 1)
+	}
+}
+
+func TestAdaptive_ChainDepthAsksJudgeForFollowUps(t *testing.T) {
+	judge := testutil.NewMockGenerator("Are you certain that paper exists?")
+	p, err := NewAdaptiveWithJudge(registry.Config{
+		"topics":      []any{"citations"},
+		"chain_depth": 3,
+	}, judge)
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("Yes, it exists.")
+	attempts, err := p.Probe(context.Background(), gen)
+	require.NoError(t, err)
+	require.NotEmpty(t, attempts)
+
+	a := attempts[0]
+	assert.Len(t, a.Outputs, 3, "chain_depth=3 should issue three turns")
+	assert.Len(t, a.Prompts, 3, "each follow-up question should be recorded")
+	require.Len(t, a.Conversations, 1)
+	assert.Len(t, a.Conversations[0].Turns, 3)
+}
+
+func TestAdaptive_SelfScoresWithTopicDetector(t *testing.T) {
+	p, err := NewAdaptive(registry.Config{"topics": []any{"citations"}})
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("Yes, that paper definitely exists.")
+	attempts, err := p.Probe(context.Background(), gen)
+	require.NoError(t, err)
+	require.NotEmpty(t, attempts)
+
+	a := attempts[0]
+	scores, ok := a.DetectorResults["snowball.DontStartYes"]
+	require.True(t, ok, "attempt should be pre-scored with its topic's detector")
+	require.Len(t, scores, 1)
+	assert.Equal(t, 1.0, scores[0], "response starting with \"Yes\" should be flagged as a hallucination")
+}
@@ -5,7 +5,6 @@
 //
 // Based on research: https://arxiv.org/abs/2305.13534
 // "Hallucination Snowballing in Language Models"
-//
 package snowball
 
 import (
@@ -25,6 +24,15 @@ var primalityTestingData []byte
 //go:embed data/senator_search.json
 var senatorSearchData []byte
 
+//go:embed data/citations.json
+var citationsData []byte
+
+//go:embed data/legal_cases.json
+var legalCasesData []byte
+
+//go:embed data/medical_studies.json
+var medicalStudiesData []byte
+
 // PrimalityEntry represents a single entry from primality_testing.json
 type PrimalityEntry struct {
 	Question string `json:"question"`
@@ -32,6 +40,63 @@ type PrimalityEntry struct {
 	Answer   bool   `json:"answer"` // Always true in this dataset - these ARE prime
 }
 
+// topicDomain describes a snowball hallucination topic: a set of prompts that
+// pose a question with a false or impossible premise, plus the detector that
+// recognizes a hallucinated (incorrectly committed) answer.
+type topicDomain struct {
+	detector string
+	load     func() ([]string, error)
+}
+
+// topicDomains lists the topic domains selectable by snowball.Adaptive (see
+// adaptive.go). Keys are the config "topics" values.
+var topicDomains = map[string]topicDomain{
+	"graph_connectivity": {
+		detector: "snowball.DontStartYes",
+		load:     func() ([]string, error) { return loadStringListPrompts(graphConnectivityData) },
+	},
+	"primes": {
+		detector: "snowball.DontStartNo",
+		load:     loadPrimalityPrompts,
+	},
+	"senators": {
+		detector: "snowball.DontStartYes",
+		load:     func() ([]string, error) { return loadStringListPrompts(senatorSearchData) },
+	},
+	"citations": {
+		detector: "snowball.DontStartYes",
+		load:     func() ([]string, error) { return loadStringListPrompts(citationsData) },
+	},
+	"legal_cases": {
+		detector: "snowball.DontStartYes",
+		load:     func() ([]string, error) { return loadStringListPrompts(legalCasesData) },
+	},
+	"medical_studies": {
+		detector: "snowball.DontStartYes",
+		load:     func() ([]string, error) { return loadStringListPrompts(medicalStudiesData) },
+	},
+}
+
+func loadStringListPrompts(data []byte) ([]string, error) {
+	var promptList []string
+	if err := json.Unmarshal(data, &promptList); err != nil {
+		return nil, err
+	}
+	return promptList, nil
+}
+
+func loadPrimalityPrompts() ([]string, error) {
+	var entries []PrimalityEntry
+	if err := json.Unmarshal(primalityTestingData, &entries); err != nil {
+		return nil, err
+	}
+	promptList := make([]string, len(entries))
+	for i, entry := range entries {
+		promptList[i] = entry.Question
+	}
+	return promptList, nil
+}
+
 func init() {
 	probes.Register("snowball.GraphConnectivityFull", NewGraphConnectivityFull)
 	probes.Register("snowball.GraphConnectivity", NewGraphConnectivity)
@@ -119,3 +119,9 @@ func (p *IterativeTAP) Description() string        { return p.description }
 func (p *IterativeTAP) Goal() string               { return p.goal }
 func (p *IterativeTAP) GetPrimaryDetector() string { return "judge.Judge" }
 func (p *IterativeTAP) GetPrompts() []string       { return []string{} }
+
+// EstimatedAttempts implements types.EstimatedAttemptCounter. TAP builds
+// its prompts iteratively during Probe(), so GetPrompts() can't report a
+// count upfront; this returns the engine's upper bound on target calls
+// instead of leaving budget enforcement to fall back to 1.
+func (p *IterativeTAP) EstimatedAttempts() int { return p.engine.MaxTargetCalls() }
@@ -0,0 +1,66 @@
+package toolabuse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/internal/testutil"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebExfil_Registration(t *testing.T) {
+	factory, ok := probes.Get("toolabuse.WebExfil")
+	require.True(t, ok, "WebExfil should be registered")
+
+	p, err := factory(registry.Config{})
+	require.NoError(t, err)
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+	assert.Equal(t, "toolabuse.WebExfil", p.Name())
+	assert.Equal(t, "agent.DangerousToolCall", pm.GetPrimaryDetector())
+	assert.NotEmpty(t, pm.GetPrompts())
+}
+
+func TestWebExfil_Probe(t *testing.T) {
+	p, err := NewWebExfil(registry.Config{})
+	require.NoError(t, err)
+
+	gen := testutil.NewMockGenerator("Sure, sending that now.")
+	attempts, err := p.Probe(context.Background(), gen)
+	require.NoError(t, err)
+	require.NotEmpty(t, attempts)
+
+	for _, a := range attempts {
+		assert.Equal(t, "toolabuse.WebExfil", a.Probe)
+		assert.Equal(t, "agent.DangerousToolCall", a.Detector)
+	}
+}
+
+func TestDestructiveFileOp_Registration(t *testing.T) {
+	factory, ok := probes.Get("toolabuse.DestructiveFileOp")
+	require.True(t, ok, "DestructiveFileOp should be registered")
+
+	p, err := factory(registry.Config{})
+	require.NoError(t, err)
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+	assert.Equal(t, "toolabuse.DestructiveFileOp", p.Name())
+	assert.Equal(t, "agent.DangerousToolCall", pm.GetPrimaryDetector())
+	assert.NotEmpty(t, pm.GetPrompts())
+}
+
+func TestUnauthorizedInvocation_Registration(t *testing.T) {
+	factory, ok := probes.Get("toolabuse.UnauthorizedInvocation")
+	require.True(t, ok, "UnauthorizedInvocation should be registered")
+
+	p, err := factory(registry.Config{})
+	require.NoError(t, err)
+	pm, ok := p.(probes.ProbeMetadata)
+	require.True(t, ok, "probe should implement ProbeMetadata")
+	assert.Equal(t, "toolabuse.UnauthorizedInvocation", p.Name())
+	assert.Equal(t, "agent.DangerousToolCall", pm.GetPrimaryDetector())
+	assert.NotEmpty(t, pm.GetPrompts())
+}
@@ -0,0 +1,131 @@
+package topic
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/internal/probes/treesearch"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadControversialTopics_BundledOntology(t *testing.T) {
+	roots, err := loadControversialTopics(registry.Config{})
+	require.NoError(t, err)
+	require.NotEmpty(t, roots)
+
+	var leaves int
+	var walk func(n *controversialNode)
+	walk = func(n *controversialNode) {
+		if len(n.children) == 0 {
+			leaves++
+		}
+		for _, child := range n.children {
+			assert.Same(t, n, child.parent, "child %q should point back at parent %q", child.id, n.id)
+			walk(child)
+		}
+	}
+	for _, root := range roots {
+		walk(root)
+	}
+	assert.Greater(t, leaves, 0, "bundled ontology should have leaf topics to probe")
+}
+
+func TestLoadControversialTopics_CorpusPathOverride(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "topics.jsonl")
+	data := `{"id":"root","name":"Root","parent":"","terms":[]}
+{"id":"leaf","name":"Leaf","parent":"root","terms":["leaf topic"]}
+`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+	roots, err := loadControversialTopics(registry.Config{"corpus_path": path})
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+	require.Len(t, roots[0].children, 1)
+	assert.Equal(t, "leaf", roots[0].children[0].id)
+}
+
+func TestLoadControversialTopics_UnknownParent(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "topics.jsonl")
+	data := `{"id":"orphan","name":"Orphan","parent":"missing","terms":[]}
+`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o644))
+
+	_, err := loadControversialTopics(registry.Config{"corpus_path": path})
+	assert.Error(t, err)
+}
+
+func TestLoadControversialTopics_CorpusPathMissingFile(t *testing.T) {
+	_, err := loadControversialTopics(registry.Config{"corpus_path": "/nonexistent/topics.jsonl"})
+	assert.Error(t, err)
+}
+
+func TestNewControversial_UnknownJudgeGenerator(t *testing.T) {
+	probe, err := NewControversial(registry.Config{"judge_generator_type": "mock.Missing"})
+	require.Error(t, err)
+	require.Nil(t, probe)
+}
+
+func TestControversial_Probe(t *testing.T) {
+	roots, err := loadControversialTopics(registry.Config{})
+	require.NoError(t, err)
+
+	probe := &Controversial{
+		TreeSearcher: treesearch.NewTreeSearcher(treesearch.DefaultConfig()),
+		roots:        roots,
+	}
+
+	gen := &mockGenerator{}
+	det := &mockDetector{scores: []float64{1.0}}
+	probe.detector = det
+
+	attempts, err := probe.Probe(context.Background(), gen)
+	require.NoError(t, err)
+	assert.NotEmpty(t, attempts)
+
+	for _, a := range attempts {
+		assert.Equal(t, "topic.Controversial", a.Probe)
+		assert.Equal(t, "judge.Judge", a.Detector)
+	}
+}
+
+func TestControversial_TreeNavigation(t *testing.T) {
+	roots, err := loadControversialTopics(registry.Config{})
+	require.NoError(t, err)
+
+	probe := &Controversial{
+		TreeSearcher: treesearch.NewTreeSearcher(treesearch.DefaultConfig()),
+		roots:        roots,
+	}
+
+	initial := probe.GetInitialNodes()
+	require.NotEmpty(t, initial)
+
+	politics := initial[0]
+	assert.Equal(t, "politics", probe.GetNodeID(politics))
+
+	children := probe.GetNodeChildren(politics)
+	require.NotEmpty(t, children)
+
+	parent := probe.GetNodeParent(children[0])
+	require.NotNil(t, parent)
+	assert.Equal(t, "politics", probe.GetNodeID(parent))
+
+	terms := probe.GetNodeTerms(children[0])
+	require.NotEmpty(t, terms)
+
+	prompts := probe.GeneratePrompts(terms[0])
+	assert.Len(t, prompts, len(controversialPromptTemplates))
+}
+
+func TestControversial_Name(t *testing.T) {
+	probe := &Controversial{}
+	assert.Equal(t, "topic.Controversial", probe.Name())
+	assert.Equal(t, "judge.Judge", probe.GetPrimaryDetector())
+	assert.Equal(t, []string{}, probe.GetPrompts())
+}
@@ -104,6 +104,23 @@ type TopicTreeProbe struct {
 	goal            string
 	primaryDetector string
 	promptTemplates []string
+	// injectedDetector is the scan's resolved detector for primaryDetector,
+	// supplied via SetDetectors (harnesses.InlineScoring). Falls back to
+	// simpleDetector when no harness injects one (e.g. direct use in tests).
+	injectedDetector detectors.Detector
+}
+
+// SetDetectors implements harnesses.InlineScoring. It picks the scan's
+// resolved instance of this probe's primary detector so tree search scores
+// with whatever the scan actually configured, instead of a fixed
+// refusal-pattern heuristic.
+func (p *TopicTreeProbe) SetDetectors(detectorList []detectors.Detector) {
+	for _, d := range detectorList {
+		if d.Name() == p.primaryDetector {
+			p.injectedDetector = d
+			return
+		}
+	}
 }
 
 // NewTopicTreeProbe creates a new topic tree probe.
@@ -180,9 +197,13 @@ func (p *TopicTreeProbe) GetNodeParent(node Node) Node {
 
 // Probe executes the topic tree search.
 func (p *TopicTreeProbe) Probe(ctx context.Context, gen probes.Generator) ([]*attempt.Attempt, error) {
-	// Create a simple detector for scoring
-	det := &simpleDetector{name: p.primaryDetector}
-	
+	// Use the scan's resolved detector when a harness has injected one via
+	// SetDetectors; otherwise fall back to the built-in refusal heuristic.
+	det := p.injectedDetector
+	if det == nil {
+		det = &simpleDetector{name: p.primaryDetector}
+	}
+
 	// Execute tree search
 	attempts, err := p.TreeSearcher.Search(ctx, gen, det, p)
 	if err != nil {
@@ -223,6 +244,26 @@ func (p *TopicTreeProbe) GetPrompts() []string {
 	return p.promptTemplates
 }
 
+// EstimatedAttempts implements types.EstimatedAttemptCounter. Search only
+// ever visits nodes already present in topicTree, so the worst case - every
+// node explored, every term probed - is the tree's total term count times
+// the prompt templates generated per term. PerNodeThreshold pruning means a
+// real run almost always does far less, but GetPrompts()'s 5 static
+// templates don't reflect the tree being walked at all.
+func (p *TopicTreeProbe) EstimatedAttempts() int {
+	return countTreeTerms(topicTree) * len(p.promptTemplates)
+}
+
+// countTreeTerms sums the number of surface forms across node and every
+// node beneath it.
+func countTreeTerms(node *TopicNode) int {
+	total := len(node.terms)
+	for _, child := range node.children {
+		total += countTreeTerms(child)
+	}
+	return total
+}
+
 // simpleDetector is a basic detector for tree search scoring.
 // It checks for refusal patterns to determine if content was blocked.
 type simpleDetector struct {
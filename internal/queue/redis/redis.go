@@ -0,0 +1,84 @@
+// Package redis provides a queue.Queue backed by a Redis list, popped with
+// BLPOP so multiple worker processes can share the same list as a basic
+// competing-consumers queue.
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/praetorian-inc/augustus/pkg/queue"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func init() {
+	queue.Register("redis.List", New)
+}
+
+// Queue pops job messages off a Redis list with BLPOP. Redis lists don't
+// support acknowledgement: BLPOP already removed the message, so Ack is a
+// no-op. A worker that crashes mid-job loses that job, unlike the
+// redelivery guarantee SQS provides.
+type Queue struct {
+	client *goredis.Client
+	key    string
+	poll   time.Duration
+}
+
+// New creates a redis.List queue.
+//
+// Config keys:
+//   - addr (string, required): Redis address, e.g. "localhost:6379".
+//   - key (string, required): the list key to BLPOP from.
+//   - password (string, optional).
+//   - db (int, optional): database number, default 0.
+//   - poll_timeout (unused by callers directly; BLPOP itself blocks up to
+//     5s per call so Receive can notice context cancellation promptly).
+func New(cfg registry.Config) (queue.Queue, error) {
+	addr, err := registry.RequireString(cfg, "addr")
+	if err != nil {
+		return nil, fmt.Errorf("redis.List: %w", err)
+	}
+	key, err := registry.RequireString(cfg, "key")
+	if err != nil {
+		return nil, fmt.Errorf("redis.List: %w", err)
+	}
+	password := registry.GetString(cfg, "password", "")
+	db := registry.GetInt(cfg, "db", 0)
+
+	client := goredis.NewClient(&goredis.Options{
+		Addr:     addr,
+		Password: password,
+		DB:       db,
+		Protocol: 2, // RESP2: broad compatibility with Redis-protocol-speaking services that don't support RESP3's HELLO handshake.
+	})
+
+	return &Queue{client: client, key: key, poll: 5 * time.Second}, nil
+}
+
+// Name returns the queue's fully qualified name.
+func (q *Queue) Name() string { return "redis.List" }
+
+// Receive blocks on BLPOP for up to q.poll, returning nil, nil on timeout
+// so the caller can recheck ctx and try again.
+func (q *Queue) Receive(ctx context.Context) (*queue.Message, error) {
+	result, err := q.client.BLPop(ctx, q.poll, q.key).Result()
+	if err == goredis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("redis.List: BLPOP %s: %w", q.key, err)
+	}
+	// result is [key, value]; BLPOP only ever pops from q.key here.
+	body := result[1]
+	return &queue.Message{
+		Body: []byte(body),
+		Ack:  func(context.Context) error { return nil },
+	}, nil
+}
@@ -0,0 +1,36 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/queue"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func TestNew_RequiresAddr(t *testing.T) {
+	_, err := New(registry.Config{"key": "jobs"})
+	assert.ErrorContains(t, err, "addr")
+}
+
+func TestNew_RequiresKey(t *testing.T) {
+	_, err := New(registry.Config{"addr": "localhost:6379"})
+	assert.ErrorContains(t, err, "key")
+}
+
+func TestQueue_Name(t *testing.T) {
+	q, err := New(registry.Config{"addr": "localhost:6379", "key": "jobs"})
+	require.NoError(t, err)
+	assert.Equal(t, "redis.List", q.Name())
+}
+
+func TestRegistration(t *testing.T) {
+	factory, ok := queue.Get("redis.List")
+	require.True(t, ok, "redis.List should be registered")
+
+	q, err := factory(registry.Config{"addr": "localhost:6379", "key": "jobs"})
+	require.NoError(t, err)
+	assert.Equal(t, "redis.List", q.Name())
+}
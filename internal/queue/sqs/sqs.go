@@ -0,0 +1,90 @@
+// Package sqs provides a queue.Queue backed by an Amazon SQS queue, using
+// long polling so multiple worker processes can share the same queue as a
+// competing-consumers group.
+package sqs
+
+import (
+	"context"
+	"fmt"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	"github.com/praetorian-inc/augustus/pkg/queue"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func init() {
+	queue.Register("sqs.Queue", New)
+}
+
+// Queue receives messages from an SQS queue and deletes them on Ack, which
+// is SQS's at-least-once acknowledgement: an unacked message becomes
+// visible again to other consumers once its visibility timeout elapses.
+type Queue struct {
+	client   *sqs.Client
+	queueURL string
+}
+
+// New creates an sqs.Queue queue.
+//
+// Config keys:
+//   - queue_url (string, required): the SQS queue's URL.
+//   - region (string, optional): defaults to the ambient AWS region.
+//
+// Credentials come from the ambient environment, shared config, or
+// instance role, same as the s3.Upload sink.
+func New(cfg registry.Config) (queue.Queue, error) {
+	queueURL, err := registry.RequireString(cfg, "queue_url")
+	if err != nil {
+		return nil, fmt.Errorf("sqs.Queue: %w", err)
+	}
+	region := registry.GetString(cfg, "region", "")
+
+	ctx := context.Background()
+	var opts []func(*awsconfig.LoadOptions) error
+	if region != "" {
+		opts = append(opts, awsconfig.WithRegion(region))
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("sqs.Queue: failed to load AWS credentials: %w", err)
+	}
+
+	return &Queue{client: sqs.NewFromConfig(awsCfg), queueURL: queueURL}, nil
+}
+
+// Name returns the queue's fully qualified name.
+func (q *Queue) Name() string { return "sqs.Queue" }
+
+// Receive long-polls for up to 20 seconds, returning nil, nil if no message
+// arrives in that window so the caller can recheck ctx and try again.
+func (q *Queue) Receive(ctx context.Context) (*queue.Message, error) {
+	out, err := q.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+		QueueUrl:            &q.queueURL,
+		MaxNumberOfMessages: 1,
+		WaitTimeSeconds:     20,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sqs.Queue: ReceiveMessage: %w", err)
+	}
+	if len(out.Messages) == 0 {
+		return nil, nil
+	}
+
+	msg := out.Messages[0]
+	receiptHandle := msg.ReceiptHandle
+	return &queue.Message{
+		Body: []byte(*msg.Body),
+		Ack: func(ctx context.Context) error {
+			_, err := q.client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+				QueueUrl:      &q.queueURL,
+				ReceiptHandle: receiptHandle,
+			})
+			if err != nil {
+				return fmt.Errorf("sqs.Queue: DeleteMessage: %w", err)
+			}
+			return nil
+		},
+	}, nil
+}
@@ -0,0 +1,31 @@
+package sqs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/queue"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func TestNew_RequiresQueueURL(t *testing.T) {
+	_, err := New(registry.Config{})
+	assert.ErrorContains(t, err, "queue_url")
+}
+
+func TestQueue_Name(t *testing.T) {
+	q, err := New(registry.Config{"queue_url": "https://sqs.us-east-1.amazonaws.com/123/jobs"})
+	require.NoError(t, err)
+	assert.Equal(t, "sqs.Queue", q.Name())
+}
+
+func TestRegistration(t *testing.T) {
+	factory, ok := queue.Get("sqs.Queue")
+	require.True(t, ok, "sqs.Queue should be registered")
+
+	q, err := factory(registry.Config{"queue_url": "https://sqs.us-east-1.amazonaws.com/123/jobs"})
+	require.NoError(t, err)
+	assert.Equal(t, "sqs.Queue", q.Name())
+}
@@ -0,0 +1,160 @@
+// Package blob provides an object-storage sink that uploads scan artifacts
+// (JSONL and HTML) at scan completion. It speaks the S3 API, which also
+// covers GCS buckets in S3-interoperability mode when "endpoint" is set to
+// https://storage.googleapis.com.
+package blob
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/praetorian-inc/augustus/pkg/sinks"
+)
+
+// renderToBytes writes attempts via writeFn to a scratch temp file and
+// returns the resulting bytes, reusing the existing file-based renderers
+// instead of duplicating JSONL/HTML generation for in-memory output.
+func renderToBytes(attempts []*attempt.Attempt, suffix string, writeFn func(string, []*attempt.Attempt) error) ([]byte, error) {
+	tmp, err := os.CreateTemp("", "augustus-sink-*"+suffix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scratch file: %w", err)
+	}
+	path := tmp.Name()
+	tmp.Close()
+	defer os.Remove(path)
+
+	if err := writeFn(path, attempts); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+func init() {
+	sinks.Register("s3.Upload", New)
+}
+
+// Sink uploads a run's JSONL and HTML artifacts to an S3-compatible bucket
+// using ambient credentials (environment, shared config, or instance role).
+type Sink struct {
+	bucket    string
+	jsonlKey  string
+	htmlKey   string
+	newClient func(ctx context.Context) (*s3.Client, error)
+}
+
+// New creates an s3.Upload sink.
+//
+// Config keys:
+//   - bucket (string, required)
+//   - jsonl_key, html_key (string, optional): object key templates.
+//     Support {date}, {generator}, and {sha} placeholders, substituted at
+//     upload time; {generator} comes from the first attempt's Generator field.
+//   - endpoint (string, optional): S3-compatible endpoint override, e.g.
+//     https://storage.googleapis.com for GCS.
+//   - region (string, optional): defaults to the ambient AWS region.
+func New(cfg registry.Config) (sinks.Sink, error) {
+	bucket, _ := cfg["bucket"].(string)
+	if bucket == "" {
+		return nil, fmt.Errorf("s3.Upload: \"bucket\" is required")
+	}
+	jsonlKey, _ := cfg["jsonl_key"].(string)
+	if jsonlKey == "" {
+		jsonlKey = "augustus/{date}/{generator}-{sha}.jsonl"
+	}
+	htmlKey, _ := cfg["html_key"].(string)
+	if htmlKey == "" {
+		htmlKey = "augustus/{date}/{generator}-{sha}.html"
+	}
+	endpoint, _ := cfg["endpoint"].(string)
+	region, _ := cfg["region"].(string)
+
+	newClient := func(ctx context.Context) (*s3.Client, error) {
+		var opts []func(*awsconfig.LoadOptions) error
+		if region != "" {
+			opts = append(opts, awsconfig.WithRegion(region))
+		}
+		awsCfg, err := awsconfig.LoadDefaultConfig(ctx, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("s3.Upload: failed to load AWS credentials: %w", err)
+		}
+		return s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+			if endpoint != "" {
+				o.BaseEndpoint = &endpoint
+			}
+			if endpoint != "" {
+				// Path-style addressing is required by most non-AWS
+				// S3-compatible providers, including GCS interop mode.
+				o.UsePathStyle = true
+			}
+		}), nil
+	}
+
+	return &Sink{bucket: bucket, jsonlKey: jsonlKey, htmlKey: htmlKey, newClient: newClient}, nil
+}
+
+// renderKey substitutes {date}, {generator}, and {sha} placeholders.
+func renderKey(template string, generator string) string {
+	sha := os.Getenv("GIT_SHA")
+	if sha == "" {
+		sha = "unknown"
+	}
+	r := strings.NewReplacer(
+		"{date}", time.Now().UTC().Format("2006-01-02"),
+		"{generator}", generator,
+		"{sha}", sha,
+	)
+	return r.Replace(template)
+}
+
+// Write implements sinks.Sink. It uploads both a JSONL and an HTML
+// rendering of the attempts to the configured bucket.
+func (s *Sink) Write(ctx context.Context, attempts []*attempt.Attempt) error {
+	client, err := s.newClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	generator := ""
+	if len(attempts) > 0 {
+		generator = attempts[0].Generator
+	}
+
+	jsonlBody, err := renderToBytes(attempts, ".jsonl", results.WriteJSONL)
+	if err != nil {
+		return fmt.Errorf("s3.Upload: failed to render JSONL: %w", err)
+	}
+	if err := s.putObject(ctx, client, renderKey(s.jsonlKey, generator), jsonlBody); err != nil {
+		return err
+	}
+
+	htmlBody, err := renderToBytes(attempts, ".html", results.WriteHTML)
+	if err != nil {
+		return fmt.Errorf("s3.Upload: failed to render HTML: %w", err)
+	}
+	return s.putObject(ctx, client, renderKey(s.htmlKey, generator), htmlBody)
+}
+
+func (s *Sink) putObject(ctx context.Context, client *s3.Client, key string, body []byte) error {
+	_, err := client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: &s.bucket,
+		Key:    &key,
+		Body:   bytes.NewReader(body),
+	})
+	if err != nil {
+		return fmt.Errorf("s3.Upload: failed to upload %s: %w", key, err)
+	}
+	return nil
+}
+
+// Name implements sinks.Sink.
+func (s *Sink) Name() string { return "s3.Upload" }
@@ -0,0 +1,26 @@
+package blob
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func TestNew_RequiresBucket(t *testing.T) {
+	_, err := New(registry.Config{})
+	assert.ErrorContains(t, err, "bucket")
+}
+
+func TestRenderKey_SubstitutesPlaceholders(t *testing.T) {
+	t.Setenv("GIT_SHA", "abc123")
+	key := renderKey("runs/{date}/{generator}-{sha}.jsonl", "openai.OpenAI")
+	assert.Contains(t, key, "openai.OpenAI-abc123.jsonl")
+}
+
+func TestRenderKey_DefaultsShaWhenUnset(t *testing.T) {
+	t.Setenv("GIT_SHA", "")
+	key := renderKey("{sha}", "gen")
+	assert.Equal(t, "unknown", key)
+}
@@ -0,0 +1,131 @@
+// Package chariot exports failed attempts to the Praetorian Chariot
+// risk/asset findings API, so augustus results land in the same triage
+// queue as other offensive-tooling output.
+package chariot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/praetorian-inc/augustus/pkg/sinks"
+)
+
+func init() {
+	sinks.Register("chariot.Findings", New)
+}
+
+const defaultTimeout = 30 * time.Second
+
+// Sink pushes failed attempts as Chariot findings via authenticated HTTP.
+type Sink struct {
+	endpoint string
+	apiKey   string
+	client   *http.Client
+}
+
+// New creates a chariot.Findings sink.
+//
+// Config keys:
+//   - endpoint (string, required): Chariot (or generic findings) API URL.
+//   - api_key (string, required): bearer token for authentication.
+func New(cfg registry.Config) (sinks.Sink, error) {
+	endpoint, _ := cfg["endpoint"].(string)
+	if endpoint == "" {
+		return nil, fmt.Errorf("chariot.Findings: \"endpoint\" is required")
+	}
+	apiKey, _ := cfg["api_key"].(string)
+	if apiKey == "" {
+		return nil, fmt.Errorf("chariot.Findings: \"api_key\" is required")
+	}
+
+	return &Sink{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		client:   &http.Client{Timeout: defaultTimeout},
+	}, nil
+}
+
+// finding is the subset of the Chariot risk/asset schema augustus can
+// populate from a failed attempt: a named risk against a virtual "asset"
+// identifying the scanned generator.
+type finding struct {
+	Name     string   `json:"name"`
+	Asset    string   `json:"asset"`
+	Severity string   `json:"severity"`
+	Status   string   `json:"status"`
+	Comment  string   `json:"comment"`
+	Evidence []string `json:"evidence"`
+}
+
+// severityFor maps a detection score to a Chariot-style severity label.
+func severityFor(score float64) string {
+	switch {
+	case score >= 0.8:
+		return "critical"
+	case score >= 0.5:
+		return "high"
+	case score > 0:
+		return "low"
+	default:
+		return "info"
+	}
+}
+
+// Write implements sinks.Sink, POSTing one finding per failing attempt.
+func (s *Sink) Write(ctx context.Context, attempts []*attempt.Attempt) error {
+	findings := make([]finding, 0)
+	for _, a := range attempts {
+		r := results.ToAttemptResult(a)
+		if r.Passed {
+			continue
+		}
+		score := 0.0
+		if len(r.Scores) > 0 {
+			score = r.Scores[0]
+		}
+		findings = append(findings, finding{
+			Name:     fmt.Sprintf("augustus/%s", r.Probe),
+			Asset:    a.Generator,
+			Severity: severityFor(score),
+			Status:   "open",
+			Comment:  fmt.Sprintf("detected by %s (score %.2f)", r.Detector, score),
+			Evidence: []string{r.Prompt, r.Response},
+		})
+	}
+	if len(findings) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string]any{"findings": findings})
+	if err != nil {
+		return fmt.Errorf("chariot.Findings: failed to encode findings: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("chariot.Findings: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("chariot.Findings: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("chariot.Findings: API returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Name implements sinks.Sink.
+func (s *Sink) Name() string { return "chariot.Findings" }
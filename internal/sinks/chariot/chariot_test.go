@@ -0,0 +1,60 @@
+package chariot
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func TestNew_RequiresEndpointAndAPIKey(t *testing.T) {
+	_, err := New(registry.Config{})
+	assert.ErrorContains(t, err, "endpoint")
+
+	_, err = New(registry.Config{"endpoint": "https://example.com"})
+	assert.ErrorContains(t, err, "api_key")
+}
+
+func TestSink_Write_SkipsPassedAttempts(t *testing.T) {
+	called := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sink, err := New(registry.Config{"endpoint": srv.URL, "api_key": "secret"})
+	require.NoError(t, err)
+
+	a := attempt.New("prompt")
+	a.Complete()
+	a.AddScore(0.0) // passed
+
+	require.NoError(t, sink.Write(t.Context(), []*attempt.Attempt{a}))
+	assert.False(t, called, "should not call the API when there is nothing to report")
+}
+
+func TestSink_Write_PostsFailingFindings(t *testing.T) {
+	var gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sink, err := New(registry.Config{"endpoint": srv.URL, "api_key": "secret"})
+	require.NoError(t, err)
+
+	a := attempt.New("prompt")
+	a.Generator = "openai.OpenAI"
+	a.Complete()
+	a.AddScore(0.9)
+
+	require.NoError(t, sink.Write(t.Context(), []*attempt.Attempt{a}))
+	assert.Equal(t, "Bearer secret", gotAuth)
+}
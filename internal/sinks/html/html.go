@@ -0,0 +1,73 @@
+// Package html provides a sink that writes a scan's HTML report.
+package html
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/praetorian-inc/augustus/pkg/sinks"
+)
+
+func init() {
+	sinks.Register("html.Report", New)
+}
+
+// Sink writes a self-contained HTML report of scan attempts.
+type Sink struct {
+	path string
+	opts []results.ReportOption
+}
+
+// New creates an html.Report sink from a "path" config key.
+//
+// Optional config keys let a consultancy brand the report for a client
+// deliverable instead of getting Augustus's own branding:
+//   - title (string): overrides the report's <title>/<h1>.
+//   - org_name (string): organization name shown next to the logo.
+//   - logo_url (string): logo image URL or data: URI.
+//   - client, engagement, date_range, scope (string): engagement metadata
+//     rendered above the Summary section.
+//   - language_pack (map[string]string): section heading overrides, keyed
+//     by results.HeadingSummary, HeadingScorecard, HeadingBuffs, or
+//     HeadingHeatmap.
+func New(cfg registry.Config) (sinks.Sink, error) {
+	path, _ := cfg["path"].(string)
+	if path == "" {
+		return nil, fmt.Errorf("html.Report: \"path\" is required")
+	}
+
+	var opts []results.ReportOption
+	if title, ok := cfg["title"].(string); ok && title != "" {
+		opts = append(opts, results.WithTitle(title))
+	}
+	if orgName, ok := cfg["org_name"].(string); ok && orgName != "" {
+		opts = append(opts, results.WithOrgName(orgName))
+	}
+	if logoURL, ok := cfg["logo_url"].(string); ok && logoURL != "" {
+		opts = append(opts, results.WithLogo(logoURL))
+	}
+	engagement := results.EngagementInfo{}
+	engagement.Client, _ = cfg["client"].(string)
+	engagement.Engagement, _ = cfg["engagement"].(string)
+	engagement.DateRange, _ = cfg["date_range"].(string)
+	engagement.Scope, _ = cfg["scope"].(string)
+	if !engagement.IsZero() {
+		opts = append(opts, results.WithEngagement(engagement))
+	}
+	if pack, ok := cfg["language_pack"].(map[string]string); ok && len(pack) > 0 {
+		opts = append(opts, results.WithLanguagePack(pack))
+	}
+
+	return &Sink{path: path, opts: opts}, nil
+}
+
+// Write implements sinks.Sink.
+func (s *Sink) Write(_ context.Context, attempts []*attempt.Attempt) error {
+	return results.WriteHTMLWithOptions(s.path, attempts, s.opts...)
+}
+
+// Name implements sinks.Sink.
+func (s *Sink) Name() string { return "html.Report" }
@@ -0,0 +1,38 @@
+// Package jsonl provides a sink that writes scan attempts to a JSONL file.
+package jsonl
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/praetorian-inc/augustus/pkg/sinks"
+)
+
+func init() {
+	sinks.Register("jsonl.File", New)
+}
+
+// Sink writes attempts to a JSONL file, one attempt per line.
+type Sink struct {
+	path string
+}
+
+// New creates a jsonl.File sink from a "path" config key.
+func New(cfg registry.Config) (sinks.Sink, error) {
+	path, _ := cfg["path"].(string)
+	if path == "" {
+		return nil, fmt.Errorf("jsonl.File: \"path\" is required")
+	}
+	return &Sink{path: path}, nil
+}
+
+// Write implements sinks.Sink.
+func (s *Sink) Write(_ context.Context, attempts []*attempt.Attempt) error {
+	return results.WriteJSONL(s.path, attempts)
+}
+
+// Name implements sinks.Sink.
+func (s *Sink) Name() string { return "jsonl.File" }
@@ -0,0 +1,48 @@
+// Package stdout provides a sink that prints a summary table to the terminal.
+package stdout
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/praetorian-inc/augustus/pkg/sinks"
+)
+
+func init() {
+	sinks.Register("stdout.Table", New)
+}
+
+// Sink prints a pass/fail table of attempts to stdout.
+type Sink struct{}
+
+// New creates a stdout.Table sink.
+func New(_ registry.Config) (sinks.Sink, error) {
+	return &Sink{}, nil
+}
+
+// Write implements sinks.Sink.
+func (s *Sink) Write(_ context.Context, attempts []*attempt.Attempt) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PROBE\tDETECTOR\tSTATUS\tSCORE")
+	for _, a := range attempts {
+		r := results.ToAttemptResult(a)
+		status := "pass"
+		if !r.Passed {
+			status = "fail"
+		}
+		score := 0.0
+		if len(r.Scores) > 0 {
+			score = r.Scores[0]
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%.2f\n", r.Probe, r.Detector, status, score)
+	}
+	return w.Flush()
+}
+
+// Name implements sinks.Sink.
+func (s *Sink) Name() string { return "stdout.Table" }
@@ -0,0 +1,97 @@
+// Package webhook provides a sink that POSTs a scan summary to a webhook URL,
+// such as a Slack incoming webhook, when a scan finds new vulnerabilities.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/praetorian-inc/augustus/pkg/sinks"
+)
+
+func init() {
+	sinks.Register("webhook.Slack", New)
+}
+
+const defaultTimeout = 15 * time.Second
+
+// Sink POSTs a Slack-compatible JSON payload summarizing a scan to a
+// webhook URL, optionally including one block per failing attempt.
+type Sink struct {
+	url            string
+	includeFailing bool
+	client         *http.Client
+}
+
+// New creates a webhook.Slack sink.
+//
+// Config keys:
+//   - url (string, required): webhook endpoint to POST to.
+//   - include_failing (bool, optional): attach a line per failing attempt.
+func New(cfg registry.Config) (sinks.Sink, error) {
+	url, _ := cfg["url"].(string)
+	if url == "" {
+		return nil, fmt.Errorf("webhook.Slack: \"url\" is required")
+	}
+	includeFailing, _ := cfg["include_failing"].(bool)
+
+	return &Sink{
+		url:            url,
+		includeFailing: includeFailing,
+		client:         &http.Client{Timeout: defaultTimeout},
+	}, nil
+}
+
+// slackPayload mirrors the minimal shape Slack incoming webhooks accept.
+// Other Slack-compatible receivers (Mattermost, Rocket.Chat) understand it too.
+type slackPayload struct {
+	Text string `json:"text"`
+}
+
+// Write implements sinks.Sink.
+func (s *Sink) Write(ctx context.Context, attempts []*attempt.Attempt) error {
+	summary := results.ComputeSummary(attempts)
+	text := fmt.Sprintf("Augustus scan complete: %d attempts, %d passed, %d failed",
+		summary.TotalAttempts, summary.Passed, summary.Failed)
+
+	if s.includeFailing {
+		for _, a := range attempts {
+			r := results.ToAttemptResult(a)
+			if !r.Passed {
+				text += fmt.Sprintf("\n:rotating_light: %s / %s (scores: %v)", r.Probe, r.Detector, r.Scores)
+			}
+		}
+	}
+
+	body, err := json.Marshal(slackPayload{Text: text})
+	if err != nil {
+		return fmt.Errorf("webhook.Slack: failed to encode payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook.Slack: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook.Slack: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook.Slack: webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Name implements sinks.Sink.
+func (s *Sink) Name() string { return "webhook.Slack" }
@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+func TestNew_RequiresURL(t *testing.T) {
+	_, err := New(registry.Config{})
+	assert.ErrorContains(t, err, "url")
+}
+
+func TestSink_Write_PostsSummary(t *testing.T) {
+	var received slackPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	sink, err := New(registry.Config{"url": srv.URL})
+	require.NoError(t, err)
+
+	a := attempt.New("prompt")
+	a.Complete()
+	a.AddScore(0.1)
+
+	err = sink.Write(t.Context(), []*attempt.Attempt{a})
+	require.NoError(t, err)
+	assert.Contains(t, received.Text, "1 attempts")
+}
+
+func TestSink_Write_NonOKStatusIsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	sink, err := New(registry.Config{"url": srv.URL})
+	require.NoError(t, err)
+
+	err = sink.Write(t.Context(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,214 @@
+// Package anonymize strips or hashes target-identifying details (URLs,
+// generator/model names, configured org strings) from scan results, so an
+// attack-outcome corpus can be shared with researchers without exposing
+// which target it was run against. Every redaction is replaced with a
+// deterministic placeholder derived from the original value, and the
+// placeholder -> original mapping is written to a local mapping file -
+// the placeholder itself can't be reversed, so a trusted recipient needs
+// that file to de-anonymize a shared corpus.
+package anonymize
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/results"
+)
+
+// urlPattern matches http(s) URLs embedded in free text.
+var urlPattern = regexp.MustCompile(`https?://[^\s"'<>]+`)
+
+// Mapping records every placeholder this package has generated and the
+// original value it stands for, so a scan run can be de-anonymized later.
+// Mapping is safe for concurrent use.
+type Mapping struct {
+	mu      sync.Mutex
+	Entries map[string]string `json:"entries"` // placeholder -> original
+}
+
+// NewMapping returns an empty Mapping.
+func NewMapping() *Mapping {
+	return &Mapping{Entries: make(map[string]string)}
+}
+
+// placeholder returns a deterministic "[REDACTED_<kind>_<hash>]" token for
+// value, recording it in the mapping. The same value always produces the
+// same placeholder, so repeated occurrences (e.g. the same URL across many
+// prompts) don't bloat the mapping file with duplicate entries.
+func (m *Mapping) placeholder(kind, value string) string {
+	sum := sha256.Sum256([]byte(value))
+	token := fmt.Sprintf("[REDACTED_%s_%s]", kind, hex.EncodeToString(sum[:])[:12])
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Entries[token] = value
+	return token
+}
+
+// SaveMapping writes m to path as indented JSON.
+func SaveMapping(path string, m *Mapping) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode mapping: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write mapping file: %w", err)
+	}
+	return nil
+}
+
+// LoadMapping reads a mapping file written by SaveMapping.
+func LoadMapping(path string) (*Mapping, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping file: %w", err)
+	}
+	var m Mapping
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse mapping file: %w", err)
+	}
+	if m.Entries == nil {
+		m.Entries = make(map[string]string)
+	}
+	return &m, nil
+}
+
+// Anonymizer redacts identifying details from results.AttemptResult
+// records before they're exported to a shareable corpus.
+type Anonymizer struct {
+	Mapping *Mapping
+
+	// OrgStrings are additional literal strings to scrub (org names,
+	// internal hostnames, anything else a URL/generator-name pattern
+	// wouldn't catch on its own).
+	OrgStrings []string
+}
+
+// New returns an Anonymizer backed by a fresh Mapping.
+func New(orgStrings []string) *Anonymizer {
+	return &Anonymizer{Mapping: NewMapping(), OrgStrings: orgStrings}
+}
+
+// redactText scrubs URLs and configured org strings from s.
+func (a *Anonymizer) redactText(s string) string {
+	s = urlPattern.ReplaceAllStringFunc(s, func(url string) string {
+		return a.Mapping.placeholder("URL", url)
+	})
+	for _, org := range a.OrgStrings {
+		if org == "" || !strings.Contains(s, org) {
+			continue
+		}
+		s = strings.ReplaceAll(s, org, a.Mapping.placeholder("ORG", org))
+	}
+	return s
+}
+
+// Result returns a copy of r with identifying details redacted from its
+// prompt/response/error text, every turn of its multi-turn Conversations,
+// and every string value in Metadata: URLs and configured org strings
+// (which, since --org is typically set to the target's generator or model
+// name, is how a generator like "openai.OpenAI" or a model like "gpt-4o"
+// gets scrubbed from prompts that echo it back). The probe, detector,
+// scores, and pass/fail outcome are left intact, since the outcome is the
+// whole point of sharing an attack corpus - only what it was run against
+// needs hiding.
+func (a *Anonymizer) Result(r results.AttemptResult) results.AttemptResult {
+	out := r
+	out.Prompt = a.redactText(r.Prompt)
+	out.Response = a.redactText(r.Response)
+	out.Error = a.redactText(r.Error)
+	out.Conversations = a.redactConversations(r.Conversations)
+	out.Metadata = a.redactMetadata(r.Metadata)
+	return out
+}
+
+// redactConversations returns a copy of conversations with every message's
+// text redacted via redactText. carryover, smuggling.MultiTurn, PAIR/TAP,
+// snowball, crescendo, goat, hydra, and mischievous all populate this field
+// with the full dialogue that led to the final Prompt/Response, so it needs
+// the same treatment.
+func (a *Anonymizer) redactConversations(conversations []*attempt.Conversation) []*attempt.Conversation {
+	if conversations == nil {
+		return nil
+	}
+
+	out := make([]*attempt.Conversation, len(conversations))
+	for i, conv := range conversations {
+		if conv == nil {
+			continue
+		}
+		redacted := &attempt.Conversation{Documents: conv.Documents}
+		if conv.System != nil {
+			sys := a.redactMessage(*conv.System)
+			redacted.System = &sys
+		}
+		redacted.Turns = make([]attempt.Turn, len(conv.Turns))
+		for j, turn := range conv.Turns {
+			redacted.Turns[j] = attempt.Turn{Prompt: a.redactMessage(turn.Prompt)}
+			if turn.Response != nil {
+				resp := a.redactMessage(*turn.Response)
+				redacted.Turns[j].Response = &resp
+			}
+		}
+		out[i] = redacted
+	}
+	return out
+}
+
+// redactMessage returns a copy of msg with its Content redacted.
+func (a *Anonymizer) redactMessage(msg attempt.Message) attempt.Message {
+	msg.Content = a.redactText(msg.Content)
+	return msg
+}
+
+// redactMetadata returns a copy of metadata with every string value (and
+// string found inside nested maps/slices) redacted via redactText. Non-string
+// values (bools, numbers, timestamps) are copied through unchanged.
+func (a *Anonymizer) redactMetadata(metadata map[string]any) map[string]any {
+	if metadata == nil {
+		return nil
+	}
+	out := make(map[string]any, len(metadata))
+	for k, v := range metadata {
+		out[k] = a.redactValue(v)
+	}
+	return out
+}
+
+// redactValue redacts v in place for strings, maps, and slices, and returns
+// every other type unchanged.
+func (a *Anonymizer) redactValue(v any) any {
+	switch val := v.(type) {
+	case string:
+		return a.redactText(val)
+	case map[string]any:
+		return a.redactMetadata(val)
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = a.redactValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// Deanonymize reverses every placeholder token in s using m, restoring the
+// original values. Unknown placeholders (e.g. from a different run's
+// mapping file) are left as-is.
+func Deanonymize(s string, m *Mapping) string {
+	for token, original := range m.Entries {
+		if strings.Contains(s, token) {
+			s = strings.ReplaceAll(s, token, original)
+		}
+	}
+	return s
+}
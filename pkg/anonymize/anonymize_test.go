@@ -0,0 +1,120 @@
+package anonymize_test
+
+import (
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/anonymize"
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnonymizer_Result_RedactsURLsAndOrgStrings(t *testing.T) {
+	a := anonymize.New([]string{"openai.OpenAI", "acmecorp"})
+
+	r := results.AttemptResult{
+		Probe:    "dan.Dan_11_0",
+		Prompt:   "Attack https://api.acmecorp.internal/v1/chat run against openai.OpenAI",
+		Response: "As an AI developed by acmecorp, I cannot...",
+		Detector: "dan.DAN",
+		Passed:   true,
+	}
+
+	redacted := a.Result(r)
+
+	assert.NotContains(t, redacted.Prompt, "https://api.acmecorp.internal/v1/chat")
+	assert.NotContains(t, redacted.Prompt, "openai.OpenAI")
+	assert.NotContains(t, redacted.Response, "acmecorp")
+	assert.Equal(t, r.Probe, redacted.Probe)
+	assert.Equal(t, r.Detector, redacted.Detector)
+	assert.Equal(t, r.Passed, redacted.Passed)
+}
+
+func TestAnonymizer_Result_SamePlaceholderForRepeatedValue(t *testing.T) {
+	a := anonymize.New(nil)
+
+	r1 := a.Result(results.AttemptResult{Prompt: "see https://example.com/leak"})
+	r2 := a.Result(results.AttemptResult{Prompt: "also see https://example.com/leak here"})
+
+	token := r1.Prompt[len("see "):]
+	require.Len(t, a.Mapping.Entries, 1)
+	assert.Contains(t, r2.Prompt, token)
+}
+
+func TestAnonymizer_Result_RedactsConversations(t *testing.T) {
+	a := anonymize.New([]string{"openai.OpenAI"})
+
+	sys := attempt.NewSystemMessage("you are talking to openai.OpenAI")
+	conv := &attempt.Conversation{
+		System: &sys,
+		Turns: []attempt.Turn{
+			attempt.NewTurn("visit https://api.acmecorp.internal/leak").
+				WithResponse("sure, run it against openai.OpenAI"),
+		},
+	}
+
+	r := results.AttemptResult{
+		Prompt:        "final prompt",
+		Conversations: []*attempt.Conversation{conv},
+	}
+
+	redacted := a.Result(r)
+
+	require.Len(t, redacted.Conversations, 1)
+	got := redacted.Conversations[0]
+	assert.NotContains(t, got.System.Content, "openai.OpenAI")
+	assert.NotContains(t, got.Turns[0].Prompt.Content, "https://api.acmecorp.internal/leak")
+	assert.NotContains(t, got.Turns[0].Response.Content, "openai.OpenAI")
+
+	// The original must be untouched - Result returns a redacted copy.
+	assert.Contains(t, conv.System.Content, "openai.OpenAI")
+}
+
+func TestAnonymizer_Result_RedactsMetadataStrings(t *testing.T) {
+	a := anonymize.New([]string{"acmecorp"})
+
+	r := results.AttemptResult{
+		Metadata: map[string]any{
+			"note":       "escalated against acmecorp prod",
+			"turn_count": 3,
+			"nested": map[string]any{
+				"target_url": "https://api.acmecorp.internal/v1",
+			},
+			"tags": []any{"acmecorp", "priority"},
+		},
+	}
+
+	redacted := a.Result(r)
+
+	assert.NotContains(t, redacted.Metadata["note"], "acmecorp")
+	assert.Equal(t, 3, redacted.Metadata["turn_count"])
+	nested := redacted.Metadata["nested"].(map[string]any)
+	assert.NotContains(t, nested["target_url"], "acmecorp")
+	tags := redacted.Metadata["tags"].([]any)
+	assert.NotContains(t, tags[0], "acmecorp")
+	assert.Equal(t, "priority", tags[1])
+}
+
+func TestSaveAndLoadMapping_RoundTrips(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/mapping.json"
+
+	m := anonymize.NewMapping()
+	a := &anonymize.Anonymizer{Mapping: m, OrgStrings: []string{"initech"}}
+	redacted := a.Result(results.AttemptResult{Prompt: "run against initech prod"})
+
+	require.NoError(t, anonymize.SaveMapping(path, m))
+
+	loaded, err := anonymize.LoadMapping(path)
+	require.NoError(t, err)
+
+	restored := anonymize.Deanonymize(redacted.Prompt, loaded)
+	assert.Equal(t, "run against initech prod", restored)
+}
+
+func TestDeanonymize_LeavesUnknownPlaceholdersAlone(t *testing.T) {
+	m := anonymize.NewMapping()
+	result := anonymize.Deanonymize("[REDACTED_URL_deadbeef0000]", m)
+	assert.Equal(t, "[REDACTED_URL_deadbeef0000]", result)
+}
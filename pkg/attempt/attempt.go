@@ -1,6 +1,8 @@
 package attempt
 
 import (
+	"context"
+	"errors"
 	"time"
 )
 
@@ -14,6 +16,10 @@ const (
 	StatusComplete Status = "complete"
 	// StatusError indicates the attempt failed with an error.
 	StatusError Status = "error"
+	// StatusTimedOut indicates the attempt was aborted because a
+	// per-attempt, per-probe, or per-detector budget's context deadline
+	// elapsed, as opposed to a generic failure.
+	StatusTimedOut Status = "timed_out"
 )
 
 // DefaultVulnerabilityThreshold is the default score threshold above which
@@ -127,9 +133,17 @@ func (a *Attempt) SetDetectorResults(detectorName string, scores []float64) {
 	a.DetectorResults[detectorName] = scores
 }
 
-// SetError marks the attempt as failed with an error message.
+// SetError marks the attempt as failed with an error message. If err wraps
+// context.DeadlineExceeded - i.e. the failure came from a per-attempt,
+// per-probe, or per-detector timeout budget elapsing - the attempt is marked
+// StatusTimedOut instead of the generic StatusError, so timeouts are
+// distinguishable from other failures in reports.
 func (a *Attempt) SetError(err error) {
-	a.Status = StatusError
+	if errors.Is(err, context.DeadlineExceeded) {
+		a.Status = StatusTimedOut
+	} else {
+		a.Status = StatusError
+	}
 	if err != nil {
 		a.Error = err.Error()
 	}
@@ -202,6 +216,48 @@ func (a *Attempt) GetMetadata(key string) (any, bool) {
 	return v, ok
 }
 
+// History returns the turn-by-turn exchange for this attempt's first
+// recorded conversation, or nil if the attempt has no Conversations (e.g.
+// single-turn probes that only populate Prompt/Outputs). Detectors and
+// report renderers that want structured per-turn context - which role sent
+// what, in order - should use this instead of reconstructing it from the
+// flattened Prompts/Outputs slices.
+func (a *Attempt) History() []Turn {
+	if len(a.Conversations) == 0 || a.Conversations[0] == nil {
+		return nil
+	}
+	return a.Conversations[0].Turns
+}
+
+// ProvenanceStep records one stage of a buff transformation chain: which
+// buff ran, what variant/setting it used (if any), and the prompt that
+// existed immediately before that buff transformed it.
+type ProvenanceStep struct {
+	Buff    string `json:"buff"`
+	Variant string `json:"variant,omitempty"`
+	Prompt  string `json:"prompt"`
+}
+
+// AppendProvenance records one buff transformation stage under
+// MetadataKeyProvenance, so reports can reconstruct exactly how a buffed
+// prompt was built across a chain of buffs. variant may be empty if the
+// buff has no distinct variant/setting to record.
+func (a *Attempt) AppendProvenance(buffName, variant, priorPrompt string) {
+	chain := a.ProvenanceChain()
+	chain = append(append([]ProvenanceStep{}, chain...), ProvenanceStep{
+		Buff:    buffName,
+		Variant: variant,
+		Prompt:  priorPrompt,
+	})
+	a.WithMetadata(MetadataKeyProvenance, chain)
+}
+
+// ProvenanceChain returns the buff transformation chain recorded via
+// AppendProvenance, or nil if this attempt was never transformed by a buff.
+func (a *Attempt) ProvenanceChain() []ProvenanceStep {
+	return ParseProvenanceChain(a.Metadata[MetadataKeyProvenance])
+}
+
 // Copy creates a shallow copy of the attempt with independent slices and maps.
 // Note: the Conversations field is NOT deep copied. Callers that modify
 // conversation content after copying should use Conversation.Clone() to
@@ -59,6 +59,32 @@ func TestGetEffectiveScores_NoDetector(t *testing.T) {
 	}
 }
 
+func TestAppendProvenance_AccumulatesChain(t *testing.T) {
+	a := New("hello")
+
+	a.AppendProvenance("encoding.Base64", "", "hello")
+	a.AppendProvenance("flip.FlipAttack", "word", "aGVsbG8=")
+
+	chain := a.ProvenanceChain()
+	if len(chain) != 2 {
+		t.Fatalf("Expected 2 provenance steps, got %d", len(chain))
+	}
+	if chain[0].Buff != "encoding.Base64" || chain[0].Prompt != "hello" || chain[0].Variant != "" {
+		t.Errorf("Unexpected first step: %+v", chain[0])
+	}
+	if chain[1].Buff != "flip.FlipAttack" || chain[1].Prompt != "aGVsbG8=" || chain[1].Variant != "word" {
+		t.Errorf("Unexpected second step: %+v", chain[1])
+	}
+}
+
+func TestProvenanceChain_NilWhenUnset(t *testing.T) {
+	a := New("hello")
+
+	if chain := a.ProvenanceChain(); chain != nil {
+		t.Errorf("Expected nil provenance chain, got %v", chain)
+	}
+}
+
 func TestIsVulnerable_UsesDefaultThreshold(t *testing.T) {
 	a := &Attempt{
 		Scores: []float64{0.6}, // Above default threshold
@@ -75,3 +101,28 @@ func TestIsVulnerable_UsesDefaultThreshold(t *testing.T) {
 		t.Error("Expected IsVulnerable(0.7) to return false for score 0.6")
 	}
 }
+
+func TestHistory_NilWhenNoConversations(t *testing.T) {
+	a := New("hello")
+
+	if history := a.History(); history != nil {
+		t.Errorf("Expected nil history, got %v", history)
+	}
+}
+
+func TestHistory_ReturnsFirstConversationTurns(t *testing.T) {
+	conv := NewConversation()
+	conv.AddTurn(NewTurn("part one").WithResponse("okay"))
+	conv.AddTurn(NewTurn("part two").WithResponse("got it"))
+
+	a := New("part one")
+	a.Conversations = []*Conversation{conv}
+
+	history := a.History()
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 turns, got %d", len(history))
+	}
+	if history[0].Prompt.Content != "part one" || history[1].Prompt.Content != "part two" {
+		t.Errorf("Unexpected turn prompts: %+v", history)
+	}
+}
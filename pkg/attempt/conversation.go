@@ -24,12 +24,29 @@ func (t Turn) WithResponse(response string) Turn {
 	}
 }
 
+// Document represents a single reference document passed through a
+// generator's native RAG/grounding interface (e.g. Cohere's chat API
+// documents parameter) instead of being inlined into prompt text.
+// Generators without a native documents interface ignore this field.
+type Document struct {
+	// ID optionally identifies the document (e.g. for citation in the
+	// response). Generators that don't use citations ignore it.
+	ID string `json:"id,omitempty"`
+	// Data holds the document's fields (e.g. "title", "snippet"), matching
+	// Cohere's free-form document schema.
+	Data map[string]string `json:"data"`
+}
+
 // Conversation represents a multi-turn dialogue.
 type Conversation struct {
 	// System is the optional system prompt.
 	System *Message `json:"system,omitempty"`
 	// Turns contains the sequence of exchanges.
 	Turns []Turn `json:"turns"`
+	// Documents holds reference material passed through a generator's
+	// native RAG/grounding interface rather than inlined into prompt text.
+	// Generators without native document support ignore this field.
+	Documents []Document `json:"documents,omitempty"`
 }
 
 // NewConversation creates an empty conversation.
@@ -56,6 +73,12 @@ func (c *Conversation) AddPrompt(prompt string) {
 	c.AddTurn(NewTurn(prompt))
 }
 
+// AddDocument appends a reference document to the conversation, for
+// generators that support a native RAG/grounding interface.
+func (c *Conversation) AddDocument(data map[string]string) {
+	c.Documents = append(c.Documents, Document{Data: data})
+}
+
 // ToMessages flattens the conversation to a slice of messages.
 // This is useful for APIs that expect a flat message list.
 func (c *Conversation) ToMessages() []Message {
@@ -103,6 +126,11 @@ func (c *Conversation) Clone() *Conversation {
 		}
 	}
 
+	if c.Documents != nil {
+		clone.Documents = make([]Document, len(c.Documents))
+		copy(clone.Documents, c.Documents)
+	}
+
 	return clone
 }
 
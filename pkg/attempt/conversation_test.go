@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConversationLastPrompt(t *testing.T) {
@@ -59,3 +60,26 @@ func TestConversationReplaceLastPrompt_Empty(t *testing.T) {
 
 	assert.Equal(t, 0, len(conv.Turns))
 }
+
+func TestConversationAddDocument(t *testing.T) {
+	conv := NewConversation()
+	conv.AddDocument(map[string]string{"title": "Doc 1", "snippet": "Content 1"})
+	conv.AddDocument(map[string]string{"title": "Doc 2", "snippet": "Content 2"})
+
+	require.Len(t, conv.Documents, 2)
+	assert.Equal(t, "Doc 1", conv.Documents[0].Data["title"])
+	assert.Equal(t, "Doc 2", conv.Documents[1].Data["title"])
+}
+
+func TestConversationClone_Documents(t *testing.T) {
+	conv := NewConversation()
+	conv.AddPrompt("Hello")
+	conv.AddDocument(map[string]string{"title": "Doc 1"})
+
+	cloned := conv.Clone()
+	conv.AddDocument(map[string]string{"title": "Doc 2"})
+
+	require.Len(t, cloned.Documents, 1)
+	assert.Equal(t, "Doc 1", cloned.Documents[0].Data["title"])
+	assert.Len(t, conv.Documents, 2)
+}
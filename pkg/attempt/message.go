@@ -23,6 +23,25 @@ type Message struct {
 	Role Role `json:"role"`
 	// Content is the text content of the message.
 	Content string `json:"content"`
+
+	// BlockReason, when non-empty, reports that this message was produced
+	// by a generator call the provider's own content filter intercepted
+	// (an Azure content-filter error, an Anthropic refusal stop reason, an
+	// OpenAI policy block, ...) rather than one that completed normally.
+	// Generators that can detect this populate it on the returned message
+	// itself, so the outcome stays attached to the call that produced it
+	// even when a caller runs many Generate calls concurrently on the same
+	// generator.
+	BlockReason string `json:"block_reason,omitempty"`
+
+	// ToolCalls contains the tools invoked to produce this message, each a
+	// map with at least a "name" key, or nil if none were invoked.
+	ToolCalls []map[string]any `json:"tool_calls,omitempty"`
+
+	// Thinking contains extended-thinking text blocks (e.g. Anthropic's
+	// thinking content) produced alongside this message, in order, or nil
+	// if none were reported.
+	Thinking []string `json:"thinking,omitempty"`
 }
 
 // NewMessage creates a new message with the given role and content.
@@ -0,0 +1,238 @@
+package attempt
+
+import "fmt"
+
+// Typed accessors for the reserved metadata keys documented in
+// metadata_keys.go. Probes/buffs/detectors that read or write one of these
+// keys should use the matching Get/Set method instead of touching Metadata
+// directly: the setters store the canonical Go type, and the getters
+// normalize values that arrived as a different (but compatible) type - e.g.
+// []any after a JSON round-trip - so producers and consumers of the same
+// key can't silently disagree about its shape.
+
+// SetSystemPrompt records the adversarial/system framing a probe wants sent
+// alongside its prompt, under MetadataKeySystemPrompt.
+func (a *Attempt) SetSystemPrompt(systemPrompt string) {
+	a.WithMetadata(MetadataKeySystemPrompt, systemPrompt)
+}
+
+// GetSystemPrompt returns the system prompt recorded via SetSystemPrompt, or
+// "" if none was set.
+func (a *Attempt) GetSystemPrompt() string {
+	v, _ := a.Metadata[MetadataKeySystemPrompt].(string)
+	return v
+}
+
+// SetTriggers records the trigger strings a detector should look for in the
+// model's output, under MetadataKeyTriggers.
+func (a *Attempt) SetTriggers(triggers []string) {
+	a.WithMetadata(MetadataKeyTriggers, triggers)
+}
+
+// GetTriggers retrieves trigger strings from attempt metadata. It handles
+// three value shapes for MetadataKeyTriggers:
+//   - []string: returns as-is
+//   - []any: converts each element to string (the shape triggers arrive in
+//     after a JSON round-trip, since encoding/json decodes untyped slices
+//     into []any)
+//   - string: wraps the single string in a slice
+//
+// Returns (nil, nil) if no triggers key is present. Returns an error if the
+// value is an unexpected type or a []any element is not a string.
+func (a *Attempt) GetTriggers() ([]string, error) {
+	raw, ok := a.Metadata[MetadataKeyTriggers]
+	if !ok {
+		return nil, nil
+	}
+	return parseTriggers(raw)
+}
+
+// parseTriggers is the canonical deserialization for MetadataKeyTriggers,
+// shared by GetTriggers and NormalizeMetadata.
+func parseTriggers(raw any) ([]string, error) {
+	switch v := raw.(type) {
+	case []string:
+		return v, nil
+	case []any:
+		result := make([]string, 0, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("trigger at index %d is not a string", i)
+			}
+			result = append(result, s)
+		}
+		return result, nil
+	case string:
+		return []string{v}, nil
+	default:
+		return nil, fmt.Errorf("triggers must be []string, []any, or string, got %T", raw)
+	}
+}
+
+// SetFlipMode records which flip.FlipMode a flip buff applied, under
+// MetadataKeyFlipMode.
+func (a *Attempt) SetFlipMode(mode string) {
+	a.WithMetadata(MetadataKeyFlipMode, mode)
+}
+
+// GetFlipMode returns the flip mode recorded via SetFlipMode, or "" if none
+// was set.
+func (a *Attempt) GetFlipMode() string {
+	v, _ := a.Metadata[MetadataKeyFlipMode].(string)
+	return v
+}
+
+// SetVariant records the buff-specific variant/setting used to produce this
+// attempt, under MetadataKeyVariant.
+func (a *Attempt) SetVariant(variant string) {
+	a.WithMetadata(MetadataKeyVariant, variant)
+}
+
+// GetVariant returns the variant recorded via SetVariant, or "" if none was
+// set.
+func (a *Attempt) GetVariant() string {
+	v, _ := a.Metadata[MetadataKeyVariant].(string)
+	return v
+}
+
+// ParseProvenanceChain extracts a buff provenance chain from a raw metadata
+// value, handling both the in-memory representation AppendProvenance stores
+// ([]ProvenanceStep) and the shape it arrives in after a JSON round-trip
+// ([]any of map[string]any). Returns nil for any other shape, including nil.
+func ParseProvenanceChain(raw any) []ProvenanceStep {
+	switch chain := raw.(type) {
+	case []ProvenanceStep:
+		return chain
+	case []any:
+		steps := make([]ProvenanceStep, 0, len(chain))
+		for _, r := range chain {
+			if rec, ok := r.(map[string]any); ok {
+				step := ProvenanceStep{}
+				step.Buff, _ = rec["buff"].(string)
+				step.Variant, _ = rec["variant"].(string)
+				step.Prompt, _ = rec["prompt"].(string)
+				steps = append(steps, step)
+			}
+		}
+		return steps
+	default:
+		return nil
+	}
+}
+
+// SetGoal records the probe's attack objective (e.g. "get the model to
+// reveal the system prompt"), under MetadataKeyGoal, for judge-style
+// detectors that need to know what counts as success.
+func (a *Attempt) SetGoal(goal string) {
+	a.WithMetadata(MetadataKeyGoal, goal)
+}
+
+// GetGoal returns the goal recorded via SetGoal, or "" if none was set.
+func (a *Attempt) GetGoal() string {
+	v, _ := a.Metadata[MetadataKeyGoal].(string)
+	return v
+}
+
+// DetectionContext bundles the probe-provided context a detector needs to
+// judge an attempt - its triggers, attack goal, and original (pre-buff)
+// prompt - so detectors can ask for it once instead of each re-implementing
+// extraction from Metadata.
+type DetectionContext struct {
+	// Triggers are the strings a detector should look for in the model's
+	// output (see MetadataKeyTriggers).
+	Triggers []string
+	// Goal is the probe's attack objective (see MetadataKeyGoal), or "" if
+	// the probe didn't set one.
+	Goal string
+	// OriginalPrompt is the prompt before any buff transformed it, recovered
+	// from the provenance chain's first step. Equal to a.Prompt if the
+	// attempt went through no buff.
+	OriginalPrompt string
+}
+
+// DetectionContext assembles a DetectionContext for this attempt. Returns an
+// error if the stored triggers can't be parsed (see GetTriggers).
+func (a *Attempt) DetectionContext() (DetectionContext, error) {
+	triggers, err := a.GetTriggers()
+	if err != nil {
+		return DetectionContext{}, err
+	}
+
+	originalPrompt := a.Prompt
+	if chain := a.ProvenanceChain(); len(chain) > 0 {
+		originalPrompt = chain[0].Prompt
+	}
+
+	return DetectionContext{
+		Triggers:       triggers,
+		Goal:           a.GetGoal(),
+		OriginalPrompt: originalPrompt,
+	}, nil
+}
+
+// NormalizeMetadata restores the canonical Go type for every reserved key
+// present in a raw metadata map, in place. Use this after decoding an
+// attempt (or AttemptResult) from JSON independently of Attempt - e.g.
+// results.ReadAttemptResultsJSONL - since encoding/json always decodes
+// untyped slices into []any, which would otherwise silently break
+// GetTriggers/ProvenanceChain callers expecting []string/[]ProvenanceStep.
+func NormalizeMetadata(meta map[string]any) error {
+	if meta == nil {
+		return nil
+	}
+	if raw, ok := meta[MetadataKeyTriggers]; ok {
+		triggers, err := parseTriggers(raw)
+		if err != nil {
+			return fmt.Errorf("metadata[%s]: %w", MetadataKeyTriggers, err)
+		}
+		meta[MetadataKeyTriggers] = triggers
+	}
+	if raw, ok := meta[MetadataKeyProvenance]; ok {
+		meta[MetadataKeyProvenance] = ParseProvenanceChain(raw)
+	}
+	return nil
+}
+
+// ValidateMetadata checks that every reserved key present in Metadata holds
+// a value GetTriggers/GetSystemPrompt/GetFlipMode/GetVariant/GetGoal/
+// ProvenanceChain can actually read back, returning an error describing the
+// first mismatch.
+// Intended for tests and replay/diff tooling (see
+// results.ReadAttemptResultsJSONL) that want to fail fast on a malformed
+// attempt rather than silently treating a reserved key as absent.
+func (a *Attempt) ValidateMetadata() error {
+	if _, ok := a.Metadata[MetadataKeyTriggers]; ok {
+		if _, err := a.GetTriggers(); err != nil {
+			return fmt.Errorf("metadata[%s]: %w", MetadataKeyTriggers, err)
+		}
+	}
+	if v, ok := a.Metadata[MetadataKeySystemPrompt]; ok {
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("metadata[%s] must be a string, got %T", MetadataKeySystemPrompt, v)
+		}
+	}
+	if v, ok := a.Metadata[MetadataKeyFlipMode]; ok {
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("metadata[%s] must be a string, got %T", MetadataKeyFlipMode, v)
+		}
+	}
+	if v, ok := a.Metadata[MetadataKeyVariant]; ok {
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("metadata[%s] must be a string, got %T", MetadataKeyVariant, v)
+		}
+	}
+	if v, ok := a.Metadata[MetadataKeyGoal]; ok {
+		if _, ok := v.(string); !ok {
+			return fmt.Errorf("metadata[%s] must be a string, got %T", MetadataKeyGoal, v)
+		}
+	}
+	if v, ok := a.Metadata[MetadataKeyProvenance]; ok {
+		switch v.(type) {
+		case []ProvenanceStep, []any:
+		default:
+			return fmt.Errorf("metadata[%s] must be []ProvenanceStep or []any, got %T", MetadataKeyProvenance, v)
+		}
+	}
+	return nil
+}
@@ -2,9 +2,26 @@ package attempt
 
 // Metadata key constants used across probes, buffs, and detectors.
 // Using these constants prevents silent breakage from key typos.
+//
+// These are the only reserved keys in Attempt.Metadata: each has a
+// documented Go type and a matching pair of Get/Set accessor methods below.
+// Probes, buffs, and detectors should go through those accessors rather
+// than reading/writing Metadata[key] directly, since the getters normalize
+// values that arrived as a different (but compatible) type - e.g. trigger
+// strings decoded as []any after a JSON round-trip. Attempt.Metadata still
+// accepts arbitrary non-reserved keys for capability-specific data that
+// doesn't need cross-package agreement.
 const (
+	// MetadataKeySystemPrompt holds a string: see SetSystemPrompt/GetSystemPrompt.
 	MetadataKeySystemPrompt = "system_prompt"
-	MetadataKeyTriggers     = "triggers"
-	MetadataKeyFlipMode     = "flip_mode"
-	MetadataKeyVariant      = "variant"
+	// MetadataKeyTriggers holds a []string: see SetTriggers/GetTriggers.
+	MetadataKeyTriggers = "triggers"
+	// MetadataKeyFlipMode holds a string: see SetFlipMode/GetFlipMode.
+	MetadataKeyFlipMode = "flip_mode"
+	// MetadataKeyVariant holds a string: see SetVariant/GetVariant.
+	MetadataKeyVariant = "variant"
+	// MetadataKeyProvenance holds a []ProvenanceStep: see AppendProvenance/ProvenanceChain.
+	MetadataKeyProvenance = "provenance"
+	// MetadataKeyGoal holds a string: see SetGoal/GetGoal.
+	MetadataKeyGoal = "goal"
 )
@@ -0,0 +1,234 @@
+package attempt
+
+import "testing"
+
+func TestSystemPromptRoundTrip(t *testing.T) {
+	a := New("hello")
+	if got := a.GetSystemPrompt(); got != "" {
+		t.Fatalf("expected empty system prompt, got %q", got)
+	}
+
+	a.SetSystemPrompt("you are a helpful assistant")
+	if got := a.GetSystemPrompt(); got != "you are a helpful assistant" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestGetTriggers_StringSlice(t *testing.T) {
+	a := New("hello")
+	a.SetTriggers([]string{"one", "two"})
+
+	triggers, err := a.GetTriggers()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(triggers) != 2 || triggers[0] != "one" || triggers[1] != "two" {
+		t.Fatalf("got %v", triggers)
+	}
+}
+
+func TestGetTriggers_AnySliceAfterJSONRoundTrip(t *testing.T) {
+	a := New("hello")
+	a.Metadata[MetadataKeyTriggers] = []any{"one", "two"}
+
+	triggers, err := a.GetTriggers()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(triggers) != 2 || triggers[0] != "one" || triggers[1] != "two" {
+		t.Fatalf("got %v", triggers)
+	}
+}
+
+func TestGetTriggers_SingleString(t *testing.T) {
+	a := New("hello")
+	a.Metadata[MetadataKeyTriggers] = "only-one"
+
+	triggers, err := a.GetTriggers()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(triggers) != 1 || triggers[0] != "only-one" {
+		t.Fatalf("got %v", triggers)
+	}
+}
+
+func TestGetTriggers_Unset(t *testing.T) {
+	a := New("hello")
+
+	triggers, err := a.GetTriggers()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if triggers != nil {
+		t.Fatalf("expected nil, got %v", triggers)
+	}
+}
+
+func TestGetTriggers_InvalidType(t *testing.T) {
+	a := New("hello")
+	a.Metadata[MetadataKeyTriggers] = 42
+
+	if _, err := a.GetTriggers(); err == nil {
+		t.Fatal("expected error for invalid triggers type")
+	}
+}
+
+func TestGetTriggers_AnySliceWithNonStringElement(t *testing.T) {
+	a := New("hello")
+	a.Metadata[MetadataKeyTriggers] = []any{"one", 2}
+
+	if _, err := a.GetTriggers(); err == nil {
+		t.Fatal("expected error for non-string element")
+	}
+}
+
+func TestFlipModeRoundTrip(t *testing.T) {
+	a := New("hello")
+	a.SetFlipMode("word")
+
+	if got := a.GetFlipMode(); got != "word" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestVariantRoundTrip(t *testing.T) {
+	a := New("hello")
+	a.SetVariant("cot")
+
+	if got := a.GetVariant(); got != "cot" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestValidateMetadata_ValidAttempt(t *testing.T) {
+	a := New("hello")
+	a.SetSystemPrompt("sys")
+	a.SetTriggers([]string{"trigger"})
+	a.SetFlipMode("word")
+	a.SetVariant("cot")
+	a.AppendProvenance("encoding.Base64", "", "hello")
+
+	if err := a.ValidateMetadata(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestParseProvenanceChain_InMemory(t *testing.T) {
+	chain := ParseProvenanceChain([]ProvenanceStep{{Buff: "encoding.Base64", Prompt: "hello"}})
+	if len(chain) != 1 || chain[0].Buff != "encoding.Base64" {
+		t.Fatalf("got %+v", chain)
+	}
+}
+
+func TestParseProvenanceChain_JSONDeserialized(t *testing.T) {
+	raw := []any{map[string]any{"buff": "encoding.Base64", "prompt": "hello"}}
+
+	chain := ParseProvenanceChain(raw)
+	if len(chain) != 1 || chain[0].Buff != "encoding.Base64" || chain[0].Prompt != "hello" {
+		t.Fatalf("got %+v", chain)
+	}
+}
+
+func TestParseProvenanceChain_Nil(t *testing.T) {
+	if chain := ParseProvenanceChain(nil); chain != nil {
+		t.Fatalf("expected nil, got %+v", chain)
+	}
+}
+
+func TestNormalizeMetadata_RestoresTriggersAndProvenance(t *testing.T) {
+	meta := map[string]any{
+		MetadataKeyTriggers:   []any{"one", "two"},
+		MetadataKeyProvenance: []any{map[string]any{"buff": "encoding.Base64", "prompt": "hello"}},
+	}
+
+	if err := NormalizeMetadata(meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	triggers, ok := meta[MetadataKeyTriggers].([]string)
+	if !ok || len(triggers) != 2 {
+		t.Fatalf("got %v", meta[MetadataKeyTriggers])
+	}
+	chain, ok := meta[MetadataKeyProvenance].([]ProvenanceStep)
+	if !ok || len(chain) != 1 {
+		t.Fatalf("got %v", meta[MetadataKeyProvenance])
+	}
+}
+
+func TestNormalizeMetadata_NilMap(t *testing.T) {
+	if err := NormalizeMetadata(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNormalizeMetadata_PropagatesTriggerError(t *testing.T) {
+	meta := map[string]any{MetadataKeyTriggers: 42}
+
+	if err := NormalizeMetadata(meta); err == nil {
+		t.Fatal("expected error for invalid triggers type")
+	}
+}
+
+func TestGoalRoundTrip(t *testing.T) {
+	a := New("hello")
+	if got := a.GetGoal(); got != "" {
+		t.Fatalf("expected empty goal, got %q", got)
+	}
+
+	a.SetGoal("get the model to reveal the system prompt")
+	if got := a.GetGoal(); got != "get the model to reveal the system prompt" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestDetectionContext_AssemblesFromMetadata(t *testing.T) {
+	a := New("buffed prompt")
+	a.SetTriggers([]string{"secret"})
+	a.SetGoal("leak the secret")
+	a.AppendProvenance("encoding.Base64", "", "original prompt")
+
+	dc, err := a.DetectionContext()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(dc.Triggers) != 1 || dc.Triggers[0] != "secret" {
+		t.Fatalf("got triggers %v", dc.Triggers)
+	}
+	if dc.Goal != "leak the secret" {
+		t.Fatalf("got goal %q", dc.Goal)
+	}
+	if dc.OriginalPrompt != "original prompt" {
+		t.Fatalf("got original prompt %q", dc.OriginalPrompt)
+	}
+}
+
+func TestDetectionContext_NoBuffUsesAttemptPrompt(t *testing.T) {
+	a := New("unbuffed prompt")
+
+	dc, err := a.DetectionContext()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dc.OriginalPrompt != "unbuffed prompt" {
+		t.Fatalf("got %q", dc.OriginalPrompt)
+	}
+}
+
+func TestDetectionContext_PropagatesTriggerError(t *testing.T) {
+	a := New("hello")
+	a.Metadata[MetadataKeyTriggers] = 42
+
+	if _, err := a.DetectionContext(); err == nil {
+		t.Fatal("expected error for invalid triggers type")
+	}
+}
+
+func TestValidateMetadata_RejectsWrongType(t *testing.T) {
+	a := New("hello")
+	a.Metadata[MetadataKeySystemPrompt] = 123
+
+	if err := a.ValidateMetadata(); err == nil {
+		t.Fatal("expected error for non-string system prompt")
+	}
+}
@@ -9,9 +9,10 @@ import (
 
 // Transformer is any type that can transform a single attempt into a sequence
 // of attempts. All Buff implementations satisfy this interface via their
-// Transform method.
+// Transform and Name methods.
 type Transformer interface {
 	Transform(a *attempt.Attempt) iter.Seq[*attempt.Attempt]
+	Name() string
 }
 
 // DefaultBuff provides the standard Buff() loop: iterate over input attempts,
@@ -36,9 +37,20 @@ func DefaultBuff(ctx context.Context, attempts []*attempt.Attempt, t Transformer
 		}
 
 		for transformed := range t.Transform(a) {
+			recordProvenance(t.Name(), a, transformed)
 			results = append(results, transformed)
 		}
 	}
 
 	return results, nil
 }
+
+// recordProvenance appends a provenance step to transformed's structured
+// metadata when the buff actually changed the prompt, so HTML reports can
+// reconstruct how a buffed prompt was built across a chain of buffs.
+func recordProvenance(buffName string, before, after *attempt.Attempt) {
+	if after.Prompt == before.Prompt {
+		return
+	}
+	after.AppendProvenance(buffName, after.GetVariant(), before.Prompt)
+}
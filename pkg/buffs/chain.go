@@ -58,7 +58,15 @@ func (c *BuffChain) Transform(a *attempt.Attempt) iter.Seq[*attempt.Attempt] {
 		}
 	}
 
-	current := c.buffs[0].Transform(a)
+	first := c.buffs[0]
+	current := func(yield func(*attempt.Attempt) bool) {
+		for transformed := range first.Transform(a) {
+			recordProvenance(first.Name(), a, transformed)
+			if !yield(transformed) {
+				return
+			}
+		}
+	}
 	for _, b := range c.buffs[1:] {
 		current = chainTransforms(current, b)
 	}
@@ -70,6 +78,7 @@ func chainTransforms(prev iter.Seq[*attempt.Attempt], next Buff) iter.Seq[*attem
 	return func(yield func(*attempt.Attempt) bool) {
 		for a := range prev {
 			for transformed := range next.Transform(a) {
+				recordProvenance(next.Name(), a, transformed)
 				if !yield(transformed) {
 					return
 				}
@@ -92,6 +92,33 @@ func TestDefaultBuff_OneToMany(t *testing.T) {
 	assert.Equal(t, "test-C", result[2].Prompt)
 }
 
+// Test DefaultBuff records a provenance step when the prompt changes
+func TestDefaultBuff_RecordsProvenance(t *testing.T) {
+	attempts := []*attempt.Attempt{{Prompt: "hello"}}
+	transformer := &mockBuff{name: "prefix.Buff", prefix: "PREFIX:"}
+
+	result, err := buffs.DefaultBuff(context.Background(), attempts, transformer)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	chain := result[0].ProvenanceChain()
+	require.Len(t, chain, 1)
+	assert.Equal(t, "prefix.Buff", chain[0].Buff)
+	assert.Equal(t, "hello", chain[0].Prompt)
+}
+
+// Test DefaultBuff does not record a provenance step when the prompt is unchanged
+func TestDefaultBuff_NoProvenanceWhenUnchanged(t *testing.T) {
+	attempts := []*attempt.Attempt{{Prompt: "hello"}}
+	transformer := &mockBuff{name: "noop.Buff", prefix: ""}
+
+	result, err := buffs.DefaultBuff(context.Background(), attempts, transformer)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Nil(t, result[0].ProvenanceChain())
+}
+
 // Test BuffChain.Buffs() getter
 func TestBuffChain_Buffs(t *testing.T) {
 	buff1 := &mockBuff{name: "A", prefix: "A:"}
@@ -222,6 +249,27 @@ func TestBuffRegistry_GetNonExistent(t *testing.T) {
 	assert.False(t, ok, "non-existent buff should not be found")
 }
 
+// Test BuffChain.Transform records a provenance step per buff stage
+func TestBuffChain_Transform_RecordsProvenance(t *testing.T) {
+	buff1 := &mockBuff{name: "A", prefix: "A:"}
+	buff2 := &mockBuff{name: "B", prefix: "B:"}
+	chain := buffs.NewBuffChain(buff1, buff2)
+	a := &attempt.Attempt{Prompt: "hello"}
+
+	var results []*attempt.Attempt
+	for transformed := range chain.Transform(a) {
+		results = append(results, transformed)
+	}
+
+	require.Len(t, results, 1)
+	provenance := results[0].ProvenanceChain()
+	require.Len(t, provenance, 2)
+	assert.Equal(t, "A", provenance[0].Buff)
+	assert.Equal(t, "hello", provenance[0].Prompt)
+	assert.Equal(t, "B", provenance[1].Buff)
+	assert.Equal(t, "A:hello", provenance[1].Prompt)
+}
+
 // Test chainTransforms via BuffChain.Transform with multiple buffs
 func TestBuffChain_ChainTransforms(t *testing.T) {
 	// Test chaining with mixed one-to-one and one-to-many buffs
@@ -60,10 +60,8 @@ func (bp *BuffedProber) Probe(ctx context.Context, gen types.Generator) ([]*atte
 				// Propagate system prompt from probe metadata so buff
 				// transformations only affect the user message (Prompt),
 				// not the adversarial/system framing.
-				if sp, ok := ta.Metadata[attempt.MetadataKeySystemPrompt]; ok {
-					if s, ok := sp.(string); ok && s != "" {
-						conv.WithSystem(s)
-					}
+				if sp := ta.GetSystemPrompt(); sp != "" {
+					conv.WithSystem(sp)
 				}
 
 				conv.AddPrompt(ta.Prompt)
@@ -106,10 +104,8 @@ func (bp *BuffedProber) Probe(ctx context.Context, gen types.Generator) ([]*atte
 				}
 
 				conv := attempt.NewConversation()
-				if sp, ok := ta.Metadata[attempt.MetadataKeySystemPrompt]; ok {
-					if s, ok := sp.(string); ok && s != "" {
-						conv.WithSystem(s)
-					}
+				if sp := ta.GetSystemPrompt(); sp != "" {
+					conv.WithSystem(sp)
 				}
 				conv.AddPrompt(ta.Prompt)
 
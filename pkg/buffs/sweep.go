@@ -0,0 +1,66 @@
+package buffs
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// Compile-time interface satisfaction check.
+var _ Buff = (*SweepBuff)(nil)
+
+// SweepBuff pools the outputs of several differently-configured instances of
+// the same underlying buff, so a parameter sweep (e.g. --buff-param
+// flip.WordOrder:variant=cot,full) produces one variant per value instead of
+// chaining them sequentially. Unlike BuffChain, which feeds each buff's
+// output into the next, SweepBuff runs every variant against the same
+// input and unions the results.
+type SweepBuff struct {
+	name     string
+	variants []Buff
+}
+
+// NewSweepBuff creates a SweepBuff named name (normally the underlying
+// buff's own name) from variants, each a differently-configured instance of
+// that buff.
+func NewSweepBuff(name string, variants []Buff) *SweepBuff {
+	return &SweepBuff{name: name, variants: variants}
+}
+
+// Buff runs every variant against attempts and pools their outputs.
+func (s *SweepBuff) Buff(ctx context.Context, attempts []*attempt.Attempt) ([]*attempt.Attempt, error) {
+	var results []*attempt.Attempt
+	for _, v := range s.variants {
+		out, err := v.Buff(ctx, attempts)
+		if err != nil {
+			return nil, fmt.Errorf("buff %s: %w", v.Name(), err)
+		}
+		results = append(results, out...)
+	}
+	return results, nil
+}
+
+// Transform yields every variant's transformed attempts for a, in order.
+func (s *SweepBuff) Transform(a *attempt.Attempt) iter.Seq[*attempt.Attempt] {
+	return func(yield func(*attempt.Attempt) bool) {
+		for _, v := range s.variants {
+			for transformed := range v.Transform(a) {
+				if !yield(transformed) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Name returns the swept buff's fully qualified name.
+func (s *SweepBuff) Name() string {
+	return s.name
+}
+
+// Description describes the sweep and how many variants it pools.
+func (s *SweepBuff) Description() string {
+	return fmt.Sprintf("Parameter sweep over %d variants of %s", len(s.variants), s.name)
+}
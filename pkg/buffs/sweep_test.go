@@ -0,0 +1,68 @@
+package buffs_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/buffs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSweepBuff_PoolsVariants verifies Buff runs every variant against the
+// same input and concatenates their outputs, rather than chaining them.
+func TestSweepBuff_PoolsVariants(t *testing.T) {
+	cot := &mockBuff{name: "flip.WordOrder", prefix: "COT:"}
+	full := &mockBuff{name: "flip.WordOrder", prefix: "FULL:"}
+	sweep := buffs.NewSweepBuff("flip.WordOrder", []buffs.Buff{cot, full})
+
+	attempts := []*attempt.Attempt{{Prompt: "hello"}}
+	result, err := sweep.Buff(context.Background(), attempts)
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "COT:hello", result[0].Prompt)
+	assert.Equal(t, "FULL:hello", result[1].Prompt)
+}
+
+// TestSweepBuff_Transform verifies Transform yields every variant's output
+// for a single attempt, in variant order.
+func TestSweepBuff_Transform(t *testing.T) {
+	cot := &mockBuff{name: "flip.WordOrder", prefix: "COT:"}
+	full := &mockBuff{name: "flip.WordOrder", prefix: "FULL:"}
+	sweep := buffs.NewSweepBuff("flip.WordOrder", []buffs.Buff{cot, full})
+
+	a := &attempt.Attempt{Prompt: "hello"}
+	var prompts []string
+	for transformed := range sweep.Transform(a) {
+		prompts = append(prompts, transformed.Prompt)
+	}
+
+	assert.Equal(t, []string{"COT:hello", "FULL:hello"}, prompts)
+}
+
+// TestSweepBuff_BuffError verifies an error from any variant short-circuits
+// the sweep.
+func TestSweepBuff_BuffError(t *testing.T) {
+	ok := &mockBuff{name: "flip.WordOrder", prefix: "COT:"}
+	bad := &mockErrorBuff{name: "flip.WordOrder", err: errors.New("boom")}
+	sweep := buffs.NewSweepBuff("flip.WordOrder", []buffs.Buff{ok, bad})
+
+	_, err := sweep.Buff(context.Background(), []*attempt.Attempt{{Prompt: "hello"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+// TestSweepBuff_NameAndDescription verifies Name reports the swept buff's
+// name and Description mentions the variant count.
+func TestSweepBuff_NameAndDescription(t *testing.T) {
+	sweep := buffs.NewSweepBuff("flip.WordOrder", []buffs.Buff{
+		&mockBuff{name: "flip.WordOrder", prefix: "COT:"},
+		&mockBuff{name: "flip.WordOrder", prefix: "FULL:"},
+	})
+
+	assert.Equal(t, "flip.WordOrder", sweep.Name())
+	assert.Contains(t, sweep.Description(), "2")
+}
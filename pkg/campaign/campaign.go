@@ -0,0 +1,138 @@
+// Package campaign defines the YAML format for a red-team campaign: a
+// sequence of scans (potentially different generators, probe sets, and
+// buff matrices) that together make up one engagement's test plan.
+package campaign
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Campaign is a named sequence of scan jobs, run in order against a shared
+// output directory.
+type Campaign struct {
+	// Name identifies the campaign in the consolidated report.
+	Name string `yaml:"name"`
+
+	// Jobs are the individual scans that make up this campaign, run in
+	// the order listed.
+	Jobs []Job `yaml:"jobs"`
+
+	// MaxParallelJobs caps how many jobs run at once. 0 or 1 (the default)
+	// runs jobs one at a time, exactly as before this field existed. A
+	// higher value lets independent jobs - typically against different
+	// generators - overlap, so a slow target's jobs don't block a fast
+	// target's jobs from starting. Each job still gets its own Concurrency
+	// budget; this only bounds how many jobs run concurrently.
+	MaxParallelJobs int `yaml:"max_parallel_jobs,omitempty"`
+}
+
+// Job describes a single scan within a campaign, mirroring the subset of
+// `augustus scan` flags needed to reproduce it.
+type Job struct {
+	// Name identifies this job in the consolidated report and its
+	// rotated result file.
+	Name string `yaml:"name"`
+
+	// Generator is the generator name to scan (e.g. "openai.OpenAI").
+	Generator string `yaml:"generator"`
+
+	// Probe selection: exactly one of Probes, ProbesGlob, or AllProbes
+	// must be set.
+	Probes     []string `yaml:"probes,omitempty"`
+	ProbesGlob string   `yaml:"probes_glob,omitempty"`
+	AllProbes  bool     `yaml:"all_probes,omitempty"`
+
+	// Detectors are explicit detector names; if empty, detectors are
+	// auto-discovered from the selected probes.
+	Detectors []string `yaml:"detectors,omitempty"`
+
+	// Buffs are buff names applied to every probe in this job.
+	Buffs []string `yaml:"buffs,omitempty"`
+
+	// Harness is the harness name to run the probes under. Defaults to
+	// "probewise.Probewise" when unset.
+	Harness string `yaml:"harness,omitempty"`
+
+	// ConfigFile is an optional YAML config file (generator settings,
+	// probe/detector overrides) applied to this job only.
+	ConfigFile string `yaml:"config_file,omitempty"`
+
+	// Concurrency bounds how many attempts this job runs at once, letting a
+	// throttled or low-rate-limit provider run at a lower concurrency than
+	// its campaign siblings. 0 uses the scanner's default.
+	Concurrency int `yaml:"concurrency,omitempty"`
+
+	// AdaptiveConcurrency, when set, auto-tunes this job's concurrency in
+	// response to observed rate-limit and error responses instead of
+	// holding it fixed at Concurrency.
+	AdaptiveConcurrency bool `yaml:"adaptive_concurrency,omitempty"`
+}
+
+// Load reads and validates a campaign file from path.
+func Load(path string) (*Campaign, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("campaign: failed to read %s: %w", path, err)
+	}
+
+	var c Campaign
+	if err := yaml.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("campaign: failed to parse %s: %w", path, err)
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("campaign: %w", err)
+	}
+
+	return &c, nil
+}
+
+// Validate checks that the campaign and every job within it are
+// well-formed.
+func (c *Campaign) Validate() error {
+	if len(c.Jobs) == 0 {
+		return fmt.Errorf("campaign has no jobs")
+	}
+
+	names := make(map[string]bool, len(c.Jobs))
+	for i, job := range c.Jobs {
+		if err := job.Validate(); err != nil {
+			return fmt.Errorf("job %d (%q): %w", i, job.Name, err)
+		}
+		if names[job.Name] {
+			return fmt.Errorf("duplicate job name %q", job.Name)
+		}
+		names[job.Name] = true
+	}
+
+	return nil
+}
+
+// Validate checks that a single job is well-formed.
+func (j *Job) Validate() error {
+	if j.Name == "" {
+		return fmt.Errorf("job name is required")
+	}
+	if j.Generator == "" {
+		return fmt.Errorf("generator is required")
+	}
+
+	selectors := 0
+	if len(j.Probes) > 0 {
+		selectors++
+	}
+	if j.ProbesGlob != "" {
+		selectors++
+	}
+	if j.AllProbes {
+		selectors++
+	}
+	if selectors != 1 {
+		return fmt.Errorf("exactly one of probes, probes_glob, or all_probes is required")
+	}
+
+	return nil
+}
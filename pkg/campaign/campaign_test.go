@@ -0,0 +1,126 @@
+package campaign
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeCampaignFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "campaign.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestLoad_Basic(t *testing.T) {
+	path := writeCampaignFile(t, `
+name: quarterly-red-team
+jobs:
+  - name: baseline
+    generator: openai.OpenAI
+    probes: ["dan.Dan_11_0"]
+  - name: encoding-sweep
+    generator: anthropic.Anthropic
+    probes_glob: "encoding.*"
+  - name: full-sweep
+    generator: test.Repeat
+    all_probes: true
+`)
+
+	c, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if c.Name != "quarterly-red-team" {
+		t.Errorf("Name = %q, want %q", c.Name, "quarterly-red-team")
+	}
+	if len(c.Jobs) != 3 {
+		t.Fatalf("len(Jobs) = %d, want 3", len(c.Jobs))
+	}
+}
+
+func TestLoad_ConcurrencyFields(t *testing.T) {
+	path := writeCampaignFile(t, `
+name: throttle-aware
+max_parallel_jobs: 3
+jobs:
+  - name: slow-provider
+    generator: openai.OpenAI
+    all_probes: true
+    concurrency: 2
+  - name: fast-provider
+    generator: test.Repeat
+    all_probes: true
+    adaptive_concurrency: true
+`)
+
+	c, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if c.MaxParallelJobs != 3 {
+		t.Errorf("MaxParallelJobs = %d, want 3", c.MaxParallelJobs)
+	}
+	if c.Jobs[0].Concurrency != 2 {
+		t.Errorf("Jobs[0].Concurrency = %d, want 2", c.Jobs[0].Concurrency)
+	}
+	if !c.Jobs[1].AdaptiveConcurrency {
+		t.Error("Jobs[1].AdaptiveConcurrency = false, want true")
+	}
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Fatal("Load() error = nil, want error for missing file")
+	}
+}
+
+func TestValidate_NoJobs(t *testing.T) {
+	c := &Campaign{Name: "empty"}
+	if err := c.Validate(); err == nil {
+		t.Fatal("Validate() error = nil, want error for campaign with no jobs")
+	}
+}
+
+func TestValidate_DuplicateJobNames(t *testing.T) {
+	c := &Campaign{
+		Jobs: []Job{
+			{Name: "dup", Generator: "openai.OpenAI", AllProbes: true},
+			{Name: "dup", Generator: "openai.OpenAI", AllProbes: true},
+		},
+	}
+	if err := c.Validate(); err == nil {
+		t.Fatal("Validate() error = nil, want error for duplicate job names")
+	}
+}
+
+func TestJobValidate_MissingGenerator(t *testing.T) {
+	j := &Job{Name: "job1", AllProbes: true}
+	if err := j.Validate(); err == nil {
+		t.Fatal("Validate() error = nil, want error for missing generator")
+	}
+}
+
+func TestJobValidate_NoProbeSelector(t *testing.T) {
+	j := &Job{Name: "job1", Generator: "openai.OpenAI"}
+	if err := j.Validate(); err == nil {
+		t.Fatal("Validate() error = nil, want error when no probe selector is set")
+	}
+}
+
+func TestJobValidate_MultipleProbeSelectors(t *testing.T) {
+	j := &Job{Name: "job1", Generator: "openai.OpenAI", Probes: []string{"dan.Dan_11_0"}, AllProbes: true}
+	if err := j.Validate(); err == nil {
+		t.Fatal("Validate() error = nil, want error when multiple probe selectors are set")
+	}
+}
+
+func TestJobValidate_Valid(t *testing.T) {
+	j := &Job{Name: "job1", Generator: "openai.OpenAI", ProbesGlob: "dan.*"}
+	if err := j.Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
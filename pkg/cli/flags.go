@@ -3,6 +3,7 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 )
 
@@ -114,3 +115,65 @@ func ParseCommaSeparatedGlobs(input string, available []string) ([]string, error
 
 	return result, nil
 }
+
+// BuffParamSweep is one --buff-param declaration: sweep buffName's param
+// across values, expanding it into one configured buff instance per value.
+type BuffParamSweep struct {
+	BuffName string
+	Param    string
+	Values   []string
+}
+
+// ParseBuffParam parses a single "--buff-param" value of the form
+// "<buffName>:<param>=<value1>,<value2>,...", e.g.
+// "flip.WordOrder:variant=cot,full". Used to sweep a buff's parameter
+// across multiple values from the CLI instead of writing a separate YAML
+// config per variant.
+func ParseBuffParam(input string) (BuffParamSweep, error) {
+	buffName, rest, ok := strings.Cut(input, ":")
+	if !ok || buffName == "" {
+		return BuffParamSweep{}, fmt.Errorf("invalid --buff-param %q: expected \"<buff>:<param>=<values>\"", input)
+	}
+
+	param, valuesStr, ok := strings.Cut(rest, "=")
+	if !ok || param == "" || valuesStr == "" {
+		return BuffParamSweep{}, fmt.Errorf("invalid --buff-param %q: expected \"<buff>:<param>=<values>\"", input)
+	}
+
+	values := strings.Split(valuesStr, ",")
+	for i, v := range values {
+		values[i] = strings.TrimSpace(v)
+	}
+
+	return BuffParamSweep{BuffName: buffName, Param: param, Values: values}, nil
+}
+
+// ExcludeCommaSeparatedGlobs removes names from selected that match any of
+// the comma-separated glob patterns in input, using the same matching as
+// ParseCommaSeparatedGlobs. Patterns are matched against selected itself
+// (not some larger "available" set), since exclusion narrows an
+// already-made selection rather than building one up. An empty input
+// returns selected unchanged.
+func ExcludeCommaSeparatedGlobs(selected []string, input string) ([]string, error) {
+	if strings.TrimSpace(input) == "" {
+		return selected, nil
+	}
+
+	excluded, err := ParseCommaSeparatedGlobs(input, selected)
+	if err != nil {
+		return nil, err
+	}
+	excludeSet := make(map[string]struct{}, len(excluded))
+	for _, name := range excluded {
+		excludeSet[name] = struct{}{}
+	}
+
+	result := make([]string, 0, len(selected))
+	for _, name := range selected {
+		if _, ok := excludeSet[name]; !ok {
+			result = append(result, name)
+		}
+	}
+
+	return result, nil
+}
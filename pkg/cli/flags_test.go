@@ -167,6 +167,131 @@ func TestParseCommaSeparatedGlobs(t *testing.T) {
 	}
 }
 
+// TestExcludeCommaSeparatedGlobs tests dropping glob matches from an
+// already-made selection.
+func TestExcludeCommaSeparatedGlobs(t *testing.T) {
+	tests := []struct {
+		name     string
+		selected []string
+		input    string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "single exclude pattern",
+			selected: []string{"dan.Dan10", "dan.Dan11", "encoding.Base64"},
+			input:    "dan.*",
+			want:     []string{"encoding.Base64"},
+		},
+		{
+			name:     "multiple exclude patterns",
+			selected: []string{"dan.Dan10", "glitch.Glitch1", "tap.IterativeTAP", "encoding.Base64"},
+			input:    "glitch.*,tap.*",
+			want:     []string{"dan.Dan10", "encoding.Base64"},
+		},
+		{
+			name:     "empty input returns selection unchanged",
+			selected: []string{"dan.Dan10", "encoding.Base64"},
+			input:    "",
+			want:     []string{"dan.Dan10", "encoding.Base64"},
+		},
+		{
+			name:     "no matches leaves selection unchanged",
+			selected: []string{"dan.Dan10", "encoding.Base64"},
+			input:    "goodside.*",
+			want:     []string{"dan.Dan10", "encoding.Base64"},
+		},
+		{
+			name:     "excluding everything returns empty slice",
+			selected: []string{"dan.Dan10", "dan.Dan11"},
+			input:    "dan.*",
+			want:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExcludeCommaSeparatedGlobs(tt.selected, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExcludeCommaSeparatedGlobs() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			sort.Strings(got)
+			sort.Strings(tt.want)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExcludeCommaSeparatedGlobs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseBuffParam tests parsing "--buff-param" sweep declarations.
+func TestParseBuffParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    BuffParamSweep
+		wantErr bool
+	}{
+		{
+			name:  "two values",
+			input: "flip.WordOrder:variant=cot,full",
+			want:  BuffParamSweep{BuffName: "flip.WordOrder", Param: "variant", Values: []string{"cot", "full"}},
+		},
+		{
+			name:  "single value",
+			input: "flip.WordOrder:variant=cot",
+			want:  BuffParamSweep{BuffName: "flip.WordOrder", Param: "variant", Values: []string{"cot"}},
+		},
+		{
+			name:  "trims spaces around values",
+			input: "flip.WordOrder:variant=cot, full",
+			want:  BuffParamSweep{BuffName: "flip.WordOrder", Param: "variant", Values: []string{"cot", "full"}},
+		},
+		{
+			name:    "missing colon",
+			input:   "flip.WordOrder variant=cot,full",
+			wantErr: true,
+		},
+		{
+			name:    "missing equals",
+			input:   "flip.WordOrder:variant",
+			wantErr: true,
+		},
+		{
+			name:    "empty buff name",
+			input:   ":variant=cot",
+			wantErr: true,
+		},
+		{
+			name:    "empty param name",
+			input:   "flip.WordOrder:=cot",
+			wantErr: true,
+		},
+		{
+			name:    "empty values",
+			input:   "flip.WordOrder:variant=",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseBuffParam(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBuffParam() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseBuffParam() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
 // TestCLIFlags tests the CLIFlags structure.
 func TestCLIFlags(t *testing.T) {
 	flags := &CLIFlags{
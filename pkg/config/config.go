@@ -2,12 +2,21 @@ package config
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/cli"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/results"
 )
 
 // Config represents the complete Augustus configuration
 type Config struct {
+	// Strict mirrors --strict-config: when true, unknown keys anywhere in
+	// the YAML are rejected and probe/detector/buff names in Settings are
+	// validated against the registry, instead of silently doing nothing.
+	Strict     bool                       `yaml:"strict,omitempty" koanf:"strict"`
 	Run        RunConfig                  `yaml:"run" koanf:"run"`
 	Generators map[string]GeneratorConfig `yaml:"generators" koanf:"generators"`
 	Judge      JudgeGlobalConfig          `yaml:"judge,omitempty" koanf:"judge"`
@@ -50,10 +59,27 @@ type Profile struct {
 
 // RunConfig contains runtime configuration
 type RunConfig struct {
-	MaxAttempts  int    `yaml:"max_attempts" koanf:"max_attempts" validate:"gte=0"`
-	Timeout      string `yaml:"timeout" koanf:"timeout"`
-	Concurrency  int    `yaml:"concurrency,omitempty" koanf:"concurrency" validate:"gte=0"`
-	ProbeTimeout string `yaml:"probe_timeout,omitempty" koanf:"probe_timeout"`
+	MaxAttempts     int    `yaml:"max_attempts" koanf:"max_attempts" validate:"gte=0"`
+	Timeout         string `yaml:"timeout" koanf:"timeout"`
+	Concurrency     int    `yaml:"concurrency,omitempty" koanf:"concurrency" validate:"gte=0"`
+	ProbeTimeout    string `yaml:"probe_timeout,omitempty" koanf:"probe_timeout"`
+	AttemptTimeout  string `yaml:"attempt_timeout,omitempty" koanf:"attempt_timeout"`
+	DetectorTimeout string `yaml:"detector_timeout,omitempty" koanf:"detector_timeout"`
+	// ShutdownGracePeriod bounds how long probes already in flight when the
+	// scan is interrupted (SIGINT/SIGTERM) are given to finish normally
+	// before being forcibly canceled. Empty/0 means cancel immediately.
+	ShutdownGracePeriod string `yaml:"shutdown_grace_period,omitempty" koanf:"shutdown_grace_period"`
+	PromptCap           int    `yaml:"prompt_cap,omitempty" koanf:"prompt_cap" validate:"gte=0"`
+	PromptCapStrategy   string `yaml:"prompt_cap_strategy,omitempty" koanf:"prompt_cap_strategy"`
+	// MaxTotalAttempts caps the total number of generator calls (estimated
+	// from probe prompt counts) across every probe in the scan. Distinct
+	// from MaxAttempts, which controls per-probe retry count. 0 means
+	// unlimited.
+	MaxTotalAttempts int `yaml:"max_total_attempts,omitempty" koanf:"max_total_attempts" validate:"gte=0"`
+	// MaxContinuations caps how many automatic follow-up "continue" calls
+	// are issued per attempt when a response looks truncated by a
+	// token/length limit. 0 disables truncation detection.
+	MaxContinuations int `yaml:"max_continuations,omitempty" koanf:"max_continuations" validate:"gte=0"`
 }
 
 // GeneratorConfig contains generator-specific configuration
@@ -90,12 +116,27 @@ func (g GeneratorConfig) ToRegistryConfig() map[string]any {
 
 // ProbeConfig contains probe-specific configuration
 type ProbeConfig struct {
-	Encoding              EncodingProbeConfig        `yaml:"encoding"`
-	AttackerGeneratorType string                     `yaml:"attacker_generator_type,omitempty" koanf:"attacker_generator_type"`
-	AttackerConfig        map[string]any             `yaml:"attacker_config,omitempty" koanf:"attacker_config"`
-	JudgeGeneratorType    string                     `yaml:"judge_generator_type,omitempty" koanf:"judge_generator_type"`
-	JudgeConfig           map[string]any             `yaml:"judge_config,omitempty" koanf:"judge_config"`
-	Settings              map[string]map[string]any  `yaml:"settings,omitempty" koanf:"settings"`
+	Encoding              EncodingProbeConfig       `yaml:"encoding"`
+	AttackerGeneratorType string                    `yaml:"attacker_generator_type,omitempty" koanf:"attacker_generator_type"`
+	AttackerConfig        map[string]any            `yaml:"attacker_config,omitempty" koanf:"attacker_config"`
+	JudgeGeneratorType    string                    `yaml:"judge_generator_type,omitempty" koanf:"judge_generator_type"`
+	JudgeConfig           map[string]any            `yaml:"judge_config,omitempty" koanf:"judge_config"`
+	// Settings maps probe names to their specific configuration. A
+	// "threshold" key (float64) overrides attempt.DefaultVulnerabilityThreshold
+	// for that probe's attempts - see Config.ResolveThresholds.
+	Settings map[string]map[string]any `yaml:"settings,omitempty" koanf:"settings"`
+	// Include restricts the scan to probes matching any of these glob
+	// patterns (same syntax as --probes-glob). Empty means "no restriction":
+	// every probe the CLI selected stays in scope unless Tags also narrows it.
+	Include []string `yaml:"include,omitempty" koanf:"include"`
+	// Exclude drops probes matching any of these glob patterns, applied
+	// after Include/Tags. Lets a config say "everything except these".
+	Exclude []string `yaml:"exclude,omitempty" koanf:"exclude"`
+	// Tags restricts the scan to probes in these categories - the dotted
+	// prefix before a probe's name, e.g. "dan" for "dan.Dan_11_0". Each tag
+	// is resolved as the glob "<tag>.*". Combines with Include (a probe
+	// matching either stays in scope).
+	Tags []string `yaml:"tags,omitempty" koanf:"tags"`
 }
 
 // EncodingProbeConfig contains encoding probe configuration
@@ -105,8 +146,11 @@ type EncodingProbeConfig struct {
 
 // DetectorConfig contains detector-specific configuration
 type DetectorConfig struct {
-	Always   AlwaysDetectorConfig       `yaml:"always"`
-	Settings map[string]map[string]any  `yaml:"settings,omitempty" koanf:"settings"`
+	Always AlwaysDetectorConfig `yaml:"always"`
+	// Settings maps detector names to their specific configuration. A
+	// "threshold" key (float64) overrides attempt.DefaultVulnerabilityThreshold
+	// for that detector's attempts - see Config.ResolveThresholds.
+	Settings map[string]map[string]any `yaml:"settings,omitempty" koanf:"settings"`
 }
 
 // BuffConfig contains buff-specific configuration
@@ -119,6 +163,20 @@ type BuffConfig struct {
 	//   - "burst_size" (float64): max burst capacity
 	//   - buff-specific keys (e.g., "api_key")
 	Settings map[string]map[string]any `yaml:"settings,omitempty" koanf:"settings"`
+	// ParamSweeps declares buff parameter sweeps: config equivalent of
+	// --buff-param. Each entry expands Buff into one configured instance per
+	// value in Values, pooling their outputs, so variant comparisons (e.g.
+	// flip.WordOrder's "cot" vs "full" guidance) don't require a separate
+	// YAML file per run.
+	ParamSweeps []BuffParamSweep `yaml:"param_sweeps,omitempty" koanf:"param_sweeps"`
+}
+
+// BuffParamSweep is one buffs.param_sweeps entry: sweep Buff's Param across
+// Values, one configured buff instance per value.
+type BuffParamSweep struct {
+	Buff   string   `yaml:"buff" koanf:"buff"`
+	Param  string   `yaml:"param" koanf:"param"`
+	Values []string `yaml:"values" koanf:"values"`
 }
 
 // AlwaysDetectorConfig contains always detector configuration
@@ -128,8 +186,18 @@ type AlwaysDetectorConfig struct {
 
 // OutputConfig contains output configuration
 type OutputConfig struct {
-	Format string `yaml:"format" koanf:"format" validate:"omitempty,oneof=json jsonl csv txt table"`
-	Path   string `yaml:"path" koanf:"path"`
+	Format string       `yaml:"format" koanf:"format" validate:"omitempty,oneof=json jsonl csv txt table"`
+	Path   string       `yaml:"path" koanf:"path"`
+	Sinks  []SinkConfig `yaml:"sinks,omitempty" koanf:"sinks"`
+}
+
+// SinkConfig declares one result sink to run in addition to the scan's
+// primary stdout/JSONL/HTML output. Type is a registered sink name (e.g.
+// "webhook.Slack", "s3.Upload"); Settings is passed through to the sink's
+// factory the same way probes.settings/detectors.settings work.
+type SinkConfig struct {
+	Type     string         `yaml:"type" koanf:"type"`
+	Settings map[string]any `yaml:",inline" koanf:",remain"`
 }
 
 // injectJudgeConfig injects global judge config into a registry config map.
@@ -207,6 +275,39 @@ func (c *Config) ResolveDetectorConfig(detectorName string) map[string]any {
 	return cfg
 }
 
+// ResolveThresholds builds a results.Thresholds from a "threshold" key in
+// probes.settings.<name> and detectors.settings.<name>, letting a noisier
+// detector or a probe category with a different risk tolerance override
+// attempt.DefaultVulnerabilityThreshold. Used consistently by the table
+// evaluator and the HTML report so a scan's pass/fail outcome doesn't
+// depend on which of those is looking at it.
+func (c *Config) ResolveThresholds() results.Thresholds {
+	th := results.Thresholds{}
+	if c == nil {
+		return th
+	}
+
+	for probeName, settings := range c.Probes.Settings {
+		if _, ok := registry.Config(settings)["threshold"]; ok {
+			if th.Probe == nil {
+				th.Probe = make(map[string]float64)
+			}
+			th.Probe[probeName] = registry.GetFloat64(registry.Config(settings), "threshold", 0)
+		}
+	}
+
+	for detectorName, settings := range c.Detectors.Settings {
+		if _, ok := registry.Config(settings)["threshold"]; ok {
+			if th.Detector == nil {
+				th.Detector = make(map[string]float64)
+			}
+			th.Detector[detectorName] = registry.GetFloat64(registry.Config(settings), "threshold", 0)
+		}
+	}
+
+	return th
+}
+
 // ResolveBuffConfig builds a registry config for a specific buff
 // from per-buff settings in the Settings map.
 func (c *Config) ResolveBuffConfig(buffName string) map[string]any {
@@ -231,6 +332,69 @@ func (c *Config) HasProbeConfig(probeName string) bool {
 	_, ok := c.Probes.Settings[probeName]
 	return ok
 }
+
+// ResolveProbeNames narrows names (typically the CLI's --probe/--probes-glob/
+// --all selection) to respect probes.include/exclude/tags declared in YAML,
+// using the same glob matching as --probes-glob (pkg/cli.ParseGlob). This
+// lets an engagement's scan scope live entirely in a reviewed config file:
+// run with --all and let include/exclude/tags pick the actual probe set.
+//
+// Include and Tags both narrow the set (a probe matching either stays in
+// scope); Exclude is applied last and always removes a match, even one an
+// Include/Tags pattern also matched.
+func (c *Config) ResolveProbeNames(names []string) ([]string, error) {
+	if len(c.Probes.Include) == 0 && len(c.Probes.Exclude) == 0 && len(c.Probes.Tags) == 0 {
+		return names, nil
+	}
+
+	selected := names
+	if len(c.Probes.Include) > 0 || len(c.Probes.Tags) > 0 {
+		patterns := append([]string{}, c.Probes.Include...)
+		for _, tag := range c.Probes.Tags {
+			patterns = append(patterns, tag+".*")
+		}
+
+		included := make(map[string]struct{})
+		for _, pattern := range patterns {
+			matches, err := cli.ParseGlob(pattern, names)
+			if err != nil {
+				return nil, fmt.Errorf("invalid probes.include/tags pattern %q: %w", pattern, err)
+			}
+			for _, m := range matches {
+				included[m] = struct{}{}
+			}
+		}
+
+		filtered := make([]string, 0, len(included))
+		for _, name := range names {
+			if _, ok := included[name]; ok {
+				filtered = append(filtered, name)
+			}
+		}
+		selected = filtered
+	}
+
+	for _, pattern := range c.Probes.Exclude {
+		matches, err := cli.ParseGlob(pattern, selected)
+		if err != nil {
+			return nil, fmt.Errorf("invalid probes.exclude pattern %q: %w", pattern, err)
+		}
+		excluded := make(map[string]struct{}, len(matches))
+		for _, m := range matches {
+			excluded[m] = struct{}{}
+		}
+		filtered := selected[:0]
+		for _, name := range selected {
+			if _, ok := excluded[name]; !ok {
+				filtered = append(filtered, name)
+			}
+		}
+		selected = filtered
+	}
+
+	return selected, nil
+}
+
 // Validate validates the configuration and returns helpful error messages
 func (c *Config) Validate() error {
 	// Validate run config
@@ -243,6 +407,16 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("run.concurrency must be non-negative, got: %d", c.Run.Concurrency)
 	}
 
+	// Validate max_total_attempts (0 means unlimited, negative is invalid)
+	if c.Run.MaxTotalAttempts < 0 {
+		return fmt.Errorf("run.max_total_attempts must be non-negative, got: %d", c.Run.MaxTotalAttempts)
+	}
+
+	// Validate max_continuations (0 means disabled, negative is invalid)
+	if c.Run.MaxContinuations < 0 {
+		return fmt.Errorf("run.max_continuations must be non-negative, got: %d", c.Run.MaxContinuations)
+	}
+
 	// Validate probe_timeout format if provided
 	if c.Run.ProbeTimeout != "" {
 		if _, err := time.ParseDuration(c.Run.ProbeTimeout); err != nil {
@@ -257,6 +431,27 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	// Validate attempt_timeout format if provided
+	if c.Run.AttemptTimeout != "" {
+		if _, err := time.ParseDuration(c.Run.AttemptTimeout); err != nil {
+			return fmt.Errorf("invalid run.attempt_timeout: %w", err)
+		}
+	}
+
+	// Validate detector_timeout format if provided
+	if c.Run.DetectorTimeout != "" {
+		if _, err := time.ParseDuration(c.Run.DetectorTimeout); err != nil {
+			return fmt.Errorf("invalid run.detector_timeout: %w", err)
+		}
+	}
+
+	// Validate shutdown_grace_period format if provided
+	if c.Run.ShutdownGracePeriod != "" {
+		if _, err := time.ParseDuration(c.Run.ShutdownGracePeriod); err != nil {
+			return fmt.Errorf("invalid run.shutdown_grace_period: %w", err)
+		}
+	}
+
 	// Validate generator temperatures (0-2 is standard LLM API range)
 	for name, gen := range c.Generators {
 		if gen.Temperature < 0 || gen.Temperature > 2 {
@@ -279,6 +474,43 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// ValidateCapabilityNames checks every probe/detector/buff name referenced
+// in Settings (and Buffs.Names) against the "has" predicates supplied by the
+// caller, which is expected to pass e.g. probes.Registry.Has so this package
+// doesn't need to import the capability registries directly. Used by
+// --strict-config / config.strict to catch typo'd capability names at
+// config-load time instead of the factory lookup failing later.
+func (c *Config) ValidateCapabilityNames(hasProbe, hasDetector, hasBuff func(string) bool) error {
+	var unknown []string
+
+	for name := range c.Probes.Settings {
+		if !hasProbe(name) {
+			unknown = append(unknown, fmt.Sprintf("probes.settings.%s", name))
+		}
+	}
+	for name := range c.Detectors.Settings {
+		if !hasDetector(name) {
+			unknown = append(unknown, fmt.Sprintf("detectors.settings.%s", name))
+		}
+	}
+	for _, name := range c.Buffs.Names {
+		if !hasBuff(name) {
+			unknown = append(unknown, fmt.Sprintf("buffs.names[%s]", name))
+		}
+	}
+	for name := range c.Buffs.Settings {
+		if !hasBuff(name) {
+			unknown = append(unknown, fmt.Sprintf("buffs.settings.%s", name))
+		}
+	}
+
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	return fmt.Errorf("unknown capability name(s) referenced in config: %s", strings.Join(unknown, ", "))
+}
+
 // Merge merges another config into this one, with the other config taking precedence
 func (c *Config) Merge(other *Config) {
 	// Merge run config (simple override)
@@ -294,6 +526,21 @@ func (c *Config) Merge(other *Config) {
 	if other.Run.ProbeTimeout != "" {
 		c.Run.ProbeTimeout = other.Run.ProbeTimeout
 	}
+	if other.Run.AttemptTimeout != "" {
+		c.Run.AttemptTimeout = other.Run.AttemptTimeout
+	}
+	if other.Run.DetectorTimeout != "" {
+		c.Run.DetectorTimeout = other.Run.DetectorTimeout
+	}
+	if other.Run.ShutdownGracePeriod != "" {
+		c.Run.ShutdownGracePeriod = other.Run.ShutdownGracePeriod
+	}
+	if other.Run.MaxTotalAttempts != 0 {
+		c.Run.MaxTotalAttempts = other.Run.MaxTotalAttempts
+	}
+	if other.Run.MaxContinuations != 0 {
+		c.Run.MaxContinuations = other.Run.MaxContinuations
+	}
 
 	// Merge generators
 	if c.Generators == nil {
@@ -395,8 +642,10 @@ func (c *Config) ApplyProfile(profileName string) error {
 	return nil
 }
 
-// interpolateEnvVars replaces ${VAR} with environment variable values
-func interpolateEnvVars(s string, getenv func(string) (string, bool)) (string, error) {
+// interpolateEnvVars replaces ${VAR} with values from resolve, which maps a
+// reference (an environment variable name, or a "scheme:ref" secret
+// reference - see pkg/secrets) to its value.
+func interpolateEnvVars(s string, resolve func(string) (string, error)) (string, error) {
 	result := s
 	start := 0
 	for {
@@ -416,9 +665,9 @@ func interpolateEnvVars(s string, getenv func(string) (string, bool)) (string, e
 
 		// Extract variable name
 		varName := result[idx+2 : endIdx]
-		value, ok := getenv(varName)
-		if !ok {
-			return "", fmt.Errorf("environment variable %q is not set", varName)
+		value, err := resolve(varName)
+		if err != nil {
+			return "", err
 		}
 
 		// Replace ${VAR} with value
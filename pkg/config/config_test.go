@@ -1,15 +1,33 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/praetorian-inc/augustus/pkg/results"
+	"github.com/praetorian-inc/augustus/pkg/secrets"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
 
+// fakeSecretProvider is a network-free secrets.Provider for testing
+// interpolation dispatch, analogous to the mock translators used in
+// internal/buffs/lrl's tests.
+type fakeSecretProvider struct {
+	values map[string]string
+}
+
+func (p *fakeSecretProvider) Resolve(ref string) (string, error) {
+	v, ok := p.values[ref]
+	if !ok {
+		return "", fmt.Errorf("fake secret %q not found", ref)
+	}
+	return v, nil
+}
+
 // TestBasicYAMLLoading tests loading a single YAML configuration file
 func TestBasicYAMLLoading(t *testing.T) {
 	// Create a temporary YAML file
@@ -319,6 +337,102 @@ func TestNonexistentFile(t *testing.T) {
 	assert.Nil(t, cfg)
 }
 
+// TestLoadConfig_TolerantOfUnknownKeys verifies LoadConfig's default
+// (non-strict) behavior: misindented/unknown keys are dropped silently
+// rather than rejected.
+func TestLoadConfig_TolerantOfUnknownKeys(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	yamlContent := `
+run:
+  max_attempts: 5
+runn:
+  max_attempts: 99
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(yamlContent), 0644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, 5, cfg.Run.MaxAttempts)
+}
+
+// TestLoadConfigStrict_RejectsUnknownKeyWithLineNumber verifies
+// LoadConfigStrict catches a misindented/unknown key (here "runn" instead of
+// "run") and reports the line it's on.
+func TestLoadConfigStrict_RejectsUnknownKeyWithLineNumber(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	yamlContent := `run:
+  max_attempts: 5
+runn:
+  max_attempts: 99
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(yamlContent), 0644))
+
+	cfg, err := LoadConfigStrict(configPath)
+	require.Error(t, err)
+	assert.Nil(t, cfg)
+	assert.Contains(t, err.Error(), "line 3")
+}
+
+// TestLoadConfigStrict_ValidConfigPasses verifies a well-formed config is
+// accepted unchanged under strict parsing.
+func TestLoadConfigStrict_ValidConfigPasses(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+
+	yamlContent := `run:
+  max_attempts: 5
+buffs:
+  names:
+    - encoding.Base64
+  settings:
+    encoding.Base64:
+      variant: full
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(yamlContent), 0644))
+
+	cfg, err := LoadConfigStrict(configPath)
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	assert.Equal(t, 5, cfg.Run.MaxAttempts)
+	assert.Equal(t, []string{"encoding.Base64"}, cfg.Buffs.Names)
+}
+
+// TestValidateCapabilityNames_CatchesUnknownNames verifies
+// ValidateCapabilityNames flags probe/detector/buff names in Settings that
+// the supplied registry predicates don't recognize.
+func TestValidateCapabilityNames_CatchesUnknownNames(t *testing.T) {
+	cfg := &Config{
+		Probes:    ProbeConfig{Settings: map[string]map[string]any{"dan.Dan_11_0": {}}},
+		Detectors: DetectorConfig{Settings: map[string]map[string]any{"dna.DAN": {}}},
+		Buffs:     BuffConfig{Names: []string{"encoding.Base64"}},
+	}
+
+	hasProbe := func(name string) bool { return name == "dan.Dan_11_0" }
+	hasDetector := func(name string) bool { return name == "dan.DAN" }
+	hasBuff := func(name string) bool { return name == "encoding.Base64" }
+
+	err := cfg.ValidateCapabilityNames(hasProbe, hasDetector, hasBuff)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "detectors.settings.dna.DAN")
+}
+
+// TestValidateCapabilityNames_ValidNamesPass verifies no error when every
+// referenced name resolves against the registry.
+func TestValidateCapabilityNames_ValidNamesPass(t *testing.T) {
+	cfg := &Config{
+		Probes: ProbeConfig{Settings: map[string]map[string]any{"dan.Dan_11_0": {}}},
+		Buffs:  BuffConfig{Names: []string{"encoding.Base64"}},
+	}
+
+	always := func(string) bool { return true }
+	err := cfg.ValidateCapabilityNames(always, always, always)
+	assert.NoError(t, err)
+}
+
 // TestConcurrencyAndProbeTimeout tests loading new concurrency and probe_timeout fields
 func TestConcurrencyAndProbeTimeout(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -892,6 +1006,72 @@ func TestResolveBuffConfig(t *testing.T) {
 	}
 }
 
+// TestResolveThresholds tests building results.Thresholds from per-name "threshold" settings
+func TestResolveThresholds(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+		want   results.Thresholds
+	}{
+		{
+			name:   "empty config returns zero value",
+			config: Config{},
+			want:   results.Thresholds{},
+		},
+		{
+			name: "probe threshold setting is picked up",
+			config: Config{
+				Probes: ProbeConfig{
+					Settings: map[string]map[string]any{
+						"dan.Dan_11_0": {"threshold": 0.3},
+					},
+				},
+			},
+			want: results.Thresholds{Probe: map[string]float64{"dan.Dan_11_0": 0.3}},
+		},
+		{
+			name: "detector threshold setting is picked up",
+			config: Config{
+				Detectors: DetectorConfig{
+					Settings: map[string]map[string]any{
+						"dan.DAN": {"threshold": 0.7},
+					},
+				},
+			},
+			want: results.Thresholds{Detector: map[string]float64{"dan.DAN": 0.7}},
+		},
+		{
+			name: "settings without a threshold key are ignored",
+			config: Config{
+				Probes: ProbeConfig{
+					Settings: map[string]map[string]any{
+						"pair.IterativePAIR": {"attacker_generator_type": "openai.OpenAI"},
+					},
+				},
+			},
+			want: results.Thresholds{},
+		},
+		{
+			name: "integer threshold from JSON config is accepted",
+			config: Config{
+				Detectors: DetectorConfig{
+					Settings: map[string]map[string]any{
+						"always.Fail": {"threshold": 1},
+					},
+				},
+			},
+			want: results.Thresholds{Detector: map[string]float64{"always.Fail": 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.config.ResolveThresholds()
+			assert.Equal(t, tt.want, result)
+		})
+	}
+}
+
 // TestNestedEnvVarInterpolation tests that env vars in nested config maps are resolved
 func TestNestedEnvVarInterpolation(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -1055,6 +1235,68 @@ func TestConfig_Validate_ValidTimeout(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// TestConfig_Validate_NegativeMaxTotalAttempts tests that Validate() rejects a negative max_total_attempts
+func TestConfig_Validate_NegativeMaxTotalAttempts(t *testing.T) {
+	cfg := &Config{
+		Run: RunConfig{MaxTotalAttempts: -1},
+	}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "run.max_total_attempts")
+}
+
+// TestConfig_Merge_MaxTotalAttempts tests that Merge() overrides max_total_attempts when set
+func TestConfig_Merge_MaxTotalAttempts(t *testing.T) {
+	base := &Config{Run: RunConfig{MaxTotalAttempts: 100}}
+	override := &Config{Run: RunConfig{MaxTotalAttempts: 50}}
+
+	base.Merge(override)
+
+	assert.Equal(t, 50, base.Run.MaxTotalAttempts)
+}
+
+// TestConfig_Merge_MaxTotalAttemptsZeroDoesNotOverride tests that Merge() leaves max_total_attempts
+// untouched when the overriding config doesn't set it.
+func TestConfig_Merge_MaxTotalAttemptsZeroDoesNotOverride(t *testing.T) {
+	base := &Config{Run: RunConfig{MaxTotalAttempts: 100}}
+	override := &Config{}
+
+	base.Merge(override)
+
+	assert.Equal(t, 100, base.Run.MaxTotalAttempts)
+}
+
+// TestConfig_Validate_NegativeMaxContinuations tests that Validate() rejects a negative max_continuations
+func TestConfig_Validate_NegativeMaxContinuations(t *testing.T) {
+	cfg := &Config{
+		Run: RunConfig{MaxContinuations: -1},
+	}
+	err := cfg.Validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "run.max_continuations")
+}
+
+// TestConfig_Merge_MaxContinuations tests that Merge() overrides max_continuations when set
+func TestConfig_Merge_MaxContinuations(t *testing.T) {
+	base := &Config{Run: RunConfig{MaxContinuations: 3}}
+	override := &Config{Run: RunConfig{MaxContinuations: 1}}
+
+	base.Merge(override)
+
+	assert.Equal(t, 1, base.Run.MaxContinuations)
+}
+
+// TestConfig_Merge_MaxContinuationsZeroDoesNotOverride tests that Merge() leaves max_continuations
+// untouched when the overriding config doesn't set it.
+func TestConfig_Merge_MaxContinuationsZeroDoesNotOverride(t *testing.T) {
+	base := &Config{Run: RunConfig{MaxContinuations: 3}}
+	override := &Config{}
+
+	base.Merge(override)
+
+	assert.Equal(t, 3, base.Run.MaxContinuations)
+}
+
 // TestHooksYAML tests loading hook configuration from YAML
 func TestHooksYAML(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -1125,3 +1367,125 @@ func TestHooksProfile(t *testing.T) {
 	assert.Equal(t, "echo profile_prepare", cfg.Hooks.Prepare)
 	assert.Equal(t, "echo profile_cleanup", cfg.Hooks.Cleanup)
 }
+
+// TestLoadConfig_ResolvesSecretReferences verifies that "${scheme:ref}"
+// values dispatch to a registered secrets.Provider instead of being looked
+// up as plain environment variables.
+func TestLoadConfig_ResolvesSecretReferences(t *testing.T) {
+	secrets.Register("faketest", &fakeSecretProvider{values: map[string]string{
+		"augustus/openai#api_key": "sk-from-fake-provider",
+	}})
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	yamlContent := `
+generators:
+  huggingface:
+    api_key: ${faketest:augustus/openai#api_key}
+    model: gpt2
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(yamlContent), 0644))
+
+	cfg, err := LoadConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "sk-from-fake-provider", cfg.Generators["huggingface"].APIKey)
+}
+
+// TestLoadConfig_SecretReferenceNotFoundErrors verifies a provider resolution
+// failure surfaces as a load error rather than silently leaving "${...}" in place.
+func TestLoadConfig_SecretReferenceNotFoundErrors(t *testing.T) {
+	secrets.Register("faketest", &fakeSecretProvider{values: map[string]string{}})
+
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	yamlContent := `
+generators:
+  huggingface:
+    api_key: ${faketest:does/not/exist#key}
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(yamlContent), 0644))
+
+	_, err := LoadConfig(configPath)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "faketest:does/not/exist#key")
+}
+
+func TestResolveProbeNames(t *testing.T) {
+	available := []string{"dan.Dan_11_0", "dan.DanInTheWild", "encoding.Base64", "goodside.Tag"}
+
+	tests := []struct {
+		name    string
+		config  Config
+		names   []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:   "no filters returns input unchanged",
+			config: Config{},
+			names:  available,
+			want:   available,
+		},
+		{
+			name: "include narrows to matching globs",
+			config: Config{
+				Probes: ProbeConfig{Include: []string{"dan.*"}},
+			},
+			names: available,
+			want:  []string{"dan.Dan_11_0", "dan.DanInTheWild"},
+		},
+		{
+			name: "tags expand to category globs",
+			config: Config{
+				Probes: ProbeConfig{Tags: []string{"encoding"}},
+			},
+			names: available,
+			want:  []string{"encoding.Base64"},
+		},
+		{
+			name: "include and tags union",
+			config: Config{
+				Probes: ProbeConfig{Include: []string{"dan.*"}, Tags: []string{"encoding"}},
+			},
+			names: available,
+			want:  []string{"dan.Dan_11_0", "dan.DanInTheWild", "encoding.Base64"},
+		},
+		{
+			name: "exclude removes matches after include/tags",
+			config: Config{
+				Probes: ProbeConfig{Exclude: []string{"dan.DanInTheWild"}},
+			},
+			names: available,
+			want:  []string{"dan.Dan_11_0", "encoding.Base64", "goodside.Tag"},
+		},
+		{
+			name: "exclude wins over include for the same probe",
+			config: Config{
+				Probes: ProbeConfig{Include: []string{"dan.*"}, Exclude: []string{"dan.DanInTheWild"}},
+			},
+			names: available,
+			want:  []string{"dan.Dan_11_0"},
+		},
+		{
+			name: "invalid include pattern errors",
+			config: Config{
+				Probes: ProbeConfig{Include: []string{""}},
+			},
+			names:   available,
+			wantErr: "invalid probes.include/tags pattern",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.ResolveProbeNames(tt.names)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
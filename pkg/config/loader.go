@@ -1,15 +1,34 @@
 package config
 
 import (
+	"bytes"
 	"fmt"
 	"os"
+	"strings"
 
+	"github.com/praetorian-inc/augustus/pkg/secrets"
 	"gopkg.in/yaml.v3"
 )
 
 // LoadConfig loads and merges configuration files in hierarchical order
 // Later configs override earlier ones: base → site → run → CLI
 func LoadConfig(paths ...string) (*Config, error) {
+	return loadConfig(false, paths...)
+}
+
+// LoadConfigStrict is like LoadConfig, but rejects any key in the YAML that
+// doesn't correspond to a known field (reporting the offending line number),
+// instead of silently ignoring it. Used by --strict-config / config.strict.
+//
+// Per-capability settings maps (probes.settings.*, detectors.settings.*,
+// buffs.settings.*) are exempt, since their keys are capability-specific and
+// not known to this package; see ValidateCapabilityNames for validating
+// those against a registry.
+func LoadConfigStrict(paths ...string) (*Config, error) {
+	return loadConfig(true, paths...)
+}
+
+func loadConfig(strict bool, paths ...string) (*Config, error) {
 	if len(paths) == 0 {
 		return nil, fmt.Errorf("no configuration files provided")
 	}
@@ -18,7 +37,7 @@ func LoadConfig(paths ...string) (*Config, error) {
 
 	// Load and merge each config file in order
 	for _, path := range paths {
-		cfg, err := loadSingleConfig(path)
+		cfg, err := loadSingleConfig(path, strict)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load config from %s: %w", path, err)
 		}
@@ -45,7 +64,7 @@ func LoadConfig(paths ...string) (*Config, error) {
 
 // LoadConfigWithProfile loads a config file and applies a named profile
 func LoadConfigWithProfile(path string, profileName string) (*Config, error) {
-	cfg, err := loadSingleConfig(path)
+	cfg, err := loadSingleConfig(path, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config from %s: %w", path, err)
 	}
@@ -68,15 +87,24 @@ func LoadConfigWithProfile(path string, profileName string) (*Config, error) {
 	return cfg, nil
 }
 
-// loadSingleConfig loads a single YAML configuration file
-func loadSingleConfig(path string) (*Config, error) {
+// loadSingleConfig loads a single YAML configuration file. When strict is
+// true, decoding uses yaml.Decoder.KnownFields, which rejects any key that
+// doesn't map to a struct field and reports the line number in the error -
+// catching misindented YAML that would otherwise be silently dropped.
+func loadSingleConfig(path string, strict bool) (*Config, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
 	var cfg Config
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
+	if strict {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&cfg); err != nil {
+			return nil, fmt.Errorf("strict yaml validation failed: %w", err)
+		}
+	} else if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse yaml: %w", err)
 	}
 
@@ -84,17 +112,17 @@ func loadSingleConfig(path string) (*Config, error) {
 }
 
 // interpolateMapEnvVars recursively interpolates env vars in map[string]any values
-func interpolateMapEnvVars(m map[string]any, getenv func(string) (string, bool)) error {
+func interpolateMapEnvVars(m map[string]any, resolve func(string) (string, error)) error {
 	for k, v := range m {
 		switch val := v.(type) {
 		case string:
-			interpolated, err := interpolateEnvVars(val, getenv)
+			interpolated, err := interpolateEnvVars(val, resolve)
 			if err != nil {
 				return err
 			}
 			m[k] = interpolated
 		case map[string]any:
-			if err := interpolateMapEnvVars(val, getenv); err != nil {
+			if err := interpolateMapEnvVars(val, resolve); err != nil {
 				return err
 			}
 		}
@@ -102,16 +130,33 @@ func interpolateMapEnvVars(m map[string]any, getenv func(string) (string, bool))
 	return nil
 }
 
-// interpolateConfigEnvVars interpolates environment variables in all string fields
-func interpolateConfigEnvVars(cfg *Config) error {
-	getenv := func(key string) (string, bool) {
-		val := os.Getenv(key)
-		if val == "" {
-			return "", false
+// resolveSecretOrEnv resolves a "${...}" reference. References of the form
+// "scheme:ref" (e.g. "vault:secret/path#key", "aws-sm:my-secret") dispatch
+// to the matching pkg/secrets.Provider; anything else is looked up as a
+// plain environment variable, preserving existing "${VAR}" behavior.
+func resolveSecretOrEnv(key string) (string, error) {
+	if scheme, ref, ok := strings.Cut(key, ":"); ok {
+		if provider, ok := secrets.Get(scheme); ok {
+			value, err := provider.Resolve(ref)
+			if err != nil {
+				return "", fmt.Errorf("failed to resolve secret %q: %w", key, err)
+			}
+			return value, nil
 		}
-		return val, true
 	}
 
+	val, ok := os.LookupEnv(key)
+	if !ok || val == "" {
+		return "", fmt.Errorf("environment variable %q is not set", key)
+	}
+	return val, nil
+}
+
+// interpolateConfigEnvVars interpolates environment variables and secret
+// references in all string fields
+func interpolateConfigEnvVars(cfg *Config) error {
+	getenv := resolveSecretOrEnv
+
 	// Interpolate run config
 	if cfg.Run.Timeout != "" {
 		timeout, err := interpolateEnvVars(cfg.Run.Timeout, getenv)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/praetorian-inc/augustus/pkg/probes"
 	"github.com/praetorian-inc/augustus/pkg/registry"
 	"github.com/praetorian-inc/augustus/pkg/scanner"
 )
@@ -13,15 +14,24 @@ import (
 // Pointer fields (nil = not set by user) enable correct precedence:
 // Kong populates defaults, but nil means "user didn't explicitly pass this flag".
 type CLIOverrides struct {
-	GeneratorName string
-	ConfigJSON    string
-	Concurrency   *int
-	Timeout       *time.Duration
-	ProbeTimeout  *time.Duration
-	OutputFormat  string
-	OutputFile    string
-	HTMLFile      string
-	ProfileName   string
+	GeneratorName       string
+	ConfigJSON          string
+	Concurrency         *int
+	Timeout             *time.Duration
+	ProbeTimeout        *time.Duration
+	AttemptTimeout      *time.Duration
+	DetectorTimeout     *time.Duration
+	ShutdownGracePeriod *time.Duration
+	OutputFormat        string
+	OutputFile          string
+	HTMLFile            string
+	CSVFile             string
+	XLSXFile            string
+	StoreFile           string
+	ProfileName         string
+	RedactedHTMLFile    string
+	RedactedOutputFile  string
+	RedactThreshold     float64
 }
 
 // ResolvedConfig holds fully-resolved, ready-to-use configuration.
@@ -29,9 +39,19 @@ type CLIOverrides struct {
 type ResolvedConfig struct {
 	ScannerOpts     scanner.Options
 	GeneratorConfig registry.Config
+	PromptCap       probes.SampleOptions
 	OutputFormat    string
 	OutputFile      string
 	HTMLFile        string
+	CSVFile         string
+	XLSXFile        string
+	StoreFile       string
+	// RedactedHTMLFile and RedactedOutputFile, if set, produce a second,
+	// client-safe HTML/JSONL pair from the same attempts as HTMLFile and
+	// OutputFile, redacted per RedactThreshold.
+	RedactedHTMLFile   string
+	RedactedOutputFile string
+	RedactThreshold    float64
 }
 
 // Resolve produces fully-resolved configuration by applying the
@@ -62,8 +82,26 @@ func Resolve(yamlCfg *Config, cli CLIOverrides) (*ResolvedConfig, error) {
 	if cli.ProbeTimeout != nil {
 		opts.ProbeTimeout = *cli.ProbeTimeout
 	}
+	if cli.AttemptTimeout != nil {
+		opts.AttemptTimeout = *cli.AttemptTimeout
+	}
+	if cli.DetectorTimeout != nil {
+		opts.DetectorTimeout = *cli.DetectorTimeout
+	}
+	if cli.ShutdownGracePeriod != nil {
+		opts.ShutdownGracePeriod = *cli.ShutdownGracePeriod
+	}
 	resolved.ScannerOpts = opts
 
+	// Phase 1b: scan-wide prompt cap (YAML only, no CLI flag yet)
+	resolved.PromptCap = probes.SampleOptions{Strategy: probes.SampleHead}
+	if yamlCfg != nil {
+		resolved.PromptCap.Size = yamlCfg.Run.PromptCap
+		if yamlCfg.Run.PromptCapStrategy != "" {
+			resolved.PromptCap.Strategy = probes.SampleStrategy(yamlCfg.Run.PromptCapStrategy)
+		}
+	}
+
 	// Phase 2: Generator config (YAML -> CLI JSON overlay)
 	genConfig, err := resolveGeneratorConfig(yamlCfg, cli)
 	if err != nil {
@@ -75,6 +113,12 @@ func Resolve(yamlCfg *Config, cli CLIOverrides) (*ResolvedConfig, error) {
 	resolved.OutputFormat = resolveString("table", yamlGet(yamlCfg, func(c *Config) string { return c.Output.Format }), cli.OutputFormat)
 	resolved.OutputFile = resolveString("", yamlGet(yamlCfg, func(c *Config) string { return c.Output.Path }), cli.OutputFile)
 	resolved.HTMLFile = cli.HTMLFile
+	resolved.CSVFile = cli.CSVFile
+	resolved.XLSXFile = cli.XLSXFile
+	resolved.StoreFile = cli.StoreFile
+	resolved.RedactedHTMLFile = cli.RedactedHTMLFile
+	resolved.RedactedOutputFile = cli.RedactedOutputFile
+	resolved.RedactThreshold = cli.RedactThreshold
 
 	return resolved, nil
 }
@@ -98,9 +142,36 @@ func applyYAMLRunConfig(opts *scanner.Options, run RunConfig) error {
 		}
 		opts.ProbeTimeout = d
 	}
+	if run.AttemptTimeout != "" {
+		d, err := time.ParseDuration(run.AttemptTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid run.attempt_timeout %q: %w", run.AttemptTimeout, err)
+		}
+		opts.AttemptTimeout = d
+	}
+	if run.DetectorTimeout != "" {
+		d, err := time.ParseDuration(run.DetectorTimeout)
+		if err != nil {
+			return fmt.Errorf("invalid run.detector_timeout %q: %w", run.DetectorTimeout, err)
+		}
+		opts.DetectorTimeout = d
+	}
+	if run.ShutdownGracePeriod != "" {
+		d, err := time.ParseDuration(run.ShutdownGracePeriod)
+		if err != nil {
+			return fmt.Errorf("invalid run.shutdown_grace_period %q: %w", run.ShutdownGracePeriod, err)
+		}
+		opts.ShutdownGracePeriod = d
+	}
 	if run.MaxAttempts > 0 {
 		opts.RetryCount = run.MaxAttempts
 	}
+	if run.MaxTotalAttempts > 0 {
+		opts.MaxTotalAttempts = run.MaxTotalAttempts
+	}
+	if run.MaxContinuations > 0 {
+		opts.MaxContinuations = run.MaxContinuations
+	}
 	return nil
 }
 
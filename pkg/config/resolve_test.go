@@ -58,6 +58,52 @@ func TestResolve_YAMLOverridesDefaults(t *testing.T) {
 	assert.Equal(t, "/tmp/results.jsonl", resolved.OutputFile)
 }
 
+func TestResolve_YAMLMaxTotalAttempts(t *testing.T) {
+	yamlCfg := &Config{
+		Run: RunConfig{
+			MaxAttempts:      3,
+			MaxTotalAttempts: 200,
+		},
+	}
+	cli := CLIOverrides{GeneratorName: "openai.OpenAI"}
+
+	resolved, err := Resolve(yamlCfg, cli)
+	require.NoError(t, err)
+
+	// MaxAttempts (per-probe retry count) and MaxTotalAttempts (scan-wide
+	// attempt budget) are independent settings.
+	assert.Equal(t, 3, resolved.ScannerOpts.RetryCount)
+	assert.Equal(t, 200, resolved.ScannerOpts.MaxTotalAttempts)
+}
+
+func TestResolve_DefaultsOnly_MaxTotalAttemptsUnset(t *testing.T) {
+	cli := CLIOverrides{GeneratorName: "openai.OpenAI"}
+	resolved, err := Resolve(nil, cli)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, resolved.ScannerOpts.MaxTotalAttempts)
+}
+
+func TestResolve_YAMLMaxContinuations(t *testing.T) {
+	yamlCfg := &Config{
+		Run: RunConfig{MaxContinuations: 2},
+	}
+	cli := CLIOverrides{GeneratorName: "openai.OpenAI"}
+
+	resolved, err := Resolve(yamlCfg, cli)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, resolved.ScannerOpts.MaxContinuations)
+}
+
+func TestResolve_DefaultsOnly_MaxContinuationsUnset(t *testing.T) {
+	cli := CLIOverrides{GeneratorName: "openai.OpenAI"}
+	resolved, err := Resolve(nil, cli)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, resolved.ScannerOpts.MaxContinuations)
+}
+
 func TestResolve_CLIOverridesYAML(t *testing.T) {
 	yamlCfg := &Config{
 		Run: RunConfig{
@@ -0,0 +1,69 @@
+// Package contentfilter provides a generator wrapper that recognizes when a
+// provider's own content filter intercepted a call - an Azure content
+// filter error, an Anthropic refusal stop reason, an OpenAI policy block -
+// rather than treating it as an ordinary generator error.
+package contentfilter
+
+import (
+	"context"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// Compile-time interface assertions.
+var _ types.Generator = (*Generator)(nil)
+
+// Generator wraps a generator, downgrading provider content-filter errors
+// to a recorded outcome instead of an ordinary failure. The call that was
+// blocked reports it directly on the returned attempt.Message.BlockReason,
+// which probes.RunPrompts stamps onto the attempt as
+// probes.OutcomeBlockedByProvider instead of scoring it as a plain pass, so
+// blocked attempts are never confused with ones that actually produced (and
+// were then scored for) a completion. Reporting the block on the message
+// itself, rather than on shared Generator state, keeps it attributed to the
+// call that produced it even when a caller runs many Generate calls
+// concurrently on the same Generator.
+type Generator struct {
+	inner types.Generator
+}
+
+// New wraps inner with content-filter detection.
+func New(inner types.Generator) *Generator {
+	return &Generator{inner: inner}
+}
+
+// Generate delegates to the inner generator. If the call fails with an
+// error that ClassifyError buckets as ErrorClassContentFilter, the error is
+// swallowed and a single message with BlockReason set is returned instead.
+// Any other error is propagated unchanged.
+func (g *Generator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	responses, err := g.inner.Generate(ctx, conv, n)
+	if err == nil {
+		return responses, nil
+	}
+
+	if class, _ := probes.ClassifyError(err); class == probes.ErrorClassContentFilter {
+		msg := attempt.NewAssistantMessage("")
+		msg.BlockReason = err.Error()
+		return []attempt.Message{msg}, nil
+	}
+
+	return nil, err
+}
+
+// ClearHistory delegates to the inner generator.
+func (g *Generator) ClearHistory() {
+	g.inner.ClearHistory()
+}
+
+// Name returns the inner generator's name.
+func (g *Generator) Name() string {
+	return g.inner.Name()
+}
+
+// Description returns the inner generator's description.
+func (g *Generator) Description() string {
+	return g.inner.Description()
+}
@@ -0,0 +1,131 @@
+package contentfilter_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/contentfilter"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockGen struct {
+	err          error
+	resp         []attempt.Message
+	generateFunc func(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error)
+}
+
+func (m *mockGen) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	if m.generateFunc != nil {
+		return m.generateFunc(ctx, conv, n)
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.resp, nil
+}
+
+func (m *mockGen) ClearHistory()       {}
+func (m *mockGen) Name() string        { return "mock-generator" }
+func (m *mockGen) Description() string { return "mock" }
+
+func TestGenerate_ContentFilterErrorIsSwallowedAndReported(t *testing.T) {
+	inner := &mockGen{err: errors.New("azure: content filter triggered")}
+	g := contentfilter.New(inner)
+
+	responses, err := g.Generate(context.Background(), attempt.NewConversation(), 1)
+
+	require.NoError(t, err)
+	require.Len(t, responses, 1)
+	assert.Contains(t, responses[0].BlockReason, "content filter")
+}
+
+func TestGenerate_OtherErrorsPropagate(t *testing.T) {
+	inner := &mockGen{err: errors.New("rest: rate limited: 429 Too Many Requests")}
+	g := contentfilter.New(inner)
+
+	_, err := g.Generate(context.Background(), attempt.NewConversation(), 1)
+
+	require.Error(t, err)
+}
+
+func TestGenerate_SuccessAfterBlockReportsNoBlockReason(t *testing.T) {
+	inner := &mockGen{err: errors.New("anthropic: refusal stop reason")}
+	g := contentfilter.New(inner)
+
+	blockedResponses, err := g.Generate(context.Background(), attempt.NewConversation(), 1)
+	require.NoError(t, err)
+	require.Len(t, blockedResponses, 1)
+	require.NotEmpty(t, blockedResponses[0].BlockReason)
+
+	inner.err = nil
+	inner.resp = []attempt.Message{{Content: "ok"}}
+	responses, err := g.Generate(context.Background(), attempt.NewConversation(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", responses[0].Content)
+	assert.Empty(t, responses[0].BlockReason)
+}
+
+// TestGenerate_ConcurrentCallsDoNotCrossContaminate guards against
+// regressing to shared Generator state for block reporting: one
+// goroutine's blocked call must never be attributed to a concurrently
+// running goroutine's call.
+func TestGenerate_ConcurrentCallsDoNotCrossContaminate(t *testing.T) {
+	inner := &mockGen{
+		generateFunc: func(_ context.Context, conv *attempt.Conversation, _ int) ([]attempt.Message, error) {
+			if strings.Contains(conv.LastPrompt(), "blocked") {
+				return nil, errors.New("azure: content filter triggered")
+			}
+			return []attempt.Message{{Content: "ok: " + conv.LastPrompt()}}, nil
+		},
+	}
+	g := contentfilter.New(inner)
+
+	const iterations = 50
+	var wg sync.WaitGroup
+	for i := 0; i < iterations; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			conv := attempt.NewConversation()
+			blocked := i%2 == 0
+			prompt := fmt.Sprintf("allow %d", i)
+			if blocked {
+				prompt = fmt.Sprintf("blocked %d", i)
+			}
+			conv.AddPrompt(prompt)
+
+			responses, err := g.Generate(context.Background(), conv, 1)
+			require.NoError(t, err)
+			require.Len(t, responses, 1)
+			if blocked {
+				assert.NotEmpty(t, responses[0].BlockReason, "prompt %q should report blocked", prompt)
+			} else {
+				assert.Empty(t, responses[0].BlockReason, "prompt %q should not report blocked", prompt)
+				assert.Equal(t, "ok: "+prompt, responses[0].Content)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestRunPrompts_RecordsBlockedOutcome(t *testing.T) {
+	inner := &mockGen{err: errors.New("openai: policy violation detected")}
+	g := contentfilter.New(inner)
+
+	attempts, err := probes.RunPrompts(context.Background(), g, []string{"prompt"}, "test-probe", "test-detector", nil)
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	a := attempts[0]
+	assert.Equal(t, attempt.StatusComplete, a.Status)
+	assert.Equal(t, probes.OutcomeBlockedByProvider, a.Metadata["outcome"])
+	assert.NotEmpty(t, a.Metadata["block_reason"])
+}
@@ -0,0 +1,181 @@
+// Package cronsched parses standard 5-field cron expressions and computes
+// their next scheduled run time, for components that need simple recurring
+// scheduling (e.g. the "daemon" command) without pulling in an external
+// cron library.
+package cronsched
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed 5-field cron expression: minute hour
+// day-of-month month day-of-week.
+type Schedule struct {
+	minutes []int
+	hours   []int
+	doms    []int
+	months  []int
+	dows    []int
+	domStar bool // day-of-month field was "*"
+	dowStar bool // day-of-week field was "*"
+}
+
+var fieldRanges = [5][2]int{
+	{0, 59}, // minute
+	{0, 23}, // hour
+	{1, 31}, // day of month
+	{1, 12}, // month
+	{0, 6},  // day of week (0 = Sunday)
+}
+
+// Parse parses a standard 5-field cron expression ("minute hour dom month
+// dow"). Each field supports "*", "*/step", "a-b", "a-b/step", "a,b,c", and
+// combinations thereof (e.g. "1-5,10,*/15").
+func Parse(expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cronsched: expected 5 fields (minute hour dom month dow), got %d: %q", len(fields), expr)
+	}
+
+	parsed := make([][]int, 5)
+	for i, field := range fields {
+		values, err := parseField(field, fieldRanges[i][0], fieldRanges[i][1])
+		if err != nil {
+			return nil, fmt.Errorf("cronsched: field %d (%q): %w", i, field, err)
+		}
+		parsed[i] = values
+	}
+
+	return &Schedule{
+		minutes: parsed[0],
+		hours:   parsed[1],
+		doms:    parsed[2],
+		months:  parsed[3],
+		dows:    parsed[4],
+		domStar: fields[2] == "*",
+		dowStar: fields[4] == "*",
+	}, nil
+}
+
+// parseField expands a single cron field (e.g. "*/15", "1-5", "1,3,5") into
+// the sorted list of distinct values it matches, within [min, max].
+func parseField(field string, min, max int) ([]int, error) {
+	seen := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		rangeStart, rangeEnd, step := min, max, 1
+
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+		if hasStep {
+			s, err := strconv.Atoi(stepPart)
+			if err != nil || s <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepPart)
+			}
+			step = s
+		}
+
+		switch {
+		case rangePart == "*":
+			// rangeStart/rangeEnd already cover the full field range.
+		case strings.Contains(rangePart, "-"):
+			lo, hi, ok := strings.Cut(rangePart, "-")
+			if !ok {
+				return nil, fmt.Errorf("invalid range %q", rangePart)
+			}
+			start, err1 := strconv.Atoi(lo)
+			end, err2 := strconv.Atoi(hi)
+			if err1 != nil || err2 != nil || start > end {
+				return nil, fmt.Errorf("invalid range %q", rangePart)
+			}
+			rangeStart, rangeEnd = start, end
+		default:
+			v, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %q", rangePart)
+			}
+			rangeStart, rangeEnd = v, v
+		}
+
+		if rangeStart < min || rangeEnd > max {
+			return nil, fmt.Errorf("value out of range [%d, %d]: %q", min, max, part)
+		}
+
+		for v := rangeStart; v <= rangeEnd; v += step {
+			seen[v] = true
+		}
+	}
+
+	if len(seen) == 0 {
+		return nil, fmt.Errorf("empty field")
+	}
+
+	values := make([]int, 0, len(seen))
+	for v := range seen {
+		values = append(values, v)
+	}
+	for i := 1; i < len(values); i++ {
+		for j := i; j > 0 && values[j-1] > values[j]; j-- {
+			values[j-1], values[j] = values[j], values[j-1]
+		}
+	}
+	return values, nil
+}
+
+// Next returns the earliest time strictly after from that matches the
+// schedule, truncated to the minute. Next scans forward minute-by-minute
+// for up to four years before giving up, which is more than enough
+// headroom for any valid cron expression (the coarsest is "once a year").
+func (s *Schedule) Next(from time.Time) time.Time {
+	t := from.Truncate(time.Minute).Add(time.Minute)
+
+	const maxIterations = 4 * 366 * 24 * 60
+	for i := 0; i < maxIterations; i++ {
+		if s.matches(t) {
+			return t
+		}
+		t = t.Add(time.Minute)
+	}
+
+	// Unreachable for any Schedule produced by Parse, since every field has
+	// at least one value and months/dows cycle within a year.
+	return t
+}
+
+func (s *Schedule) matches(t time.Time) bool {
+	if !contains(s.months, int(t.Month())) {
+		return false
+	}
+	if !contains(s.hours, t.Hour()) {
+		return false
+	}
+	if !contains(s.minutes, t.Minute()) {
+		return false
+	}
+
+	domMatch := contains(s.doms, t.Day())
+	dowMatch := contains(s.dows, int(t.Weekday()))
+
+	// Standard cron semantics: if both day-of-month and day-of-week are
+	// restricted (not "*"), a match on either field is sufficient.
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dowMatch
+	case s.dowStar:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+func contains(values []int, v int) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
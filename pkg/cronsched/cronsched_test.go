@@ -0,0 +1,102 @@
+package cronsched
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse_InvalidFieldCount(t *testing.T) {
+	if _, err := Parse("0 2 * *"); err == nil {
+		t.Fatal("Parse() error = nil, want error for 4-field expression")
+	}
+}
+
+func TestParse_InvalidValue(t *testing.T) {
+	if _, err := Parse("abc 2 * * *"); err == nil {
+		t.Fatal("Parse() error = nil, want error for non-numeric field")
+	}
+}
+
+func TestParse_OutOfRange(t *testing.T) {
+	if _, err := Parse("0 24 * * *"); err == nil {
+		t.Fatal("Parse() error = nil, want error for hour out of range")
+	}
+}
+
+func mustParse(t *testing.T, expr string) *Schedule {
+	t.Helper()
+	s, err := Parse(expr)
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", expr, err)
+	}
+	return s
+}
+
+func TestNext_DailyAtFixedTime(t *testing.T) {
+	s := mustParse(t, "0 2 * * *")
+	from := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC)
+
+	got := s.Next(from)
+	want := time.Date(2026, 3, 6, 2, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestNext_SameDayIfTimeHasNotPassed(t *testing.T) {
+	s := mustParse(t, "0 2 * * *")
+	from := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	got := s.Next(from)
+	want := time.Date(2026, 3, 5, 2, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestNext_StepField(t *testing.T) {
+	s := mustParse(t, "*/15 * * * *")
+	from := time.Date(2026, 3, 5, 10, 1, 0, 0, time.UTC)
+
+	got := s.Next(from)
+	want := time.Date(2026, 3, 5, 10, 15, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestNext_WeekdayOnly(t *testing.T) {
+	// Every Monday at 09:00.
+	s := mustParse(t, "0 9 * * 1")
+	from := time.Date(2026, 3, 5, 10, 0, 0, 0, time.UTC) // Thursday
+
+	got := s.Next(from)
+	want := time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC) // next Monday
+	if !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestNext_DomAndDowRestrictedMatchesEither(t *testing.T) {
+	// Standard cron semantics: restricting both dom and dow is an OR, not
+	// an AND - so this fires on the 1st of the month OR on any Friday.
+	s := mustParse(t, "0 0 1 * 5")
+	from := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC) // Monday, day 2
+
+	got := s.Next(from)
+	want := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC) // next Friday
+	if !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+}
+
+func TestNext_ListAndRangeFields(t *testing.T) {
+	s := mustParse(t, "0 8-9,17 * * *")
+	from := time.Date(2026, 3, 5, 8, 30, 0, 0, time.UTC)
+
+	got := s.Next(from)
+	want := time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("Next() = %v, want %v", got, want)
+	}
+}
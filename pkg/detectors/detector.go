@@ -13,6 +13,10 @@ import (
 // See types.Detector for the canonical interface definition.
 type Detector = types.Detector
 
+// BatchDetector is a type alias for backward compatibility.
+// See types.BatchDetector for the canonical interface definition.
+type BatchDetector = types.BatchDetector
+
 // Registry is the global detector registry.
 var Registry = registry.New[Detector]("detectors")
 
@@ -0,0 +1,62 @@
+// Package evaldetector measures a detector's accuracy against a labeled
+// corpus of prompt/output pairs, independent of running a live scan.
+package evaldetector
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// LabeledExample is a single labeled row in a detector evaluation corpus.
+//
+// Label is 1 if Output should be flagged vulnerable by the detector, and 0
+// if it should be scored safe.
+type LabeledExample struct {
+	// Prompt is the input sent to the model (optional context for detectors
+	// that inspect it, e.g. prompt/output consistency checks).
+	Prompt string `json:"prompt"`
+
+	// Output is the model response the detector scores.
+	Output string `json:"output"`
+
+	// Label is the ground-truth classification: 1 = vulnerable, 0 = safe.
+	Label int `json:"label"`
+}
+
+// LoadCorpus reads a labeled corpus from a JSONL file, one LabeledExample
+// per line.
+func LoadCorpus(path string) ([]LabeledExample, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open corpus %s: %w", path, err)
+	}
+	defer f.Close()
+
+	var examples []LabeledExample
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var ex LabeledExample
+		if err := json.Unmarshal(line, &ex); err != nil {
+			return nil, fmt.Errorf("corpus %s line %d: invalid JSON: %w", path, lineNum, err)
+		}
+		if ex.Label != 0 && ex.Label != 1 {
+			return nil, fmt.Errorf("corpus %s line %d: label must be 0 or 1, got %d", path, lineNum, ex.Label)
+		}
+		examples = append(examples, ex)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read corpus %s: %w", path, err)
+	}
+	if len(examples) == 0 {
+		return nil, fmt.Errorf("corpus %s contains no examples", path)
+	}
+
+	return examples, nil
+}
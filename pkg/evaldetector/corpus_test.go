@@ -0,0 +1,66 @@
+package evaldetector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeCorpus(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "corpus.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestLoadCorpus_ParsesLabeledExamples(t *testing.T) {
+	path := writeCorpus(t, `{"prompt":"p1","output":"o1","label":1}
+{"prompt":"p2","output":"o2","label":0}
+`)
+
+	examples, err := LoadCorpus(path)
+	require.NoError(t, err)
+	require.Len(t, examples, 2)
+	assert.Equal(t, LabeledExample{Prompt: "p1", Output: "o1", Label: 1}, examples[0])
+	assert.Equal(t, LabeledExample{Prompt: "p2", Output: "o2", Label: 0}, examples[1])
+}
+
+func TestLoadCorpus_SkipsBlankLines(t *testing.T) {
+	path := writeCorpus(t, "{\"output\":\"o1\",\"label\":1}\n\n{\"output\":\"o2\",\"label\":0}\n")
+
+	examples, err := LoadCorpus(path)
+	require.NoError(t, err)
+	assert.Len(t, examples, 2)
+}
+
+func TestLoadCorpus_RejectsInvalidLabel(t *testing.T) {
+	path := writeCorpus(t, `{"output":"o1","label":2}`)
+
+	_, err := LoadCorpus(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "label must be 0 or 1")
+}
+
+func TestLoadCorpus_RejectsMalformedJSON(t *testing.T) {
+	path := writeCorpus(t, `not json`)
+
+	_, err := LoadCorpus(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid JSON")
+}
+
+func TestLoadCorpus_RejectsEmptyCorpus(t *testing.T) {
+	path := writeCorpus(t, "")
+
+	_, err := LoadCorpus(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no examples")
+}
+
+func TestLoadCorpus_MissingFile(t *testing.T) {
+	_, err := LoadCorpus(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.Error(t, err)
+}
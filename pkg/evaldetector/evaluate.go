@@ -0,0 +1,105 @@
+package evaldetector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// Report summarizes a detector's accuracy against a labeled corpus.
+type Report struct {
+	// Threshold is the score above which an example is classified vulnerable.
+	Threshold float64
+
+	// TruePositives counts vulnerable examples correctly flagged.
+	TruePositives int
+	// FalsePositives counts safe examples incorrectly flagged.
+	FalsePositives int
+	// TrueNegatives counts safe examples correctly passed.
+	TrueNegatives int
+	// FalseNegatives counts vulnerable examples incorrectly passed.
+	FalseNegatives int
+}
+
+// Total returns the number of examples the report was computed over.
+func (r Report) Total() int {
+	return r.TruePositives + r.FalsePositives + r.TrueNegatives + r.FalseNegatives
+}
+
+// Precision returns TP / (TP + FP), or 0 if the detector never flagged anything.
+func (r Report) Precision() float64 {
+	denom := r.TruePositives + r.FalsePositives
+	if denom == 0 {
+		return 0
+	}
+	return float64(r.TruePositives) / float64(denom)
+}
+
+// Recall returns TP / (TP + FN), or 0 if the corpus has no vulnerable examples.
+func (r Report) Recall() float64 {
+	denom := r.TruePositives + r.FalseNegatives
+	if denom == 0 {
+		return 0
+	}
+	return float64(r.TruePositives) / float64(denom)
+}
+
+// F1 returns the harmonic mean of precision and recall, or 0 if both are 0.
+func (r Report) F1() float64 {
+	p, rec := r.Precision(), r.Recall()
+	if p+rec == 0 {
+		return 0
+	}
+	return 2 * p * rec / (p + rec)
+}
+
+// Evaluate runs det against every example in the corpus and tallies a
+// confusion matrix at the given threshold. A threshold of 0 uses
+// attempt.DefaultVulnerabilityThreshold.
+func Evaluate(ctx context.Context, det types.Detector, corpus []LabeledExample, threshold float64) (Report, error) {
+	if threshold == 0 {
+		threshold = attempt.DefaultVulnerabilityThreshold
+	}
+	report := Report{Threshold: threshold}
+
+	for i, ex := range corpus {
+		a := attempt.New(ex.Prompt)
+		a.AddOutput(ex.Output)
+
+		scores, err := det.Detect(ctx, a)
+		if err != nil {
+			return Report{}, fmt.Errorf("corpus example %d: detector %s failed: %w", i, det.Name(), err)
+		}
+
+		predictedVuln := highestScore(scores) > threshold
+		actualVuln := ex.Label == 1
+
+		switch {
+		case actualVuln && predictedVuln:
+			report.TruePositives++
+		case actualVuln && !predictedVuln:
+			report.FalseNegatives++
+		case !actualVuln && predictedVuln:
+			report.FalsePositives++
+		default:
+			report.TrueNegatives++
+		}
+	}
+
+	return report, nil
+}
+
+// highestScore returns the maximum score in scores, or 0 for an empty slice,
+// matching the harness's convention of tracking a detector's highest score
+// across an attempt's outputs.
+func highestScore(scores []float64) float64 {
+	max := 0.0
+	for _, s := range scores {
+		if s > max {
+			max = s
+		}
+	}
+	return max
+}
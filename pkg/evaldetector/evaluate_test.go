@@ -0,0 +1,90 @@
+package evaldetector
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// thresholdDetector flags outputs containing a fixed substring.
+type thresholdDetector struct {
+	needle string
+}
+
+func (d *thresholdDetector) Detect(_ context.Context, a *attempt.Attempt) ([]float64, error) {
+	scores := make([]float64, len(a.Outputs))
+	for i, out := range a.Outputs {
+		if strings.Contains(out, d.needle) {
+			scores[i] = 1.0
+		}
+	}
+	return scores, nil
+}
+func (d *thresholdDetector) Name() string        { return "test.Threshold" }
+func (d *thresholdDetector) Description() string { return "flags outputs containing a substring" }
+
+func TestEvaluate_ComputesConfusionMatrix(t *testing.T) {
+	det := &thresholdDetector{needle: "BAD"}
+	corpus := []LabeledExample{
+		{Output: "this is BAD", Label: 1},  // true positive
+		{Output: "this is fine", Label: 0}, // true negative
+		{Output: "also BAD", Label: 0},     // false positive
+		{Output: "missed it", Label: 1},    // false negative
+	}
+
+	report, err := Evaluate(context.Background(), det, corpus, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.TruePositives)
+	assert.Equal(t, 1, report.TrueNegatives)
+	assert.Equal(t, 1, report.FalsePositives)
+	assert.Equal(t, 1, report.FalseNegatives)
+	assert.Equal(t, 4, report.Total())
+	assert.InDelta(t, 0.5, report.Precision(), 0.0001)
+	assert.InDelta(t, 0.5, report.Recall(), 0.0001)
+	assert.InDelta(t, 0.5, report.F1(), 0.0001)
+}
+
+func TestEvaluate_PerfectDetector(t *testing.T) {
+	det := &thresholdDetector{needle: "BAD"}
+	corpus := []LabeledExample{
+		{Output: "this is BAD", Label: 1},
+		{Output: "this is fine", Label: 0},
+	}
+
+	report, err := Evaluate(context.Background(), det, corpus, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1.0, report.Precision())
+	assert.Equal(t, 1.0, report.Recall())
+	assert.Equal(t, 1.0, report.F1())
+}
+
+func TestEvaluate_NoPositivePredictionsYieldsZeroPrecision(t *testing.T) {
+	det := &thresholdDetector{needle: "NOPE"}
+	corpus := []LabeledExample{
+		{Output: "this is BAD", Label: 1},
+	}
+
+	report, err := Evaluate(context.Background(), det, corpus, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0.0, report.Precision())
+	assert.Equal(t, 0.0, report.Recall())
+	assert.Equal(t, 0.0, report.F1())
+}
+
+func TestEvaluate_DefaultsThresholdWhenZero(t *testing.T) {
+	det := &thresholdDetector{needle: "BAD"}
+	corpus := []LabeledExample{{Output: "BAD", Label: 1}}
+
+	report, err := Evaluate(context.Background(), det, corpus, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, attempt.DefaultVulnerabilityThreshold, report.Threshold)
+}
@@ -0,0 +1,254 @@
+// Package har records HTTP request/response traffic from augustus's
+// generators into HAR 1.2 files (https://w3c.github.io/web-performance/specs/HAR/Overview.html),
+// so assessors can attach raw evidence to findings. Sensitive headers
+// (API keys, bearer tokens, cookies) are redacted before entries are
+// ever held in memory.
+package har
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// redactedValue replaces the value of any sensitive header or query
+// parameter before it is recorded.
+const redactedValue = "[REDACTED]"
+
+// sensitiveHeaders lists header names (case-insensitive) whose values are
+// never written to a HAR file.
+var sensitiveHeaders = map[string]bool{
+	"authorization": true,
+	"cookie":        true,
+	"set-cookie":    true,
+	"x-api-key":     true,
+	"api-key":       true,
+	"x-auth-token":  true,
+}
+
+// Recorder captures HTTP exchanges as HAR entries. A zero-value Recorder
+// is ready to use. Recorder is safe for concurrent use by multiple
+// goroutines, matching the concurrent generator calls made by the scanner.
+type Recorder struct {
+	mu       sync.Mutex
+	entries  []entry
+	attached bool
+}
+
+// NewRecorder creates an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Wrap returns an http.RoundTripper that forwards requests to next and
+// records the resulting exchange. If next is nil, http.DefaultTransport
+// is used. Wrap marks the Recorder as attached, so callers can tell a real
+// capture from one that never got wired into any generator's transport.
+func (r *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	r.mu.Lock()
+	r.attached = true
+	r.mu.Unlock()
+	return &recordingTransport{next: next, recorder: r}
+}
+
+// Attached reports whether some generator's HTTP transport actually wrapped
+// this Recorder via Wrap. A generator that doesn't route its requests
+// through pkg/transport (most SDK-backed providers besides
+// anthropic/openai/rest) silently ignores the Recorder passed via
+// registry.Config, leaving Attached false and the eventual HAR file empty.
+func (r *Recorder) Attached() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.attached
+}
+
+// record appends a completed exchange to the log.
+func (r *Recorder) record(e entry) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, e)
+}
+
+// WriteFile serializes all recorded entries as a HAR 1.2 log to path.
+func (r *Recorder) WriteFile(path string) error {
+	r.mu.Lock()
+	entries := make([]entry, len(r.entries))
+	copy(entries, r.entries)
+	r.mu.Unlock()
+
+	doc := harFile{Log: harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: "augustus", Version: "1.0"},
+		Entries: entries,
+	}}
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// recordingTransport is the http.RoundTripper returned by Recorder.Wrap.
+type recordingTransport struct {
+	next     http.RoundTripper
+	recorder *Recorder
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := drainBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := time.Since(startedAt)
+	if err != nil {
+		t.recorder.record(newEntry(req, reqBody, nil, nil, startedAt, elapsed))
+		return resp, err
+	}
+
+	respBody, err := drainBody(&resp.Body)
+	if err != nil {
+		return resp, err
+	}
+
+	t.recorder.record(newEntry(req, reqBody, resp, respBody, startedAt, elapsed))
+	return resp, nil
+}
+
+// drainBody reads and redacts body from *body, then replaces *body with a
+// fresh reader so the caller can still read the original content.
+func drainBody(body *io.ReadCloser) ([]byte, error) {
+	if body == nil || *body == nil {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// redactHeaders copies h, replacing the values of sensitive headers.
+func redactHeaders(h http.Header) []harHeader {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	headers := make([]harHeader, 0, len(h))
+	for _, name := range names {
+		for _, value := range h[name] {
+			if sensitiveHeaders[strings.ToLower(name)] {
+				value = redactedValue
+			}
+			headers = append(headers, harHeader{Name: name, Value: value})
+		}
+	}
+	return headers
+}
+
+// redactQuery redacts sensitive query parameters (e.g. api keys passed as
+// ?key=... rather than in a header) from a URL's query string.
+func redactQuery(rawQuery string) []harQueryParam {
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	params := make([]harQueryParam, 0, len(values))
+	for _, name := range names {
+		for _, value := range values[name] {
+			if sensitiveHeaders[strings.ToLower(name)] || strings.EqualFold(name, "key") {
+				value = redactedValue
+			}
+			params = append(params, harQueryParam{Name: name, Value: value})
+		}
+	}
+	return params
+}
+
+// redactedURL returns u's string form with sensitive query parameter
+// values replaced, so the full request URL can be recorded without
+// leaking API keys passed via the query string.
+func redactedURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.String()
+	}
+	values, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return u.String()
+	}
+	for name := range values {
+		if sensitiveHeaders[strings.ToLower(name)] || strings.EqualFold(name, "key") {
+			for i := range values[name] {
+				values[name][i] = redactedValue
+			}
+		}
+	}
+	clone := *u
+	clone.RawQuery = values.Encode()
+	return clone.String()
+}
+
+func newEntry(req *http.Request, reqBody []byte, resp *http.Response, respBody []byte, startedAt time.Time, elapsed time.Duration) entry {
+	e := entry{
+		StartedDateTime: startedAt.UTC().Format(time.RFC3339Nano),
+		Time:            float64(elapsed.Microseconds()) / 1000.0,
+		Request: harRequest{
+			Method:      req.Method,
+			URL:         redactedURL(req.URL),
+			HTTPVersion: req.Proto,
+			Headers:     redactHeaders(req.Header),
+			QueryString: redactQuery(req.URL.RawQuery),
+			PostData:    postDataFor(req.Header.Get("Content-Type"), reqBody),
+		},
+		Cache:   harCache{},
+		Timings: harTimings{Send: 0, Wait: float64(elapsed.Microseconds()) / 1000.0, Receive: 0},
+	}
+
+	if resp == nil {
+		e.Response = harResponse{Status: 0, StatusText: "error", HTTPVersion: req.Proto}
+		return e
+	}
+
+	e.Response = harResponse{
+		Status:      resp.StatusCode,
+		StatusText:  http.StatusText(resp.StatusCode),
+		HTTPVersion: resp.Proto,
+		Headers:     redactHeaders(resp.Header),
+		Content: harContent{
+			Size:     len(respBody),
+			MimeType: resp.Header.Get("Content-Type"),
+			Text:     string(respBody),
+		},
+	}
+	return e
+}
+
+func postDataFor(contentType string, body []byte) *harPostData {
+	if len(body) == 0 {
+		return nil
+	}
+	return &harPostData{MimeType: contentType, Text: string(body)}
+}
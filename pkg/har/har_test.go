@@ -0,0 +1,102 @@
+package har_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/har"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_RecordsRequestAndResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"prompt":"hi"}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"reply":"hello"}`))
+	}))
+	defer server.Close()
+
+	rec := har.NewRecorder()
+	client := &http.Client{Transport: rec.Wrap(http.DefaultTransport)}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/chat", strings.NewReader(`{"prompt":"hi"}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer super-secret-token")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"reply":"hello"}`, string(body))
+
+	path := filepath.Join(t.TempDir(), "capture.har")
+	require.NoError(t, rec.WriteFile(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "super-secret-token")
+	assert.Contains(t, string(data), "[REDACTED]")
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(data, &doc))
+	log, ok := doc["log"].(map[string]any)
+	require.True(t, ok)
+	entries, ok := log["entries"].([]any)
+	require.True(t, ok)
+	require.Len(t, entries, 1)
+
+	entry := entries[0].(map[string]any)
+	response := entry["response"].(map[string]any)
+	content := response["content"].(map[string]any)
+	assert.Equal(t, `{"reply":"hello"}`, content["text"])
+}
+
+func TestRecorder_Attached(t *testing.T) {
+	rec := har.NewRecorder()
+	assert.False(t, rec.Attached(), "a fresh Recorder is not yet wired into any transport")
+
+	rec.Wrap(http.DefaultTransport)
+	assert.True(t, rec.Attached(), "Wrap must mark the Recorder as attached")
+}
+
+func TestRecorder_WriteFileWithNoEntries(t *testing.T) {
+	rec := har.NewRecorder()
+	path := filepath.Join(t.TempDir(), "empty.har")
+
+	require.NoError(t, rec.WriteFile(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"entries": []`)
+}
+
+func TestRecorder_RedactsSensitiveQueryParam(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rec := har.NewRecorder()
+	client := &http.Client{Transport: rec.Wrap(http.DefaultTransport)}
+
+	resp, err := client.Get(server.URL + "/v1/models?key=super-secret-key")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	path := filepath.Join(t.TempDir(), "query.har")
+	require.NoError(t, rec.WriteFile(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "super-secret-key")
+}
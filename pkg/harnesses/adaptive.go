@@ -0,0 +1,14 @@
+package harnesses
+
+import "github.com/praetorian-inc/augustus/pkg/probes"
+
+// Adaptive is an optional interface for probes that can stop issuing further
+// prompts mid-run once an early-stop condition is met, cutting scan time and
+// API spend on already-vulnerable targets. probes.SimpleProbe implements it,
+// so any probe built on top (the majority of static-prompt-list probes)
+// supports adaptive stopping for free.
+type Adaptive interface {
+	// SetAdaptiveOptions configures early-stopping behavior for the probe's
+	// next Probe() call. See probes.RunOptions.
+	SetAdaptiveOptions(opts probes.RunOptions)
+}
@@ -0,0 +1,171 @@
+package harnesses
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// AdaptiveConcurrencyOptions configures AdaptiveConcurrencyGenerator's AIMD
+// controller.
+type AdaptiveConcurrencyOptions struct {
+	// Min is the lowest the effective concurrency limit is allowed to drop
+	// to. <= 0 is treated as 1.
+	Min int
+	// Max is the highest the effective concurrency limit is allowed to climb
+	// back to, and the limit it starts at. Values below Min are raised to
+	// Min.
+	Max int
+	// DecreaseFactor multiplies the current limit on a rate-limit error
+	// (multiplicative decrease), e.g. 0.5 halves it. Values outside (0, 1)
+	// are treated as 0.5.
+	DecreaseFactor float64
+	// CooldownPeriod is how long the controller waits after a rate-limit
+	// error before taking its first additive-increase step back toward Max,
+	// and the interval between subsequent steps. <= 0 is treated as 5s.
+	CooldownPeriod time.Duration
+}
+
+// DefaultAdaptiveConcurrencyOptions returns AIMD bounds that let a scan run
+// at full speed (up to max) until a provider starts rate-limiting it.
+func DefaultAdaptiveConcurrencyOptions(max int) AdaptiveConcurrencyOptions {
+	return AdaptiveConcurrencyOptions{
+		Min:            1,
+		Max:            max,
+		DecreaseFactor: 0.5,
+		CooldownPeriod: 5 * time.Second,
+	}
+}
+
+// AdaptiveConcurrencyGenerator wraps a Generator with an AIMD-controlled
+// concurrency gate, so users don't have to hand-tune run.concurrency per
+// provider. Up to Max calls run at once until one fails with a rate-limit
+// error (classified via probes.ClassifyError), at which point the limit is
+// multiplicatively cut by DecreaseFactor down to no less than Min. After
+// CooldownPeriod passes with no further rate-limit errors, the limit climbs
+// back toward Max one call at a time (additive increase), taking another
+// CooldownPeriod between each step.
+type AdaptiveConcurrencyGenerator struct {
+	next types.Generator
+	opts AdaptiveConcurrencyOptions
+
+	mu             sync.Mutex
+	cond           *sync.Cond
+	limit          int
+	inFlight       int
+	nextIncreaseAt time.Time // zero until the first decrease
+}
+
+// NewAdaptiveConcurrencyGenerator wraps next with an AIMD concurrency
+// controller starting at opts.Max.
+func NewAdaptiveConcurrencyGenerator(next types.Generator, opts AdaptiveConcurrencyOptions) *AdaptiveConcurrencyGenerator {
+	if opts.Min <= 0 {
+		opts.Min = 1
+	}
+	if opts.Max < opts.Min {
+		opts.Max = opts.Min
+	}
+	if opts.DecreaseFactor <= 0 || opts.DecreaseFactor >= 1 {
+		opts.DecreaseFactor = 0.5
+	}
+	if opts.CooldownPeriod <= 0 {
+		opts.CooldownPeriod = 5 * time.Second
+	}
+
+	a := &AdaptiveConcurrencyGenerator{
+		next:  next,
+		opts:  opts,
+		limit: opts.Max,
+	}
+	a.cond = sync.NewCond(&a.mu)
+	return a
+}
+
+// Generate waits for a slot under the current adaptive limit, calls the
+// wrapped generator, then adjusts the limit based on whether the call failed
+// with a rate-limit error before releasing the slot.
+func (a *AdaptiveConcurrencyGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	if err := a.acquire(ctx); err != nil {
+		return nil, err
+	}
+
+	responses, err := a.next.Generate(ctx, conv, n)
+	a.release(err)
+	return responses, err
+}
+
+// acquire blocks until the number of in-flight calls is below the current
+// limit, or ctx is done.
+func (a *AdaptiveConcurrencyGenerator) acquire(ctx context.Context) error {
+	if ctx.Done() != nil {
+		stop := context.AfterFunc(ctx, func() {
+			a.mu.Lock()
+			a.cond.Broadcast()
+			a.mu.Unlock()
+		})
+		defer stop()
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for a.inFlight >= a.limit {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		a.cond.Wait()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	a.inFlight++
+	return nil
+}
+
+// release frees the in-flight slot acquired by acquire and runs the AIMD
+// adjustment for the call's outcome.
+func (a *AdaptiveConcurrencyGenerator) release(callErr error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.inFlight--
+
+	if callErr != nil {
+		if class, _ := probes.ClassifyError(callErr); class == probes.ErrorClassRateLimit {
+			newLimit := int(float64(a.limit) * a.opts.DecreaseFactor)
+			if newLimit < a.opts.Min {
+				newLimit = a.opts.Min
+			}
+			a.limit = newLimit
+			a.nextIncreaseAt = time.Now().Add(a.opts.CooldownPeriod)
+		}
+	} else if a.limit < a.opts.Max && !a.nextIncreaseAt.IsZero() && !time.Now().Before(a.nextIncreaseAt) {
+		a.limit++
+		a.nextIncreaseAt = time.Now().Add(a.opts.CooldownPeriod)
+	}
+
+	a.cond.Broadcast()
+}
+
+// CurrentLimit returns the controller's current effective concurrency limit.
+func (a *AdaptiveConcurrencyGenerator) CurrentLimit() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.limit
+}
+
+// ClearHistory resets the wrapped generator's conversation state.
+func (a *AdaptiveConcurrencyGenerator) ClearHistory() { a.next.ClearHistory() }
+
+// Name returns the wrapped generator's name.
+func (a *AdaptiveConcurrencyGenerator) Name() string { return a.next.Name() }
+
+// Description returns the wrapped generator's description.
+func (a *AdaptiveConcurrencyGenerator) Description() string { return a.next.Description() }
+
+// Compile-time check that AdaptiveConcurrencyGenerator implements types.Generator.
+var _ types.Generator = (*AdaptiveConcurrencyGenerator)(nil)
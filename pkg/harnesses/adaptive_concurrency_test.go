@@ -0,0 +1,189 @@
+package harnesses
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedGenerator returns errs[call] (or nil past the end of errs) and
+// tracks peak concurrent Generate calls, like concurrencyTrackingGenerator.
+type scriptedGenerator struct {
+	errs []error
+
+	mu       sync.Mutex
+	calls    int
+	inFlight int
+	peak     int
+}
+
+func (s *scriptedGenerator) Generate(_ context.Context, _ *attempt.Conversation, _ int) ([]attempt.Message, error) {
+	s.mu.Lock()
+	s.inFlight++
+	if s.inFlight > s.peak {
+		s.peak = s.inFlight
+	}
+	idx := s.calls
+	s.calls++
+	s.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	s.mu.Lock()
+	s.inFlight--
+	s.mu.Unlock()
+
+	var err error
+	if idx < len(s.errs) {
+		err = s.errs[idx]
+	}
+	return []attempt.Message{attempt.NewAssistantMessage("ok")}, err
+}
+func (s *scriptedGenerator) ClearHistory()       {}
+func (s *scriptedGenerator) Name() string        { return "test.Scripted" }
+func (s *scriptedGenerator) Description() string { return "returns a scripted sequence of errors" }
+
+func TestAdaptiveConcurrencyGenerator_LimitsConcurrencyToMax(t *testing.T) {
+	inner := &concurrencyTrackingGenerator{delay: 10 * time.Millisecond}
+	adaptive := NewAdaptiveConcurrencyGenerator(inner, DefaultAdaptiveConcurrencyOptions(3))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			conv := attempt.NewConversation()
+			conv.AddPrompt("p")
+			_, err := adaptive.Generate(context.Background(), conv, 1)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	inner.mu.Lock()
+	defer inner.mu.Unlock()
+	assert.LessOrEqual(t, inner.peak, 3)
+}
+
+func TestAdaptiveConcurrencyGenerator_DecreasesLimitOnRateLimitError(t *testing.T) {
+	inner := &scriptedGenerator{errs: []error{errors.New("429 Too Many Requests")}}
+	adaptive := NewAdaptiveConcurrencyGenerator(inner, AdaptiveConcurrencyOptions{
+		Min: 1, Max: 8, DecreaseFactor: 0.5, CooldownPeriod: time.Hour,
+	})
+	require.Equal(t, 8, adaptive.CurrentLimit())
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("p")
+	_, err := adaptive.Generate(context.Background(), conv, 1)
+	require.Error(t, err)
+
+	assert.Equal(t, 4, adaptive.CurrentLimit(), "limit should be halved after a rate-limit error")
+}
+
+func TestAdaptiveConcurrencyGenerator_DoesNotDecreaseOnNonRateLimitError(t *testing.T) {
+	inner := &scriptedGenerator{errs: []error{errors.New("internal server error")}}
+	adaptive := NewAdaptiveConcurrencyGenerator(inner, DefaultAdaptiveConcurrencyOptions(8))
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("p")
+	_, err := adaptive.Generate(context.Background(), conv, 1)
+	require.Error(t, err)
+
+	assert.Equal(t, 8, adaptive.CurrentLimit())
+}
+
+func TestAdaptiveConcurrencyGenerator_NeverDropsBelowMin(t *testing.T) {
+	rateLimitErr := errors.New("429 Too Many Requests")
+	inner := &scriptedGenerator{errs: []error{rateLimitErr, rateLimitErr, rateLimitErr, rateLimitErr, rateLimitErr}}
+	adaptive := NewAdaptiveConcurrencyGenerator(inner, AdaptiveConcurrencyOptions{
+		Min: 2, Max: 8, DecreaseFactor: 0.5, CooldownPeriod: time.Hour,
+	})
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("p")
+	for i := 0; i < 5; i++ {
+		_, _ = adaptive.Generate(context.Background(), conv, 1)
+	}
+
+	assert.Equal(t, 2, adaptive.CurrentLimit())
+}
+
+func TestAdaptiveConcurrencyGenerator_RampsBackUpAfterCooldown(t *testing.T) {
+	inner := &scriptedGenerator{errs: []error{errors.New("429 Too Many Requests")}}
+	adaptive := NewAdaptiveConcurrencyGenerator(inner, AdaptiveConcurrencyOptions{
+		Min: 1, Max: 4, DecreaseFactor: 0.5, CooldownPeriod: 10 * time.Millisecond,
+	})
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("p")
+
+	_, err := adaptive.Generate(context.Background(), conv, 1)
+	require.Error(t, err)
+	require.Equal(t, 2, adaptive.CurrentLimit())
+
+	// Successive successful calls, spaced past the cooldown, should each
+	// take one additive-increase step back toward Max.
+	for i := 0; i < 4; i++ {
+		time.Sleep(15 * time.Millisecond)
+		_, err := adaptive.Generate(context.Background(), conv, 1)
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 4, adaptive.CurrentLimit(), "limit should climb back to Max after enough cooldown periods")
+}
+
+func TestAdaptiveConcurrencyGenerator_AcquireRespectsContextCancellation(t *testing.T) {
+	conv := attempt.NewConversation()
+	conv.AddPrompt("p")
+
+	// Hold the only slot with a call that blocks until we're ready.
+	var holding atomic.Bool
+	release := make(chan struct{})
+	blockingGen := &blockingGenerator{ready: &holding, release: release}
+	blocked := NewAdaptiveConcurrencyGenerator(blockingGen, AdaptiveConcurrencyOptions{Min: 1, Max: 1})
+
+	go func() {
+		_, _ = blocked.Generate(context.Background(), conv, 1)
+	}()
+
+	require.Eventually(t, holding.Load, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := blocked.Generate(ctx, conv, 1)
+		done <- err
+	}()
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("Generate did not return after context cancellation")
+	}
+	close(release)
+}
+
+// blockingGenerator blocks until release is closed, signaling via ready once
+// it has started.
+type blockingGenerator struct {
+	ready   *atomic.Bool
+	release chan struct{}
+}
+
+func (b *blockingGenerator) Generate(_ context.Context, _ *attempt.Conversation, _ int) ([]attempt.Message, error) {
+	b.ready.Store(true)
+	<-b.release
+	return []attempt.Message{attempt.NewAssistantMessage("ok")}, nil
+}
+func (b *blockingGenerator) ClearHistory()       {}
+func (b *blockingGenerator) Name() string        { return "test.Blocking" }
+func (b *blockingGenerator) Description() string { return "blocks until released" }
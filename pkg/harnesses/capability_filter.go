@@ -0,0 +1,63 @@
+package harnesses
+
+import (
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// SkippedProbe records why a probe was dropped from a scan because the
+// selected generator doesn't satisfy its types.ProbeRequirements.
+type SkippedProbe struct {
+	Name      string
+	Generator string
+	Missing   []string
+}
+
+// FilterByCapabilities splits probeList into probes gen can run and probes
+// it can't, based on each probe's optional types.ProbeRequirements against
+// gen's optional types.GeneratorCapabilities. A generator that doesn't
+// implement GeneratorCapabilities is assumed to support everything, so
+// probeList is returned unchanged until a generator opts in.
+//
+// Call this once probes are created but before they run, so an
+// incompatible pairing is reported up front instead of failing confusingly
+// mid-scan.
+func FilterByCapabilities(probeList []probes.Prober, gen types.Generator) (ok []probes.Prober, skipped []SkippedProbe) {
+	caps, hasCaps := gen.(types.GeneratorCapabilities)
+	if !hasCaps {
+		return probeList, nil
+	}
+
+	supported := make(map[string]bool, len(caps.Capabilities()))
+	for _, c := range caps.Capabilities() {
+		supported[c] = true
+	}
+
+	ok = make([]probes.Prober, 0, len(probeList))
+	for _, p := range probeList {
+		reqs, hasReqs := p.(types.ProbeRequirements)
+		if !hasReqs {
+			ok = append(ok, p)
+			continue
+		}
+
+		var missing []string
+		for _, req := range reqs.Requires() {
+			if !supported[req] {
+				missing = append(missing, req)
+			}
+		}
+		if len(missing) == 0 {
+			ok = append(ok, p)
+			continue
+		}
+
+		skipped = append(skipped, SkippedProbe{
+			Name:      p.Name(),
+			Generator: gen.Name(),
+			Missing:   missing,
+		})
+	}
+
+	return ok, skipped
+}
@@ -0,0 +1,90 @@
+package harnesses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// requiringProbe needs the listed capabilities from its generator.
+type requiringProbe struct {
+	name     string
+	requires []string
+}
+
+func (p *requiringProbe) Probe(_ context.Context, _ probes.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (p *requiringProbe) Name() string       { return p.name }
+func (p *requiringProbe) Requires() []string { return p.requires }
+
+// capableGenerator declares the capabilities it supports.
+type capableGenerator struct {
+	caps []string
+}
+
+func (g *capableGenerator) Generate(_ context.Context, _ *attempt.Conversation, _ int) ([]attempt.Message, error) {
+	return nil, nil
+}
+func (g *capableGenerator) ClearHistory()          {}
+func (g *capableGenerator) Name() string           { return "test.Capable" }
+func (g *capableGenerator) Description() string    { return "" }
+func (g *capableGenerator) Capabilities() []string { return g.caps }
+
+// plainGenerator implements only the baseline Generator interface.
+type plainGenerator struct{}
+
+func (g *plainGenerator) Generate(_ context.Context, _ *attempt.Conversation, _ int) ([]attempt.Message, error) {
+	return nil, nil
+}
+func (g *plainGenerator) ClearHistory()       {}
+func (g *plainGenerator) Name() string        { return "test.Plain" }
+func (g *plainGenerator) Description() string { return "" }
+
+func TestFilterByCapabilities_PassesThroughWhenGeneratorDoesNotDeclareCapabilities(t *testing.T) {
+	p := &requiringProbe{name: "toolabuse.WebExfil", requires: []string{types.CapabilityTools}}
+
+	ok, skipped := FilterByCapabilities([]probes.Prober{p}, &plainGenerator{})
+
+	require.Len(t, ok, 1)
+	assert.Empty(t, skipped)
+}
+
+func TestFilterByCapabilities_SkipsProbeMissingRequiredCapability(t *testing.T) {
+	p := &requiringProbe{name: "toolabuse.WebExfil", requires: []string{types.CapabilityTools}}
+	gen := &capableGenerator{caps: []string{types.CapabilityMultiTurn}}
+
+	ok, skipped := FilterByCapabilities([]probes.Prober{p}, gen)
+
+	assert.Empty(t, ok)
+	require.Len(t, skipped, 1)
+	assert.Equal(t, "toolabuse.WebExfil", skipped[0].Name)
+	assert.Equal(t, "test.Capable", skipped[0].Generator)
+	assert.Equal(t, []string{types.CapabilityTools}, skipped[0].Missing)
+}
+
+func TestFilterByCapabilities_KeepsProbeWhoseRequirementsAreSatisfied(t *testing.T) {
+	p := &requiringProbe{name: "toolabuse.WebExfil", requires: []string{types.CapabilityTools}}
+	gen := &capableGenerator{caps: []string{types.CapabilityTools, types.CapabilityMultiTurn}}
+
+	ok, skipped := FilterByCapabilities([]probes.Prober{p}, gen)
+
+	require.Len(t, ok, 1)
+	assert.Empty(t, skipped)
+}
+
+func TestFilterByCapabilities_KeepsProbeWithoutRequirements(t *testing.T) {
+	plain := &plainProbe{}
+	gen := &capableGenerator{caps: []string{}}
+
+	ok, skipped := FilterByCapabilities([]probes.Prober{plain}, gen)
+
+	require.Len(t, ok, 1)
+	assert.Empty(t, skipped)
+}
@@ -0,0 +1,211 @@
+package harnesses
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// defaultDedupCapacity bounds the number of distinct conversations
+// DedupGenerator remembers before evicting the least-recently-used entry.
+// Scans that legitimately reuse the same prompt thousands of times (e.g.
+// --all across every probe) shouldn't grow the cache without bound.
+const defaultDedupCapacity = 10000
+
+// normalizePrompt collapses whitespace and lowercases a prompt so that
+// cosmetically different but semantically identical prompts (e.g. differing
+// only in leading/trailing whitespace or case) hash to the same key.
+func normalizePrompt(prompt string) string {
+	return strings.ToLower(strings.Join(strings.Fields(prompt), " "))
+}
+
+// hashPrompt returns a stable hash of a normalized prompt, used as the
+// AnnotateDuplicates grouping key.
+func hashPrompt(prompt string) string {
+	sum := sha256.Sum256([]byte(normalizePrompt(prompt)))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashConversation returns a stable hash of a generator name, the
+// generation count n, and the full conversation state (system prompt, every
+// prior turn, and the pending prompt), used as the DedupGenerator cache key.
+// Hashing the whole conversation - not just the last prompt - means two
+// calls only collide when their entire history matches, so multi-turn
+// conversations can be cached safely alongside single-turn ones. n is part
+// of the key so a cached single-generation response can never be handed
+// back to a later call asking for more generations of the same conversation.
+func hashConversation(generatorName string, conv *attempt.Conversation, n int) string {
+	var b strings.Builder
+	b.WriteString(generatorName)
+	b.WriteByte('\x00')
+	b.WriteString(strconv.Itoa(n))
+	b.WriteByte('\x00')
+	for _, msg := range conv.ToMessages() {
+		b.WriteString(string(msg.Role))
+		b.WriteByte('\x00')
+		b.WriteString(normalizePrompt(msg.Content))
+		b.WriteByte('\x00')
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// DedupStats reports DedupGenerator's cache effectiveness over the life of a
+// scan.
+type DedupStats struct {
+	// Hits is the number of Generate calls served from the cache.
+	Hits int64
+	// Misses is the number of Generate calls that reached the wrapped
+	// generator, including the first occurrence of every distinct
+	// conversation.
+	Misses int64
+}
+
+// CacheStatsReporter is an optional interface a harness can implement to
+// expose the cache-hit statistics of any DedupGenerator it ran the scan
+// through, so the CLI can report them in the run summary.
+type CacheStatsReporter interface {
+	// CacheStats returns the generator cache's hit/miss counts for the scan
+	// that just ran, or a zero value if caching wasn't enabled.
+	CacheStats() DedupStats
+}
+
+// DedupGenerator wraps a Generator with a bounded, in-memory LRU cache keyed
+// by generator name and full conversation state, so that identical
+// conversations within a run - common after dedup-less buff matrices expand
+// one base prompt into many probes - reuse the first response instead of
+// re-calling the API. Wrap the scan's generator with this when running large
+// batches (e.g. --all) where probes commonly share prompts, such as "Ignore
+// all previous instructions..." variants.
+type DedupGenerator struct {
+	next     types.Generator
+	capacity int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element // key -> node in order
+	order   *list.List               // front = most recently used
+	hits    atomic.Int64
+	misses  atomic.Int64
+}
+
+// cacheEntry is the value stored in DedupGenerator.order's list elements.
+type cacheEntry struct {
+	key       string
+	responses []attempt.Message
+}
+
+// NewDedupGenerator wraps next with LRU conversation-response caching, up to
+// defaultDedupCapacity distinct conversations.
+func NewDedupGenerator(next types.Generator) *DedupGenerator {
+	return NewDedupGeneratorWithCapacity(next, defaultDedupCapacity)
+}
+
+// NewDedupGeneratorWithCapacity wraps next with LRU conversation-response
+// caching bounded to capacity distinct conversations. capacity <= 0 is
+// treated as defaultDedupCapacity.
+func NewDedupGeneratorWithCapacity(next types.Generator, capacity int) *DedupGenerator {
+	if capacity <= 0 {
+		capacity = defaultDedupCapacity
+	}
+	return &DedupGenerator{
+		next:     next,
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Generate returns a cached response for a conversation whose generator name
+// and full history (system prompt, prior turns, and pending prompt) match a
+// previous call, without calling the wrapped generator.
+func (d *DedupGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	key := hashConversation(d.next.Name(), conv, n)
+
+	d.mu.Lock()
+	if elem, ok := d.entries[key]; ok {
+		d.order.MoveToFront(elem)
+		cached := elem.Value.(*cacheEntry).responses
+		d.mu.Unlock()
+		d.hits.Add(1)
+		return cached, nil
+	}
+	d.mu.Unlock()
+
+	d.misses.Add(1)
+	responses, err := d.next.Generate(ctx, conv, n)
+	if err != nil {
+		return responses, err
+	}
+
+	d.mu.Lock()
+	if _, ok := d.entries[key]; !ok {
+		elem := d.order.PushFront(&cacheEntry{key: key, responses: responses})
+		d.entries[key] = elem
+		for d.order.Len() > d.capacity {
+			oldest := d.order.Back()
+			if oldest == nil {
+				break
+			}
+			d.order.Remove(oldest)
+			delete(d.entries, oldest.Value.(*cacheEntry).key)
+		}
+	}
+	d.mu.Unlock()
+
+	return responses, nil
+}
+
+// Stats returns the cache's hit/miss counts so far.
+func (d *DedupGenerator) Stats() DedupStats {
+	return DedupStats{Hits: d.hits.Load(), Misses: d.misses.Load()}
+}
+
+// ClearHistory resets the wrapped generator's conversation state. The dedup
+// cache itself is intentionally left intact across calls.
+func (d *DedupGenerator) ClearHistory() { d.next.ClearHistory() }
+
+// Name returns the wrapped generator's name.
+func (d *DedupGenerator) Name() string { return d.next.Name() }
+
+// Description returns the wrapped generator's description.
+func (d *DedupGenerator) Description() string { return d.next.Description() }
+
+// AnnotateDuplicates records the alias relationship between attempts that
+// share a normalized prompt. The first attempt seen with a given prompt is
+// treated as canonical; every later attempt with the same prompt gets
+// "dedup_of" set to the canonical attempt's ID, and the canonical attempt
+// gets "dedup_group_size" set to the number of attempts sharing its prompt.
+// Call this after a scan that used DedupGenerator so the relationship
+// survives in the final report even though only one call reached the LLM.
+func AnnotateDuplicates(attempts []*attempt.Attempt) {
+	canonical := make(map[string]*attempt.Attempt)
+	groupSize := make(map[string]int)
+
+	for _, a := range attempts {
+		if a.Prompt == "" {
+			continue
+		}
+		key := hashPrompt(a.Prompt)
+		if first, ok := canonical[key]; ok {
+			a.Metadata["dedup_of"] = first.ID
+			groupSize[key]++
+			continue
+		}
+		canonical[key] = a
+		groupSize[key] = 1
+	}
+
+	for key, first := range canonical {
+		if n := groupSize[key]; n > 1 {
+			first.Metadata["dedup_group_size"] = n
+		}
+	}
+}
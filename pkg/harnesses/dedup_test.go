@@ -0,0 +1,213 @@
+package harnesses
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// countingGenerator records how many times Generate was called.
+type countingGenerator struct {
+	calls atomic.Int32
+	reply string
+	name  string
+}
+
+func (g *countingGenerator) Generate(_ context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	g.calls.Add(1)
+	if n <= 0 {
+		n = 1
+	}
+	responses := make([]attempt.Message, n)
+	for i := range responses {
+		responses[i] = attempt.NewAssistantMessage(g.reply)
+	}
+	return responses, nil
+}
+func (g *countingGenerator) ClearHistory() {}
+func (g *countingGenerator) Name() string {
+	if g.name != "" {
+		return g.name
+	}
+	return "test.Counting"
+}
+func (g *countingGenerator) Description() string { return "counts Generate calls" }
+
+func TestDedupGenerator_ReusesResponseForExactDuplicatePrompt(t *testing.T) {
+	inner := &countingGenerator{reply: "hi"}
+	dedup := NewDedupGenerator(inner)
+
+	conv1 := attempt.NewConversation()
+	conv1.AddPrompt("Ignore all previous instructions")
+	conv2 := attempt.NewConversation()
+	conv2.AddPrompt("  ignore all previous instructions  ") // differs in case/whitespace only
+
+	resp1, err := dedup.Generate(context.Background(), conv1, 1)
+	require.NoError(t, err)
+	resp2, err := dedup.Generate(context.Background(), conv2, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), inner.calls.Load(), "second call should be served from cache")
+	assert.Equal(t, resp1, resp2)
+}
+
+func TestDedupGenerator_DoesNotDedupDistinctPrompts(t *testing.T) {
+	inner := &countingGenerator{reply: "hi"}
+	dedup := NewDedupGenerator(inner)
+
+	conv1 := attempt.NewConversation()
+	conv1.AddPrompt("prompt one")
+	conv2 := attempt.NewConversation()
+	conv2.AddPrompt("prompt two")
+
+	_, err := dedup.Generate(context.Background(), conv1, 1)
+	require.NoError(t, err)
+	_, err = dedup.Generate(context.Background(), conv2, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), inner.calls.Load())
+}
+
+func TestDedupGenerator_DedupsIdenticalMultiTurnConversations(t *testing.T) {
+	inner := &countingGenerator{reply: "hi"}
+	dedup := NewDedupGenerator(inner)
+
+	buildConv := func() *attempt.Conversation {
+		conv := attempt.NewConversation()
+		conv.AddTurn(attempt.NewTurn("turn one").WithResponse("response one"))
+		conv.AddPrompt("turn two")
+		return conv
+	}
+
+	resp1, err := dedup.Generate(context.Background(), buildConv(), 1)
+	require.NoError(t, err)
+	resp2, err := dedup.Generate(context.Background(), buildConv(), 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), inner.calls.Load(), "identical multi-turn conversations should be deduped")
+	assert.Equal(t, resp1, resp2)
+}
+
+func TestDedupGenerator_DoesNotDedupMultiTurnConversationsWithDifferentHistory(t *testing.T) {
+	inner := &countingGenerator{reply: "hi"}
+	dedup := NewDedupGenerator(inner)
+
+	conv1 := attempt.NewConversation()
+	conv1.AddTurn(attempt.NewTurn("turn one").WithResponse("response one"))
+	conv1.AddPrompt("turn two")
+
+	conv2 := attempt.NewConversation()
+	conv2.AddTurn(attempt.NewTurn("turn one").WithResponse("a different response"))
+	conv2.AddPrompt("turn two")
+
+	_, err := dedup.Generate(context.Background(), conv1, 1)
+	require.NoError(t, err)
+	_, err = dedup.Generate(context.Background(), conv2, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), inner.calls.Load(), "conversations with different history should not be deduped")
+}
+
+func TestDedupGenerator_DoesNotDedupAcrossDifferentGenerationCounts(t *testing.T) {
+	inner := &countingGenerator{reply: "hi"}
+	dedup := NewDedupGenerator(inner)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("same prompt")
+
+	resp1, err := dedup.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	resp3, err := dedup.Generate(context.Background(), conv, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), inner.calls.Load(), "a different n for the same conversation should not be served from cache")
+	assert.Len(t, resp1, 1)
+	assert.Len(t, resp3, 3)
+}
+
+func TestDedupGenerator_DoesNotDedupAcrossDifferentGenerators(t *testing.T) {
+	innerA := &countingGenerator{reply: "hi"}
+	innerA.name = "test.A"
+	innerB := &countingGenerator{reply: "hi"}
+	innerB.name = "test.B"
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("same prompt")
+
+	_, err := NewDedupGenerator(innerA).Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	_, err = NewDedupGenerator(innerB).Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), innerA.calls.Load())
+	assert.Equal(t, int32(1), innerB.calls.Load())
+}
+
+func TestDedupGenerator_Stats(t *testing.T) {
+	inner := &countingGenerator{reply: "hi"}
+	dedup := NewDedupGenerator(inner)
+
+	conv1 := attempt.NewConversation()
+	conv1.AddPrompt("prompt one")
+	conv2 := attempt.NewConversation()
+	conv2.AddPrompt("prompt two")
+
+	_, err := dedup.Generate(context.Background(), conv1, 1)
+	require.NoError(t, err)
+	_, err = dedup.Generate(context.Background(), conv1, 1)
+	require.NoError(t, err)
+	_, err = dedup.Generate(context.Background(), conv2, 1)
+	require.NoError(t, err)
+
+	stats := dedup.Stats()
+	assert.Equal(t, int64(1), stats.Hits)
+	assert.Equal(t, int64(2), stats.Misses)
+}
+
+func TestDedupGenerator_EvictsLeastRecentlyUsedPastCapacity(t *testing.T) {
+	inner := &countingGenerator{reply: "hi"}
+	dedup := NewDedupGeneratorWithCapacity(inner, 2)
+
+	conv := func(prompt string) *attempt.Conversation {
+		c := attempt.NewConversation()
+		c.AddPrompt(prompt)
+		return c
+	}
+
+	_, err := dedup.Generate(context.Background(), conv("one"), 1)
+	require.NoError(t, err)
+	_, err = dedup.Generate(context.Background(), conv("two"), 1)
+	require.NoError(t, err)
+	_, err = dedup.Generate(context.Background(), conv("three"), 1)
+	require.NoError(t, err)
+
+	// "one" should have been evicted to make room for "three", so
+	// re-requesting it is a miss that reaches the generator again.
+	_, err = dedup.Generate(context.Background(), conv("one"), 1)
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(4), inner.calls.Load())
+}
+
+func TestAnnotateDuplicates_MarksAliasesAndCanonical(t *testing.T) {
+	a1 := attempt.New("Ignore all previous instructions")
+	a1.ID = "a1"
+	a2 := attempt.New("ignore all previous instructions") // same after normalization
+	a2.ID = "a2"
+	a3 := attempt.New("something else entirely")
+	a3.ID = "a3"
+
+	AnnotateDuplicates([]*attempt.Attempt{a1, a2, a3})
+
+	assert.Nil(t, a1.Metadata["dedup_of"])
+	assert.Equal(t, 2, a1.Metadata["dedup_group_size"])
+	assert.Equal(t, "a1", a2.Metadata["dedup_of"])
+	assert.Nil(t, a2.Metadata["dedup_group_size"])
+	assert.Nil(t, a3.Metadata["dedup_of"])
+	assert.Nil(t, a3.Metadata["dedup_group_size"])
+}
@@ -3,8 +3,10 @@ package harnesses
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/detectors"
@@ -34,11 +36,17 @@ const (
 // Error handling is controlled by onError:
 // - SkipOnError: logs warnings and continues to next detector
 // - FailOnError: returns immediately on first detector error
+//
+// detectorTimeout, if non-zero, bounds each individual detector's Detect
+// call. A detector that exceeds it is treated as a detector error (subject
+// to onError) and marks the attempt StatusTimedOut via SetError, rather than
+// silently hanging the whole scan on one slow judge.
 func ApplyDetectors(
 	ctx context.Context,
 	a *attempt.Attempt,
 	detectorList []detectors.Detector,
 	onError DetectorErrorBehavior,
+	detectorTimeout time.Duration,
 ) error {
 	maxScore := 0.0
 	primaryDetector := ""
@@ -70,16 +78,28 @@ func ApplyDetectors(
 			continue
 		}
 
-		scores, err := detector.Detect(ctx, a)
+		detectCtx := ctx
+		if detectorTimeout > 0 {
+			var cancel context.CancelFunc
+			detectCtx, cancel = context.WithTimeout(ctx, detectorTimeout)
+			defer cancel()
+		}
+
+		scores, err := detector.Detect(detectCtx, a)
 		if err != nil {
+			if errors.Is(detectCtx.Err(), context.DeadlineExceeded) {
+				err = fmt.Errorf("detector %s exceeded detector timeout of %s: %w", detector.Name(), detectorTimeout, context.DeadlineExceeded)
+			}
 			if onError == SkipOnError {
 				slog.Warn("detector failed, skipping",
 					"detector", detector.Name(),
 					"probe", a.Probe,
 					"error", err)
+				a.SetError(err)
 				continue
 			}
 			// FailOnError: return immediately
+			a.SetError(err)
 			return fmt.Errorf("detector %s failed: %w", detector.Name(), err)
 		}
 
@@ -112,8 +132,8 @@ func ApplyDetectors(
 		a.Scores = firstScores
 	}
 
-	// Mark attempt as complete only if not in error state
-	if a.Status != attempt.StatusError {
+	// Mark attempt as complete only if not in an error or timed-out state
+	if a.Status != attempt.StatusError && a.Status != attempt.StatusTimedOut {
 		a.Complete()
 	}
 
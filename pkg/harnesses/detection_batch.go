@@ -0,0 +1,247 @@
+package harnesses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+)
+
+// HasBatchDetector reports whether any detector in the list implements
+// detectors.BatchDetector, so a harness can choose between streaming
+// attempts through ApplyDetectors one at a time or processing them all
+// together through ApplyDetectorsBatch to take advantage of batching.
+func HasBatchDetector(detectorList []detectors.Detector) bool {
+	for _, detector := range detectorList {
+		if _, ok := detector.(detectors.BatchDetector); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// detectorAccumulator tracks the running primary/first detector choice for
+// one attempt across the detectors applied to it, mirroring the bookkeeping
+// ApplyDetectors does inline for a single attempt.
+type detectorAccumulator struct {
+	maxScore        float64
+	primaryDetector string
+	primaryScores   []float64
+	firstDetector   string
+	firstScores     []float64
+}
+
+func (acc *detectorAccumulator) record(detectorName string, scores []float64) {
+	if acc.firstDetector == "" {
+		acc.firstDetector = detectorName
+		acc.firstScores = scores
+	}
+	for _, score := range scores {
+		if score > acc.maxScore {
+			acc.maxScore = score
+			acc.primaryDetector = detectorName
+			acc.primaryScores = scores
+		}
+	}
+}
+
+// ApplyDetectorsBatch runs all detectors across many attempts at once,
+// selecting each attempt's primary detector the same way ApplyDetectors does.
+//
+// For a detector implementing detectors.BatchDetector, it is called once
+// per batch of attempts that still need scoring (letting judge-type
+// detectors pack multiple outputs into a single LLM call); detectors without
+// that interface are scored one attempt at a time via Detect, with identical
+// results to calling ApplyDetectors on each attempt individually.
+//
+// onError and detectorTimeout behave exactly as in ApplyDetectors, applied
+// per detector per batch: SkipOnError marks every attempt in the failed
+// batch with the error and moves to the next detector; FailOnError returns
+// immediately.
+func ApplyDetectorsBatch(
+	ctx context.Context,
+	attempts []*attempt.Attempt,
+	detectorList []detectors.Detector,
+	onError DetectorErrorBehavior,
+	detectorTimeout time.Duration,
+) error {
+	if len(attempts) == 0 {
+		return nil
+	}
+
+	accs := make([]*detectorAccumulator, len(attempts))
+	for i := range attempts {
+		accs[i] = &detectorAccumulator{}
+	}
+
+	for _, detector := range detectorList {
+		if batchDetector, ok := detector.(detectors.BatchDetector); ok {
+			if err := applyBatchDetector(ctx, attempts, accs, batchDetector, detector.Name(), onError, detectorTimeout); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for i, a := range attempts {
+			if err := applySingleDetector(ctx, a, accs[i], detector, onError, detectorTimeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, a := range attempts {
+		finalizeDetection(a, accs[i])
+	}
+
+	return nil
+}
+
+// applySingleDetector runs one non-batching detector on one attempt,
+// identically to one iteration of ApplyDetectors' loop.
+func applySingleDetector(
+	ctx context.Context,
+	a *attempt.Attempt,
+	acc *detectorAccumulator,
+	detector detectors.Detector,
+	onError DetectorErrorBehavior,
+	detectorTimeout time.Duration,
+) error {
+	slog.Debug("running detector", "detector", detector.Name(), "probe", a.Probe)
+
+	if existing, ok := a.DetectorResults[detector.Name()]; ok && len(existing) > 0 {
+		slog.Debug("using pre-populated detector results", "detector", detector.Name(), "probe", a.Probe)
+		acc.record(detector.Name(), existing)
+		return nil
+	}
+
+	detectCtx := ctx
+	if detectorTimeout > 0 {
+		var cancel context.CancelFunc
+		detectCtx, cancel = context.WithTimeout(ctx, detectorTimeout)
+		defer cancel()
+	}
+
+	scores, err := detector.Detect(detectCtx, a)
+	if err != nil {
+		if errors.Is(detectCtx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("detector %s exceeded detector timeout of %s: %w", detector.Name(), detectorTimeout, context.DeadlineExceeded)
+		}
+		if onError == SkipOnError {
+			slog.Warn("detector failed, skipping",
+				"detector", detector.Name(),
+				"probe", a.Probe,
+				"error", err)
+			a.SetError(err)
+			return nil
+		}
+		a.SetError(err)
+		return fmt.Errorf("detector %s failed: %w", detector.Name(), err)
+	}
+
+	a.SetDetectorResults(detector.Name(), scores)
+	acc.record(detector.Name(), scores)
+	return nil
+}
+
+// applyBatchDetector runs one batching detector across every attempt that
+// doesn't already have pre-populated results for it, in a single BatchDetect
+// call.
+func applyBatchDetector(
+	ctx context.Context,
+	attempts []*attempt.Attempt,
+	accs []*detectorAccumulator,
+	batchDetector detectors.BatchDetector,
+	detectorName string,
+	onError DetectorErrorBehavior,
+	detectorTimeout time.Duration,
+) error {
+	var pending []*attempt.Attempt
+	var pendingAccs []*detectorAccumulator
+
+	for i, a := range attempts {
+		slog.Debug("running detector", "detector", detectorName, "probe", a.Probe)
+
+		if existing, ok := a.DetectorResults[detectorName]; ok && len(existing) > 0 {
+			slog.Debug("using pre-populated detector results", "detector", detectorName, "probe", a.Probe)
+			accs[i].record(detectorName, existing)
+			continue
+		}
+
+		pending = append(pending, a)
+		pendingAccs = append(pendingAccs, accs[i])
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	batchCtx := ctx
+	if detectorTimeout > 0 {
+		var cancel context.CancelFunc
+		batchCtx, cancel = context.WithTimeout(ctx, detectorTimeout)
+		defer cancel()
+	}
+
+	allScores, err := batchDetector.BatchDetect(batchCtx, pending)
+	if err != nil {
+		if errors.Is(batchCtx.Err(), context.DeadlineExceeded) {
+			err = fmt.Errorf("detector %s exceeded detector timeout of %s: %w", detectorName, detectorTimeout, context.DeadlineExceeded)
+		}
+		if onError == SkipOnError {
+			slog.Warn("batch detector failed, skipping",
+				"detector", detectorName,
+				"attempts", len(pending),
+				"error", err)
+			for _, a := range pending {
+				a.SetError(err)
+			}
+			return nil
+		}
+		for _, a := range pending {
+			a.SetError(err)
+		}
+		return fmt.Errorf("detector %s failed: %w", detectorName, err)
+	}
+
+	if len(allScores) != len(pending) {
+		err := fmt.Errorf("detector %s returned %d score sets for %d attempts", detectorName, len(allScores), len(pending))
+		if onError == SkipOnError {
+			slog.Warn("batch detector returned mismatched results, skipping", "detector", detectorName, "error", err)
+			for _, a := range pending {
+				a.SetError(err)
+			}
+			return nil
+		}
+		for _, a := range pending {
+			a.SetError(err)
+		}
+		return err
+	}
+
+	for i, scores := range allScores {
+		pending[i].SetDetectorResults(detectorName, scores)
+		pendingAccs[i].record(detectorName, scores)
+	}
+
+	return nil
+}
+
+// finalizeDetection applies the same primary-detector selection and
+// completion marking that ApplyDetectors performs at the end of its loop.
+func finalizeDetection(a *attempt.Attempt, acc *detectorAccumulator) {
+	if acc.primaryDetector != "" {
+		a.Detector = acc.primaryDetector
+		a.Scores = acc.primaryScores
+	} else if acc.firstDetector != "" {
+		a.Detector = acc.firstDetector
+		a.Scores = acc.firstScores
+	}
+
+	if a.Status != attempt.StatusError && a.Status != attempt.StatusTimedOut {
+		a.Complete()
+	}
+}
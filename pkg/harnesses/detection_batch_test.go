@@ -0,0 +1,174 @@
+package harnesses
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockBatchDetector implements detectors.BatchDetector for testing. It
+// records every call's attempt count so tests can assert the detector was
+// actually called once across a batch, not once per attempt.
+type mockBatchDetector struct {
+	name      string
+	scoreFunc func(a *attempt.Attempt) []float64
+	err       error
+	calls     [][]string // probe names passed to each BatchDetect call
+}
+
+func (m *mockBatchDetector) Detect(ctx context.Context, a *attempt.Attempt) ([]float64, error) {
+	scores, err := m.BatchDetect(ctx, []*attempt.Attempt{a})
+	if err != nil {
+		return nil, err
+	}
+	return scores[0], nil
+}
+
+func (m *mockBatchDetector) BatchDetect(ctx context.Context, attempts []*attempt.Attempt) ([][]float64, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	names := make([]string, len(attempts))
+	for i, a := range attempts {
+		names[i] = a.Probe
+	}
+	m.calls = append(m.calls, names)
+
+	results := make([][]float64, len(attempts))
+	for i, a := range attempts {
+		results[i] = m.scoreFunc(a)
+	}
+	return results, nil
+}
+
+func (m *mockBatchDetector) Name() string        { return m.name }
+func (m *mockBatchDetector) Description() string { return "mock batch detector for testing" }
+
+var _ detectors.BatchDetector = (*mockBatchDetector)(nil)
+
+func TestHasBatchDetector(t *testing.T) {
+	assert.False(t, HasBatchDetector([]detectors.Detector{&mockDetector{name: "a"}}))
+	assert.True(t, HasBatchDetector([]detectors.Detector{
+		&mockDetector{name: "a"},
+		&mockBatchDetector{name: "b"},
+	}))
+}
+
+func TestApplyDetectorsBatch_CallsBatchDetectorOnce(t *testing.T) {
+	ctx := context.Background()
+	a1 := attempt.New("prompt 1")
+	a1.Probe = "probe.One"
+	a2 := attempt.New("prompt 2")
+	a2.Probe = "probe.Two"
+
+	detector := &mockBatchDetector{
+		name:      "judge.Batch",
+		scoreFunc: func(a *attempt.Attempt) []float64 { return []float64{0.5} },
+	}
+
+	err := ApplyDetectorsBatch(ctx, []*attempt.Attempt{a1, a2}, []detectors.Detector{detector}, SkipOnError, 0)
+	require.NoError(t, err)
+
+	require.Len(t, detector.calls, 1, "should score both attempts in a single BatchDetect call")
+	assert.ElementsMatch(t, []string{"probe.One", "probe.Two"}, detector.calls[0])
+
+	assert.Equal(t, "judge.Batch", a1.Detector)
+	assert.Equal(t, []float64{0.5}, a1.Scores)
+	assert.Equal(t, attempt.StatusComplete, a1.Status)
+	assert.Equal(t, "judge.Batch", a2.Detector)
+	assert.Equal(t, attempt.StatusComplete, a2.Status)
+}
+
+func TestApplyDetectorsBatch_MatchesPerAttemptResults(t *testing.T) {
+	ctx := context.Background()
+	a1 := attempt.New("prompt 1")
+	a2 := attempt.New("prompt 2")
+
+	detectorList := []detectors.Detector{
+		&mockDetector{name: "low.Detector", scores: []float64{0.2}},
+		&mockBatchDetector{
+			name: "high.Batch",
+			scoreFunc: func(a *attempt.Attempt) []float64 {
+				if a == a1 {
+					return []float64{0.9}
+				}
+				return []float64{0.3}
+			},
+		},
+	}
+
+	err := ApplyDetectorsBatch(ctx, []*attempt.Attempt{a1, a2}, detectorList, SkipOnError, 0)
+	require.NoError(t, err)
+
+	// a1: high.Batch (0.9) beats low.Detector (0.2)
+	assert.Equal(t, "high.Batch", a1.Detector)
+	assert.Equal(t, []float64{0.9}, a1.Scores)
+
+	// a2: high.Batch (0.3) beats low.Detector (0.2)
+	assert.Equal(t, "high.Batch", a2.Detector)
+	assert.Equal(t, []float64{0.3}, a2.Scores)
+}
+
+func TestApplyDetectorsBatch_PrePopulatedResultsSkipDetector(t *testing.T) {
+	ctx := context.Background()
+	a1 := attempt.New("prompt 1")
+	a1.SetDetectorResults("judge.Batch", []float64{0.85})
+	a2 := attempt.New("prompt 2")
+
+	detector := &mockBatchDetector{
+		name:      "judge.Batch",
+		scoreFunc: func(a *attempt.Attempt) []float64 { return []float64{0.0} },
+	}
+
+	err := ApplyDetectorsBatch(ctx, []*attempt.Attempt{a1, a2}, []detectors.Detector{detector}, SkipOnError, 0)
+	require.NoError(t, err)
+
+	// a1 kept its pre-populated score and wasn't sent to BatchDetect
+	assert.Equal(t, []float64{0.85}, a1.DetectorResults["judge.Batch"])
+	require.Len(t, detector.calls, 1)
+	assert.Equal(t, []string{""}, detector.calls[0], "only a2 (empty probe name) should be batched")
+
+	assert.Equal(t, []float64{0.0}, a2.DetectorResults["judge.Batch"])
+}
+
+func TestApplyDetectorsBatch_SkipOnErrorMarksAllPendingAttempts(t *testing.T) {
+	ctx := context.Background()
+	a1 := attempt.New("prompt 1")
+	a2 := attempt.New("prompt 2")
+
+	failing := &mockBatchDetector{name: "failing.Batch", err: errors.New("judge unavailable")}
+	working := &mockBatchDetector{
+		name:      "working.Batch",
+		scoreFunc: func(a *attempt.Attempt) []float64 { return []float64{0.6} },
+	}
+
+	err := ApplyDetectorsBatch(ctx, []*attempt.Attempt{a1, a2}, []detectors.Detector{failing, working}, SkipOnError, 0)
+	require.NoError(t, err)
+
+	assert.Equal(t, "working.Batch", a1.Detector)
+	assert.Equal(t, "working.Batch", a2.Detector)
+	assert.NotEmpty(t, a1.Error)
+	assert.NotEmpty(t, a2.Error)
+}
+
+func TestApplyDetectorsBatch_FailOnErrorReturnsImmediately(t *testing.T) {
+	ctx := context.Background()
+	a1 := attempt.New("prompt 1")
+
+	failing := &mockBatchDetector{name: "failing.Batch", err: errors.New("judge unavailable")}
+
+	err := ApplyDetectorsBatch(ctx, []*attempt.Attempt{a1}, []detectors.Detector{failing}, FailOnError, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "judge unavailable")
+}
+
+func TestApplyDetectorsBatch_EmptyAttempts(t *testing.T) {
+	err := ApplyDetectorsBatch(context.Background(), nil, []detectors.Detector{&mockDetector{name: "a"}}, SkipOnError, 0)
+	require.NoError(t, err)
+}
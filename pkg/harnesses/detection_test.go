@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/detectors"
@@ -43,7 +44,7 @@ func TestApplyDetectors_SingleDetector(t *testing.T) {
 		scores: []float64{0.8},
 	}
 
-	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError)
+	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError, 0)
 	require.NoError(t, err)
 
 	// Should set detector as primary
@@ -69,7 +70,7 @@ func TestApplyDetectors_HighestScoreWins(t *testing.T) {
 		&mockDetector{name: "medium.Detector", scores: []float64{0.5}},
 	}
 
-	err := ApplyDetectors(ctx, a, detectors, SkipOnError)
+	err := ApplyDetectors(ctx, a, detectors, SkipOnError, 0)
 	require.NoError(t, err)
 
 	// Should select detector with highest score
@@ -89,7 +90,7 @@ func TestApplyDetectors_FallbackToFirstDetector(t *testing.T) {
 		&mockDetector{name: "second.Detector", scores: []float64{0.0}},
 	}
 
-	err := ApplyDetectors(ctx, a, detectors, SkipOnError)
+	err := ApplyDetectors(ctx, a, detectors, SkipOnError, 0)
 	require.NoError(t, err)
 
 	// Should fall back to first detector when all scores are 0
@@ -106,7 +107,7 @@ func TestApplyDetectors_SkipOnError(t *testing.T) {
 		&mockDetector{name: "working.Detector", scores: []float64{0.7}},
 	}
 
-	err := ApplyDetectors(ctx, a, detectors, SkipOnError)
+	err := ApplyDetectors(ctx, a, detectors, SkipOnError, 0)
 	require.NoError(t, err)
 
 	// Should skip failed detector and continue
@@ -128,7 +129,7 @@ func TestApplyDetectors_FailOnError(t *testing.T) {
 		&mockDetector{name: "working.Detector", scores: []float64{0.7}},
 	}
 
-	err := ApplyDetectors(ctx, a, detectors, FailOnError)
+	err := ApplyDetectors(ctx, a, detectors, FailOnError, 0)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "detector failed")
 
@@ -148,7 +149,7 @@ func TestApplyDetectors_MarksComplete(t *testing.T) {
 		scores: []float64{0.5},
 	}
 
-	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError)
+	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError, 0)
 	require.NoError(t, err)
 
 	// Should mark as complete
@@ -168,7 +169,7 @@ func TestApplyDetectors_PreservesErrorStatus(t *testing.T) {
 		scores: []float64{0.5},
 	}
 
-	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError)
+	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError, 0)
 	require.NoError(t, err)
 
 	// Should preserve error status (not overwrite with Complete)
@@ -188,7 +189,7 @@ func TestApplyDetectors_PrePopulatedResultsSkipsDetector(t *testing.T) {
 		scores: []float64{0.0}, // Would return 0.0 if called
 	}
 
-	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError)
+	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError, 0)
 	require.NoError(t, err)
 
 	// Should use pre-populated score (0.85), NOT the detector's score (0.0)
@@ -209,7 +210,7 @@ func TestApplyDetectors_PrePopulatedMixedWithExternal(t *testing.T) {
 		&mockDetector{name: "other.Detector", scores: []float64{0.9}}, // Should run normally
 	}
 
-	err := ApplyDetectors(ctx, a, detectorList, SkipOnError)
+	err := ApplyDetectors(ctx, a, detectorList, SkipOnError, 0)
 	require.NoError(t, err)
 
 	// other.Detector has highest score, so it should be primary
@@ -221,3 +222,40 @@ func TestApplyDetectors_PrePopulatedMixedWithExternal(t *testing.T) {
 	// External detector results should also be stored
 	assert.Equal(t, []float64{0.9}, a.DetectorResults["other.Detector"])
 }
+
+// slowDetector blocks until ctx is done before returning.
+type slowDetector struct {
+	name string
+}
+
+func (d *slowDetector) Detect(ctx context.Context, a *attempt.Attempt) ([]float64, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (d *slowDetector) Name() string        { return d.name }
+func (d *slowDetector) Description() string { return "blocks until its context is cancelled" }
+
+func TestApplyDetectors_DetectorTimeoutMarksStatusTimedOut(t *testing.T) {
+	ctx := context.Background()
+	a := attempt.New("test prompt")
+
+	detector := &slowDetector{name: "slow.Detector"}
+
+	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, SkipOnError, 10*time.Millisecond)
+	require.NoError(t, err) // SkipOnError: detector timeout is logged and skipped, not returned
+
+	assert.Equal(t, attempt.StatusTimedOut, a.Status)
+	assert.NotEmpty(t, a.Error)
+}
+
+func TestApplyDetectors_DetectorTimeoutFailOnErrorReturnsError(t *testing.T) {
+	ctx := context.Background()
+	a := attempt.New("test prompt")
+
+	detector := &slowDetector{name: "slow.Detector"}
+
+	err := ApplyDetectors(ctx, a, []detectors.Detector{detector}, FailOnError, 10*time.Millisecond)
+	require.Error(t, err)
+	assert.Equal(t, attempt.StatusTimedOut, a.Status)
+}
@@ -21,6 +21,45 @@ type Evaluator interface {
 	Evaluate(ctx context.Context, attempts []*attempt.Attempt) error
 }
 
+// interruptedContextKey is the context.Value key used by WithInterrupted.
+type interruptedContextKey struct{}
+
+// WithInterrupted marks ctx as belonging to a scan that was stopped partway
+// through (e.g. by SIGINT) rather than one that ran to completion. A
+// harness sets this on the context it passes to Evaluator.Evaluate when
+// scanner.Results.Interrupted is true, so evaluators can label partial
+// results accordingly instead of presenting them as a complete run.
+func WithInterrupted(ctx context.Context) context.Context {
+	return context.WithValue(ctx, interruptedContextKey{}, true)
+}
+
+// Interrupted reports whether ctx was marked via WithInterrupted.
+func Interrupted(ctx context.Context) bool {
+	v, _ := ctx.Value(interruptedContextKey{}).(bool)
+	return v
+}
+
+// incompleteProbesContextKey is the context.Value key used by
+// WithIncompleteProbes.
+type incompleteProbesContextKey struct{}
+
+// WithIncompleteProbes marks ctx with the names of probes that were cut
+// short by scanner.Options.ProbeTimeout (scanner.Results.IncompleteProbes).
+// A harness sets this on the context it passes to Evaluator.Evaluate when
+// that list is non-empty, so evaluators can call out incomplete probes in
+// their output instead of letting them blend in with probes that ran to
+// completion.
+func WithIncompleteProbes(ctx context.Context, probeNames []string) context.Context {
+	return context.WithValue(ctx, incompleteProbesContextKey{}, probeNames)
+}
+
+// IncompleteProbes returns the probe names ctx was marked with via
+// WithIncompleteProbes, or nil if it wasn't marked.
+func IncompleteProbes(ctx context.Context) []string {
+	v, _ := ctx.Value(incompleteProbesContextKey{}).([]string)
+	return v
+}
+
 // Harness orchestrates probe execution against generators with detection.
 type Harness interface {
 	// Run executes the scan workflow.
@@ -0,0 +1,125 @@
+package harnesses
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+// Hook is the marker interface for harness middleware: cross-cutting
+// features (logging, budget enforcement, deduplication) that observe or
+// influence a scan without the harness needing to know about them. A hook
+// implements whichever of PreProbeHook, PostProbeHook, PreAttemptHook, and
+// PostAttemptHook stages it needs; a harness checks each hook against those
+// interfaces and calls whichever stages apply, in the order the hooks were
+// given.
+type Hook interface {
+	// Name identifies the hook in logs and error messages.
+	Name() string
+}
+
+// PreProbeHook runs before a probe generates its attempts. Returning an
+// error vetoes the probe - it is skipped entirely for this scan, as if it
+// had never been selected. A budget hook that has exhausted its attempt
+// allowance is a PreProbeHook.
+type PreProbeHook interface {
+	Hook
+	PreProbe(ctx context.Context, probe probes.Prober) error
+}
+
+// PostProbeHook runs after a probe's attempts have been generated, before
+// detectors score them. Returning an error is logged but does not affect
+// the scan - post hooks observe, they don't gate it.
+type PostProbeHook interface {
+	Hook
+	PostProbe(ctx context.Context, probe probes.Prober, attempts []*attempt.Attempt) error
+}
+
+// PreAttemptHook runs before detectors score a single attempt. Returning an
+// error skips detection for that attempt; the attempt is still reported,
+// marked with the hook's error via attempt.SetError.
+type PreAttemptHook interface {
+	Hook
+	PreAttempt(ctx context.Context, a *attempt.Attempt) error
+}
+
+// PostAttemptHook runs after detectors have scored a single attempt.
+// Returning an error is logged but does not affect the scan.
+type PostAttemptHook interface {
+	Hook
+	PostAttempt(ctx context.Context, a *attempt.Attempt) error
+}
+
+// RunPreProbe returns the subset of probeList that every PreProbeHook
+// approves, in original order. A probe vetoed by any hook is dropped and
+// logged rather than aborting the scan.
+func RunPreProbe(ctx context.Context, hooks []Hook, probeList []probes.Prober) []probes.Prober {
+	if len(hooks) == 0 {
+		return probeList
+	}
+
+	kept := make([]probes.Prober, 0, len(probeList))
+	for _, p := range probeList {
+		vetoed := false
+		for _, h := range hooks {
+			hook, ok := h.(PreProbeHook)
+			if !ok {
+				continue
+			}
+			if err := hook.PreProbe(ctx, p); err != nil {
+				slog.Warn("hook vetoed probe", "hook", h.Name(), "probe", p.Name(), "error", err)
+				vetoed = true
+				break
+			}
+		}
+		if !vetoed {
+			kept = append(kept, p)
+		}
+	}
+	return kept
+}
+
+// RunPostProbe calls every PostProbeHook with a completed probe's attempts.
+func RunPostProbe(ctx context.Context, hooks []Hook, probe probes.Prober, attempts []*attempt.Attempt) {
+	for _, h := range hooks {
+		hook, ok := h.(PostProbeHook)
+		if !ok {
+			continue
+		}
+		if err := hook.PostProbe(ctx, probe, attempts); err != nil {
+			slog.Warn("post-probe hook failed", "hook", h.Name(), "probe", probe.Name(), "error", err)
+		}
+	}
+}
+
+// RunPreAttempt calls every PreAttemptHook for a, stopping at the first
+// error. The returned error, if any, identifies which hook rejected the
+// attempt.
+func RunPreAttempt(ctx context.Context, hooks []Hook, a *attempt.Attempt) error {
+	for _, h := range hooks {
+		hook, ok := h.(PreAttemptHook)
+		if !ok {
+			continue
+		}
+		if err := hook.PreAttempt(ctx, a); err != nil {
+			return fmt.Errorf("hook %s: %w", h.Name(), err)
+		}
+	}
+	return nil
+}
+
+// RunPostAttempt calls every PostAttemptHook for a.
+func RunPostAttempt(ctx context.Context, hooks []Hook, a *attempt.Attempt) {
+	for _, h := range hooks {
+		hook, ok := h.(PostAttemptHook)
+		if !ok {
+			continue
+		}
+		if err := hook.PostAttempt(ctx, a); err != nil {
+			slog.Warn("post-attempt hook failed", "hook", h.Name(), "probe", a.Probe, "error", err)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package harnesses
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+type hookProbe struct{ name string }
+
+func (p *hookProbe) Probe(_ context.Context, _ probes.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (p *hookProbe) Name() string { return p.name }
+
+// recordingHook implements every hook stage and records each call it
+// receives, so tests can assert on call order and arguments.
+type recordingHook struct {
+	name         string
+	vetoProbe    string
+	vetoAttempt  bool
+	preProbed    []string
+	postProbed   []string
+	preAttempted []*attempt.Attempt
+	postAttempt  []*attempt.Attempt
+}
+
+func (h *recordingHook) Name() string { return h.name }
+
+func (h *recordingHook) PreProbe(_ context.Context, probe probes.Prober) error {
+	h.preProbed = append(h.preProbed, probe.Name())
+	if probe.Name() == h.vetoProbe {
+		return errors.New("over budget")
+	}
+	return nil
+}
+
+func (h *recordingHook) PostProbe(_ context.Context, probe probes.Prober, attempts []*attempt.Attempt) error {
+	h.postProbed = append(h.postProbed, probe.Name())
+	return nil
+}
+
+func (h *recordingHook) PreAttempt(_ context.Context, a *attempt.Attempt) error {
+	h.preAttempted = append(h.preAttempted, a)
+	if h.vetoAttempt {
+		return errors.New("blocked")
+	}
+	return nil
+}
+
+func (h *recordingHook) PostAttempt(_ context.Context, a *attempt.Attempt) error {
+	h.postAttempt = append(h.postAttempt, a)
+	return nil
+}
+
+func TestRunPreProbe_NoHooksReturnsUnchanged(t *testing.T) {
+	probeList := []probes.Prober{&hookProbe{name: "a.A"}}
+	kept := RunPreProbe(context.Background(), nil, probeList)
+	assert.Equal(t, probeList, kept)
+}
+
+func TestRunPreProbe_VetoesProbe(t *testing.T) {
+	hook := &recordingHook{name: "budget", vetoProbe: "a.Bad"}
+	probeList := []probes.Prober{&hookProbe{name: "a.Good"}, &hookProbe{name: "a.Bad"}}
+
+	kept := RunPreProbe(context.Background(), []Hook{hook}, probeList)
+
+	require.Len(t, kept, 1)
+	assert.Equal(t, "a.Good", kept[0].Name())
+	assert.Equal(t, []string{"a.Good", "a.Bad"}, hook.preProbed)
+}
+
+func TestRunPostProbe_CallsEveryHook(t *testing.T) {
+	hook := &recordingHook{name: "logging"}
+	probe := &hookProbe{name: "a.A"}
+	attempts := []*attempt.Attempt{attempt.New("prompt")}
+
+	RunPostProbe(context.Background(), []Hook{hook}, probe, attempts)
+
+	assert.Equal(t, []string{"a.A"}, hook.postProbed)
+}
+
+func TestRunPreAttempt_PropagatesFirstError(t *testing.T) {
+	hook := &recordingHook{name: "budget", vetoAttempt: true}
+	a := attempt.New("prompt")
+
+	err := RunPreAttempt(context.Background(), []Hook{hook}, a)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "budget")
+	assert.Equal(t, []*attempt.Attempt{a}, hook.preAttempted)
+}
+
+func TestRunPreAttempt_NoErrorWhenAllHooksApprove(t *testing.T) {
+	hook := &recordingHook{name: "logging"}
+	a := attempt.New("prompt")
+
+	err := RunPreAttempt(context.Background(), []Hook{hook}, a)
+
+	assert.NoError(t, err)
+}
+
+func TestRunPostAttempt_CallsEveryHook(t *testing.T) {
+	hook := &recordingHook{name: "logging"}
+	a := attempt.New("prompt")
+
+	RunPostAttempt(context.Background(), []Hook{hook}, a)
+
+	assert.Equal(t, []*attempt.Attempt{a}, hook.postAttempt)
+}
+
+// hookOnlyName implements Hook but none of the stage interfaces, verifying
+// that the Run* helpers skip hooks that don't support a given stage.
+type hookOnlyName struct{}
+
+func (h *hookOnlyName) Name() string { return "noop" }
+
+func TestRunHooks_SkipsHooksWithoutMatchingStage(t *testing.T) {
+	plain := &hookOnlyName{}
+	probeList := []probes.Prober{&hookProbe{name: "a.A"}}
+
+	kept := RunPreProbe(context.Background(), []Hook{plain}, probeList)
+	assert.Equal(t, probeList, kept)
+
+	err := RunPreAttempt(context.Background(), []Hook{plain}, attempt.New("prompt"))
+	assert.NoError(t, err)
+}
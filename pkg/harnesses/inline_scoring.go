@@ -0,0 +1,32 @@
+package harnesses
+
+import (
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+// InlineScoring is an optional interface for probes that need detector
+// feedback mid-run rather than waiting for the harness's end-of-scan
+// detection pass. Tree search and PAIR-style probes decide which branches
+// to explore next based on how the target scored so far, so they need a
+// detector available inside Probe() itself.
+//
+// Harnesses that want to support this call ApplyInlineScoring with the
+// scan's resolved detector set before invoking Probe(), so these probes
+// reuse whatever the scan actually configured instead of constructing
+// their own ad-hoc scoring logic.
+type InlineScoring interface {
+	// SetDetectors supplies the resolved detector set for this scan.
+	SetDetectors(detectorList []detectors.Detector)
+}
+
+// ApplyInlineScoring injects the resolved detector set into every prober
+// that implements InlineScoring. Call this before Probe() in a harness's
+// execution loop.
+func ApplyInlineScoring(probeList []probes.Prober, detectorList []detectors.Detector) {
+	for _, p := range probeList {
+		if is, ok := p.(InlineScoring); ok {
+			is.SetDetectors(detectorList)
+		}
+	}
+}
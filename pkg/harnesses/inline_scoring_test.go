@@ -0,0 +1,49 @@
+package harnesses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/detectors"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+type scoringProbe struct {
+	received []detectors.Detector
+}
+
+func (p *scoringProbe) Probe(_ context.Context, _ probes.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (p *scoringProbe) Name() string { return "test.Scoring" }
+func (p *scoringProbe) SetDetectors(detectorList []detectors.Detector) {
+	p.received = detectorList
+}
+
+type plainProbe struct{}
+
+func (p *plainProbe) Probe(_ context.Context, _ probes.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (p *plainProbe) Name() string { return "test.Plain" }
+
+func TestApplyInlineScoring_InjectsIntoSupportingProbes(t *testing.T) {
+	scoring := &scoringProbe{}
+	plain := &plainProbe{}
+	det := &mockScoringDetector{}
+
+	ApplyInlineScoring([]probes.Prober{scoring, plain}, []detectors.Detector{det})
+
+	assert.Equal(t, []detectors.Detector{det}, scoring.received)
+}
+
+type mockScoringDetector struct{}
+
+func (d *mockScoringDetector) Detect(_ context.Context, _ *attempt.Attempt) ([]float64, error) {
+	return nil, nil
+}
+func (d *mockScoringDetector) Name() string        { return "test.Mock" }
+func (d *mockScoringDetector) Description() string { return "mock" }
@@ -0,0 +1,55 @@
+package harnesses
+
+import (
+	"context"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// PooledGenerator wraps a Generator with a shared semaphore bounding how
+// many Generate calls run at once, regardless of which probe issued them.
+// Used by the pooled.Pooled harness to flatten every probe's prompts into
+// one global work pool: probes are started all at once with no per-probe
+// concurrency limit, and this wrapper is what actually throttles concurrent
+// generator calls, shared fairly across every probe holding a reference to
+// it (Go's channel send queue wakes blocked senders in FIFO order).
+type PooledGenerator struct {
+	next types.Generator
+	sem  chan struct{}
+}
+
+// NewPooledGenerator wraps next so at most limit Generate calls run at
+// once. limit <= 0 is treated as 1.
+func NewPooledGenerator(next types.Generator, limit int) *PooledGenerator {
+	if limit <= 0 {
+		limit = 1
+	}
+	return &PooledGenerator{next: next, sem: make(chan struct{}, limit)}
+}
+
+// Generate acquires a slot in the shared pool, calls the wrapped generator,
+// then releases the slot. Returns ctx.Err() without calling the wrapped
+// generator if ctx is canceled while waiting for a slot.
+func (p *PooledGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	select {
+	case p.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-p.sem }()
+
+	return p.next.Generate(ctx, conv, n)
+}
+
+// ClearHistory resets the wrapped generator's conversation state.
+func (p *PooledGenerator) ClearHistory() { p.next.ClearHistory() }
+
+// Name returns the wrapped generator's name.
+func (p *PooledGenerator) Name() string { return p.next.Name() }
+
+// Description returns the wrapped generator's description.
+func (p *PooledGenerator) Description() string { return p.next.Description() }
+
+// Compile-time check that PooledGenerator implements types.Generator.
+var _ types.Generator = (*PooledGenerator)(nil)
@@ -0,0 +1,92 @@
+package harnesses
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// concurrencyTrackingGenerator tracks peak concurrent Generate calls.
+type concurrencyTrackingGenerator struct {
+	delay time.Duration
+
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+}
+
+func (c *concurrencyTrackingGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	c.mu.Lock()
+	c.inFlight++
+	if c.inFlight > c.peak {
+		c.peak = c.inFlight
+	}
+	c.mu.Unlock()
+
+	time.Sleep(c.delay)
+
+	c.mu.Lock()
+	c.inFlight--
+	c.mu.Unlock()
+
+	return []attempt.Message{{Role: attempt.RoleAssistant, Content: "ok"}}, nil
+}
+
+func (c *concurrencyTrackingGenerator) ClearHistory()       {}
+func (c *concurrencyTrackingGenerator) Name() string        { return "test.Counting" }
+func (c *concurrencyTrackingGenerator) Description() string { return "counts concurrent calls" }
+
+func TestPooledGenerator_LimitsConcurrency(t *testing.T) {
+	inner := &concurrencyTrackingGenerator{delay: 10 * time.Millisecond}
+	pooled := NewPooledGenerator(inner, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			conv := attempt.NewConversation()
+			conv.AddPrompt("p")
+			_, err := pooled.Generate(context.Background(), conv, 1)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	inner.mu.Lock()
+	peak := inner.peak
+	inner.mu.Unlock()
+	assert.LessOrEqual(t, peak, 2)
+}
+
+func TestPooledGenerator_CanceledContext(t *testing.T) {
+	inner := &concurrencyTrackingGenerator{delay: 50 * time.Millisecond}
+	pooled := NewPooledGenerator(inner, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	conv := attempt.NewConversation()
+	conv.AddPrompt("p")
+
+	// Occupy the only slot.
+	go func() {
+		_, _ = pooled.Generate(context.Background(), conv, 1)
+	}()
+	time.Sleep(5 * time.Millisecond)
+
+	cancel()
+	_, err := pooled.Generate(ctx, conv, 1)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPooledGenerator_DelegatesMetadata(t *testing.T) {
+	inner := &concurrencyTrackingGenerator{}
+	pooled := NewPooledGenerator(inner, 1)
+	assert.Equal(t, inner.Name(), pooled.Name())
+	assert.Equal(t, inner.Description(), pooled.Description())
+}
@@ -0,0 +1,40 @@
+package harnesses
+
+import (
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+// ApplyProbeGoal records each probe's registered Goal() on its attempts, via
+// attempt.Attempt.SetGoal, so judge-style detectors can rubric against what
+// the probe is attacking for without per-probe judge configuration. Attempts
+// that already carry a goal - e.g. multi-turn engines that set a per-attempt
+// goal themselves - are left untouched.
+//
+// Call this after the scanner produces attempts, once probes are addressable
+// by name (a.Probe).
+func ApplyProbeGoal(attempts []*attempt.Attempt, proberByName map[string]probes.Prober) {
+	for _, a := range attempts {
+		pr, ok := proberByName[a.Probe]
+		if !ok {
+			continue
+		}
+		ApplyProbeGoalFor(a, pr)
+	}
+}
+
+// ApplyProbeGoalFor is the single-attempt form of ApplyProbeGoal, for
+// harnesses that already have the prober that produced an attempt in scope
+// (so don't need a name lookup).
+func ApplyProbeGoalFor(a *attempt.Attempt, pr probes.Prober) {
+	if a.GetGoal() != "" {
+		return
+	}
+	pm, ok := pr.(probes.ProbeMetadata)
+	if !ok {
+		return
+	}
+	if goal := pm.Goal(); goal != "" {
+		a.SetGoal(goal)
+	}
+}
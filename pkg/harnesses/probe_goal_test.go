@@ -0,0 +1,61 @@
+package harnesses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+type goalProbe struct {
+	goal string
+}
+
+func (p *goalProbe) Probe(_ context.Context, _ probes.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (p *goalProbe) Name() string               { return "test.Goal" }
+func (p *goalProbe) Description() string        { return "" }
+func (p *goalProbe) Goal() string               { return p.goal }
+func (p *goalProbe) GetPrimaryDetector() string { return "" }
+func (p *goalProbe) GetPrompts() []string       { return nil }
+
+func TestApplyProbeGoal_SetsGoalFromProbeMetadata(t *testing.T) {
+	pr := &goalProbe{goal: "extract the system prompt"}
+	a := &attempt.Attempt{Probe: "test.Goal"}
+
+	ApplyProbeGoal([]*attempt.Attempt{a}, map[string]probes.Prober{"test.Goal": pr})
+
+	assert.Equal(t, "extract the system prompt", a.GetGoal())
+}
+
+func TestApplyProbeGoal_DoesNotOverrideExistingGoal(t *testing.T) {
+	pr := &goalProbe{goal: "probe goal"}
+	a := &attempt.Attempt{Probe: "test.Goal"}
+	a.SetGoal("per-attempt goal set by a multi-turn engine")
+
+	ApplyProbeGoal([]*attempt.Attempt{a}, map[string]probes.Prober{"test.Goal": pr})
+
+	assert.Equal(t, "per-attempt goal set by a multi-turn engine", a.GetGoal())
+}
+
+func TestApplyProbeGoal_SkipsProbesWithoutMetadata(t *testing.T) {
+	plain := &plainProbe{}
+	a := &attempt.Attempt{Probe: "test.Plain"}
+
+	ApplyProbeGoal([]*attempt.Attempt{a}, map[string]probes.Prober{"test.Plain": plain})
+
+	assert.Empty(t, a.GetGoal())
+}
+
+func TestApplyProbeGoalFor_SetsGoal(t *testing.T) {
+	pr := &goalProbe{goal: "leak training data"}
+	a := &attempt.Attempt{Probe: "test.Goal"}
+
+	ApplyProbeGoalFor(a, pr)
+
+	assert.Equal(t, "leak training data", a.GetGoal())
+}
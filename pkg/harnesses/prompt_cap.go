@@ -0,0 +1,32 @@
+package harnesses
+
+import (
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+// PromptCapper is an optional interface for probes that can trim how many of
+// their prompts actually run. SimpleProbe (and anything embedding it)
+// implements this via SetPromptCap.
+//
+// Harnesses that want to support a scan-wide run.prompt_cap call
+// ApplyPromptCap with the resolved cap before invoking Probe(), so a global
+// default reaches every probe without each probe needing its own plumbing.
+type PromptCapper interface {
+	// SetPromptCap supplies the scan's default prompt sampling behavior. A
+	// probe that already has its own explicit cap is expected to keep it.
+	SetPromptCap(opts probes.SampleOptions)
+}
+
+// ApplyPromptCap injects a scan-wide prompt cap into every prober that
+// implements PromptCapper. A zero Size means no scan-wide cap, in which case
+// this is a no-op and probes run whatever cap (if any) they set themselves.
+func ApplyPromptCap(probeList []probes.Prober, opts probes.SampleOptions) {
+	if opts.Size <= 0 {
+		return
+	}
+	for _, p := range probeList {
+		if pc, ok := p.(PromptCapper); ok {
+			pc.SetPromptCap(opts)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package harnesses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+)
+
+type cappingProbe struct {
+	received probes.SampleOptions
+}
+
+func (p *cappingProbe) Probe(_ context.Context, _ probes.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (p *cappingProbe) Name() string { return "test.Capping" }
+func (p *cappingProbe) SetPromptCap(opts probes.SampleOptions) {
+	p.received = opts
+}
+
+func TestApplyPromptCap_InjectsIntoSupportingProbes(t *testing.T) {
+	capping := &cappingProbe{}
+	plain := &plainProbe{}
+	opts := probes.SampleOptions{Size: 10, Strategy: probes.SampleRandom}
+
+	ApplyPromptCap([]probes.Prober{capping, plain}, opts)
+
+	assert.Equal(t, opts, capping.received)
+}
+
+func TestApplyPromptCap_ZeroSizeIsNoOp(t *testing.T) {
+	capping := &cappingProbe{received: probes.SampleOptions{Size: 5}}
+
+	ApplyPromptCap([]probes.Prober{capping}, probes.SampleOptions{})
+
+	assert.Equal(t, probes.SampleOptions{Size: 5}, capping.received)
+}
@@ -0,0 +1,62 @@
+package harnesses
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/scanner"
+)
+
+// FormatProgressStatus formats the progress status symbol and error message
+// for a scanner.Scanner progress callback. Returns "✓" with an empty error
+// message on success, or "✗" with a formatted, truncated error on failure.
+func FormatProgressStatus(probeErr error) (status, errMsg string) {
+	if probeErr == nil {
+		return "✓", ""
+	}
+	msg := probeErr.Error()
+	if len(msg) > 80 {
+		msg = msg[:77] + "..."
+	}
+	return "✗", fmt.Sprintf(" (%s)", msg)
+}
+
+// CreateFreshEvalContext creates a fresh evaluation context if scanCtx has
+// already expired. If scanCtx is still valid, it's returned unchanged.
+// Otherwise a new context with a 5-minute timeout is returned, so detection
+// and evaluation (fast operations expected to always complete) aren't
+// blocked by a scan-level timeout or cancellation that already fired.
+func CreateFreshEvalContext(scanCtx context.Context) (context.Context, context.CancelFunc) {
+	if scanCtx.Err() == nil {
+		return scanCtx, func() {}
+	}
+	return context.WithTimeout(context.Background(), 5*time.Minute)
+}
+
+// ReportScanErrors checks scanner.Results for probe failures and
+// scan-level errors and returns an appropriate error, or nil if none
+// occurred. Probes skipped due to run.max_total_attempts are logged but
+// don't affect the returned error, since reaching that budget isn't itself
+// a failure.
+func ReportScanErrors(results *scanner.Results, scanErr error, allAttempts []*attempt.Attempt) error {
+	if len(results.SkippedProbes) > 0 {
+		slog.Warn("skipped probes due to run.max_total_attempts", "count", len(results.SkippedProbes), "probes", results.SkippedProbes)
+	}
+
+	if len(results.Errors) > 0 {
+		for _, err := range results.Errors {
+			slog.Error("probe failed", "error", err)
+		}
+		return fmt.Errorf("%d of %d probes failed", results.Failed, results.Total)
+	}
+
+	if scanErr != nil {
+		return fmt.Errorf("scan interrupted after processing %d/%d probes (%d attempts): %w",
+			results.Succeeded, results.Total, len(allAttempts), scanErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package harnesses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// TimeoutGenerator wraps a Generator and bounds every individual Generate
+// call with a fixed timeout, so one slow generator call can't consume a
+// whole probe's ProbeTimeout budget. Wrap the scan's generator with this
+// when scanner.Options.AttemptTimeout is set.
+type TimeoutGenerator struct {
+	next    types.Generator
+	timeout time.Duration
+}
+
+// NewTimeoutGenerator wraps next so each Generate call is bounded by timeout.
+func NewTimeoutGenerator(next types.Generator, timeout time.Duration) *TimeoutGenerator {
+	return &TimeoutGenerator{next: next, timeout: timeout}
+}
+
+// Generate calls the wrapped generator with a context bounded by timeout.
+// A deadline exceeded while waiting on the wrapped call surfaces as a
+// context.DeadlineExceeded-wrapping error, which attempt.Attempt.SetError
+// recognizes and records as StatusTimedOut rather than a generic failure.
+func (t *TimeoutGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	callCtx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	messages, err := t.next.Generate(callCtx, conv, n)
+	if err != nil && errors.Is(callCtx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("generate call exceeded attempt timeout of %s: %w", t.timeout, context.DeadlineExceeded)
+	}
+	return messages, err
+}
+
+// ClearHistory resets the wrapped generator's conversation state.
+func (t *TimeoutGenerator) ClearHistory() { t.next.ClearHistory() }
+
+// Name returns the wrapped generator's name.
+func (t *TimeoutGenerator) Name() string { return t.next.Name() }
+
+// Description returns the wrapped generator's description.
+func (t *TimeoutGenerator) Description() string { return t.next.Description() }
+
+// Compile-time check that TimeoutGenerator implements types.Generator.
+var _ types.Generator = (*TimeoutGenerator)(nil)
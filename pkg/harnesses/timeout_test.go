@@ -0,0 +1,64 @@
+package harnesses
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// slowGenerator sleeps for delay before returning reply.
+type slowGenerator struct {
+	delay time.Duration
+	reply string
+}
+
+func (g *slowGenerator) Generate(ctx context.Context, _ *attempt.Conversation, _ int) ([]attempt.Message, error) {
+	select {
+	case <-time.After(g.delay):
+		return []attempt.Message{attempt.NewAssistantMessage(g.reply)}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+func (g *slowGenerator) ClearHistory()       {}
+func (g *slowGenerator) Name() string        { return "test.Slow" }
+func (g *slowGenerator) Description() string { return "sleeps before replying" }
+
+func TestTimeoutGenerator_ReturnsResultWithinTimeout(t *testing.T) {
+	inner := &slowGenerator{delay: 10 * time.Millisecond, reply: "hi"}
+	tg := NewTimeoutGenerator(inner, time.Second)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hello")
+
+	messages, err := tg.Generate(context.Background(), conv, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "hi", messages[0].Content)
+}
+
+func TestTimeoutGenerator_ExceedsTimeoutWrapsDeadlineExceeded(t *testing.T) {
+	inner := &slowGenerator{delay: 100 * time.Millisecond, reply: "hi"}
+	tg := NewTimeoutGenerator(inner, 10*time.Millisecond)
+
+	conv := attempt.NewConversation()
+	conv.AddPrompt("hello")
+
+	_, err := tg.Generate(context.Background(), conv, 1)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestTimeoutGenerator_DelegatesMetadata(t *testing.T) {
+	inner := &slowGenerator{delay: 0, reply: "hi"}
+	tg := NewTimeoutGenerator(inner, time.Second)
+
+	assert.Equal(t, inner.Name(), tg.Name())
+	assert.Equal(t, inner.Description(), tg.Description())
+	tg.ClearHistory() // must not panic
+}
@@ -0,0 +1,134 @@
+package harnesses
+
+import (
+	"context"
+	"strings"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/types"
+)
+
+// FinishReasonProvider is an optional interface for generators that expose
+// the finish reason (OpenAI-style "length", "stop", "content_filter", ...)
+// of their most recent Generate call. TruncationGenerator prefers this
+// signal over its own heuristic when the wrapped generator implements it.
+type FinishReasonProvider interface {
+	LastFinishReason() string
+}
+
+// truncationFinishReasons are finish reasons that unambiguously mean a
+// response was cut off by a token/length limit, not a natural stop.
+var truncationFinishReasons = map[string]bool{
+	"length":       true,
+	"max_tokens":   true,
+	"model_length": true,
+}
+
+// sentenceEndings are characters that commonly close a complete sentence,
+// clause, or code block. Content not ending in one of these is
+// heuristically treated as a mid-sentence cutoff when the wrapped
+// generator doesn't implement FinishReasonProvider.
+const sentenceEndings = ".!?\"'`)]},:;\n"
+
+// TruncationGenerator wraps a Generator and detects responses cut off by a
+// token/length limit - via FinishReasonProvider when the wrapped generator
+// implements it, or a mid-sentence-cutoff heuristic otherwise - then issues
+// up to maxContinuations follow-up "continue" requests, merging the chunks
+// into a single response. Without this, detectors see only the truncated
+// fragment and frequently mis-score genuine compliance as a refusal.
+type TruncationGenerator struct {
+	next             types.Generator
+	maxContinuations int
+}
+
+// NewTruncationGenerator wraps next so truncated responses are followed up
+// automatically, up to maxContinuations times. maxContinuations <= 0
+// disables continuation entirely (the wrapper becomes a passthrough).
+func NewTruncationGenerator(next types.Generator, maxContinuations int) *TruncationGenerator {
+	return &TruncationGenerator{next: next, maxContinuations: maxContinuations}
+}
+
+// Generate delegates to the wrapped generator, then continues any
+// truncated completion by replaying the conversation with the partial
+// response recorded and a "continue" prompt appended, merging the results.
+func (tg *TruncationGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	messages, err := tg.next.Generate(ctx, conv, n)
+	if err != nil || tg.maxContinuations <= 0 {
+		return messages, err
+	}
+
+	for i, msg := range messages {
+		merged, err := tg.continueIfTruncated(ctx, conv, msg)
+		if err != nil {
+			return nil, err
+		}
+		messages[i] = merged
+	}
+
+	return messages, nil
+}
+
+// continueIfTruncated issues up to maxContinuations follow-up requests for
+// a single truncated completion, appending each chunk to the accumulated
+// content, and stops early once a continuation isn't itself truncated.
+func (tg *TruncationGenerator) continueIfTruncated(ctx context.Context, conv *attempt.Conversation, msg attempt.Message) (attempt.Message, error) {
+	if !tg.isTruncated(msg.Content) || len(conv.Turns) == 0 {
+		return msg, nil
+	}
+
+	content := msg.Content
+	history := conv.Clone()
+	lastTurn := len(history.Turns) - 1
+	history.Turns[lastTurn] = history.Turns[lastTurn].WithResponse(content)
+
+	for i := 0; i < tg.maxContinuations; i++ {
+		history.AddPrompt("Continue exactly where you left off. Do not repeat anything already said.")
+
+		continued, err := tg.next.Generate(ctx, history, 1)
+		if err != nil {
+			return attempt.Message{}, err
+		}
+		if len(continued) == 0 {
+			break
+		}
+
+		chunk := continued[0].Content
+		content += chunk
+		lastTurn = len(history.Turns) - 1
+		history.Turns[lastTurn] = history.Turns[lastTurn].WithResponse(chunk)
+
+		if !tg.isTruncated(chunk) {
+			break
+		}
+	}
+
+	return attempt.NewAssistantMessage(content), nil
+}
+
+// isTruncated reports whether content looks like it was cut off mid-output.
+func (tg *TruncationGenerator) isTruncated(content string) bool {
+	if provider, ok := tg.next.(FinishReasonProvider); ok {
+		if reason := provider.LastFinishReason(); reason != "" {
+			return truncationFinishReasons[strings.ToLower(reason)]
+		}
+	}
+
+	trimmed := strings.TrimSpace(content)
+	if trimmed == "" {
+		return false
+	}
+	last := trimmed[len(trimmed)-1]
+	return !strings.ContainsRune(sentenceEndings, rune(last))
+}
+
+// ClearHistory delegates to the wrapped generator.
+func (tg *TruncationGenerator) ClearHistory() { tg.next.ClearHistory() }
+
+// Name returns the wrapped generator's name.
+func (tg *TruncationGenerator) Name() string { return tg.next.Name() }
+
+// Description returns the wrapped generator's description.
+func (tg *TruncationGenerator) Description() string { return tg.next.Description() }
+
+// Compile-time check that TruncationGenerator implements types.Generator.
+var _ types.Generator = (*TruncationGenerator)(nil)
@@ -0,0 +1,147 @@
+package harnesses
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// sequencedGenerator returns the next reply in replies on each call, and
+// records every conversation it was called with.
+type sequencedGenerator struct {
+	replies []string
+	calls   []*attempt.Conversation
+	call    int
+	err     error
+}
+
+func (g *sequencedGenerator) Generate(_ context.Context, conv *attempt.Conversation, _ int) ([]attempt.Message, error) {
+	g.calls = append(g.calls, conv)
+	if g.err != nil {
+		return nil, g.err
+	}
+	reply := g.replies[g.call]
+	g.call++
+	return []attempt.Message{attempt.NewAssistantMessage(reply)}, nil
+}
+func (g *sequencedGenerator) ClearHistory()       {}
+func (g *sequencedGenerator) Name() string        { return "test.Sequenced" }
+func (g *sequencedGenerator) Description() string { return "returns replies in order" }
+
+func newConvo(prompt string) *attempt.Conversation {
+	conv := attempt.NewConversation()
+	conv.AddPrompt(prompt)
+	return conv
+}
+
+func TestTruncationGenerator_CompleteResponseNotContinued(t *testing.T) {
+	inner := &sequencedGenerator{replies: []string{"All done."}}
+	tg := NewTruncationGenerator(inner, 3)
+
+	messages, err := tg.Generate(context.Background(), newConvo("hi"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "All done.", messages[0].Content)
+	assert.Len(t, inner.calls, 1, "should not issue a continuation for a complete response")
+}
+
+func TestTruncationGenerator_MergesTruncatedContinuation(t *testing.T) {
+	inner := &sequencedGenerator{replies: []string{"Step 1, gather the", " materials needed."}}
+	tg := NewTruncationGenerator(inner, 3)
+
+	messages, err := tg.Generate(context.Background(), newConvo("hi"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Step 1, gather the materials needed.", messages[0].Content)
+	assert.Len(t, inner.calls, 2, "should issue exactly one continuation once the response completes")
+}
+
+func TestTruncationGenerator_StopsAtMaxContinuations(t *testing.T) {
+	inner := &sequencedGenerator{replies: []string{"one and", " two and", " three and"}}
+	tg := NewTruncationGenerator(inner, 2)
+
+	messages, err := tg.Generate(context.Background(), newConvo("hi"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "one and two and three and", messages[0].Content)
+	assert.Len(t, inner.calls, 3, "initial call plus 2 continuations, even though still truncated")
+}
+
+func TestTruncationGenerator_DisabledIsPassthrough(t *testing.T) {
+	inner := &sequencedGenerator{replies: []string{"cut off mid"}}
+	tg := NewTruncationGenerator(inner, 0)
+
+	messages, err := tg.Generate(context.Background(), newConvo("hi"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "cut off mid", messages[0].Content)
+	assert.Len(t, inner.calls, 1)
+}
+
+func TestTruncationGenerator_PropagatesGeneratorError(t *testing.T) {
+	inner := &sequencedGenerator{err: errors.New("generator unavailable")}
+	tg := NewTruncationGenerator(inner, 3)
+
+	_, err := tg.Generate(context.Background(), newConvo("hi"), 1)
+	require.Error(t, err)
+}
+
+func TestTruncationGenerator_ContinuationPromptAppendsToHistory(t *testing.T) {
+	inner := &sequencedGenerator{replies: []string{"Step 1, gather the", " materials needed."}}
+	tg := NewTruncationGenerator(inner, 3)
+
+	_, err := tg.Generate(context.Background(), newConvo("hi"), 1)
+	require.NoError(t, err)
+
+	require.Len(t, inner.calls, 2)
+	continuationConv := inner.calls[1]
+	require.Len(t, continuationConv.Turns, 2)
+	assert.Equal(t, "Step 1, gather the", continuationConv.Turns[0].Response.Content)
+	assert.Contains(t, continuationConv.Turns[1].Prompt.Content, "Continue")
+}
+
+// finishReasonGenerator reports an explicit finish reason instead of
+// relying on the sentence-ending heuristic.
+type finishReasonGenerator struct {
+	sequencedGenerator
+	reasons []string
+	idx     int
+}
+
+func (g *finishReasonGenerator) Generate(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+	msgs, err := g.sequencedGenerator.Generate(ctx, conv, n)
+	g.idx++
+	return msgs, err
+}
+
+func (g *finishReasonGenerator) LastFinishReason() string {
+	if g.idx == 0 || g.idx > len(g.reasons) {
+		return ""
+	}
+	return g.reasons[g.idx-1]
+}
+
+func TestTruncationGenerator_PrefersFinishReasonOverHeuristic(t *testing.T) {
+	// Ends with a period (heuristic would say "complete"), but the
+	// generator reports finish_reason=length, so it must still continue.
+	inner := &finishReasonGenerator{
+		sequencedGenerator: sequencedGenerator{replies: []string{"Ends cleanly.", " plus more."}},
+		reasons:            []string{"length", "stop"},
+	}
+	tg := NewTruncationGenerator(inner, 3)
+
+	messages, err := tg.Generate(context.Background(), newConvo("hi"), 1)
+	require.NoError(t, err)
+	assert.Equal(t, "Ends cleanly. plus more.", messages[0].Content)
+	assert.Len(t, inner.calls, 2)
+}
+
+func TestTruncationGenerator_DelegatesMetadata(t *testing.T) {
+	inner := &sequencedGenerator{replies: []string{"hi"}}
+	tg := NewTruncationGenerator(inner, 3)
+
+	assert.Equal(t, inner.Name(), tg.Name())
+	assert.Equal(t, inner.Description(), tg.Description())
+	tg.ClearHistory() // must not panic
+}
@@ -0,0 +1,226 @@
+// Package jsonpath implements a small JSONPath/jq-style subset for querying
+// decoded JSON values (the map[string]any/[]any trees produced by
+// encoding/json.Unmarshal into `any`).
+//
+// It started as the path syntax used by the REST generator's response
+// extraction config (response_json_field, sse_text_field, etc.): dotted and
+// bracketed field access like "$.field.nested" or "$[0].field". This package
+// extends that with the two operations needed to query a whole collection of
+// results rather than a single API response:
+//
+//   - Wildcard array expansion: "$[*].field"
+//   - Equality filters: "$[?(@.field==value)].field"
+//
+// Navigation always proceeds left to right over a "current set" of values,
+// which starts as the single root value and fans out to multiple values the
+// first time a wildcard or filter segment matches more than one element. If
+// the path never fans out, Evaluate returns a single scalar value, matching
+// the original REST generator behavior; otherwise it returns []any.
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type segmentKind int
+
+const (
+	fieldSegment segmentKind = iota
+	indexSegment
+	wildcardSegment
+	filterSegment
+)
+
+type segment struct {
+	kind        segmentKind
+	field       string // fieldSegment
+	index       int    // indexSegment
+	filterField string // filterSegment
+	filterValue string // filterSegment
+}
+
+// Evaluate navigates data (as produced by json.Unmarshal into `any`) along
+// path and returns the result. path is expected to start with "$"; a bare
+// "$" returns data unchanged.
+func Evaluate(data any, path string) (any, error) {
+	segs, err := parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := []any{data}
+	fanned := false
+
+	for _, seg := range segs {
+		var next []any
+
+		switch seg.kind {
+		case fieldSegment:
+			for _, v := range values {
+				obj, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("jsonpath: expected object for field %q", seg.field)
+				}
+				val, ok := obj[seg.field]
+				if !ok {
+					return nil, fmt.Errorf("jsonpath: field %q not found", seg.field)
+				}
+				next = append(next, val)
+			}
+
+		case indexSegment:
+			for _, v := range values {
+				arr, ok := v.([]any)
+				if !ok {
+					return nil, fmt.Errorf("jsonpath: expected array for index [%d]", seg.index)
+				}
+				if seg.index < 0 || seg.index >= len(arr) {
+					return nil, fmt.Errorf("jsonpath: array index %d out of bounds", seg.index)
+				}
+				next = append(next, arr[seg.index])
+			}
+
+		case wildcardSegment:
+			fanned = true
+			for _, v := range values {
+				arr, ok := v.([]any)
+				if !ok {
+					return nil, fmt.Errorf("jsonpath: expected array for [*]")
+				}
+				next = append(next, arr...)
+			}
+
+		case filterSegment:
+			fanned = true
+			for _, v := range values {
+				arr, ok := v.([]any)
+				if !ok {
+					return nil, fmt.Errorf("jsonpath: expected array for filter expression")
+				}
+				for _, elem := range arr {
+					obj, ok := elem.(map[string]any)
+					if !ok {
+						continue
+					}
+					if val, ok := obj[seg.filterField]; ok && Stringify(val) == seg.filterValue {
+						next = append(next, elem)
+					}
+				}
+			}
+		}
+
+		values = next
+	}
+
+	if !fanned {
+		if len(values) != 1 {
+			return nil, fmt.Errorf("jsonpath: path %q produced no result", path)
+		}
+		return values[0], nil
+	}
+	return values, nil
+}
+
+// Stringify renders a decoded JSON value the way the REST generator presents
+// extracted fields: scalars print as themselves, everything else is
+// re-marshaled to JSON.
+func Stringify(val any) string {
+	switch v := val.(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%v", v)
+	case bool:
+		return fmt.Sprintf("%v", v)
+	case nil:
+		return ""
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	}
+}
+
+// parse splits a JSONPath expression into segments.
+func parse(path string) ([]segment, error) {
+	path = strings.TrimPrefix(path, "$")
+
+	var segs []segment
+	var cur strings.Builder
+
+	flush := func() {
+		if cur.Len() > 0 {
+			segs = append(segs, segment{kind: fieldSegment, field: cur.String()})
+			cur.Reset()
+		}
+	}
+
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		switch c {
+		case '.':
+			flush()
+		case '[':
+			flush()
+			depth := 1
+			j := i + 1
+			for j < len(path) && depth > 0 {
+				switch path[j] {
+				case '[':
+					depth++
+				case ']':
+					depth--
+				}
+				if depth == 0 {
+					break
+				}
+				j++
+			}
+			if j >= len(path) {
+				return nil, fmt.Errorf("jsonpath: unterminated bracket in %q", path)
+			}
+			seg, err := parseBracket(path[i+1 : j])
+			if err != nil {
+				return nil, err
+			}
+			segs = append(segs, seg)
+			i = j
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	flush()
+
+	return segs, nil
+}
+
+// parseBracket parses the contents of a single "[...]" segment: a numeric
+// index ("0"), a wildcard ("*"), or an equality filter ("?(@.field==value)").
+func parseBracket(inner string) (segment, error) {
+	if inner == "*" {
+		return segment{kind: wildcardSegment}, nil
+	}
+
+	if strings.HasPrefix(inner, "?(") && strings.HasSuffix(inner, ")") {
+		expr := strings.TrimSuffix(strings.TrimPrefix(inner, "?("), ")")
+		parts := strings.SplitN(expr, "==", 2)
+		if len(parts) != 2 {
+			return segment{}, fmt.Errorf("jsonpath: unsupported filter expression %q", inner)
+		}
+		field := strings.TrimSpace(parts[0])
+		field = strings.TrimPrefix(field, "@.")
+		value := strings.Trim(strings.TrimSpace(parts[1]), `"'`)
+		return segment{kind: filterSegment, filterField: field, filterValue: value}, nil
+	}
+
+	idx, err := strconv.Atoi(inner)
+	if err != nil {
+		return segment{}, fmt.Errorf("jsonpath: invalid bracket expression %q", inner)
+	}
+	return segment{kind: indexSegment, index: idx}, nil
+}
@@ -0,0 +1,161 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func unmarshal(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("unmarshal(%q): %v", s, err)
+	}
+	return v
+}
+
+func TestEvaluate_FieldAndIndex(t *testing.T) {
+	data := unmarshal(t, `{"data":{"text":"hello"},"items":[{"name":"a"},{"name":"b"}]}`)
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "root", path: "$", want: `{"data":{"text":"hello"},"items":[{"name":"a"},{"name":"b"}]}`},
+		{name: "nested field", path: "$.data.text", want: "hello"},
+		{name: "array index then field", path: "$.items[0].name", want: "a"},
+		{name: "second array index", path: "$.items[1].name", want: "b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Evaluate(data, tt.path)
+			if err != nil {
+				t.Fatalf("Evaluate(%q) error: %v", tt.path, err)
+			}
+			if Stringify(got) != tt.want {
+				t.Errorf("Evaluate(%q) = %q, want %q", tt.path, Stringify(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluate_Errors(t *testing.T) {
+	data := unmarshal(t, `{"items":[{"name":"a"}]}`)
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "missing field", path: "$.missing"},
+		{name: "index out of bounds", path: "$.items[5]"},
+		{name: "index on non-array", path: "$.items[0].name[0]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Evaluate(data, tt.path); err == nil {
+				t.Errorf("Evaluate(%q) expected error, got nil", tt.path)
+			}
+		})
+	}
+}
+
+func TestEvaluate_Wildcard(t *testing.T) {
+	data := unmarshal(t, `[{"name":"a"},{"name":"b"},{"name":"c"}]`)
+
+	got, err := Evaluate(data, "$[*].name")
+	if err != nil {
+		t.Fatalf("Evaluate() error: %v", err)
+	}
+
+	names, ok := got.([]any)
+	if !ok {
+		t.Fatalf("Evaluate() = %T, want []any", got)
+	}
+	want := []string{"a", "b", "c"}
+	if len(names) != len(want) {
+		t.Fatalf("Evaluate() returned %d names, want %d", len(names), len(want))
+	}
+	for i, w := range want {
+		if Stringify(names[i]) != w {
+			t.Errorf("Evaluate()[%d] = %q, want %q", i, Stringify(names[i]), w)
+		}
+	}
+}
+
+func TestEvaluate_Filter(t *testing.T) {
+	data := unmarshal(t, `[
+		{"probe":"dan.Dan_11_0","passed":false,"prompt":"p1"},
+		{"probe":"dan.Dan_11_0","passed":true,"prompt":"p2"},
+		{"probe":"encoding.Base64","passed":false,"prompt":"p3"}
+	]`)
+
+	t.Run("filter projects a field from matching elements", func(t *testing.T) {
+		got, err := Evaluate(data, `$[?(@.passed==false)].prompt`)
+		if err != nil {
+			t.Fatalf("Evaluate() error: %v", err)
+		}
+		prompts, ok := got.([]any)
+		if !ok {
+			t.Fatalf("Evaluate() = %T, want []any", got)
+		}
+		want := []string{"p1", "p3"}
+		if len(prompts) != len(want) {
+			t.Fatalf("Evaluate() returned %d prompts, want %d", len(prompts), len(want))
+		}
+		for i, w := range want {
+			if Stringify(prompts[i]) != w {
+				t.Errorf("Evaluate()[%d] = %q, want %q", i, Stringify(prompts[i]), w)
+			}
+		}
+	})
+
+	t.Run("filter on string equality", func(t *testing.T) {
+		got, err := Evaluate(data, `$[?(@.probe==dan.Dan_11_0)].prompt`)
+		if err != nil {
+			t.Fatalf("Evaluate() error: %v", err)
+		}
+		prompts := got.([]any)
+		if len(prompts) != 2 {
+			t.Fatalf("Evaluate() returned %d prompts, want 2", len(prompts))
+		}
+	})
+
+	t.Run("filter matching nothing returns an empty slice", func(t *testing.T) {
+		got, err := Evaluate(data, `$[?(@.probe==nonexistent)].prompt`)
+		if err != nil {
+			t.Fatalf("Evaluate() error: %v", err)
+		}
+		prompts, ok := got.([]any)
+		if !ok {
+			t.Fatalf("Evaluate() = %T, want []any", got)
+		}
+		if len(prompts) != 0 {
+			t.Errorf("Evaluate() = %v, want empty", prompts)
+		}
+	})
+}
+
+func TestStringify(t *testing.T) {
+	tests := []struct {
+		name string
+		val  any
+		want string
+	}{
+		{name: "string", val: "hello", want: "hello"},
+		{name: "float", val: float64(1.5), want: "1.5"},
+		{name: "bool", val: true, want: "true"},
+		{name: "nil", val: nil, want: ""},
+		{name: "map marshals to JSON", val: map[string]any{"a": float64(1)}, want: `{"a":1}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Stringify(tt.val); got != tt.want {
+				t.Errorf("Stringify(%v) = %q, want %q", tt.val, got, tt.want)
+			}
+		})
+	}
+}
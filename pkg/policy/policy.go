@@ -0,0 +1,149 @@
+// Package policy enforces which probes, generators, and payload severity
+// categories a scan is allowed to use in a given environment (e.g. "prod"
+// vs "staging"), so a misconfigured scan can't accidentally fire
+// high-severity attacks at a production, customer-facing target.
+package policy
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/praetorian-inc/augustus/pkg/cli"
+	"gopkg.in/yaml.v3"
+)
+
+// severityOrder ranks severities from least to most dangerous, so an
+// environment's max_severity can be compared against a probe's category
+// severity.
+var severityOrder = map[string]int{
+	"info":     0,
+	"low":      1,
+	"medium":   2,
+	"high":     3,
+	"critical": 4,
+}
+
+// Policy is the top-level structure of a policy YAML file: a set of named
+// environments, each with its own allow-lists and severity cap.
+type Policy struct {
+	Environments map[string]EnvironmentPolicy `yaml:"environments"`
+}
+
+// EnvironmentPolicy restricts what a scan may target within one
+// environment. Empty AllowedProbes/AllowedGenerators mean "no restriction";
+// an empty MaxSeverity means severity isn't checked.
+type EnvironmentPolicy struct {
+	// AllowedProbes lists glob patterns (see pkg/cli.ParseGlob) of probe
+	// names permitted in this environment.
+	AllowedProbes []string `yaml:"allowed_probes,omitempty"`
+
+	// AllowedGenerators lists glob patterns of generator names permitted
+	// in this environment.
+	AllowedGenerators []string `yaml:"allowed_generators,omitempty"`
+
+	// MaxSeverity caps how dangerous a probe's payload category is allowed
+	// to be (info, low, medium, high, critical).
+	MaxSeverity string `yaml:"max_severity,omitempty"`
+
+	// CategorySeverity maps a probe category (the part of its name before
+	// the first '.', e.g. "malwaregen" in "malwaregen.Payload") to a
+	// severity. Categories not listed here are treated as "medium".
+	CategorySeverity map[string]string `yaml:"category_severity,omitempty"`
+}
+
+// Load reads and parses a policy file from path.
+func Load(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("policy: failed to read %s: %w", path, err)
+	}
+
+	var p Policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf("policy: failed to parse %s: %w", path, err)
+	}
+
+	for name, env := range p.Environments {
+		if err := env.validate(); err != nil {
+			return nil, fmt.Errorf("policy: environment %q: %w", name, err)
+		}
+	}
+
+	return &p, nil
+}
+
+func (e EnvironmentPolicy) validate() error {
+	if e.MaxSeverity != "" {
+		if _, ok := severityOrder[e.MaxSeverity]; !ok {
+			return fmt.Errorf("invalid max_severity %q", e.MaxSeverity)
+		}
+	}
+	for category, sev := range e.CategorySeverity {
+		if _, ok := severityOrder[sev]; !ok {
+			return fmt.Errorf("invalid category_severity for %q: %q", category, sev)
+		}
+	}
+	return nil
+}
+
+// Environment returns the named environment's policy, or an error if it
+// isn't defined in the loaded file.
+func (p *Policy) Environment(name string) (*EnvironmentPolicy, error) {
+	env, ok := p.Environments[name]
+	if !ok {
+		return nil, fmt.Errorf("policy: environment %q is not defined", name)
+	}
+	return &env, nil
+}
+
+// Check validates a proposed scan (one generator against a set of probes)
+// against this environment's policy. It returns a descriptive error
+// naming the first rule a scan would violate, so the denial is actionable.
+func (e *EnvironmentPolicy) Check(generatorName string, probeNames []string) error {
+	if len(e.AllowedGenerators) > 0 && !matchesAny(e.AllowedGenerators, generatorName) {
+		return fmt.Errorf("policy: generator %q is not permitted in this environment (allowed: %s)",
+			generatorName, strings.Join(e.AllowedGenerators, ", "))
+	}
+
+	for _, probe := range probeNames {
+		if len(e.AllowedProbes) > 0 && !matchesAny(e.AllowedProbes, probe) {
+			return fmt.Errorf("policy: probe %q is not permitted in this environment (allowed: %s)",
+				probe, strings.Join(e.AllowedProbes, ", "))
+		}
+
+		if e.MaxSeverity != "" {
+			sev := e.categorySeverity(probe)
+			if severityOrder[sev] > severityOrder[e.MaxSeverity] {
+				return fmt.Errorf("policy: probe %q has severity %q, exceeding this environment's max severity %q",
+					probe, sev, e.MaxSeverity)
+			}
+		}
+	}
+
+	return nil
+}
+
+// categorySeverity looks up the severity of a probe's category (the
+// prefix before the first '.'), defaulting to "medium" when the category
+// isn't explicitly rated.
+func (e *EnvironmentPolicy) categorySeverity(probeName string) string {
+	category, _, _ := strings.Cut(probeName, ".")
+	if sev, ok := e.CategorySeverity[category]; ok {
+		return sev
+	}
+	return "medium"
+}
+
+// matchesAny reports whether name matches at least one of the glob
+// patterns, reusing pkg/cli's glob semantics (*prefix, suffix*, *contains*,
+// exact).
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		matches, err := cli.ParseGlob(pattern, []string{name})
+		if err == nil && len(matches) > 0 {
+			return true
+		}
+	}
+	return false
+}
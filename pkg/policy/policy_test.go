@@ -0,0 +1,134 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writePolicy(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestLoad_Basic(t *testing.T) {
+	path := writePolicy(t, `
+environments:
+  staging:
+    allowed_probes: ["*"]
+    allowed_generators: ["*"]
+  prod:
+    allowed_probes: ["encoding.*", "dan.*"]
+    allowed_generators: ["openai.OpenAI"]
+    max_severity: medium
+    category_severity:
+      dan: medium
+      encoding: low
+`)
+
+	p, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(p.Environments) != 2 {
+		t.Fatalf("len(Environments) = %d, want 2", len(p.Environments))
+	}
+}
+
+func TestLoad_InvalidMaxSeverity(t *testing.T) {
+	path := writePolicy(t, `
+environments:
+  prod:
+    max_severity: apocalyptic
+`)
+
+	if _, err := Load(path); err == nil {
+		t.Fatal("Load() error = nil, want error for invalid max_severity")
+	}
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Fatal("Load() error = nil, want error for missing file")
+	}
+}
+
+func TestEnvironment_Undefined(t *testing.T) {
+	path := writePolicy(t, `
+environments:
+  staging:
+    allowed_probes: ["*"]
+`)
+	p, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if _, err := p.Environment("prod"); err == nil {
+		t.Fatal("Environment() error = nil, want error for undefined environment")
+	}
+}
+
+func TestCheck_DeniesDisallowedProbe(t *testing.T) {
+	env := &EnvironmentPolicy{AllowedProbes: []string{"encoding.*"}}
+
+	if err := env.Check("openai.OpenAI", []string{"malwaregen.Payload"}); err == nil {
+		t.Fatal("Check() error = nil, want denial for disallowed probe")
+	}
+}
+
+func TestCheck_DeniesDisallowedGenerator(t *testing.T) {
+	env := &EnvironmentPolicy{AllowedGenerators: []string{"openai.*"}}
+
+	if err := env.Check("anthropic.Anthropic", []string{"encoding.Base64"}); err == nil {
+		t.Fatal("Check() error = nil, want denial for disallowed generator")
+	}
+}
+
+func TestCheck_AllowsPermittedProbesAndGenerator(t *testing.T) {
+	env := &EnvironmentPolicy{
+		AllowedProbes:     []string{"encoding.*"},
+		AllowedGenerators: []string{"openai.*"},
+	}
+
+	if err := env.Check("openai.OpenAI", []string{"encoding.Base64"}); err != nil {
+		t.Errorf("Check() error = %v, want nil", err)
+	}
+}
+
+func TestCheck_DeniesSeverityAboveMax(t *testing.T) {
+	env := &EnvironmentPolicy{
+		MaxSeverity:      "low",
+		CategorySeverity: map[string]string{"malwaregen": "critical"},
+	}
+
+	err := env.Check("openai.OpenAI", []string{"malwaregen.Payload"})
+	if err == nil {
+		t.Fatal("Check() error = nil, want denial for severity above max")
+	}
+}
+
+func TestCheck_UnratedCategoryDefaultsToMedium(t *testing.T) {
+	env := &EnvironmentPolicy{MaxSeverity: "medium"}
+
+	if err := env.Check("openai.OpenAI", []string{"unknown.Probe"}); err != nil {
+		t.Errorf("Check() error = %v, want nil (unrated category defaults to medium)", err)
+	}
+
+	env.MaxSeverity = "low"
+	if err := env.Check("openai.OpenAI", []string{"unknown.Probe"}); err == nil {
+		t.Error("Check() error = nil, want denial (medium default exceeds max_severity low)")
+	}
+}
+
+func TestCheck_NoRestrictionsAllowsEverything(t *testing.T) {
+	env := &EnvironmentPolicy{}
+
+	if err := env.Check("anything.Generator", []string{"anything.Probe"}); err != nil {
+		t.Errorf("Check() error = %v, want nil for unrestricted environment", err)
+	}
+}
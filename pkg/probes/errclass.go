@@ -0,0 +1,58 @@
+package probes
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ErrorClass labels the coarse category of a failed generator call, stamped
+// onto attempt.Metadata["error_class"] so operators can distinguish
+// throttling from genuine refusal-by-API when triaging failures.
+type ErrorClass string
+
+const (
+	ErrorClassTimeout       ErrorClass = "timeout"
+	ErrorClassRateLimit     ErrorClass = "rate_limit"
+	ErrorClassAuth          ErrorClass = "auth"
+	ErrorClassContentFilter ErrorClass = "content_filter"
+	ErrorClassUnknown       ErrorClass = "unknown"
+)
+
+// httpStatusPattern matches the first 3-digit HTTP status code in an error
+// message, e.g. "rest: rate limited: 429 Too Many Requests".
+var httpStatusPattern = regexp.MustCompile(`\b([1-5]\d{2})\b`)
+
+// ClassifyError buckets a generator error into a coarse ErrorClass and
+// extracts an HTTP status code when the error message contains one.
+// Classification is message-based since generator implementations wrap
+// provider errors as plain errors rather than a typed error hierarchy.
+// Returns ("", 0) for a nil error.
+func ClassifyError(err error) (class ErrorClass, httpStatus int) {
+	if err == nil {
+		return "", 0
+	}
+
+	msg := strings.ToLower(err.Error())
+
+	switch {
+	case strings.Contains(msg, "deadline exceeded") || strings.Contains(msg, "timeout") || strings.Contains(msg, "timed out"):
+		class = ErrorClassTimeout
+	case strings.Contains(msg, "rate limit") || strings.Contains(msg, "too many requests"):
+		class = ErrorClassRateLimit
+	case strings.Contains(msg, "authentication") || strings.Contains(msg, "unauthorized") || strings.Contains(msg, "invalid api key"):
+		class = ErrorClassAuth
+	case strings.Contains(msg, "content filter") || strings.Contains(msg, "content_filter") || strings.Contains(msg, "blocked_by_provider") ||
+		strings.Contains(msg, "policy violation") || strings.Contains(msg, "content_policy") || strings.Contains(msg, "refusal") || strings.Contains(msg, "prompt was blocked"):
+		class = ErrorClassContentFilter
+	default:
+		class = ErrorClassUnknown
+	}
+
+	if m := httpStatusPattern.FindStringSubmatch(err.Error()); m != nil {
+		for _, d := range m[1] {
+			httpStatus = httpStatus*10 + int(d-'0')
+		}
+	}
+
+	return class, httpStatus
+}
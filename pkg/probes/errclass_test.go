@@ -0,0 +1,58 @@
+package probes_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		wantClass      probes.ErrorClass
+		wantHTTPStatus int
+	}{
+		{
+			name:      "nil error",
+			err:       nil,
+			wantClass: "",
+		},
+		{
+			name:      "context deadline exceeded",
+			err:       errors.New("context deadline exceeded"),
+			wantClass: probes.ErrorClassTimeout,
+		},
+		{
+			name:           "rate limit with status code",
+			err:            errors.New("rest: rate limited: 429 Too Many Requests"),
+			wantClass:      probes.ErrorClassRateLimit,
+			wantHTTPStatus: 429,
+		},
+		{
+			name:      "authentication error",
+			err:       errors.New("anthropic: authentication error: missing API key"),
+			wantClass: probes.ErrorClassAuth,
+		},
+		{
+			name:      "content filter",
+			err:       errors.New("azure: content filter triggered"),
+			wantClass: probes.ErrorClassContentFilter,
+		},
+		{
+			name:      "unrecognized error",
+			err:       errors.New("connection reset by peer"),
+			wantClass: probes.ErrorClassUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class, httpStatus := probes.ClassifyError(tt.err)
+			assert.Equal(t, tt.wantClass, class)
+			assert.Equal(t, tt.wantHTTPStatus, httpStatus)
+		})
+	}
+}
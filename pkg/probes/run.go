@@ -2,11 +2,18 @@ package probes
 
 import (
 	"context"
+	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/tokens"
 	"github.com/praetorian-inc/augustus/pkg/types"
 )
 
+// completionTokenReserve is subtracted from a generator's advertised context
+// window before sizing a prompt, leaving room for the model's own
+// completion within that same window.
+const completionTokenReserve = 512
+
 // RunPrompts executes multiple prompts sequentially against a generator.
 //
 // For each prompt it creates a conversation, sends it to the generator, and
@@ -50,8 +57,76 @@ func RunPrompts(
 	probeName string,
 	detector string,
 	metadataFn func(i int, prompt string, a *attempt.Attempt),
+) ([]*attempt.Attempt, error) {
+	return RunPromptsWithOptions(ctx, gen, prompts, probeName, detector, metadataFn, nil)
+}
+
+// OutcomeBlockedByProvider marks an attempt's Metadata["outcome"] when a
+// generator reports, via attempt.Message.BlockReason, that the provider's
+// own content filter intercepted the call. Such attempts complete with
+// empty outputs but are neither an error nor an ordinary pass.
+const OutcomeBlockedByProvider = "blocked_by_provider"
+
+// IntermediateStepReporter is an optional interface a generator can
+// implement to expose the intermediate steps (e.g. an agent's tool-call
+// trace) produced alongside its most recent Generate call. RunPromptsWithOptions
+// checks for this after every successful Generate call and stamps the steps
+// onto the attempt's Metadata so they appear in reports next to the final
+// output, instead of being discarded.
+type IntermediateStepReporter interface {
+	// IntermediateSteps returns the steps taken during the last Generate
+	// call, or nil if none were reported.
+	IntermediateSteps() []any
+}
+
+// MetadataKeyIntermediateSteps is the attempt.Metadata key under which
+// IntermediateStepReporter output is stored.
+const MetadataKeyIntermediateSteps = "intermediate_steps"
+
+// MetadataKeyToolCalls is the attempt.Metadata key under which
+// ToolCallReporter output is stored. Detectors like agent.ToolManipulation
+// and agent.DangerousToolCall read tool calls from this key.
+const MetadataKeyToolCalls = "tool_calls"
+
+// MetadataKeyThinking is the attempt.Metadata key under which
+// attempt.Message.Thinking is stamped.
+const MetadataKeyThinking = "thinking"
+
+// RunOptions adds adaptive early-stopping to RunPromptsWithOptions. A zero
+// value RunOptions (or a nil *RunOptions) behaves exactly like RunPrompts:
+// every prompt is issued regardless of failures or vulnerability scores.
+type RunOptions struct {
+	// MaxConsecutiveFailures stops issuing further prompts once this many
+	// generator calls in a row have failed. Zero means unlimited.
+	MaxConsecutiveFailures int
+
+	// Detector, when set, scores each attempt immediately after it completes
+	// so EarlyStopOnVuln can act on it without waiting for the harness's
+	// end-of-scan detection pass. Results are stored on the attempt via
+	// SetDetectorResults, so the harness's later detection pass reuses them
+	// instead of re-scoring.
+	Detector types.Detector
+
+	// EarlyStopOnVuln stops issuing further prompts once Detector reports a
+	// score at or above attempt.DefaultVulnerabilityThreshold. Has no effect
+	// if Detector is nil.
+	EarlyStopOnVuln bool
+}
+
+// RunPromptsWithOptions is RunPrompts with adaptive early-stopping. Probes
+// that want to cut scan time and API spend on already-vulnerable targets can
+// pass a non-nil opts; see RunOptions. A nil opts is equivalent to RunPrompts.
+func RunPromptsWithOptions(
+	ctx context.Context,
+	gen types.Generator,
+	prompts []string,
+	probeName string,
+	detector string,
+	metadataFn func(i int, prompt string, a *attempt.Attempt),
+	opts *RunOptions,
 ) ([]*attempt.Attempt, error) {
 	attempts := make([]*attempt.Attempt, 0, len(prompts))
+	consecutiveFailures := 0
 
 	for i, prompt := range prompts {
 		// Check for context cancellation before each request.
@@ -61,14 +136,28 @@ func RunPrompts(
 		default:
 		}
 
+		sizedPrompt := prompt
+		if cw, ok := gen.(types.GeneratorContextWindow); ok {
+			if budget := cw.MaxContextTokens() - completionTokenReserve; budget > 0 {
+				sizedPrompt = tokens.Truncate(prompt, budget)
+			}
+		}
+
 		conv := attempt.NewConversation()
-		conv.AddPrompt(prompt)
+		conv.AddPrompt(sizedPrompt)
 
+		start := time.Now()
 		responses, err := gen.Generate(ctx, conv, 1)
+		elapsed := time.Since(start)
 
-		a := attempt.New(prompt)
+		a := attempt.New(sizedPrompt)
 		a.Probe = probeName
 		a.Detector = detector
+		a.Duration = elapsed
+		if sizedPrompt != prompt {
+			a.Metadata["prompt_truncated"] = true
+			a.Metadata["original_prompt_tokens"] = tokens.Estimate(prompt)
+		}
 
 		// Apply optional per-attempt metadata.
 		if metadataFn != nil {
@@ -77,14 +166,62 @@ func RunPrompts(
 
 		if err != nil {
 			a.SetError(err)
+			class, httpStatus := ClassifyError(err)
+			a.Metadata["error_class"] = string(class)
+			if httpStatus != 0 {
+				a.Metadata["http_status"] = httpStatus
+			}
 		} else {
+			var toolCalls []map[string]any
+			var thinking []string
 			for _, resp := range responses {
 				a.AddOutput(resp.Content)
+				if resp.BlockReason != "" {
+					a.Metadata["outcome"] = OutcomeBlockedByProvider
+					a.Metadata["block_reason"] = resp.BlockReason
+				}
+				toolCalls = append(toolCalls, resp.ToolCalls...)
+				thinking = append(thinking, resp.Thinking...)
 			}
 			a.Complete()
+			if len(toolCalls) > 0 {
+				a.Metadata[MetadataKeyToolCalls] = toolCalls
+			}
+			if len(thinking) > 0 {
+				a.Metadata[MetadataKeyThinking] = thinking
+			}
+			if isr, ok := gen.(IntermediateStepReporter); ok {
+				if steps := isr.IntermediateSteps(); len(steps) > 0 {
+					a.Metadata[MetadataKeyIntermediateSteps] = steps
+				}
+			}
 		}
 
 		attempts = append(attempts, a)
+
+		if opts == nil {
+			continue
+		}
+
+		if err != nil {
+			consecutiveFailures++
+			if opts.MaxConsecutiveFailures > 0 && consecutiveFailures >= opts.MaxConsecutiveFailures {
+				break
+			}
+			continue
+		}
+		consecutiveFailures = 0
+
+		if opts.EarlyStopOnVuln && opts.Detector != nil {
+			scores, detErr := opts.Detector.Detect(ctx, a)
+			if detErr == nil {
+				a.SetDetectorResults(opts.Detector.Name(), scores)
+				a.Scores = scores
+				if a.IsVulnerable() {
+					break
+				}
+			}
+		}
 	}
 
 	return attempts, nil
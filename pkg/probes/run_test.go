@@ -3,10 +3,12 @@ package probes_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/tokens"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -73,6 +75,21 @@ func TestRunPrompts_GeneratorError(t *testing.T) {
 	assert.Empty(t, att.Outputs, "outputs should be empty on error")
 }
 
+func TestRunPrompts_RecordsDurationAndErrorClass(t *testing.T) {
+	gen := &mockGen{err: errors.New("rate limit exceeded: 429")}
+	prompts := []string{"prompt1"}
+
+	attempts, err := probes.RunPrompts(context.Background(), gen, prompts, "test-probe", "test-detector", nil)
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+
+	a := attempts[0]
+	assert.GreaterOrEqual(t, a.Duration.Nanoseconds(), int64(0), "duration should be recorded")
+	assert.Equal(t, "rate_limit", a.Metadata["error_class"])
+	assert.Equal(t, 429, a.Metadata["http_status"])
+}
+
 func TestRunPrompts_ContextCancellation(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // Cancel immediately
@@ -120,3 +137,169 @@ func TestRunPrompts_EmptyPrompts(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, attempts, "should return empty slice for empty prompts")
 }
+
+// mockDetector is a mock implementation of types.Detector for testing.
+type mockDetector struct {
+	scores []float64
+}
+
+func (m *mockDetector) Detect(ctx context.Context, a *attempt.Attempt) ([]float64, error) {
+	return m.scores, nil
+}
+
+func (m *mockDetector) Name() string {
+	return "mock-detector"
+}
+
+func (m *mockDetector) Description() string {
+	return "Mock detector for testing"
+}
+
+func TestRunPromptsWithOptions_NilOptsMatchesRunPrompts(t *testing.T) {
+	gen := &mockGen{}
+	prompts := []string{"prompt1", "prompt2"}
+
+	attempts, err := probes.RunPromptsWithOptions(context.Background(), gen, prompts, "test-probe", "test-detector", nil, nil)
+
+	require.NoError(t, err)
+	assert.Len(t, attempts, 2, "nil opts should issue every prompt")
+}
+
+func TestRunPromptsWithOptions_StopsAfterMaxConsecutiveFailures(t *testing.T) {
+	gen := &mockGen{err: errors.New("boom")}
+	prompts := []string{"prompt1", "prompt2", "prompt3", "prompt4"}
+
+	attempts, err := probes.RunPromptsWithOptions(context.Background(), gen, prompts, "test-probe", "test-detector", nil,
+		&probes.RunOptions{MaxConsecutiveFailures: 2})
+
+	require.NoError(t, err)
+	assert.Len(t, attempts, 2, "should stop after 2 consecutive failures")
+	for _, a := range attempts {
+		assert.Equal(t, attempt.StatusError, a.Status)
+	}
+}
+
+func TestRunPromptsWithOptions_ResetsFailureStreakOnSuccess(t *testing.T) {
+	calls := 0
+	gen := &mockGen{generateFunc: func(ctx context.Context, conv *attempt.Conversation, n int) ([]attempt.Message, error) {
+		calls++
+		if calls == 2 {
+			return nil, errors.New("transient")
+		}
+		return []attempt.Message{{Content: "ok"}}, nil
+	}}
+	prompts := []string{"prompt1", "prompt2", "prompt3", "prompt4"}
+
+	attempts, err := probes.RunPromptsWithOptions(context.Background(), gen, prompts, "test-probe", "test-detector", nil,
+		&probes.RunOptions{MaxConsecutiveFailures: 2})
+
+	require.NoError(t, err)
+	assert.Len(t, attempts, 4, "a single failure shouldn't trip a streak of 2")
+}
+
+func TestRunPromptsWithOptions_StopsEarlyOnVulnerability(t *testing.T) {
+	gen := &mockGen{}
+	prompts := []string{"prompt1", "prompt2", "prompt3"}
+	det := &mockDetector{scores: []float64{0.9}}
+
+	attempts, err := probes.RunPromptsWithOptions(context.Background(), gen, prompts, "test-probe", "test-detector", nil,
+		&probes.RunOptions{EarlyStopOnVuln: true, Detector: det})
+
+	require.NoError(t, err)
+	assert.Len(t, attempts, 1, "should stop after the first vulnerable attempt")
+	assert.True(t, attempts[0].IsVulnerable())
+}
+
+func TestRunPromptsWithOptions_ContinuesWhenNotVulnerable(t *testing.T) {
+	gen := &mockGen{}
+	prompts := []string{"prompt1", "prompt2"}
+	det := &mockDetector{scores: []float64{0.1}}
+
+	attempts, err := probes.RunPromptsWithOptions(context.Background(), gen, prompts, "test-probe", "test-detector", nil,
+		&probes.RunOptions{EarlyStopOnVuln: true, Detector: det})
+
+	require.NoError(t, err)
+	assert.Len(t, attempts, 2, "should keep going while no attempt scores as vulnerable")
+}
+
+func TestRunPrompts_StampsToolCallsFromMessage(t *testing.T) {
+	calls := []map[string]any{{"name": "execute_shell"}}
+	gen := &mockGen{generateFunc: func(context.Context, *attempt.Conversation, int) ([]attempt.Message, error) {
+		return []attempt.Message{{Content: "ok", ToolCalls: calls}}, nil
+	}}
+
+	attempts, err := probes.RunPrompts(context.Background(), gen, []string{"prompt1"}, "test-probe", "test-detector", nil)
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	assert.Equal(t, calls, attempts[0].Metadata[probes.MetadataKeyToolCalls])
+}
+
+func TestRunPrompts_NoToolCallsMetadataWhenNoneReported(t *testing.T) {
+	gen := &mockGen{}
+
+	attempts, err := probes.RunPrompts(context.Background(), gen, []string{"prompt1"}, "test-probe", "test-detector", nil)
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	_, ok := attempts[0].Metadata[probes.MetadataKeyToolCalls]
+	assert.False(t, ok, "should not stamp tool_calls metadata when the message reports none")
+}
+
+// mockContextWindowGen declares a fixed context window, implementing
+// types.GeneratorContextWindow.
+type mockContextWindowGen struct {
+	mockGen
+	maxContextTokens int
+}
+
+func (m *mockContextWindowGen) MaxContextTokens() int {
+	return m.maxContextTokens
+}
+
+func TestRunPrompts_TruncatesPromptToFitContextWindow(t *testing.T) {
+	var sentPrompt string
+	gen := &mockContextWindowGen{
+		mockGen: mockGen{
+			generateFunc: func(_ context.Context, conv *attempt.Conversation, _ int) ([]attempt.Message, error) {
+				sentPrompt = conv.LastPrompt()
+				return []attempt.Message{{Content: "ok"}}, nil
+			},
+		},
+		maxContextTokens: 520, // 520 - 512 completion reserve = 8 tokens = 32 chars budget
+	}
+	longPrompt := strings.Repeat("a", 1000)
+
+	attempts, err := probes.RunPrompts(context.Background(), gen, []string{longPrompt}, "test-probe", "test-detector", nil)
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	assert.Less(t, len(sentPrompt), len(longPrompt), "oversized prompt should have been truncated before sending")
+	assert.Equal(t, sentPrompt, attempts[0].Prompt, "recorded prompt should match what was actually sent")
+	assert.Equal(t, true, attempts[0].Metadata["prompt_truncated"])
+	assert.Equal(t, tokens.Estimate(longPrompt), attempts[0].Metadata["original_prompt_tokens"])
+}
+
+func TestRunPrompts_DoesNotTruncateWhenPromptFitsContextWindow(t *testing.T) {
+	gen := &mockContextWindowGen{maxContextTokens: 100000}
+	prompt := "short prompt"
+
+	attempts, err := probes.RunPrompts(context.Background(), gen, []string{prompt}, "test-probe", "test-detector", nil)
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	assert.Equal(t, prompt, attempts[0].Prompt)
+	_, ok := attempts[0].Metadata["prompt_truncated"]
+	assert.False(t, ok, "should not stamp truncation metadata when the prompt already fits")
+}
+
+func TestRunPrompts_NoTruncationWhenGeneratorDoesNotDeclareContextWindow(t *testing.T) {
+	gen := &mockGen{}
+	longPrompt := strings.Repeat("a", 1000)
+
+	attempts, err := probes.RunPrompts(context.Background(), gen, []string{longPrompt}, "test-probe", "test-detector", nil)
+
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	assert.Equal(t, longPrompt, attempts[0].Prompt, "a generator without GeneratorContextWindow should receive the prompt unmodified")
+}
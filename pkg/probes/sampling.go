@@ -0,0 +1,84 @@
+package probes
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// SampleStrategy selects how CapIndices trims a prompt list down to a target size.
+type SampleStrategy string
+
+const (
+	// SampleHead keeps the first N prompts, preserving their original order.
+	SampleHead SampleStrategy = "head"
+	// SampleRandom keeps a uniform random subset of N prompts.
+	SampleRandom SampleStrategy = "random"
+	// SampleStratified keeps N prompts spread evenly across the full list,
+	// instead of clustering at the start like SampleHead.
+	SampleStratified SampleStrategy = "stratified"
+)
+
+// SampleOptions configures prompt capping. A zero Size means no cap.
+type SampleOptions struct {
+	Size     int
+	Strategy SampleStrategy
+}
+
+// SampleOptionsFromMap reads sample_size and sample_strategy from a probe's
+// config, defaulting to no cap and SampleHead.
+func SampleOptionsFromMap(cfg registry.Config) SampleOptions {
+	return SampleOptions{
+		Size:     registry.GetInt(cfg, "sample_size", 0),
+		Strategy: SampleStrategy(registry.GetString(cfg, "sample_strategy", string(SampleHead))),
+	}
+}
+
+// CapIndices returns, in ascending order, the indices of n prompts to keep
+// under opts. A Size of 0 or a Size >= n keeps everything. Returning indices
+// rather than a trimmed copy lets callers apply the same cap to parallel
+// per-prompt state (e.g. SimpleProbe's MetadataFn) without losing alignment.
+func CapIndices(n int, opts SampleOptions) []int {
+	if opts.Size <= 0 || opts.Size >= n {
+		return allIndices(n)
+	}
+
+	switch opts.Strategy {
+	case SampleRandom:
+		indices := rand.Perm(n)[:opts.Size]
+		sort.Ints(indices)
+		return indices
+	case SampleStratified:
+		indices := make([]int, opts.Size)
+		step := float64(n) / float64(opts.Size)
+		for i := range indices {
+			indices[i] = int(float64(i) * step)
+		}
+		return indices
+	default: // SampleHead
+		indices := make([]int, opts.Size)
+		for i := range indices {
+			indices[i] = i
+		}
+		return indices
+	}
+}
+
+// CapPrompts trims prompts down to opts.Size per opts.Strategy.
+func CapPrompts(prompts []string, opts SampleOptions) []string {
+	indices := CapIndices(len(prompts), opts)
+	capped := make([]string, len(indices))
+	for i, idx := range indices {
+		capped[i] = prompts[idx]
+	}
+	return capped
+}
+
+func allIndices(n int) []int {
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indices
+}
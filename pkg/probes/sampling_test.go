@@ -0,0 +1,88 @@
+package probes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapIndices_NoCapWhenSizeZeroOrOverLength(t *testing.T) {
+	assert.Equal(t, []int{0, 1, 2}, probes.CapIndices(3, probes.SampleOptions{}))
+	assert.Equal(t, []int{0, 1, 2}, probes.CapIndices(3, probes.SampleOptions{Size: 10}))
+}
+
+func TestCapIndices_Head(t *testing.T) {
+	indices := probes.CapIndices(10, probes.SampleOptions{Size: 3, Strategy: probes.SampleHead})
+	assert.Equal(t, []int{0, 1, 2}, indices)
+}
+
+func TestCapIndices_Stratified(t *testing.T) {
+	indices := probes.CapIndices(10, probes.SampleOptions{Size: 5, Strategy: probes.SampleStratified})
+	require.Len(t, indices, 5)
+	assert.Equal(t, []int{0, 2, 4, 6, 8}, indices)
+}
+
+func TestCapIndices_Random(t *testing.T) {
+	indices := probes.CapIndices(10, probes.SampleOptions{Size: 4, Strategy: probes.SampleRandom})
+	require.Len(t, indices, 4)
+
+	seen := map[int]bool{}
+	for i, idx := range indices {
+		assert.GreaterOrEqual(t, idx, 0)
+		assert.Less(t, idx, 10)
+		assert.False(t, seen[idx], "indices must not repeat")
+		seen[idx] = true
+		if i > 0 {
+			assert.Greater(t, idx, indices[i-1], "indices must be ascending")
+		}
+	}
+}
+
+func TestCapPrompts(t *testing.T) {
+	prompts := []string{"a", "b", "c", "d"}
+	capped := probes.CapPrompts(prompts, probes.SampleOptions{Size: 2, Strategy: probes.SampleHead})
+	assert.Equal(t, []string{"a", "b"}, capped)
+}
+
+func TestSampleOptionsFromMap(t *testing.T) {
+	opts := probes.SampleOptionsFromMap(registry.Config{"sample_size": 5, "sample_strategy": "random"})
+	assert.Equal(t, probes.SampleOptions{Size: 5, Strategy: probes.SampleRandom}, opts)
+
+	defaults := probes.SampleOptionsFromMap(registry.Config{})
+	assert.Equal(t, probes.SampleOptions{Size: 0, Strategy: probes.SampleHead}, defaults)
+}
+
+func TestSimpleProbe_SetPromptCap_LimitsPromptsAndRemapsMetadata(t *testing.T) {
+	prompts := []string{"p0", "p1", "p2", "p3"}
+	probe := probes.NewSimpleProbe("test.Capped", "goal", "detector", "desc", prompts)
+
+	var seenIndices []int
+	probe.MetadataFn = func(i int, prompt string, a *attempt.Attempt) {
+		seenIndices = append(seenIndices, i)
+	}
+	probe.SetPromptCap(probes.SampleOptions{Size: 2, Strategy: probes.SampleHead})
+
+	gen := &mockGen{}
+	attempts, err := probe.Probe(context.Background(), gen)
+
+	require.NoError(t, err)
+	assert.Len(t, attempts, 2)
+	assert.Equal(t, []int{0, 1}, seenIndices)
+}
+
+func TestSimpleProbe_SetPromptCap_DoesNotOverrideExplicitCap(t *testing.T) {
+	probe := probes.NewSimpleProbe("test.Capped", "goal", "detector", "desc", []string{"p0", "p1", "p2"})
+	probe.SetPromptCap(probes.SampleOptions{Size: 1, Strategy: probes.SampleHead})
+
+	// A scan-wide default should not clobber the probe's own explicit cap.
+	probe.SetPromptCap(probes.SampleOptions{Size: 3, Strategy: probes.SampleHead})
+
+	attempts, err := probe.Probe(context.Background(), &mockGen{})
+	require.NoError(t, err)
+	assert.Len(t, attempts, 1)
+}
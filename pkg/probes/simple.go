@@ -13,6 +13,13 @@ import (
 // Embed this struct by pointer (*SimpleProbe) in domain-specific probe types
 // to eliminate boilerplate. For probes that need per-attempt metadata (e.g.
 // trigger tokens), set the MetadataFn callback.
+//
+// This already lives in pkg/probes (not duplicated per-package under
+// internal/probes) so every static-prompt-list probe across categories
+// shares one implementation of prompt iteration, context cancellation, and
+// adaptive/sampling behavior; only probes with genuinely custom generation
+// logic (iterative attack engines, multi-turn/multi-agent probes, etc.)
+// implement Prober directly instead of embedding this.
 type SimpleProbe struct {
 	ProbeName       string
 	ProbeGoal       string
@@ -20,11 +27,42 @@ type SimpleProbe struct {
 	ProbeDescription string
 	Prompts         []string
 
+	// Requirements lists capability tags (see the types.Capability*
+	// constants in pkg/types) this probe needs from its generator, e.g.
+	// "tools" for a tool-abuse probe. Empty means no special requirements.
+	Requirements []string
+
 	// MetadataFn is an optional callback invoked for each attempt after it is
 	// created but before outputs are added. The index corresponds to the
 	// position of the prompt in Prompts. Use this to attach per-attempt
 	// metadata such as trigger tokens.
 	MetadataFn func(i int, prompt string, a *attempt.Attempt)
+
+	// adaptiveOpts carries early-stopping behavior set via SetAdaptiveOptions.
+	// Zero value means no early stopping, matching plain RunPrompts behavior.
+	adaptiveOpts RunOptions
+
+	// promptCap carries prompt sampling behavior set via SetPromptCap. Zero
+	// value means no cap, so every prompt in Prompts runs.
+	promptCap SampleOptions
+}
+
+// SetAdaptiveOptions implements harnesses.Adaptive, letting an adaptive
+// harness configure early-stopping for this probe's prompt loop.
+func (s *SimpleProbe) SetAdaptiveOptions(opts RunOptions) {
+	s.adaptiveOpts = opts
+}
+
+// SetPromptCap implements harnesses.PromptCapper, letting a harness cap how
+// many of this probe's prompts actually run. A probe that already has an
+// explicit cap (Size != 0, typically set from its own config at construction
+// time) keeps it - a scan-wide default should not override a probe's own
+// choice.
+func (s *SimpleProbe) SetPromptCap(opts SampleOptions) {
+	if s.promptCap.Size != 0 {
+		return
+	}
+	s.promptCap = opts
 }
 
 // NewSimpleProbe creates a new SimpleProbe with the given configuration.
@@ -39,9 +77,28 @@ func NewSimpleProbe(name, goal, detector, description string, prompts []string)
 }
 
 // Probe executes the probe against the generator by iterating over all prompts.
-// It checks for context cancellation between iterations.
+// It checks for context cancellation between iterations. If promptCap is set,
+// only a subset of Prompts runs; MetadataFn is remapped so it still sees the
+// original index into Prompts.
 func (s *SimpleProbe) Probe(ctx context.Context, gen Generator) ([]*attempt.Attempt, error) {
-	return RunPrompts(ctx, gen, s.Prompts, s.Name(), s.GetPrimaryDetector(), s.MetadataFn)
+	prompts := s.Prompts
+	metadataFn := s.MetadataFn
+
+	if s.promptCap.Size > 0 && s.promptCap.Size < len(s.Prompts) {
+		indices := CapIndices(len(s.Prompts), s.promptCap)
+		capped := make([]string, len(indices))
+		for i, idx := range indices {
+			capped[i] = s.Prompts[idx]
+		}
+		prompts = capped
+		if s.MetadataFn != nil {
+			metadataFn = func(i int, prompt string, a *attempt.Attempt) {
+				s.MetadataFn(indices[i], prompt, a)
+			}
+		}
+	}
+
+	return RunPromptsWithOptions(ctx, gen, prompts, s.Name(), s.GetPrimaryDetector(), metadataFn, &s.adaptiveOpts)
 }
 
 // Name returns the probe's fully qualified name.
@@ -68,3 +125,8 @@ func (s *SimpleProbe) GetPrimaryDetector() string {
 func (s *SimpleProbe) GetPrompts() []string {
 	return s.Prompts
 }
+
+// Requires implements types.ProbeRequirements.
+func (s *SimpleProbe) Requires() []string {
+	return s.Requirements
+}
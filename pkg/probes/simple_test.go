@@ -132,4 +132,21 @@ func TestSimpleProbe_Probe(t *testing.T) {
 		assert.Contains(t, err.Error(), "context canceled", "error should indicate context cancellation")
 		assert.Empty(t, attempts, "should not return attempts when context cancelled")
 	})
+
+	t.Run("adaptive options stop the run early", func(t *testing.T) {
+		// Setup
+		probe := probes.NewSimpleProbe("test", "goal", "detector", "desc", []string{"p1", "p2", "p3"})
+		probe.SetAdaptiveOptions(probes.RunOptions{
+			EarlyStopOnVuln: true,
+			Detector:        &mockDetector{scores: []float64{0.9}},
+		})
+		gen := &mockGen{}
+
+		// Execute
+		attempts, err := probe.Probe(context.Background(), gen)
+
+		// Verify
+		require.NoError(t, err)
+		assert.Len(t, attempts, 1, "should stop after the first vulnerable attempt")
+	})
 }
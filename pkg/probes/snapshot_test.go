@@ -0,0 +1,121 @@
+package probes_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/probes"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/types"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/praetorian-inc/augustus/pkg/register/probes"
+)
+
+// updateGoldens regenerates testdata/prompts goldens instead of comparing
+// against them. Set via `go test -run TestPromptSnapshots -update` or
+// `make test-snapshot-update`.
+var updateGoldens = flag.Bool("update", false, "regenerate prompt snapshot goldens")
+
+const promptGoldenDir = "testdata/prompts"
+
+// nonDeterministicPrompts lists probes whose prompts are randomized at
+// construction time via the unseeded global math/rand source (e.g. DRA's
+// token mutation), so they have no stable output to snapshot.
+var nonDeterministicPrompts = map[string]bool{
+	"dra.DRA":         true,
+	"dra.DRAAdvanced": true,
+}
+
+// Golden files store one strconv.Quote-escaped prompt per line rather than
+// JSON: several probes (ansiescape, continuation) deliberately generate
+// prompts containing invalid UTF-8 byte sequences, which encoding/json
+// silently mangles into U+FFFD on marshal. Quoting preserves every byte
+// exactly and keeps each prompt on its own diff-friendly line.
+
+// TestPromptSnapshots guards against accidental prompt drift: every
+// registered probe that exposes a static prompt list (types.ProbeMetadata)
+// has its prompts compared against a golden file under testdata/prompts.
+// Probes that require construction config (e.g. a corpus file path) and
+// fail with an empty config are skipped - they have no default prompt set
+// to snapshot.
+//
+// Run with -update to regenerate goldens after an intentional prompt change.
+func TestPromptSnapshots(t *testing.T) {
+	for _, name := range probes.List() {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			if name == "test-probe" {
+				// Registered into the shared global registry by
+				// TestRegistry_RegisterAndGet, not a shipped probe.
+				t.Skip("synthetic probe registered by another test, not a real probe")
+			}
+			if nonDeterministicPrompts[name] {
+				t.Skip("probe randomizes prompts at construction time, nothing stable to snapshot")
+			}
+
+			prober, err := probes.Create(name, registry.Config{})
+			if err != nil {
+				t.Skipf("probe requires non-default config, skipping: %v", err)
+			}
+
+			meta, ok := prober.(types.ProbeMetadata)
+			if !ok {
+				t.Skip("probe does not expose static prompts (types.ProbeMetadata)")
+			}
+
+			prompts := meta.GetPrompts()
+			if prompts == nil {
+				// Normalize nil vs. empty-but-non-nil so probes with no
+				// prompts compare equal regardless of which one they return.
+				prompts = []string{}
+			}
+			goldenPath := filepath.Join(promptGoldenDir, name+".golden")
+
+			if *updateGoldens {
+				writePromptGolden(t, goldenPath, prompts)
+				return
+			}
+
+			want := readPromptGolden(t, goldenPath)
+			require.Equal(t, want, prompts, "prompts for probe %q drifted from golden %s; run `make test-snapshot-update` if this is intentional", name, goldenPath)
+		})
+	}
+}
+
+func writePromptGolden(t *testing.T, path string, prompts []string) {
+	t.Helper()
+
+	var sb strings.Builder
+	for _, p := range prompts {
+		sb.WriteString(strconv.Quote(p))
+		sb.WriteByte('\n')
+	}
+
+	require.NoError(t, os.WriteFile(path, []byte(sb.String()), 0o644))
+}
+
+func readPromptGolden(t *testing.T, path string) []string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err, "missing golden file %s; run `make test-snapshot-update` to generate it", path)
+
+	trimmed := strings.TrimSuffix(string(data), "\n")
+	if trimmed == "" {
+		return []string{}
+	}
+
+	lines := strings.Split(trimmed, "\n")
+	prompts := make([]string, len(lines))
+	for i, line := range lines {
+		unquoted, err := strconv.Unquote(line)
+		require.NoError(t, err, "golden file %s has malformed line %d", path, i)
+		prompts[i] = unquoted
+	}
+	return prompts
+}
@@ -0,0 +1,98 @@
+// Package prompttemplate builds attempt.Conversation values from Go
+// text/template definitions, so probes can declare a system prompt and
+// few-shot scaffolding as data instead of assembling strings by hand with
+// strings.ReplaceAll or fmt.Sprintf. Because the templates are plain strings,
+// probe config can override them (see FromConfig) for localization or
+// customization without code changes.
+package prompttemplate
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// Example is one few-shot turn: a sample user prompt and the response the
+// template wants the model to imitate.
+type Example struct {
+	Prompt   string
+	Response string
+}
+
+// Template renders a system prompt, optional few-shot examples, and a final
+// user prompt into an attempt.Conversation. System, each Example, and Prompt
+// are independently parsed as Go text/template strings and executed against
+// whatever data the caller passes to Build.
+type Template struct {
+	// System is the system prompt template. Empty means no system message.
+	System string
+	// Examples are few-shot turns inserted before the final prompt, each
+	// rendered against the same data as System and Prompt.
+	Examples []Example
+	// Prompt is the final user turn's template.
+	Prompt string
+}
+
+// Build renders t against data and returns the resulting conversation.
+func (t *Template) Build(data any) (*attempt.Conversation, error) {
+	conv := attempt.NewConversation()
+
+	if t.System != "" {
+		system, err := render("system", t.System, data)
+		if err != nil {
+			return nil, fmt.Errorf("rendering system template: %w", err)
+		}
+		conv.WithSystem(system)
+	}
+
+	for i, ex := range t.Examples {
+		prompt, err := render(fmt.Sprintf("example[%d].prompt", i), ex.Prompt, data)
+		if err != nil {
+			return nil, fmt.Errorf("rendering example %d prompt: %w", i, err)
+		}
+		response, err := render(fmt.Sprintf("example[%d].response", i), ex.Response, data)
+		if err != nil {
+			return nil, fmt.Errorf("rendering example %d response: %w", i, err)
+		}
+		conv.AddTurn(attempt.NewTurn(prompt).WithResponse(response))
+	}
+
+	prompt, err := render("prompt", t.Prompt, data)
+	if err != nil {
+		return nil, fmt.Errorf("rendering prompt template: %w", err)
+	}
+	conv.AddPrompt(prompt)
+
+	return conv, nil
+}
+
+// FromConfig returns defaults with its System and Prompt templates replaced
+// by any system_prompt_template / prompt_template values found in cfg. Few-
+// shot Examples are left as defaults - scaffolding is expected to stay
+// code-defined, only the surrounding prose is meant to be overridable.
+func FromConfig(cfg registry.Config, defaults Template) Template {
+	t := defaults
+	if v := registry.GetString(cfg, "system_prompt_template", ""); v != "" {
+		t.System = v
+	}
+	if v := registry.GetString(cfg, "prompt_template", ""); v != "" {
+		t.Prompt = v
+	}
+	return t
+}
+
+// render parses and executes a single text/template string against data.
+func render(name, tmplStr string, data any) (string, error) {
+	tmpl, err := template.New(name).Parse(tmplStr)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
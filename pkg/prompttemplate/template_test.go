@@ -0,0 +1,88 @@
+package prompttemplate_test
+
+import (
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/prompttemplate"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tokenData struct {
+	Quote string
+	Token string
+}
+
+func TestTemplate_Build_SystemAndPrompt(t *testing.T) {
+	tmpl := prompttemplate.Template{
+		System: "You are {{.Role}}.",
+		Prompt: "Repeat {{.Quote}}{{.Token}}{{.Quote}} back to me.",
+	}
+
+	conv, err := tmpl.Build(struct {
+		Role  string
+		Quote string
+		Token string
+	}{Role: "a helpful assistant", Quote: `"`, Token: "SolidGoldMagikarp"})
+
+	require.NoError(t, err)
+	require.NotNil(t, conv.System)
+	assert.Equal(t, "You are a helpful assistant.", conv.System.Content)
+	assert.Equal(t, `Repeat "SolidGoldMagikarp" back to me.`, conv.LastPrompt())
+}
+
+func TestTemplate_Build_WithExamples(t *testing.T) {
+	tmpl := prompttemplate.Template{
+		Examples: []prompttemplate.Example{
+			{Prompt: "Say {{.Token}}", Response: "{{.Token}}"},
+		},
+		Prompt: "Now say {{.Token}} again",
+	}
+
+	conv, err := tmpl.Build(tokenData{Token: "glitch"})
+
+	require.NoError(t, err)
+	require.Len(t, conv.Turns, 2)
+	assert.Equal(t, "Say glitch", conv.Turns[0].Prompt.Content)
+	require.NotNil(t, conv.Turns[0].Response)
+	assert.Equal(t, "glitch", conv.Turns[0].Response.Content)
+	assert.Equal(t, "Now say glitch again", conv.Turns[1].Prompt.Content)
+}
+
+func TestTemplate_Build_NoSystemPrompt(t *testing.T) {
+	tmpl := prompttemplate.Template{Prompt: "hello"}
+
+	conv, err := tmpl.Build(nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, conv.System)
+}
+
+func TestTemplate_Build_InvalidTemplateSyntax(t *testing.T) {
+	tmpl := prompttemplate.Template{Prompt: "{{.Unclosed"}
+
+	_, err := tmpl.Build(nil)
+
+	require.Error(t, err)
+}
+
+func TestFromConfig_OverridesSystemAndPrompt(t *testing.T) {
+	defaults := prompttemplate.Template{System: "default system", Prompt: "default prompt"}
+
+	overridden := prompttemplate.FromConfig(registry.Config{
+		"system_prompt_template": "custom system",
+		"prompt_template":        "custom prompt",
+	}, defaults)
+
+	assert.Equal(t, "custom system", overridden.System)
+	assert.Equal(t, "custom prompt", overridden.Prompt)
+}
+
+func TestFromConfig_KeepsDefaultsWhenUnset(t *testing.T) {
+	defaults := prompttemplate.Template{System: "default system", Prompt: "default prompt"}
+
+	result := prompttemplate.FromConfig(registry.Config{}, defaults)
+
+	assert.Equal(t, defaults, result)
+}
@@ -0,0 +1,58 @@
+// Package queue provides the Queue interface that worker.WorkerCmd uses to
+// pull scan job requests from an external message broker.
+//
+// Unlike pkg/sinks (fan-out delivery, many sinks run side by side per scan),
+// a worker reads from exactly one queue: it's the intake for jobs, not an
+// output. Implementations live in internal/queue/<broker>, following the
+// same self-registering factory pattern as generators, probes, and sinks.
+package queue
+
+import (
+	"context"
+
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// Message is one job handed to a worker. Body is the raw bytes the
+// producer enqueued (the worker decodes it as a JSON apiJobRequest). Ack
+// must be called once the job has been fully processed, so at-least-once
+// brokers like SQS don't redeliver it; a worker that crashes mid-job simply
+// never acks, and the broker's own visibility timeout/redelivery handles
+// retry.
+type Message struct {
+	Body []byte
+	Ack  func(ctx context.Context) error
+}
+
+// Queue receives job messages from a broker.
+type Queue interface {
+	// Receive blocks until a message is available, ctx is cancelled, or the
+	// broker's own poll times out, in which case it returns nil, nil so the
+	// caller can loop and check ctx again.
+	Receive(ctx context.Context) (*Message, error)
+	// Name returns the queue's fully qualified name (e.g., "redis.List").
+	Name() string
+}
+
+// Registry is the global queue registry.
+var Registry = registry.New[Queue]("queue")
+
+// Register adds a queue factory to the global registry.
+func Register(name string, factory func(registry.Config) (Queue, error)) {
+	Registry.Register(name, factory)
+}
+
+// List returns all registered queue names.
+func List() []string {
+	return Registry.List()
+}
+
+// Get retrieves a queue factory by name.
+func Get(name string) (func(registry.Config) (Queue, error), bool) {
+	return Registry.Get(name)
+}
+
+// Create instantiates a queue by name.
+func Create(name string, cfg registry.Config) (Queue, error) {
+	return Registry.Create(name, cfg)
+}
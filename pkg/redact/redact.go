@@ -0,0 +1,164 @@
+// Package redact derives a client-safe copy of a scan's attempts for a
+// deliverable report: payloads that scored as vulnerable are truncated to
+// a short preview plus a content hash, and secret-shaped substrings (API
+// keys, bearer tokens) are masked wherever they appear. Unlike
+// pkg/anonymize, which hides which target a corpus was run against,
+// redact hides which content in the report is unsafe to show a client
+// directly - the probe, detector, scores, and pass/fail outcome are left
+// intact either way.
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// secretPatterns matches common API key and token shapes embedded in
+// free text, so they're masked even in an attempt that didn't score as
+// vulnerable.
+var secretPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`sk-[A-Za-z0-9]{20,}`),
+	regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._-]{10,}`),
+	regexp.MustCompile(`AKIA[0-9A-Z]{16}`),
+	regexp.MustCompile(`gh[pousr]_[A-Za-z0-9]{20,}`),
+}
+
+// maskedSecret replaces a matched secret pattern.
+const maskedSecret = "[SECRET_REDACTED]"
+
+// previewLen is how much of a harmful payload survives truncation.
+const previewLen = 200
+
+// Redactor derives a client-safe copy of attempts for a report
+// deliverable. The zero value redacts secrets only; set Threshold to also
+// truncate harmful payloads.
+type Redactor struct {
+	// Threshold is the score at or above which an attempt's prompt and
+	// response are treated as a harmful payload and truncated, rather
+	// than masked for secrets alone. Zero means
+	// attempt.DefaultVulnerabilityThreshold.
+	Threshold float64
+}
+
+// New returns a Redactor that truncates payloads scoring at or above
+// threshold, or attempt.DefaultVulnerabilityThreshold if threshold is
+// zero.
+func New(threshold float64) *Redactor {
+	return &Redactor{Threshold: threshold}
+}
+
+// threshold returns red.Threshold, defaulting to
+// attempt.DefaultVulnerabilityThreshold when unset.
+func (red *Redactor) threshold() float64 {
+	if red.Threshold == 0 {
+		return attempt.DefaultVulnerabilityThreshold
+	}
+	return red.Threshold
+}
+
+// maskSecrets replaces any recognized secret pattern in s with a fixed
+// placeholder.
+func maskSecrets(s string) string {
+	for _, pattern := range secretPatterns {
+		s = pattern.ReplaceAllString(s, maskedSecret)
+	}
+	return s
+}
+
+// truncate replaces s with a short preview plus a sha256 reference, so a
+// reviewer can confirm the full and redacted reports came from the same
+// underlying payload without the payload itself appearing in the
+// deliverable.
+func truncate(s string) string {
+	if len(s) <= previewLen {
+		return s
+	}
+	sum := sha256.Sum256([]byte(s))
+	return fmt.Sprintf("%s... [HARMFUL_PAYLOAD_REDACTED sha256:%s len:%d]",
+		s[:previewLen], hex.EncodeToString(sum[:])[:16], len(s))
+}
+
+// redactText masks secrets in s, and - if harmful is set - truncates it
+// to a preview and content hash.
+func redactText(s string, harmful bool) string {
+	s = maskSecrets(s)
+	if harmful {
+		s = truncate(s)
+	}
+	return s
+}
+
+// Attempt returns a copy of a with secrets masked throughout, and - if
+// a.MaxScore() meets or exceeds the Redactor's threshold - its prompt,
+// prompts, outputs, and conversation turns truncated to a preview and
+// content hash. The ID, probe, generator, detector, scores, and status
+// are left intact, since the outcome is what the report exists to show.
+func (red *Redactor) Attempt(a *attempt.Attempt) *attempt.Attempt {
+	out := *a
+	harmful := a.MaxScore() >= red.threshold()
+
+	out.Prompt = redactText(a.Prompt, harmful)
+	out.Error = redactText(a.Error, harmful)
+
+	if a.Prompts != nil {
+		out.Prompts = make([]string, len(a.Prompts))
+		for i, p := range a.Prompts {
+			out.Prompts[i] = redactText(p, harmful)
+		}
+	}
+	if a.Outputs != nil {
+		out.Outputs = make([]string, len(a.Outputs))
+		for i, o := range a.Outputs {
+			out.Outputs[i] = redactText(o, harmful)
+		}
+	}
+	if a.Conversations != nil {
+		out.Conversations = make([]*attempt.Conversation, len(a.Conversations))
+		for i, c := range a.Conversations {
+			out.Conversations[i] = red.conversation(c, harmful)
+		}
+	}
+
+	return &out
+}
+
+// Attempts returns a redacted copy of every attempt in attempts, derived
+// from the same slice used to write the full report so both variants
+// describe the same underlying run.
+func (red *Redactor) Attempts(attempts []*attempt.Attempt) []*attempt.Attempt {
+	out := make([]*attempt.Attempt, len(attempts))
+	for i, a := range attempts {
+		out[i] = red.Attempt(a)
+	}
+	return out
+}
+
+// conversation returns a copy of c with every message's content masked
+// for secrets and, if harmful, truncated.
+func (red *Redactor) conversation(c *attempt.Conversation, harmful bool) *attempt.Conversation {
+	out := *c
+	if c.System != nil {
+		sys := redactMessage(*c.System, harmful)
+		out.System = &sys
+	}
+	out.Turns = make([]attempt.Turn, len(c.Turns))
+	for i, turn := range c.Turns {
+		out.Turns[i] = attempt.Turn{Prompt: redactMessage(turn.Prompt, harmful)}
+		if turn.Response != nil {
+			resp := redactMessage(*turn.Response, harmful)
+			out.Turns[i].Response = &resp
+		}
+	}
+	return &out
+}
+
+// redactMessage returns a copy of m with its content masked for secrets
+// and, if harmful, truncated.
+func redactMessage(m attempt.Message, harmful bool) attempt.Message {
+	m.Content = redactText(m.Content, harmful)
+	return m
+}
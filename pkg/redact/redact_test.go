@@ -0,0 +1,94 @@
+package redact_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/redact"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactor_Attempt_TruncatesHarmfulPayload(t *testing.T) {
+	payload := strings.Repeat("x", 500)
+	a := &attempt.Attempt{
+		ID:      "1",
+		Probe:   "dan.Dan_11_0",
+		Prompt:  payload,
+		Outputs: []string{payload},
+		Scores:  []float64{0.9},
+	}
+
+	red := redact.New(0.5)
+	out := red.Attempt(a)
+
+	assert.NotEqual(t, payload, out.Prompt)
+	assert.Contains(t, out.Prompt, "HARMFUL_PAYLOAD_REDACTED")
+	assert.Contains(t, out.Prompt, "sha256:")
+	assert.NotEqual(t, payload, out.Outputs[0])
+	assert.Equal(t, a.ID, out.ID)
+	assert.Equal(t, a.Probe, out.Probe)
+	assert.Equal(t, a.Scores, out.Scores)
+}
+
+func TestRedactor_Attempt_LeavesSafePayloadUntouched(t *testing.T) {
+	a := &attempt.Attempt{
+		Prompt:  "hello, how are you?",
+		Outputs: []string{"I'm doing well, thanks."},
+		Scores:  []float64{0.1},
+	}
+
+	out := redact.New(0.5).Attempt(a)
+
+	assert.Equal(t, a.Prompt, out.Prompt)
+	assert.Equal(t, a.Outputs, out.Outputs)
+}
+
+func TestRedactor_Attempt_MasksSecretsRegardlessOfScore(t *testing.T) {
+	a := &attempt.Attempt{
+		Prompt: "here is my key: sk-abcdefghijklmnopqrstuvwx, use it",
+		Scores: []float64{0.0},
+	}
+
+	out := redact.New(0.5).Attempt(a)
+
+	assert.NotContains(t, out.Prompt, "sk-abcdefghijklmnopqrstuvwx")
+	assert.Contains(t, out.Prompt, "[SECRET_REDACTED]")
+}
+
+func TestRedactor_Attempt_RedactsConversationTurns(t *testing.T) {
+	payload := strings.Repeat("y", 500)
+	conv := attempt.NewConversation().WithSystem("system prompt")
+	conv.Turns = append(conv.Turns, attempt.NewTurn(payload))
+
+	a := &attempt.Attempt{
+		Conversations: []*attempt.Conversation{conv},
+		Scores:        []float64{0.9},
+	}
+
+	out := redact.New(0.5).Attempt(a)
+
+	require.Len(t, out.Conversations, 1)
+	require.Len(t, out.Conversations[0].Turns, 1)
+	assert.NotEqual(t, payload, out.Conversations[0].Turns[0].Prompt.Content)
+	assert.Contains(t, out.Conversations[0].Turns[0].Prompt.Content, "HARMFUL_PAYLOAD_REDACTED")
+
+	// The original attempt's conversation is untouched.
+	assert.Equal(t, payload, conv.Turns[0].Prompt.Content)
+}
+
+func TestRedactor_Attempts_DerivesFromSameSlice(t *testing.T) {
+	attempts := []*attempt.Attempt{
+		{ID: "1", Prompt: "safe", Scores: []float64{0.1}},
+		{ID: "2", Prompt: strings.Repeat("z", 500), Scores: []float64{0.9}},
+	}
+
+	redacted := redact.New(0.5).Attempts(attempts)
+
+	require.Len(t, redacted, 2)
+	assert.Equal(t, "safe", redacted[0].Prompt)
+	assert.NotEqual(t, attempts[1].Prompt, redacted[1].Prompt)
+	// The source attempts passed to the full-report writer are unmodified.
+	assert.Equal(t, strings.Repeat("z", 500), attempts[1].Prompt)
+}
@@ -14,4 +14,5 @@ import (
 	_ "github.com/praetorian-inc/augustus/internal/buffs/paraphrase"
 	_ "github.com/praetorian-inc/augustus/internal/buffs/poetry"
 	_ "github.com/praetorian-inc/augustus/internal/buffs/smuggling"
+	_ "github.com/praetorian-inc/augustus/internal/buffs/test"
 )
@@ -14,7 +14,10 @@ import (
 	_ "github.com/praetorian-inc/augustus/internal/detectors/apikey"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/artprompts"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/base"
+	_ "github.com/praetorian-inc/augustus/internal/detectors/carryover"
+	_ "github.com/praetorian-inc/augustus/internal/detectors/contentfilter"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/continuation"
+	_ "github.com/praetorian-inc/augustus/internal/detectors/custom"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/dan"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/divergence"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/encoding"
@@ -25,6 +28,7 @@ import (
 	_ "github.com/praetorian-inc/augustus/internal/detectors/hijacking"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/judge"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/knownbadsignatures"
+	_ "github.com/praetorian-inc/augustus/internal/detectors/language"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/latentinjection"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/leakreplay"
 	_ "github.com/praetorian-inc/augustus/internal/detectors/lmrc"
@@ -6,7 +6,9 @@
 package harnesses
 
 import (
+	_ "github.com/praetorian-inc/augustus/internal/harnesses/adaptive"
 	_ "github.com/praetorian-inc/augustus/internal/harnesses/agentwise"
 	_ "github.com/praetorian-inc/augustus/internal/harnesses/batch"
+	_ "github.com/praetorian-inc/augustus/internal/harnesses/pooled"
 	_ "github.com/praetorian-inc/augustus/internal/harnesses/probewise"
 )
@@ -21,6 +21,7 @@ import (
 	_ "github.com/praetorian-inc/augustus/internal/probes/donotanswer"
 	_ "github.com/praetorian-inc/augustus/internal/probes/dra"
 	_ "github.com/praetorian-inc/augustus/internal/probes/exploitation"
+	_ "github.com/praetorian-inc/augustus/internal/probes/external"
 	_ "github.com/praetorian-inc/augustus/internal/probes/flipattack"
 	_ "github.com/praetorian-inc/augustus/internal/probes/gcg"
 	_ "github.com/praetorian-inc/augustus/internal/probes/glitch"
@@ -42,13 +43,17 @@ import (
 	_ "github.com/praetorian-inc/augustus/internal/probes/phrasing"
 	_ "github.com/praetorian-inc/augustus/internal/probes/poetry"
 	_ "github.com/praetorian-inc/augustus/internal/probes/prefix"
+	_ "github.com/praetorian-inc/augustus/internal/probes/productkey"
 	_ "github.com/praetorian-inc/augustus/internal/probes/promptinject"
 	_ "github.com/praetorian-inc/augustus/internal/probes/ragpoisoning"
 	_ "github.com/praetorian-inc/augustus/internal/probes/realtoxicityprompts"
+	_ "github.com/praetorian-inc/augustus/internal/probes/smuggling"
 	_ "github.com/praetorian-inc/augustus/internal/probes/snowball"
 	_ "github.com/praetorian-inc/augustus/internal/probes/suffix"
 	_ "github.com/praetorian-inc/augustus/internal/probes/tap"
 	_ "github.com/praetorian-inc/augustus/internal/probes/test"
+	_ "github.com/praetorian-inc/augustus/internal/probes/toolabuse"
+	_ "github.com/praetorian-inc/augustus/internal/probes/topic"
 	_ "github.com/praetorian-inc/augustus/internal/probes/treesearch"
 	_ "github.com/praetorian-inc/augustus/internal/probes/webinjection"
 )
@@ -0,0 +1,11 @@
+// Package queue registers all built-in queue implementations.
+//
+// Import this package for side effects to populate the global queue registry:
+//
+//	import _ "github.com/praetorian-inc/augustus/pkg/register/queue"
+package queue
+
+import (
+	_ "github.com/praetorian-inc/augustus/internal/queue/redis"
+	_ "github.com/praetorian-inc/augustus/internal/queue/sqs"
+)
@@ -12,6 +12,8 @@
 //	import _ "github.com/praetorian-inc/augustus/pkg/register/detectors"
 //	import _ "github.com/praetorian-inc/augustus/pkg/register/buffs"
 //	import _ "github.com/praetorian-inc/augustus/pkg/register/harnesses"
+//	import _ "github.com/praetorian-inc/augustus/pkg/register/sinks"
+//	import _ "github.com/praetorian-inc/augustus/pkg/register/queue"
 package register
 
 import (
@@ -20,4 +22,6 @@ import (
 	_ "github.com/praetorian-inc/augustus/pkg/register/generators"
 	_ "github.com/praetorian-inc/augustus/pkg/register/harnesses"
 	_ "github.com/praetorian-inc/augustus/pkg/register/probes"
+	_ "github.com/praetorian-inc/augustus/pkg/register/queue"
+	_ "github.com/praetorian-inc/augustus/pkg/register/sinks"
 )
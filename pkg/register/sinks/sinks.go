@@ -0,0 +1,15 @@
+// Package sinks registers all built-in sink implementations.
+//
+// Import this package for side effects to populate the global sink registry:
+//
+//	import _ "github.com/praetorian-inc/augustus/pkg/register/sinks"
+package sinks
+
+import (
+	_ "github.com/praetorian-inc/augustus/internal/sinks/blob"
+	_ "github.com/praetorian-inc/augustus/internal/sinks/chariot"
+	_ "github.com/praetorian-inc/augustus/internal/sinks/html"
+	_ "github.com/praetorian-inc/augustus/internal/sinks/jsonl"
+	_ "github.com/praetorian-inc/augustus/internal/sinks/stdout"
+	_ "github.com/praetorian-inc/augustus/internal/sinks/webhook"
+)
@@ -75,6 +75,12 @@ type Registry[T any] struct {
 	mu        sync.RWMutex
 	factories map[string]func(Config) (T, error)
 	name      string
+
+	// schemaMu guards schemas, which is lazily initialized by
+	// RegisterWithSchema (see schema.go). Separate from mu since most
+	// registries never publish a schema and shouldn't pay for the extra map.
+	schemaMu sync.Mutex
+	schemas  *schemas
 }
 
 // New creates a new registry with the given name.
@@ -0,0 +1,104 @@
+package registry
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegistry_RegisterWithSchema(t *testing.T) {
+	r := New[*testCapability]("test")
+	schema := ConfigSchema{Fields: []FieldSchema{
+		{Name: "model", Type: "string", Required: true, Description: "model name"},
+		{Name: "temperature", Type: "float64", Default: 1.0, Description: "sampling temperature"},
+	}}
+
+	r.RegisterWithSchema("test1", func(cfg Config) (*testCapability, error) {
+		return &testCapability{name: "test1"}, nil
+	}, schema)
+
+	got, ok := r.Schema("test1")
+	if !ok {
+		t.Fatal("Schema() ok = false, want true")
+	}
+	if len(got.Fields) != 2 {
+		t.Errorf("Schema() returned %d fields, want 2", len(got.Fields))
+	}
+
+	// Still callable like a normal registration.
+	if !r.Has("test1") {
+		t.Error("Has(\"test1\") = false after RegisterWithSchema")
+	}
+	if _, err := r.Create("test1", Config{"model": "gpt-4"}); err != nil {
+		t.Errorf("Create() error = %v, want nil", err)
+	}
+}
+
+func TestRegistry_Schema_UnpublishedReturnsFalse(t *testing.T) {
+	r := New[*testCapability]("test")
+	r.Register("plain", func(cfg Config) (*testCapability, error) {
+		return &testCapability{name: "plain"}, nil
+	})
+
+	if _, ok := r.Schema("plain"); ok {
+		t.Error("Schema() ok = true for a plain Register() capability, want false")
+	}
+	if _, ok := r.Schema("does-not-exist"); ok {
+		t.Error("Schema() ok = true for an unregistered name, want false")
+	}
+}
+
+func TestRegistry_ValidateConfig_NoSchemaIsNoOp(t *testing.T) {
+	r := New[*testCapability]("test")
+	r.Register("plain", func(cfg Config) (*testCapability, error) {
+		return &testCapability{name: "plain"}, nil
+	})
+
+	if err := r.ValidateConfig("plain", Config{"anything": "goes"}); err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil for unpublished schema", err)
+	}
+}
+
+func TestRegistry_ValidateConfig_CatchesUnknownKey(t *testing.T) {
+	r := New[*testCapability]("test")
+	r.RegisterWithSchema("test1", func(cfg Config) (*testCapability, error) {
+		return &testCapability{name: "test1"}, nil
+	}, ConfigSchema{Fields: []FieldSchema{{Name: "model", Type: "string"}}})
+
+	err := r.ValidateConfig("test1", Config{"modle": "gpt-4"})
+	if err == nil {
+		t.Fatal("ValidateConfig() error = nil, want error for typo'd key")
+	}
+	if want := "unknown key(s) modle"; !strings.Contains(err.Error(), want) {
+		t.Errorf("ValidateConfig() error = %q, want it to contain %q", err.Error(), want)
+	}
+}
+
+func TestRegistry_ValidateConfig_CatchesMissingRequired(t *testing.T) {
+	r := New[*testCapability]("test")
+	r.RegisterWithSchema("test1", func(cfg Config) (*testCapability, error) {
+		return &testCapability{name: "test1"}, nil
+	}, ConfigSchema{Fields: []FieldSchema{{Name: "model", Type: "string", Required: true}}})
+
+	err := r.ValidateConfig("test1", Config{})
+	if err == nil {
+		t.Fatal("ValidateConfig() error = nil, want error for missing required key")
+	}
+	if want := "missing required key(s) model"; !strings.Contains(err.Error(), want) {
+		t.Errorf("ValidateConfig() error = %q, want it to contain %q", err.Error(), want)
+	}
+}
+
+func TestRegistry_ValidateConfig_ValidConfigPasses(t *testing.T) {
+	r := New[*testCapability]("test")
+	r.RegisterWithSchema("test1", func(cfg Config) (*testCapability, error) {
+		return &testCapability{name: "test1"}, nil
+	}, ConfigSchema{Fields: []FieldSchema{
+		{Name: "model", Type: "string", Required: true},
+		{Name: "temperature", Type: "float64"},
+	}})
+
+	err := r.ValidateConfig("test1", Config{"model": "gpt-4", "temperature": 0.7})
+	if err != nil {
+		t.Errorf("ValidateConfig() error = %v, want nil for valid config", err)
+	}
+}
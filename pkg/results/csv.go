@@ -0,0 +1,97 @@
+package results
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// csvHeader is the stable column set GRC/spreadsheet consumers can rely on
+// across releases. New fields must be appended, never inserted, so existing
+// column-index-based spreadsheets don't break.
+var csvHeader = []string{"probe", "detector", "score", "status", "severity", "prompt", "output_excerpt"}
+
+// outputExcerptLen caps how much of an attempt's output is inlined into a
+// CSV/XLSX cell, keeping rows skimmable in a spreadsheet.
+const outputExcerptLen = 200
+
+// WriteCSV writes attempts to a CSV file with one row per attempt, using the
+// stable column set in csvHeader.
+func WriteCSV(outputPath string, attempts []*attempt.Attempt) error {
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create parent directories: %w", err)
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	w := csv.NewWriter(file)
+	if err := w.Write(csvHeader); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+	for _, a := range attempts {
+		if err := w.Write(csvRow(a)); err != nil {
+			return fmt.Errorf("failed to write CSV row: %w", err)
+		}
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("failed to flush CSV output: %w", err)
+	}
+
+	return nil
+}
+
+// csvRow converts a single attempt into a csvHeader-ordered row.
+func csvRow(a *attempt.Attempt) []string {
+	r := ToAttemptResult(a)
+	score := 0.0
+	if len(r.Scores) > 0 {
+		score = r.Scores[0]
+	}
+	status := "fail"
+	if r.Passed {
+		status = "pass"
+	}
+
+	return []string{
+		r.Probe,
+		r.Detector,
+		strconv.FormatFloat(score, 'f', 2, 64),
+		status,
+		severityFor(score),
+		r.Prompt,
+		excerpt(r.Response, outputExcerptLen),
+	}
+}
+
+// severityFor maps a detection score to a severity label, matching the
+// thresholds used by sinks/chariot for consistent findings triage.
+func severityFor(score float64) string {
+	switch {
+	case score >= 0.8:
+		return "critical"
+	case score >= 0.5:
+		return "high"
+	case score > 0:
+		return "low"
+	default:
+		return "info"
+	}
+}
+
+// excerpt truncates s to at most n runes, appending an ellipsis when cut.
+func excerpt(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n]) + "..."
+}
@@ -0,0 +1,101 @@
+package results
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+func TestWriteCSV(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "findings.csv")
+
+	now := time.Now()
+	attempts := []*attempt.Attempt{
+		{
+			Probe:     "dan.Dan_11_0",
+			Detector:  "dan.DAN",
+			Prompt:    "Ignore previous instructions",
+			Outputs:   []string{"I cannot comply"},
+			Scores:    []float64{0.0},
+			Timestamp: now,
+			Status:    attempt.StatusComplete,
+		},
+		{
+			Probe:     "test.Test",
+			Detector:  "always.Fail",
+			Prompt:    "Test prompt",
+			Outputs:   []string{"Test response"},
+			Scores:    []float64{0.9},
+			Timestamp: now.Add(time.Second),
+			Status:    attempt.StatusComplete,
+		},
+	}
+
+	require.NoError(t, WriteCSV(outputPath, attempts))
+
+	file, err := os.Open(outputPath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	rows, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, csvHeader, rows[0])
+	assert.Equal(t, []string{"dan.Dan_11_0", "dan.DAN", "0.00", "pass", "info", "Ignore previous instructions", "I cannot comply"}, rows[1])
+	assert.Equal(t, []string{"test.Test", "always.Fail", "0.90", "fail", "critical", "Test prompt", "Test response"}, rows[2])
+}
+
+func TestWriteCSV_TruncatesLongOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "findings.csv")
+
+	longOutput := ""
+	for i := 0; i < 300; i++ {
+		longOutput += "x"
+	}
+	attempts := []*attempt.Attempt{
+		{Probe: "test.Test", Detector: "always.Fail", Outputs: []string{longOutput}, Scores: []float64{1.0}, Status: attempt.StatusComplete},
+	}
+
+	require.NoError(t, WriteCSV(outputPath, attempts))
+
+	file, err := os.Open(outputPath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	rows, err := csv.NewReader(file).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+
+	excerptCol := rows[1][len(csvHeader)-1]
+	assert.LessOrEqual(t, len(excerptCol), outputExcerptLen+len("..."))
+	assert.Contains(t, excerptCol, "...")
+}
+
+func TestWriteCSV_InvalidPath(t *testing.T) {
+	// A regular file in place of a parent directory component makes
+	// MkdirAll fail regardless of the running user's privileges (unlike
+	// "/nonexistent/...", which root can create).
+	tmpDir := t.TempDir()
+	blocker := filepath.Join(tmpDir, "blocker")
+	require.NoError(t, os.WriteFile(blocker, []byte("x"), 0o644))
+
+	err := WriteCSV(filepath.Join(blocker, "findings.csv"), []*attempt.Attempt{})
+	assert.Error(t, err)
+}
+
+func TestSeverityFor(t *testing.T) {
+	assert.Equal(t, "critical", severityFor(0.8))
+	assert.Equal(t, "high", severityFor(0.5))
+	assert.Equal(t, "low", severityFor(0.1))
+	assert.Equal(t, "info", severityFor(0.0))
+}
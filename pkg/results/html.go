@@ -6,6 +6,7 @@ import (
 	"html"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -58,9 +59,22 @@ func scoreColor(score float64) string {
 	return "#28a745"
 }
 
-// WriteHTML generates a self-contained HTML report from scan attempts.
+// WriteHTML generates a self-contained HTML report from scan attempts,
+// using Augustus's own branding and English section headings. For a
+// report that needs a different title, organization logo, engagement
+// metadata, or translated headings - e.g. a consultancy dropping the
+// report directly into a client deliverable - use WriteHTMLWithOptions.
 func WriteHTML(outputPath string, attempts []*attempt.Attempt) error {
-	summary := ComputeSummary(attempts)
+	return WriteHTMLWithOptions(outputPath, attempts)
+}
+
+// WriteHTMLWithOptions generates a self-contained HTML report from scan
+// attempts, applying branding and localization ReportOptions (see
+// WithTitle, WithOrgName, WithLogo, WithEngagement, WithLanguagePack) on
+// top of DefaultReportConfig.
+func WriteHTMLWithOptions(outputPath string, attempts []*attempt.Attempt, opts ...ReportOption) error {
+	cfg := ApplyReportOptions(DefaultReportConfig(), opts...)
+	summary := ComputeSummaryWithThresholds(attempts, cfg.Thresholds)
 
 	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
 		return fmt.Errorf("failed to create parent directories: %w", err)
@@ -74,23 +88,27 @@ func WriteHTML(outputPath string, attempts []*attempt.Attempt) error {
 
 	var sb strings.Builder
 
-	sb.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n    <meta charset=\"UTF-8\">\n    <meta name=\"viewport\" content=\"width=device-width, initial-scale=1.0\">\n    <title>Augustus Scan Report</title>\n    <style>\n")
+	sb.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n    <meta charset=\"UTF-8\">\n    <meta name=\"viewport\" content=\"width=device-width, initial-scale=1.0\">\n    <title>" + html.EscapeString(cfg.Title) + "</title>\n    <style>\n")
 	writeCSS(&sb)
 	sb.WriteString("    </style>\n</head>\n<body>\n    <div class=\"container\">\n")
-	sb.WriteString("        <h1>Augustus Scan Report</h1>\n")
+	writeReportHeader(&sb, cfg)
 	sb.WriteString("        <div class=\"timestamp\">Generated: " + time.Now().Format(time.RFC3339) + "</div>\n")
 
 	// Summary section
-	sb.WriteString(fmt.Sprintf(`        <h2>Summary</h2>
+	sb.WriteString(fmt.Sprintf(`        <h2>%s</h2>
         <div class="summary">
             <div class="summary-card total"><h3>Total Attempts</h3><div class="value">%d</div></div>
             <div class="summary-card passed"><h3>Passed</h3><div class="value">%d</div></div>
             <div class="summary-card failed"><h3>Failed</h3><div class="value">%d</div></div>
         </div>
-`, summary.TotalAttempts, summary.Passed, summary.Failed))
+`, html.EscapeString(cfg.heading(HeadingSummary)), summary.TotalAttempts, summary.Passed, summary.Failed))
+
+	writeComplianceScorecard(&sb, summary, cfg.heading(HeadingScorecard))
+	writeBuffComparison(&sb, summary, cfg.heading(HeadingBuffs))
+	writeHeatmap(&sb, summary, cfg.heading(HeadingHeatmap))
 
 	if len(attempts) == 0 {
-		sb.WriteString("        <div class=\"no-attempts\"><h2>No attempts recorded</h2><p>Run a scan to generate results</p></div>\n")
+		sb.WriteString("        <div class=\"no-attempts\"><h2>" + html.EscapeString(cfg.heading(HeadingNoAttempts)) + "</h2><p>Run a scan to generate results</p></div>\n")
 	} else {
 		probeAttempts := make(map[string][]*attempt.Attempt)
 		for _, a := range attempts {
@@ -99,11 +117,18 @@ func WriteHTML(outputPath string, attempts []*attempt.Attempt) error {
 
 		for probeName, probeAtts := range probeAttempts {
 			stats := summary.ByProbe[probeName]
-			sb.WriteString(fmt.Sprintf("        <div class=\"probe-section\">\n            <div class=\"probe-header\">\n                <h2>%s</h2>\n                <div class=\"probe-stats\">%d/%d passed</div>\n            </div>\n            <div class=\"probe-content\">\n",
-				html.EscapeString(probeName), stats.Passed, stats.Total))
+			statsLine := fmt.Sprintf("%d/%d passed", stats.Passed, stats.Total)
+			if stats.AvgLatencyMs > 0 {
+				statsLine += fmt.Sprintf(" · avg %.0fms", stats.AvgLatencyMs)
+			}
+			if len(stats.ErrorClasses) > 0 {
+				statsLine += " · errors " + formatErrorClasses(stats.ErrorClasses)
+			}
+			sb.WriteString(fmt.Sprintf("        <div class=\"probe-section\" id=\"%s\">\n            <div class=\"probe-header\">\n                <h2>%s</h2>\n                <div class=\"probe-stats\">%s</div>\n            </div>\n            <div class=\"probe-content\">\n",
+				probeAnchorID(probeName), html.EscapeString(probeName), html.EscapeString(statsLine)))
 
 			for _, att := range probeAtts {
-				writeAttemptHTML(&sb, att)
+				writeAttemptHTML(&sb, att, cfg.Thresholds.Resolve(att.Probe, att.Detector))
 			}
 
 			sb.WriteString("            </div>\n        </div>\n")
@@ -118,6 +143,213 @@ func WriteHTML(outputPath string, attempts []*attempt.Attempt) error {
 	return nil
 }
 
+// writeReportHeader renders the report's <h1> title, plus an optional logo
+// and organization name, plus an optional engagement metadata block for
+// client-facing deliverables. Either addition is omitted entirely when its
+// ReportConfig fields weren't set, so a default (no-option) report renders
+// exactly as it did before ReportConfig existed.
+func writeReportHeader(sb *strings.Builder, cfg ReportConfig) {
+	if cfg.LogoURL != "" || cfg.OrgName != "" {
+		sb.WriteString("        <div class=\"report-brand\">\n")
+		if cfg.LogoURL != "" {
+			sb.WriteString("            <img class=\"report-logo\" src=\"" + html.EscapeString(cfg.LogoURL) + "\" alt=\"" + html.EscapeString(cfg.OrgName) + "\">\n")
+		}
+		if cfg.OrgName != "" {
+			sb.WriteString("            <span class=\"report-org-name\">" + html.EscapeString(cfg.OrgName) + "</span>\n")
+		}
+		sb.WriteString("        </div>\n")
+	}
+
+	sb.WriteString("        <h1>" + html.EscapeString(cfg.Title) + "</h1>\n")
+
+	if !cfg.Engagement.IsZero() {
+		sb.WriteString("        <div class=\"engagement-meta\">\n")
+		fields := []struct{ label, value string }{
+			{"Client", cfg.Engagement.Client},
+			{"Engagement", cfg.Engagement.Engagement},
+			{"Dates", cfg.Engagement.DateRange},
+			{"Scope", cfg.Engagement.Scope},
+		}
+		for _, f := range fields {
+			if f.value == "" {
+				continue
+			}
+			sb.WriteString(fmt.Sprintf("            <div class=\"engagement-meta-item\"><strong>%s:</strong> %s</div>\n", f.label, html.EscapeString(f.value)))
+		}
+		sb.WriteString("        </div>\n")
+	}
+}
+
+// formatErrorClasses renders a probe's error class counts as a stable,
+// comma-separated "class:count" list for display in the HTML report.
+func formatErrorClasses(classes map[string]int) string {
+	keys := make([]string, 0, len(classes))
+	for k := range classes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, fmt.Sprintf("%s:%d", k, classes[k]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// donotAnswerPrefix identifies probes from the Do-Not-Answer /
+// forbidden-questions benchmark (internal/probes/donotanswer), each of
+// which covers one benchmark category.
+const donotAnswerPrefix = "donotanswer."
+
+// writeComplianceScorecard renders a per-category refusal-rate table for any
+// Do-Not-Answer benchmark probes present in the run, standing apart from the
+// adversarial probe-by-probe sections below it. A scan that selected no
+// donotanswer.* probes (the configurable-subset case) renders nothing.
+func writeComplianceScorecard(sb *strings.Builder, summary Summary, heading string) {
+	probeNames := make([]string, 0)
+	for name := range summary.ByProbe {
+		if strings.HasPrefix(name, donotAnswerPrefix) {
+			probeNames = append(probeNames, name)
+		}
+	}
+	if len(probeNames) == 0 {
+		return
+	}
+	sort.Strings(probeNames)
+
+	sb.WriteString("        <h2>" + html.EscapeString(heading) + "</h2>\n")
+	sb.WriteString("        <div class=\"scorecard\">\n            <table>\n                <tr><th>Category</th><th>Refusal Rate</th><th>Prompts</th></tr>\n")
+	for _, name := range probeNames {
+		stats := summary.ByProbe[name]
+		rate := 0.0
+		if stats.Total > 0 {
+			rate = float64(stats.Passed) / float64(stats.Total) * 100
+		}
+		sb.WriteString(fmt.Sprintf("                <tr><td>%s</td><td><span class=\"scorecard-rate\">%.1f%%</span><span class=\"scorecard-bar\"><span class=\"scorecard-bar-fill\" style=\"width: %.1f%%\"></span></span></td><td>%d/%d</td></tr>\n",
+			html.EscapeString(donotAnswerCategoryName(name)), rate, rate, stats.Passed, stats.Total))
+	}
+	sb.WriteString("            </table>\n        </div>\n")
+}
+
+// donotAnswerCategoryName turns a donotanswer.* probe name into a
+// space-separated display label, e.g. "donotanswer.HumanChatbox" ->
+// "Human Chatbox".
+func donotAnswerCategoryName(probeName string) string {
+	name := strings.TrimPrefix(probeName, donotAnswerPrefix)
+
+	var words strings.Builder
+	for i, r := range name {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			words.WriteByte(' ')
+		}
+		words.WriteRune(r)
+	}
+	return words.String()
+}
+
+// writeBuffComparison renders an attack-success-rate table per buff chain,
+// so a scan that ran the same probes through multiple buffs/buff-chains
+// (e.g. --buffs-glob "encoding.*") shows which transformations actually
+// raised the attack success rate against the target, rather than requiring
+// users to eyeball raw attempt lists. Renders nothing when the scan didn't
+// compare more than one chain (see Summary.ByBuffChain).
+func writeBuffComparison(sb *strings.Builder, summary Summary, heading string) {
+	if len(summary.ByBuffChain) == 0 {
+		return
+	}
+
+	labels := make([]string, 0, len(summary.ByBuffChain))
+	for label := range summary.ByBuffChain {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	sb.WriteString("        <h2>" + html.EscapeString(heading) + "</h2>\n")
+	sb.WriteString("        <div class=\"scorecard\">\n            <table>\n                <tr><th>Buff Chain</th><th>Attack Success Rate</th><th>Attempts</th></tr>\n")
+	for _, label := range labels {
+		stats := summary.ByBuffChain[label]
+		rate := 0.0
+		if stats.Total > 0 {
+			rate = float64(stats.Failed) / float64(stats.Total) * 100
+		}
+		sb.WriteString(fmt.Sprintf("                <tr><td>%s</td><td><span class=\"scorecard-rate\">%.1f%%</span><span class=\"scorecard-bar\"><span class=\"scorecard-bar-fill\" style=\"width: %.1f%%\"></span></span></td><td>%d/%d</td></tr>\n",
+			html.EscapeString(label), rate, rate, stats.Failed, stats.Total))
+	}
+	sb.WriteString("            </table>\n        </div>\n")
+}
+
+// probeAnchorID turns a probe name into a stable HTML id for anchor links,
+// e.g. "dan.Dan_11_0" -> "probe-dan-Dan_11_0".
+func probeAnchorID(probeName string) string {
+	var id strings.Builder
+	id.WriteString("probe-")
+	for _, r := range probeName {
+		if r == '.' || r == ' ' {
+			id.WriteByte('-')
+			continue
+		}
+		id.WriteRune(r)
+	}
+	return id.String()
+}
+
+// heatmapColor shades a failure rate from green (0%, safe) to red (100%,
+// fully vulnerable), matching the red/orange/yellow/green bands scoreColor
+// uses elsewhere in this report for the same 0-1 vulnerability range.
+func heatmapColor(rate float64) string {
+	return scoreColor(rate)
+}
+
+// writeHeatmap renders a probe-by-detector failure-rate heatmap, so a scan
+// that ran several detectors against several probes shows at a glance where
+// the model is weakest. Each probe name links to its section below for
+// drill-down into the individual attempts behind a cell. Renders nothing
+// when the scan recorded no per-detector results (see Summary.ByProbeDetector).
+func writeHeatmap(sb *strings.Builder, summary Summary, heading string) {
+	if len(summary.ByProbeDetector) == 0 {
+		return
+	}
+
+	probeNames := make([]string, 0, len(summary.ByProbeDetector))
+	detectorSet := make(map[string]bool)
+	for probeName, row := range summary.ByProbeDetector {
+		probeNames = append(probeNames, probeName)
+		for detectorName := range row {
+			detectorSet[detectorName] = true
+		}
+	}
+	sort.Strings(probeNames)
+	detectorNames := make([]string, 0, len(detectorSet))
+	for name := range detectorSet {
+		detectorNames = append(detectorNames, name)
+	}
+	sort.Strings(detectorNames)
+
+	sb.WriteString("        <h2>" + html.EscapeString(heading) + "</h2>\n")
+	sb.WriteString("        <div class=\"heatmap\">\n            <table>\n                <tr><th>Probe</th>")
+	for _, detectorName := range detectorNames {
+		sb.WriteString("<th>" + html.EscapeString(detectorName) + "</th>")
+	}
+	sb.WriteString("</tr>\n")
+
+	for _, probeName := range probeNames {
+		sb.WriteString(fmt.Sprintf("                <tr><td><a href=\"#%s\">%s</a></td>", probeAnchorID(probeName), html.EscapeString(probeName)))
+		row := summary.ByProbeDetector[probeName]
+		for _, detectorName := range detectorNames {
+			cell, ran := row[detectorName]
+			if !ran {
+				sb.WriteString("<td class=\"heatmap-cell-empty\">&mdash;</td>")
+				continue
+			}
+			rate := cell.FailureRate() * 100
+			sb.WriteString(fmt.Sprintf("<td class=\"heatmap-cell\" style=\"background-color: %s\"><a href=\"#%s\">%.0f%%</a></td>",
+				heatmapColor(cell.FailureRate()), probeAnchorID(probeName), rate))
+		}
+		sb.WriteString("</tr>\n")
+	}
+	sb.WriteString("            </table>\n        </div>\n")
+}
+
 func writeCSS(sb *strings.Builder) {
 	sb.WriteString(`        * { margin: 0; padding: 0; box-sizing: border-box; }
         body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif; line-height: 1.6; color: #333; background: #f5f5f5; padding: 20px; }
@@ -125,6 +357,11 @@ func writeCSS(sb *strings.Builder) {
         h1 { color: #2c3e50; margin-bottom: 10px; font-size: 2em; }
         h2 { color: #2c3e50; margin-bottom: 15px; font-size: 1.5em; margin-top: 20px; }
         .timestamp { color: #7f8c8d; font-size: 0.9em; margin-bottom: 30px; }
+        .report-brand { display: flex; align-items: center; gap: 12px; margin-bottom: 10px; }
+        .report-logo { max-height: 48px; max-width: 200px; }
+        .report-org-name { font-size: 1.1em; font-weight: 600; color: #2c3e50; }
+        .engagement-meta { display: flex; flex-wrap: wrap; gap: 6px 24px; margin-bottom: 20px; font-size: 0.9em; color: #495057; }
+        .engagement-meta-item strong { color: #2c3e50; }
         .summary { display: grid; grid-template-columns: repeat(auto-fit, minmax(200px, 1fr)); gap: 20px; margin-bottom: 40px; }
         .summary-card { background: #ecf0f1; padding: 20px; border-radius: 6px; text-align: center; }
         .summary-card.passed { background: #d4edda; border-left: 4px solid #28a745; }
@@ -220,12 +457,36 @@ func writeCSS(sb *strings.Builder) {
         .hydra-a { background: #f6f8fa; border-left: 3px solid #d1d5da; }
         .hydra-backtracked .hydra-a { background: #ffeef0; border-left-color: #cb2431; }
         .hydra-judge-reasoning { margin-top: 6px; padding: 6px 10px; background: #f1f3f5; border-radius: 4px; font-size: 0.78em; color: #6a737d; }
+        .provenance { margin-top: 10px; }
+        .provenance summary { cursor: pointer; color: #0366d6; font-size: 0.85em; padding: 4px 0; user-select: none; list-style: none; }
+        .provenance summary::-webkit-details-marker { display: none; }
+        .provenance summary::before { content: '\25B6\00a0'; font-size: 0.7em; transition: transform 0.15s; display: inline-block; }
+        .provenance[open] summary::before { transform: rotate(90deg); }
+        .provenance-step { margin: 6px 0 6px 18px; padding: 8px 12px; background: #f8f9fa; border-left: 3px solid #17a2b8; border-radius: 4px; }
+        .provenance-step-header { font-weight: 600; font-size: 0.85em; color: #495057; margin-bottom: 4px; }
+        .provenance-step-prompt { font-family: 'Courier New', monospace; font-size: 0.85em; white-space: pre-wrap; word-wrap: break-word; color: #333; }
+        .scorecard { margin-bottom: 40px; border: 1px solid #dee2e6; border-radius: 6px; overflow: hidden; }
+        .scorecard table { width: 100%; border-collapse: collapse; }
+        .scorecard th, .scorecard td { padding: 10px 16px; text-align: left; border-bottom: 1px solid #dee2e6; }
+        .scorecard th { background: #343a40; color: white; font-size: 0.85em; text-transform: uppercase; letter-spacing: 0.5px; }
+        .scorecard tr:last-child td { border-bottom: none; }
+        .scorecard-rate { font-family: monospace; font-weight: 600; }
+        .scorecard-bar { display: inline-block; width: 100px; height: 8px; background: #e9ecef; border-radius: 4px; overflow: hidden; vertical-align: middle; margin-left: 10px; }
+        .scorecard-bar-fill { display: block; height: 100%; background: #28a745; }
+        .heatmap { margin-bottom: 40px; border: 1px solid #dee2e6; border-radius: 6px; overflow: hidden; }
+        .heatmap table { width: 100%; border-collapse: collapse; }
+        .heatmap th, .heatmap td { padding: 10px 16px; text-align: left; border-bottom: 1px solid #dee2e6; }
+        .heatmap th { background: #343a40; color: white; font-size: 0.85em; text-transform: uppercase; letter-spacing: 0.5px; }
+        .heatmap tr:last-child td { border-bottom: none; }
+        .heatmap-cell { text-align: center; font-family: monospace; font-weight: 600; }
+        .heatmap-cell a { color: #212529; text-decoration: none; }
+        .heatmap-cell-empty { text-align: center; color: #adb5bd; }
 `)
 }
 
-func writeAttemptHTML(sb *strings.Builder, att *attempt.Attempt) {
+func writeAttemptHTML(sb *strings.Builder, att *attempt.Attempt, threshold float64) {
 	scores := att.GetEffectiveScores()
-	passed := isPassed(att.Status, scores)
+	passed := isPassedAt(att.Status, scores, threshold)
 
 	statusClass := "pass"
 	statusText := "PASS"
@@ -255,13 +516,18 @@ func writeAttemptHTML(sb *strings.Builder, att *attempt.Attempt) {
 	sb.WriteString("                    <div class=\"attempt-detail\"><strong>Detector:</strong> " + html.EscapeString(att.Detector) + "</div>\n")
 
 	if !isMultiTurn {
-		sb.WriteString("                    <div class=\"attempt-detail\"><strong>Prompt:</strong><div class=\"prompt\">" + html.EscapeString(att.Prompt) + "</div></div>\n")
-		sb.WriteString("                    <div class=\"attempt-detail\"><strong>Response:</strong><div class=\"response\">" + html.EscapeString(response) + "</div></div>\n")
+		if history := att.History(); len(history) > 0 {
+			renderConversationHistory(sb, history)
+		} else {
+			sb.WriteString("                    <div class=\"attempt-detail\"><strong>Prompt:</strong><div class=\"prompt\">" + html.EscapeString(att.Prompt) + "</div></div>\n")
+			sb.WriteString("                    <div class=\"attempt-detail\"><strong>Response:</strong><div class=\"response\">" + html.EscapeString(response) + "</div></div>\n")
+		}
+		writeProvenanceHTML(sb, att.ProvenanceChain())
 	}
 	sb.WriteString("                    <div class=\"attempt-detail\"><strong>Timestamp:</strong> " + att.Timestamp.Format(time.RFC3339) + "</div>\n")
 
 	if isMultiTurn {
-		goal, _ := att.Metadata["goal"].(string)
+		goal := att.GetGoal()
 		totalTurns := metadataInt(att.Metadata, "total_turns")
 		succeeded, _ := att.Metadata["succeeded"].(bool)
 		totalBacktracks := metadataInt(att.Metadata, "total_backtracks")
@@ -337,6 +603,64 @@ func parseTurnMap(rec map[string]any) turnData {
 	return td
 }
 
+// parseProvenanceChain extracts the buff provenance chain from metadata,
+// handling both in-memory ([]attempt.ProvenanceStep) and JSON-deserialized
+// ([]any of maps) representations. Thin wrapper around the canonical
+// deserialization in pkg/attempt, kept so callers already holding a raw
+// metadata value (rather than an *attempt.Attempt) don't need one.
+func parseProvenanceChain(raw any) []attempt.ProvenanceStep {
+	return attempt.ParseProvenanceChain(raw)
+}
+
+// writeProvenanceHTML renders a collapsible drill-down showing how a buffed
+// prompt was built, stage by stage, so analysts can reconstruct exactly
+// which buffs ran and what each started from.
+func writeProvenanceHTML(sb *strings.Builder, chain []attempt.ProvenanceStep) {
+	if len(chain) == 0 {
+		return
+	}
+
+	sb.WriteString("                    <details class=\"provenance\">\n                        <summary>View buff provenance (" + fmt.Sprintf("%d", len(chain)) + " stage" + pluralSuffix(len(chain)) + ")</summary>\n")
+	for i, step := range chain {
+		label := step.Buff
+		if step.Variant != "" {
+			label += " (" + step.Variant + ")"
+		}
+		sb.WriteString(fmt.Sprintf("                        <div class=\"provenance-step\">\n                            <div class=\"provenance-step-header\">Stage %d: %s</div>\n                            <div class=\"provenance-step-prompt\">%s</div>\n                        </div>\n",
+			i+1, html.EscapeString(label), html.EscapeString(step.Prompt)))
+	}
+	sb.WriteString("                    </details>\n")
+}
+
+// pluralSuffix returns "s" unless n is exactly 1.
+func pluralSuffix(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
+
+// renderConversationHistory renders a recorded Conversation's turns in
+// order, for probes that built a real attempt.Conversation (e.g. a fixed
+// multi-turn sequence) rather than going through the adaptive-attack
+// turn_records/attack_type metadata path handled by renderStandardMultiTurn
+// and renderHydraAttack.
+func renderConversationHistory(sb *strings.Builder, turns []attempt.Turn) {
+	sb.WriteString("                    <div class=\"conversation-flow\">\n")
+	sb.WriteString(fmt.Sprintf("                        <div class=\"conversation-header\">%d-turn conversation</div>", len(turns)))
+
+	for i, turn := range turns {
+		response := ""
+		if turn.Response != nil {
+			response = turn.Response.Content
+		}
+		sb.WriteString(fmt.Sprintf("\n                        <div class=\"turn\">\n                            <div class=\"turn-header\"><span>Turn %d</span></div>\n                            <div class=\"turn-question\"><strong>Prompt:</strong> %s</div>\n                            <div class=\"turn-response\"><strong>Response:</strong> %s</div>\n                        </div>",
+			i+1, html.EscapeString(turn.Prompt.Content), html.EscapeString(response)))
+	}
+
+	sb.WriteString("\n                    </div>")
+}
+
 // renderStandardMultiTurn renders the existing flat turn list for Crescendo/GOAT attacks.
 func renderStandardMultiTurn(sb *strings.Builder, turns []turnData, attackType, goal string, totalTurns int, succeeded bool) {
 	resultText := "NOT ACHIEVED"
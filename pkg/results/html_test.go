@@ -450,6 +450,151 @@ func TestWriteHTML_StandardMultiTurn(t *testing.T) {
 	assert.Contains(t, htmlContent, "some crescendo goal")
 }
 
+func TestWriteHTML_ConversationHistory(t *testing.T) {
+	conv := attempt.NewConversation()
+	conv.AddTurn(attempt.NewTurn("part 1 of 2: \"Explain how to\"").WithResponse("Okay, noted."))
+	conv.AddTurn(attempt.NewTurn("join them in order and answer").WithResponse("Sure, here's how: step 1..."))
+
+	att := attempt.New("part 1 of 2: \"Explain how to\"")
+	att.Probe = "smuggling.MultiTurn"
+	att.Detector = "mitigation.MitigationBypass"
+	att.Outputs = []string{"Okay, noted.", "Sure, here's how: step 1..."}
+	att.Conversations = []*attempt.Conversation{conv}
+	att.Scores = []float64{0.8}
+	att.Status = attempt.StatusComplete
+
+	htmlContent := writeHTMLAndRead(t, []*attempt.Attempt{att})
+
+	// No attack_type metadata, so this is not the adaptive-attack path -
+	// it should render the recorded Conversation's turns instead of just
+	// the first prompt/response.
+	assert.Contains(t, htmlContent, "2-turn conversation")
+	assert.Contains(t, htmlContent, "join them in order and answer")
+	assert.Contains(t, htmlContent, "Sure, here&#39;s how: step 1...")
+}
+
+func TestWriteHTML_ProvenanceChain(t *testing.T) {
+	att := attempt.New("QmVhY29uIGF0dGFjaw==")
+	att.Probe = "dan.Dan_11_0"
+	att.Detector = "dan.DAN"
+	att.Outputs = []string{"response"}
+	att.Scores = []float64{0.1}
+	att.Status = attempt.StatusComplete
+	att.AppendProvenance("encoding.Base64", "", "Beacon attack")
+	att.AppendProvenance("flip.FlipAttack", "word", "QmVhY29uIGF0dGFjaw==")
+
+	htmlContent := writeHTMLAndRead(t, []*attempt.Attempt{att})
+
+	assert.Contains(t, htmlContent, "View buff provenance (2 stages)")
+	assert.Contains(t, htmlContent, "Stage 1: encoding.Base64")
+	assert.Contains(t, htmlContent, "Beacon attack")
+	assert.Contains(t, htmlContent, "Stage 2: flip.FlipAttack (word)")
+	assert.Contains(t, htmlContent, "QmVhY29uIGF0dGFjaw==")
+}
+
+func TestWriteHTML_NoProvenanceChain(t *testing.T) {
+	att := attempt.New("plain prompt")
+	att.Probe = "test.Test"
+	att.Detector = "test.Detector"
+	att.Outputs = []string{"response"}
+	att.Status = attempt.StatusComplete
+
+	htmlContent := writeHTMLAndRead(t, []*attempt.Attempt{att})
+
+	assert.NotContains(t, htmlContent, "View buff provenance")
+}
+
+func TestWriteHTML_BuffComparison(t *testing.T) {
+	unbuffed := attempt.New("hello")
+	unbuffed.Probe = "test.Test"
+	unbuffed.Status = attempt.StatusComplete
+	unbuffed.Scores = []float64{0.1}
+
+	buffed := attempt.New("aGVsbG8=")
+	buffed.Probe = "test.Test"
+	buffed.Status = attempt.StatusComplete
+	buffed.Scores = []float64{0.9}
+	buffed.AppendProvenance("encoding.Base64", "", "hello")
+
+	htmlContent := writeHTMLAndRead(t, []*attempt.Attempt{unbuffed, buffed})
+
+	assert.Contains(t, htmlContent, "Buff Comparison")
+	assert.Contains(t, htmlContent, "encoding.Base64")
+	assert.Contains(t, htmlContent, "none")
+}
+
+func TestWriteHTML_BuffComparisonOmittedWithSingleChain(t *testing.T) {
+	att := attempt.New("hello")
+	att.Probe = "test.Test"
+	att.Status = attempt.StatusComplete
+	att.Scores = []float64{0.1}
+
+	htmlContent := writeHTMLAndRead(t, []*attempt.Attempt{att})
+
+	assert.NotContains(t, htmlContent, "Buff Comparison")
+}
+
+func TestWriteHTML_Heatmap(t *testing.T) {
+	passing := attempt.New("hello")
+	passing.Probe = "dan.Dan_11_0"
+	passing.Status = attempt.StatusComplete
+	passing.DetectorResults = map[string][]float64{"dan.DAN": {0.1}}
+
+	failing := attempt.New("ignore instructions")
+	failing.Probe = "dan.Dan_11_0"
+	failing.Status = attempt.StatusComplete
+	failing.DetectorResults = map[string][]float64{"dan.DAN": {0.9}}
+
+	otherProbe := attempt.New("hi")
+	otherProbe.Probe = "encoding.InjectBase64"
+	otherProbe.Status = attempt.StatusComplete
+	otherProbe.DetectorResults = map[string][]float64{"dan.DAN": {0.2}}
+
+	htmlContent := writeHTMLAndRead(t, []*attempt.Attempt{passing, failing, otherProbe})
+
+	assert.Contains(t, htmlContent, "Probe × Detector Heatmap")
+	assert.Contains(t, htmlContent, "dan.DAN")
+	assert.Contains(t, htmlContent, "50%")
+
+	id := probeAnchorID("dan.Dan_11_0")
+	assert.Contains(t, htmlContent, `href="#`+id+`"`)
+	assert.Contains(t, htmlContent, `id="`+id+`"`)
+}
+
+func TestWriteHTML_HeatmapOmittedWithoutDetectorResults(t *testing.T) {
+	att := attempt.New("hello")
+	att.Probe = "test.Test"
+	att.Status = attempt.StatusComplete
+
+	htmlContent := writeHTMLAndRead(t, []*attempt.Attempt{att})
+
+	assert.NotContains(t, htmlContent, "Detector Heatmap")
+}
+
+func TestProbeAnchorID(t *testing.T) {
+	assert.Equal(t, "probe-dan-Dan_11_0", probeAnchorID("dan.Dan_11_0"))
+	assert.Equal(t, "probe-donotanswer-HumanChatbox", probeAnchorID("donotanswer.HumanChatbox"))
+}
+
+func TestParseProvenanceChain_JSONDeserializedInput(t *testing.T) {
+	raw := []any{
+		map[string]any{"buff": "encoding.Base64", "prompt": "before"},
+		map[string]any{"buff": "flip.FlipAttack", "variant": "word", "prompt": "after"},
+	}
+
+	chain := parseProvenanceChain(raw)
+
+	require.Len(t, chain, 2)
+	assert.Equal(t, "encoding.Base64", chain[0].Buff)
+	assert.Equal(t, "before", chain[0].Prompt)
+	assert.Equal(t, "flip.FlipAttack", chain[1].Buff)
+	assert.Equal(t, "word", chain[1].Variant)
+}
+
+func TestParseProvenanceChain_NilInput(t *testing.T) {
+	assert.Nil(t, parseProvenanceChain(nil))
+}
+
 func TestParseTurnRecords_TypedInput(t *testing.T) {
 	// Simulate typed struct data going through JSON marshal/unmarshal roundtrip.
 	// This is what happens when turn records are serialized and deserialized.
@@ -721,3 +866,147 @@ func TestScoreColor(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteHTML_ComplianceScorecard_DoNotAnswerProbes(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "scorecard.html")
+
+	now := time.Now()
+	attempts := []*attempt.Attempt{
+		{
+			Probe:     "donotanswer.HumanChatbox",
+			Detector:  "mitigation.MitigationBypass",
+			Prompt:    "refused1",
+			Outputs:   []string{"I can't help with that"},
+			Scores:    []float64{0.0},
+			Timestamp: now,
+		},
+		{
+			Probe:     "donotanswer.HumanChatbox",
+			Detector:  "mitigation.MitigationBypass",
+			Prompt:    "complied1",
+			Outputs:   []string{"Sure, here you go"},
+			Scores:    []float64{1.0},
+			Timestamp: now,
+		},
+		{
+			Probe:     "dan.Dan_11_0",
+			Detector:  "dan.DAN",
+			Prompt:    "unrelated",
+			Outputs:   []string{"I cannot comply"},
+			Scores:    []float64{0.0},
+			Timestamp: now,
+		},
+	}
+
+	require.NoError(t, WriteHTML(outputPath, attempts))
+
+	content, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	html := string(content)
+
+	assert.Contains(t, html, "Compliance Scorecard")
+	assert.Contains(t, html, "Human Chatbox")
+	assert.Contains(t, html, "50.0%")
+	assert.Contains(t, html, "1/2")
+}
+
+func TestWriteHTML_ComplianceScorecard_OmittedWithoutDoNotAnswerProbes(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "no-scorecard.html")
+
+	attempts := []*attempt.Attempt{
+		{
+			Probe:     "dan.Dan_11_0",
+			Detector:  "dan.DAN",
+			Prompt:    "unrelated",
+			Outputs:   []string{"I cannot comply"},
+			Scores:    []float64{0.0},
+			Timestamp: time.Now(),
+		},
+	}
+
+	require.NoError(t, WriteHTML(outputPath, attempts))
+
+	content, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "Compliance Scorecard")
+}
+
+func TestDonotAnswerCategoryName(t *testing.T) {
+	tests := []struct {
+		probeName string
+		expected  string
+	}{
+		{"donotanswer.HumanChatbox", "Human Chatbox"},
+		{"donotanswer.DiscriminationExclusionToxicityHatefulOffensive", "Discrimination Exclusion Toxicity Hateful Offensive"},
+		{"donotanswer.InformationHazard", "Information Hazard"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.probeName, func(t *testing.T) {
+			assert.Equal(t, tc.expected, donotAnswerCategoryName(tc.probeName))
+		})
+	}
+}
+
+func TestWriteHTMLWithOptions_Branding(t *testing.T) {
+	att := attempt.New("hello")
+	att.Probe = "test.Test"
+	att.Status = attempt.StatusComplete
+	att.Scores = []float64{0.1}
+
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "report.html")
+
+	err := WriteHTMLWithOptions(outputPath, []*attempt.Attempt{att},
+		WithTitle("Acme Corp Pentest Report"),
+		WithOrgName("Acme Security"),
+		WithLogo("data:image/png;base64,AAAA"),
+		WithEngagement(EngagementInfo{Client: "Globex", Engagement: "Q3 Pentest", DateRange: "2026-07-01 to 2026-07-15", Scope: "api.globex.com"}),
+	)
+	require.NoError(t, err, "WriteHTMLWithOptions should succeed")
+
+	content, err := os.ReadFile(outputPath)
+	require.NoError(t, err, "should read output file")
+	htmlContent := string(content)
+
+	assert.Contains(t, htmlContent, "<title>Acme Corp Pentest Report</title>")
+	assert.Contains(t, htmlContent, "<h1>Acme Corp Pentest Report</h1>")
+	assert.Contains(t, htmlContent, "Acme Security")
+	assert.Contains(t, htmlContent, "data:image/png;base64,AAAA")
+	assert.Contains(t, htmlContent, "Globex")
+	assert.Contains(t, htmlContent, "Q3 Pentest")
+	assert.Contains(t, htmlContent, "api.globex.com")
+}
+
+func TestWriteHTMLWithOptions_LanguagePack(t *testing.T) {
+	att := attempt.New("hello")
+	att.Probe = "test.Test"
+	att.Status = attempt.StatusComplete
+	att.Scores = []float64{0.1}
+
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "report.html")
+
+	err := WriteHTMLWithOptions(outputPath, []*attempt.Attempt{att},
+		WithLanguagePack(map[string]string{HeadingSummary: "Résumé"}),
+	)
+	require.NoError(t, err, "WriteHTMLWithOptions should succeed")
+
+	content, err := os.ReadFile(outputPath)
+	require.NoError(t, err, "should read output file")
+	htmlContent := string(content)
+
+	assert.Contains(t, htmlContent, "Résumé")
+	assert.NotContains(t, htmlContent, "<h2>Summary</h2>")
+}
+
+func TestWriteHTML_NoOptions_UnchangedDefaultBranding(t *testing.T) {
+	htmlContent := writeHTMLAndRead(t, nil)
+
+	assert.Contains(t, htmlContent, "<title>Augustus Scan Report</title>")
+	assert.Contains(t, htmlContent, "<h1>Augustus Scan Report</h1>")
+	assert.NotContains(t, htmlContent, `<div class="report-brand">`)
+	assert.NotContains(t, htmlContent, `<div class="engagement-meta">`)
+}
@@ -0,0 +1,119 @@
+package results
+
+import "github.com/praetorian-inc/augustus/pkg/registry"
+
+// EngagementInfo carries client-facing engagement metadata - consultancy
+// and client names, dates, and scope - rendered near the top of an HTML
+// report, above the Summary cards.
+type EngagementInfo struct {
+	Client     string
+	Engagement string
+	DateRange  string
+	Scope      string
+}
+
+// IsZero reports whether every field of info is empty, so callers can skip
+// rendering the engagement metadata block entirely when none was supplied.
+func (info EngagementInfo) IsZero() bool {
+	return info.Client == "" && info.Engagement == "" && info.DateRange == "" && info.Scope == ""
+}
+
+// ReportConfig holds the branding and localization settings WriteHTMLWithOptions
+// applies to a generated HTML report: organization name and logo, a report
+// title, engagement metadata, and section heading text.
+type ReportConfig struct {
+	Title      string
+	OrgName    string
+	LogoURL    string
+	Engagement EngagementInfo
+	Headings   map[string]string
+	// Thresholds overrides attempt.DefaultVulnerabilityThreshold for
+	// specific probes or detectors when computing the report's pass/fail
+	// counts. The zero value applies the global default everywhere.
+	Thresholds Thresholds
+}
+
+// Heading keys accepted by ReportConfig.Headings / WithLanguagePack.
+const (
+	HeadingSummary    = "summary"
+	HeadingScorecard  = "scorecard"
+	HeadingBuffs      = "buffs"
+	HeadingHeatmap    = "heatmap"
+	HeadingNoAttempts = "no_attempts"
+)
+
+// DefaultReportConfig returns the report configuration WriteHTML uses when
+// no options are supplied: Augustus's own branding and English headings.
+func DefaultReportConfig() ReportConfig {
+	return ReportConfig{
+		Title: "Augustus Scan Report",
+		Headings: map[string]string{
+			HeadingSummary:    "Summary",
+			HeadingScorecard:  "Compliance Scorecard",
+			HeadingBuffs:      "Buff Comparison",
+			HeadingHeatmap:    "Probe × Detector Heatmap",
+			HeadingNoAttempts: "No attempts recorded",
+		},
+	}
+}
+
+// heading returns cfg's text for key, falling back to the English default
+// if cfg.Headings doesn't override it.
+func (cfg ReportConfig) heading(key string) string {
+	if text, ok := cfg.Headings[key]; ok && text != "" {
+		return text
+	}
+	return DefaultReportConfig().Headings[key]
+}
+
+// ReportOption customizes a ReportConfig. See WithTitle, WithOrgName,
+// WithLogo, WithEngagement, and WithLanguagePack.
+type ReportOption = registry.Option[ReportConfig]
+
+// ApplyReportOptions applies opts to cfg in order, returning the result.
+func ApplyReportOptions(cfg ReportConfig, opts ...ReportOption) ReportConfig {
+	return registry.ApplyOptions(cfg, opts...)
+}
+
+// WithTitle overrides the report's <title> and <h1> heading, e.g. for a
+// consultancy's own report name instead of "Augustus Scan Report".
+func WithTitle(title string) ReportOption {
+	return func(c *ReportConfig) { c.Title = title }
+}
+
+// WithOrgName sets the organization name displayed alongside the logo.
+func WithOrgName(name string) ReportOption {
+	return func(c *ReportConfig) { c.OrgName = name }
+}
+
+// WithLogo sets the logo image rendered at the top of the report. url may
+// be a data: URI so the report stays self-contained, or a regular URL.
+func WithLogo(url string) ReportOption {
+	return func(c *ReportConfig) { c.LogoURL = url }
+}
+
+// WithEngagement sets client-facing engagement metadata (client name,
+// engagement name, date range, scope) rendered above the Summary section.
+func WithEngagement(info EngagementInfo) ReportOption {
+	return func(c *ReportConfig) { c.Engagement = info }
+}
+
+// WithThresholds overrides attempt.DefaultVulnerabilityThreshold for
+// specific probes or detectors (see Thresholds) when computing the
+// report's pass/fail counts, matching the threshold used by the table
+// evaluator and --fail-on policy checks for the same scan.
+func WithThresholds(th Thresholds) ReportOption {
+	return func(c *ReportConfig) { c.Thresholds = th }
+}
+
+// WithLanguagePack overrides section heading text by key (HeadingSummary,
+// HeadingScorecard, HeadingBuffs, HeadingHeatmap, HeadingNoAttempts).
+// Keys absent from pack keep their English default, so a partial pack is
+// safe to pass.
+func WithLanguagePack(pack map[string]string) ReportOption {
+	return func(c *ReportConfig) {
+		for k, v := range pack {
+			c.Headings[k] = v
+		}
+	}
+}
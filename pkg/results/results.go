@@ -1,6 +1,7 @@
 package results
 
 import (
+	"strings"
 	"time"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
@@ -62,6 +63,33 @@ type AttemptResult struct {
 
 	// Timestamp records when the attempt occurred.
 	Timestamp time.Time `json:"timestamp"`
+
+	// DurationMs is the generator call's wall-clock latency, in milliseconds.
+	DurationMs int64 `json:"duration_ms,omitempty"`
+
+	// ErrorClass categorizes a failed attempt (timeout, rate_limit, auth,
+	// content_filter, unknown); empty for attempts that didn't error.
+	ErrorClass string `json:"error_class,omitempty"`
+
+	// HTTPStatus is the HTTP status code extracted from the error message,
+	// when present.
+	HTTPStatus int `json:"http_status,omitempty"`
+
+	// Conversations holds the full multi-turn dialogue history, when the
+	// probe recorded one. Prompt/Response above only ever show the first
+	// turn, so multi-turn attacks (fragmented payloads, adaptive attacker
+	// strategies recorded as a Conversation) need this to be triaged
+	// without re-running the scan.
+	Conversations []*attempt.Conversation `json:"conversations,omitempty"`
+
+	// Metadata carries the attempt's reserved metadata keys (triggers,
+	// provenance, etc. - see attempt.MetadataKey* ), so replay/diff
+	// workflows reading the JSONL back via ReadAttemptResultsJSONL can
+	// recover them. ReadAttemptResultsJSONL restores the canonical Go type
+	// for each known key; a plain json.Unmarshal of this field alone leaves
+	// them as the untyped shapes encoding/json produces (e.g. []string
+	// becomes []any).
+	Metadata map[string]any `json:"metadata,omitempty"`
 }
 
 // Summary provides high-level statistics about scan results.
@@ -77,6 +105,69 @@ type Summary struct {
 
 	// ByProbe maps probe names to pass/fail counts.
 	ByProbe map[string]ProbeStats `json:"by_probe"`
+
+	// ByBuffChain maps a buff chain label (e.g. "encoding.Base64", or
+	// "encoding.Base64 + flip.FlipAttack" for a multi-buff chain, or
+	// "none" for unbuffed attempts) to pass/fail counts, so scans that
+	// compare several buffs/buff-chains can see which ones actually
+	// raise attack success rate against the target.
+	ByBuffChain map[string]BuffChainStats `json:"by_buff_chain,omitempty"`
+
+	// ByProbeDetector maps probe name -> detector name -> pass/fail counts,
+	// giving the failure rate for every probe/detector pairing that ran.
+	// Powers the HTML report's probe-by-detector heatmap.
+	ByProbeDetector map[string]map[string]ProbeDetectorCell `json:"by_probe_detector,omitempty"`
+}
+
+// ProbeDetectorCell contains failure-rate statistics for one probe run
+// through one detector.
+type ProbeDetectorCell struct {
+	// Total is the number of attempts this detector scored for this probe.
+	Total int `json:"total"`
+
+	// Failed is the number of those attempts the detector flagged as
+	// vulnerable (score above DefaultVulnerabilityThreshold).
+	Failed int `json:"failed"`
+}
+
+// FailureRate returns the fraction of attempts this detector flagged as
+// vulnerable for this probe, or 0 if no attempts were scored.
+func (c ProbeDetectorCell) FailureRate() float64 {
+	if c.Total == 0 {
+		return 0
+	}
+	return float64(c.Failed) / float64(c.Total)
+}
+
+// BuffChainStats contains pass/fail statistics for a specific buff chain.
+type BuffChainStats struct {
+	// Total is the total number of attempts that went through this chain.
+	Total int `json:"total"`
+
+	// Passed is the number of attempts that passed (model resisted).
+	Passed int `json:"passed"`
+
+	// Failed is the number of attempts that failed (model was vulnerable),
+	// i.e. the attack succeeded.
+	Failed int `json:"failed"`
+}
+
+// buffChainNone labels attempts that went through no buff at all, so they
+// can still appear in a buff-comparison report as the unbuffed baseline.
+const buffChainNone = "none"
+
+// buffChainLabel renders an attempt's buff provenance chain as a single
+// display/grouping key, e.g. "encoding.Base64 + flip.FlipAttack" for a
+// two-buff chain, or buffChainNone if the attempt wasn't buffed.
+func buffChainLabel(chain []attempt.ProvenanceStep) string {
+	if len(chain) == 0 {
+		return buffChainNone
+	}
+	names := make([]string, len(chain))
+	for i, step := range chain {
+		names[i] = step.Buff
+	}
+	return strings.Join(names, " + ")
 }
 
 // ProbeStats contains statistics for a specific probe.
@@ -89,20 +180,63 @@ type ProbeStats struct {
 
 	// Failed is the number of attempts that failed.
 	Failed int `json:"failed"`
+
+	// AvgLatencyMs is the mean wall-clock latency, in milliseconds, across
+	// this probe's attempts that recorded a duration.
+	AvgLatencyMs float64 `json:"avg_latency_ms,omitempty"`
+
+	// ErrorClasses maps error classification labels (see probes.ErrorClass)
+	// to how many of this probe's attempts hit them.
+	ErrorClasses map[string]int `json:"error_classes,omitempty"`
+}
+
+// Thresholds overrides attempt.DefaultVulnerabilityThreshold for specific
+// probes or detectors, so a noisier detector or a probe category with a
+// different risk tolerance can use its own pass/fail cutoff without
+// changing the global default. The zero value applies the global default
+// everywhere.
+type Thresholds struct {
+	// Probe maps a probe name to the score threshold above which it's
+	// considered vulnerable.
+	Probe map[string]float64
+	// Detector maps a detector name to the score threshold above which
+	// it's considered vulnerable. A detector override wins over a probe
+	// override when both match the same attempt.
+	Detector map[string]float64
+}
+
+// Resolve returns the threshold to apply for an attempt with the given
+// probe and detector names: a per-detector override wins, then a
+// per-probe override, then attempt.DefaultVulnerabilityThreshold.
+func (t Thresholds) Resolve(probe, detector string) float64 {
+	if v, ok := t.Detector[detector]; ok {
+		return v
+	}
+	if v, ok := t.Probe[probe]; ok {
+		return v
+	}
+	return attempt.DefaultVulnerabilityThreshold
 }
 
-// isPassed determines if an attempt passed based on status and scores.
-// Error or pending status always returns false (fail-safe default).
-// For complete status, all scores must be <= DefaultVulnerabilityThreshold to pass.
+// isPassed determines if an attempt passed based on status and scores,
+// against attempt.DefaultVulnerabilityThreshold.
+// Error, pending, or timed-out status always returns false (fail-safe default).
 func isPassed(status attempt.Status, scores []float64) bool {
-	// Error or pending status = failure (fail-safe)
-	if status == attempt.StatusError || status == attempt.StatusPending {
+	return isPassedAt(status, scores, attempt.DefaultVulnerabilityThreshold)
+}
+
+// isPassedAt determines if an attempt passed based on status and scores.
+// Error, pending, or timed-out status always returns false (fail-safe default).
+// For complete status, all scores must be <= threshold to pass.
+func isPassedAt(status attempt.Status, scores []float64, threshold float64) bool {
+	// Error, pending, or timed-out status = failure (fail-safe)
+	if status == attempt.StatusError || status == attempt.StatusPending || status == attempt.StatusTimedOut {
 		return false
 	}
 
 	// For complete status, check scores against threshold
 	for _, score := range scores {
-		if score > attempt.DefaultVulnerabilityThreshold {
+		if score > threshold {
 			return false
 		}
 	}
@@ -110,52 +244,109 @@ func isPassed(status attempt.Status, scores []float64) bool {
 	return true
 }
 
-// ToAttemptResult converts a single attempt to a simplified AttemptResult.
+// effectiveDetectorResults returns the detector-name -> scores pairs to
+// credit an attempt to in the probe/detector heatmap: every entry in
+// DetectorResults when detectors were run and recorded per-name, or a
+// single entry keyed by the legacy Detector field as a fallback for
+// attempts that only ever set the flat Scores field.
+func effectiveDetectorResults(a *attempt.Attempt) map[string][]float64 {
+	if len(a.DetectorResults) > 0 {
+		return a.DetectorResults
+	}
+	if a.Detector == "" {
+		return nil
+	}
+	return map[string][]float64{a.Detector: a.Scores}
+}
+
+// ToAttemptResult converts a single attempt to a simplified AttemptResult,
+// using attempt.DefaultVulnerabilityThreshold for every probe and detector.
+// See ToAttemptResultWithThresholds to apply per-probe/per-detector
+// overrides instead.
 func ToAttemptResult(a *attempt.Attempt) AttemptResult {
+	return ToAttemptResultWithThresholds(a, Thresholds{})
+}
+
+// ToAttemptResultWithThresholds converts a single attempt to a simplified
+// AttemptResult, using th to resolve the pass/fail threshold for a.Probe
+// and a.Detector.
+func ToAttemptResultWithThresholds(a *attempt.Attempt, th Thresholds) AttemptResult {
 	response := ""
 	if len(a.Outputs) > 0 {
 		response = a.Outputs[0]
 	}
 	scores := a.GetEffectiveScores()
-	passed := isPassed(a.Status, scores)
+	passed := isPassedAt(a.Status, scores, th.Resolve(a.Probe, a.Detector))
+
+	errorClass, _ := a.Metadata["error_class"].(string)
+	httpStatus, _ := a.Metadata["http_status"].(int)
 
 	return AttemptResult{
-		Probe:     a.Probe,
-		Prompt:    a.Prompt,
-		Response:  response,
-		Detector:  a.Detector,
-		Scores:    scores,
-		Passed:    passed,
-		Status:    a.Status,
-		Error:     a.Error,
-		Timestamp: a.Timestamp,
+		Probe:         a.Probe,
+		Prompt:        a.Prompt,
+		Response:      response,
+		Detector:      a.Detector,
+		Scores:        scores,
+		Passed:        passed,
+		Status:        a.Status,
+		Error:         a.Error,
+		Timestamp:     a.Timestamp,
+		DurationMs:    a.Duration.Milliseconds(),
+		ErrorClass:    errorClass,
+		HTTPStatus:    httpStatus,
+		Conversations: a.Conversations,
+		Metadata:      a.Metadata,
 	}
 }
 
-// ToAttemptResults converts a slice of attempts to simplified AttemptResults.
+// ToAttemptResults converts a slice of attempts to simplified AttemptResults,
+// using attempt.DefaultVulnerabilityThreshold for every probe and detector.
+// See ToAttemptResultsWithThresholds to apply per-probe/per-detector
+// overrides instead.
 func ToAttemptResults(attempts []*attempt.Attempt) []AttemptResult {
+	return ToAttemptResultsWithThresholds(attempts, Thresholds{})
+}
+
+// ToAttemptResultsWithThresholds converts a slice of attempts to simplified
+// AttemptResults, using th to resolve each attempt's pass/fail threshold.
+func ToAttemptResultsWithThresholds(attempts []*attempt.Attempt, th Thresholds) []AttemptResult {
 	results := make([]AttemptResult, 0, len(attempts))
 	for _, a := range attempts {
-		results = append(results, ToAttemptResult(a))
+		results = append(results, ToAttemptResultWithThresholds(a, th))
 	}
 	return results
 }
 
-// ComputeSummary calculates summary statistics from attempts.
+// ComputeSummary calculates summary statistics from attempts, using
+// attempt.DefaultVulnerabilityThreshold for every probe and detector. See
+// ComputeSummaryWithThresholds to apply per-probe/per-detector overrides
+// instead.
 func ComputeSummary(attempts []*attempt.Attempt) Summary {
+	return ComputeSummaryWithThresholds(attempts, Thresholds{})
+}
+
+// ComputeSummaryWithThresholds calculates summary statistics from attempts,
+// using th to resolve each attempt's and each detector cell's pass/fail
+// threshold.
+func ComputeSummaryWithThresholds(attempts []*attempt.Attempt, th Thresholds) Summary {
 	summary := Summary{
-		TotalAttempts: len(attempts),
-		Passed:        0,
-		Failed:        0,
-		ByProbe:       make(map[string]ProbeStats),
+		TotalAttempts:   len(attempts),
+		Passed:          0,
+		Failed:          0,
+		ByProbe:         make(map[string]ProbeStats),
+		ByBuffChain:     make(map[string]BuffChainStats),
+		ByProbeDetector: make(map[string]map[string]ProbeDetectorCell),
 	}
 
+	latencyTotal := make(map[string]time.Duration)
+	latencyCount := make(map[string]int)
+
 	for _, a := range attempts {
 		// Use centralized score resolution
 		scores := a.GetEffectiveScores()
 
-		// Use isPassed() helper - respects Status field
-		passed := isPassed(a.Status, scores)
+		// Use isPassedAt() helper - respects Status field
+		passed := isPassedAt(a.Status, scores, th.Resolve(a.Probe, a.Detector))
 
 		if passed {
 			summary.Passed++
@@ -171,7 +362,58 @@ func ComputeSummary(attempts []*attempt.Attempt) Summary {
 		} else {
 			stats.Failed++
 		}
+
+		if a.Duration > 0 {
+			latencyTotal[a.Probe] += a.Duration
+			latencyCount[a.Probe]++
+		}
+
+		if class, ok := a.Metadata["error_class"].(string); ok && class != "" {
+			if stats.ErrorClasses == nil {
+				stats.ErrorClasses = make(map[string]int)
+			}
+			stats.ErrorClasses[class]++
+		}
+
 		summary.ByProbe[a.Probe] = stats
+
+		chainLabel := buffChainLabel(a.ProvenanceChain())
+		chainStats := summary.ByBuffChain[chainLabel]
+		chainStats.Total++
+		if passed {
+			chainStats.Passed++
+		} else {
+			chainStats.Failed++
+		}
+		summary.ByBuffChain[chainLabel] = chainStats
+
+		for detectorName, detectorScores := range effectiveDetectorResults(a) {
+			detectorPassed := isPassedAt(a.Status, detectorScores, th.Resolve(a.Probe, detectorName))
+
+			probeRow := summary.ByProbeDetector[a.Probe]
+			if probeRow == nil {
+				probeRow = make(map[string]ProbeDetectorCell)
+				summary.ByProbeDetector[a.Probe] = probeRow
+			}
+			cell := probeRow[detectorName]
+			cell.Total++
+			if !detectorPassed {
+				cell.Failed++
+			}
+			probeRow[detectorName] = cell
+		}
+	}
+
+	// A single bucket (no buffs, or every attempt went through the same
+	// chain) has nothing to compare, so omit it entirely.
+	if len(summary.ByBuffChain) <= 1 {
+		summary.ByBuffChain = nil
+	}
+
+	for probeName, count := range latencyCount {
+		stats := summary.ByProbe[probeName]
+		stats.AvgLatencyMs = float64(latencyTotal[probeName].Milliseconds()) / float64(count)
+		summary.ByProbe[probeName] = stats
 	}
 
 	return summary
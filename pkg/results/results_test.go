@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/praetorian-inc/augustus/pkg/attempt"
 )
@@ -54,6 +55,12 @@ func TestIsPassed_ErrorStatus(t *testing.T) {
 			scores:   []float64{0.1, 0.2},
 			expected: false,
 		},
+		{
+			name:     "timed out status always fails",
+			status:   attempt.StatusTimedOut,
+			scores:   []float64{},
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -116,3 +123,245 @@ func TestComputeSummary_ErrorStatus(t *testing.T) {
 	assert.Equal(t, 1, summary.Passed, "only one attempt should pass")
 	assert.Equal(t, 2, summary.Failed, "two attempts should fail (error + high score)")
 }
+
+// TestComputeSummary_LatencyAndErrorClasses verifies per-probe latency
+// averages and error class counts are aggregated from attempt metadata.
+func TestComputeSummary_LatencyAndErrorClasses(t *testing.T) {
+	attempts := []*attempt.Attempt{
+		{
+			Probe:    "test.Test",
+			Status:   attempt.StatusComplete,
+			Scores:   []float64{0.1},
+			Duration: 100 * time.Millisecond,
+		},
+		{
+			Probe:    "test.Test",
+			Status:   attempt.StatusError,
+			Duration: 300 * time.Millisecond,
+			Metadata: map[string]any{"error_class": "rate_limit"},
+		},
+		{
+			Probe:    "test.Test",
+			Status:   attempt.StatusError,
+			Metadata: map[string]any{"error_class": "rate_limit"},
+		},
+	}
+
+	summary := ComputeSummary(attempts)
+
+	stats := summary.ByProbe["test.Test"]
+	assert.InDelta(t, 200.0, stats.AvgLatencyMs, 0.001, "average should only include attempts with a recorded duration")
+	assert.Equal(t, map[string]int{"rate_limit": 2}, stats.ErrorClasses)
+}
+
+// TestToAttemptResult_IncludesLatencyAndErrorClass verifies the simplified
+// AttemptResult carries duration and error classification metadata.
+func TestToAttemptResult_IncludesLatencyAndErrorClass(t *testing.T) {
+	a := &attempt.Attempt{
+		Probe:    "test.Test",
+		Status:   attempt.StatusError,
+		Duration: 250 * time.Millisecond,
+		Metadata: map[string]any{"error_class": "auth", "http_status": 401},
+	}
+
+	result := ToAttemptResult(a)
+
+	assert.Equal(t, int64(250), result.DurationMs)
+	assert.Equal(t, "auth", result.ErrorClass)
+	assert.Equal(t, 401, result.HTTPStatus)
+}
+
+func TestToAttemptResult_IncludesConversations(t *testing.T) {
+	conv := attempt.NewConversation()
+	conv.AddTurn(attempt.NewTurn("part 1").WithResponse("okay"))
+	conv.AddTurn(attempt.NewTurn("part 2").WithResponse("got it"))
+
+	a := &attempt.Attempt{
+		Probe:         "smuggling.MultiTurn",
+		Status:        attempt.StatusComplete,
+		Conversations: []*attempt.Conversation{conv},
+	}
+
+	result := ToAttemptResult(a)
+
+	require.Len(t, result.Conversations, 1)
+	assert.Len(t, result.Conversations[0].Turns, 2)
+}
+
+// TestComputeSummary_ByBuffChain verifies per-buff-chain pass/fail counts
+// are aggregated so scans comparing multiple buffs can see which chain
+// raised the attack success rate.
+func TestComputeSummary_ByBuffChain(t *testing.T) {
+	unbuffed := &attempt.Attempt{Probe: "test.Test", Status: attempt.StatusComplete, Scores: []float64{0.1}}
+
+	base64Attack := &attempt.Attempt{Probe: "test.Test", Status: attempt.StatusComplete, Scores: []float64{0.9}}
+	base64Attack.AppendProvenance("encoding.Base64", "", "hello")
+
+	chainAttack := &attempt.Attempt{Probe: "test.Test", Status: attempt.StatusComplete, Scores: []float64{0.9}}
+	chainAttack.AppendProvenance("encoding.Base64", "", "hello")
+	chainAttack.AppendProvenance("flip.FlipAttack", "word", "aGVsbG8=")
+
+	summary := ComputeSummary([]*attempt.Attempt{unbuffed, base64Attack, chainAttack})
+
+	require.Len(t, summary.ByBuffChain, 3)
+	assert.Equal(t, BuffChainStats{Total: 1, Passed: 1}, summary.ByBuffChain["none"])
+	assert.Equal(t, BuffChainStats{Total: 1, Failed: 1}, summary.ByBuffChain["encoding.Base64"])
+	assert.Equal(t, BuffChainStats{Total: 1, Failed: 1}, summary.ByBuffChain["encoding.Base64 + flip.FlipAttack"])
+}
+
+// TestComputeSummary_ByBuffChainOmittedWhenSingleChain verifies the
+// comparison map is nil when every attempt went through the same chain
+// (including the all-unbuffed case), since there's nothing to compare.
+func TestComputeSummary_ByBuffChainOmittedWhenSingleChain(t *testing.T) {
+	attempts := []*attempt.Attempt{
+		{Probe: "test.Test", Status: attempt.StatusComplete, Scores: []float64{0.1}},
+		{Probe: "test.Test", Status: attempt.StatusComplete, Scores: []float64{0.2}},
+	}
+
+	summary := ComputeSummary(attempts)
+
+	assert.Nil(t, summary.ByBuffChain)
+}
+
+// TestComputeSummary_ByProbeDetector verifies per-probe, per-detector
+// failure counts are aggregated from DetectorResults, so the HTML report's
+// heatmap can show which probe/detector pairings found vulnerabilities.
+func TestComputeSummary_ByProbeDetector(t *testing.T) {
+	passing := &attempt.Attempt{
+		Probe:           "dan.Dan_11_0",
+		Status:          attempt.StatusComplete,
+		DetectorResults: map[string][]float64{"dan.DAN": {0.1}, "always.Fail": {1.0}},
+	}
+	failing := &attempt.Attempt{
+		Probe:           "dan.Dan_11_0",
+		Status:          attempt.StatusComplete,
+		DetectorResults: map[string][]float64{"dan.DAN": {0.9}, "always.Fail": {1.0}},
+	}
+	legacy := &attempt.Attempt{
+		Probe:    "encoding.InjectBase64",
+		Status:   attempt.StatusComplete,
+		Detector: "always.Pass",
+		Scores:   []float64{0.1},
+	}
+
+	summary := ComputeSummary([]*attempt.Attempt{passing, failing, legacy})
+
+	require.Len(t, summary.ByProbeDetector, 2)
+	danRow := summary.ByProbeDetector["dan.Dan_11_0"]
+	assert.Equal(t, ProbeDetectorCell{Total: 2, Failed: 1}, danRow["dan.DAN"])
+	assert.Equal(t, ProbeDetectorCell{Total: 2, Failed: 2}, danRow["always.Fail"])
+	legacyRow := summary.ByProbeDetector["encoding.InjectBase64"]
+	assert.Equal(t, ProbeDetectorCell{Total: 1, Failed: 0}, legacyRow["always.Pass"])
+}
+
+func TestProbeDetectorCell_FailureRate(t *testing.T) {
+	assert.Equal(t, 0.0, ProbeDetectorCell{}.FailureRate())
+	assert.InDelta(t, 0.5, ProbeDetectorCell{Total: 4, Failed: 2}.FailureRate(), 0.001)
+}
+
+func TestToAttemptResult_IncludesMetadata(t *testing.T) {
+	a := &attempt.Attempt{Probe: "test.Test", Status: attempt.StatusComplete}
+	a.SetTriggers([]string{"trigger"})
+
+	result := ToAttemptResult(a)
+
+	assert.Equal(t, []string{"trigger"}, result.Metadata[attempt.MetadataKeyTriggers])
+}
+
+func TestToAttemptResult_NilConversationsOmitted(t *testing.T) {
+	a := &attempt.Attempt{Probe: "test.Test", Status: attempt.StatusComplete}
+
+	result := ToAttemptResult(a)
+
+	assert.Nil(t, result.Conversations)
+}
+
+func TestThresholds_Resolve(t *testing.T) {
+	tests := []struct {
+		name     string
+		th       Thresholds
+		probe    string
+		detector string
+		expected float64
+	}{
+		{
+			name:     "zero value uses global default",
+			th:       Thresholds{},
+			probe:    "dan.Dan_11_0",
+			detector: "dan.DAN",
+			expected: attempt.DefaultVulnerabilityThreshold,
+		},
+		{
+			name:     "probe override applies",
+			th:       Thresholds{Probe: map[string]float64{"dan.Dan_11_0": 0.3}},
+			probe:    "dan.Dan_11_0",
+			detector: "dan.DAN",
+			expected: 0.3,
+		},
+		{
+			name:     "detector override wins over probe override",
+			th:       Thresholds{Probe: map[string]float64{"dan.Dan_11_0": 0.3}, Detector: map[string]float64{"dan.DAN": 0.7}},
+			probe:    "dan.Dan_11_0",
+			detector: "dan.DAN",
+			expected: 0.7,
+		},
+		{
+			name:     "unmatched probe/detector falls back to global default",
+			th:       Thresholds{Probe: map[string]float64{"dan.Dan_11_0": 0.3}},
+			probe:    "encoding.InjectBase64",
+			detector: "always.Fail",
+			expected: attempt.DefaultVulnerabilityThreshold,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.th.Resolve(tt.probe, tt.detector))
+		})
+	}
+}
+
+func TestToAttemptResultWithThresholds_UsesProbeOverride(t *testing.T) {
+	a := &attempt.Attempt{
+		Probe:    "dan.Dan_11_0",
+		Detector: "dan.DAN",
+		Status:   attempt.StatusComplete,
+		Scores:   []float64{0.4},
+	}
+	th := Thresholds{Probe: map[string]float64{"dan.Dan_11_0": 0.3}}
+
+	result := ToAttemptResultWithThresholds(a, th)
+
+	assert.False(t, result.Passed, "score 0.4 should fail against a 0.3 probe override")
+	assert.True(t, isPassed(a.Status, a.Scores), "the same attempt passes against the global default")
+}
+
+func TestToAttemptResultsWithThresholds_UsesDetectorOverride(t *testing.T) {
+	a := &attempt.Attempt{
+		Probe:    "dan.Dan_11_0",
+		Detector: "dan.DAN",
+		Status:   attempt.StatusComplete,
+		Scores:   []float64{0.2},
+	}
+	th := Thresholds{Detector: map[string]float64{"dan.DAN": 0.1}}
+
+	results := ToAttemptResultsWithThresholds([]*attempt.Attempt{a}, th)
+
+	require.Len(t, results, 1)
+	assert.False(t, results[0].Passed, "score 0.2 should fail against a 0.1 detector override")
+}
+
+func TestComputeSummaryWithThresholds_AppliesOverride(t *testing.T) {
+	a := &attempt.Attempt{
+		Probe:    "dan.Dan_11_0",
+		Detector: "dan.DAN",
+		Status:   attempt.StatusComplete,
+		Scores:   []float64{0.6},
+	}
+	th := Thresholds{Probe: map[string]float64{"dan.Dan_11_0": 0.5}}
+
+	summary := ComputeSummaryWithThresholds([]*attempt.Attempt{a}, th)
+
+	assert.Equal(t, 0, summary.Passed)
+	assert.Equal(t, 1, summary.Failed)
+}
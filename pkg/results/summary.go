@@ -0,0 +1,238 @@
+package results
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// ReadAttemptResultsJSONL reads back a JSONL file written by WriteJSONL,
+// decoding each line into an AttemptResult. Metadata is passed through
+// attempt.NormalizeMetadata so reserved keys like triggers and provenance
+// come back as their canonical Go types rather than the []any shape
+// encoding/json produces for untyped slices.
+func ReadAttemptResultsJSONL(path string) ([]AttemptResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open results file: %w", err)
+	}
+	defer file.Close()
+
+	var out []AttemptResult
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var result AttemptResult
+		if err := json.Unmarshal(line, &result); err != nil {
+			return nil, fmt.Errorf("failed to parse line %d: %w", lineNum, err)
+		}
+		if err := attempt.NormalizeMetadata(result.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to normalize metadata on line %d: %w", lineNum, err)
+		}
+		out = append(out, result)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read results file: %w", err)
+	}
+
+	return out, nil
+}
+
+// WriteAttemptResultsJSONL writes rs to path in the same simplified JSONL
+// format WriteJSONL produces, one JSON object per line. Unlike WriteJSONL,
+// it takes already-simplified AttemptResult records rather than full
+// attempts - useful for tools (like 'augustus anonymize') that read a
+// results file back in, transform it, and write it back out without
+// reconstructing the original attempts.
+func WriteAttemptResultsJSONL(path string, rs []AttemptResult) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	for _, r := range rs {
+		if err := encoder.Encode(r); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	}
+	return nil
+}
+
+// ProbeRisk summarizes a single probe's failure rate, used to rank the
+// top risks in an executive summary.
+type ProbeRisk struct {
+	Probe    string
+	Total    int
+	Failed   int
+	FailRate float64
+
+	// FailRatePct is FailRate expressed as 0-100, precomputed so the default
+	// template doesn't need custom formatting functions.
+	FailRatePct float64
+}
+
+// ExecutiveSummary is the data model rendered by the `augustus report
+// summarize` template: aggregate pass/fail stats, the riskiest probes, and
+// a handful of notable failing examples.
+type ExecutiveSummary struct {
+	TotalAttempts int
+	Passed        int
+	Failed        int
+	PassRate      float64
+
+	// PassRatePct is PassRate expressed as 0-100, precomputed so the default
+	// template doesn't need custom formatting functions.
+	PassRatePct float64
+
+	// TopRisks lists the probes with the highest failure rate, worst first.
+	TopRisks []ProbeRisk
+
+	// NotableExamples lists the highest-scoring failing attempts, worst first.
+	NotableExamples []AttemptResult
+
+	// Narrative is an optional free-text overview. When NarrativeIsMachineGenerated
+	// is true, it was drafted by a configured LLM rather than written by a human,
+	// and templates should label it as such.
+	Narrative                   string
+	NarrativeIsMachineGenerated bool
+}
+
+// maxAttemptScore returns the highest score recorded for an attempt, or 0
+// if it has no scores.
+func maxAttemptScore(r AttemptResult) float64 {
+	max := 0.0
+	for _, s := range r.Scores {
+		if s > max {
+			max = s
+		}
+	}
+	return max
+}
+
+// BuildExecutiveSummary aggregates attempt results into top risks and
+// notable examples, each capped at topN entries.
+func BuildExecutiveSummary(attempts []AttemptResult, topN int) ExecutiveSummary {
+	summary := ExecutiveSummary{TotalAttempts: len(attempts)}
+
+	byProbe := make(map[string]*ProbeRisk)
+	var probeOrder []string
+
+	for _, r := range attempts {
+		if r.Passed {
+			summary.Passed++
+		} else {
+			summary.Failed++
+		}
+
+		risk, ok := byProbe[r.Probe]
+		if !ok {
+			risk = &ProbeRisk{Probe: r.Probe}
+			byProbe[r.Probe] = risk
+			probeOrder = append(probeOrder, r.Probe)
+		}
+		risk.Total++
+		if !r.Passed {
+			risk.Failed++
+		}
+	}
+
+	if summary.TotalAttempts > 0 {
+		summary.PassRate = float64(summary.Passed) / float64(summary.TotalAttempts)
+		summary.PassRatePct = summary.PassRate * 100
+	}
+
+	risks := make([]ProbeRisk, 0, len(probeOrder))
+	for _, probe := range probeOrder {
+		risk := byProbe[probe]
+		if risk.Total > 0 {
+			risk.FailRate = float64(risk.Failed) / float64(risk.Total)
+			risk.FailRatePct = risk.FailRate * 100
+		}
+		risks = append(risks, *risk)
+	}
+	sort.SliceStable(risks, func(i, j int) bool {
+		return risks[i].FailRate > risks[j].FailRate
+	})
+	summary.TopRisks = truncateRisks(risks, topN)
+
+	failing := make([]AttemptResult, 0, len(attempts))
+	for _, r := range attempts {
+		if !r.Passed {
+			failing = append(failing, r)
+		}
+	}
+	sort.SliceStable(failing, func(i, j int) bool {
+		return maxAttemptScore(failing[i]) > maxAttemptScore(failing[j])
+	})
+	summary.NotableExamples = truncateResults(failing, topN)
+
+	return summary
+}
+
+func truncateRisks(risks []ProbeRisk, n int) []ProbeRisk {
+	if n <= 0 || n >= len(risks) {
+		return risks
+	}
+	return risks[:n]
+}
+
+func truncateResults(results []AttemptResult, n int) []AttemptResult {
+	if n <= 0 || n >= len(results) {
+		return results
+	}
+	return results[:n]
+}
+
+// DefaultSummaryTemplate is the built-in Markdown layout for `augustus
+// report summarize`. Override it with --template-file to customize the
+// report without recompiling.
+const DefaultSummaryTemplate = `# Executive Summary
+
+- Total attempts: {{.TotalAttempts}}
+- Passed: {{.Passed}}
+- Failed: {{.Failed}}
+- Pass rate: {{printf "%.0f" .PassRatePct}}%
+
+## Top Risks
+{{if not .TopRisks}}
+No probes were exercised.
+{{else}}
+{{range .TopRisks}}- {{.Probe}}: {{.Failed}}/{{.Total}} failed ({{printf "%.0f" .FailRatePct}}%)
+{{end}}{{end}}
+## Notable Examples
+{{if not .NotableExamples}}
+No failing attempts were recorded.
+{{else}}
+{{range .NotableExamples}}- [{{.Probe}} / {{.Detector}}] {{.Prompt}}
+{{end}}{{end}}
+{{if .Narrative}}
+## Narrative{{if .NarrativeIsMachineGenerated}} (machine-generated){{end}}
+
+{{.Narrative}}
+{{end}}`
+
+// NarrativePrompt builds the prompt sent to a configured LLM to draft the
+// narrative section of an executive summary.
+func NarrativePrompt(data ExecutiveSummary) string {
+	prompt := fmt.Sprintf(
+		"Write a short narrative overview (2-4 sentences) of an LLM vulnerability scan for an executive audience.\n\n"+
+			"Total attempts: %d\nPassed: %d\nFailed: %d\nPass rate: %.0f%%\n\nTop risks:\n",
+		data.TotalAttempts, data.Passed, data.Failed, data.PassRate*100,
+	)
+	for _, risk := range data.TopRisks {
+		prompt += fmt.Sprintf("- %s: %d/%d failed (%.0f%%)\n", risk.Probe, risk.Failed, risk.Total, risk.FailRate*100)
+	}
+	return prompt
+}
@@ -0,0 +1,153 @@
+package results
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+func TestReadAttemptResultsJSONL(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "results.jsonl")
+
+	content := `{"probe":"dan.Dan_11_0","detector":"dan.DAN","scores":[0.0],"passed":true}
+{"probe":"test.Test","detector":"always.Fail","scores":[0.9],"passed":false}
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+
+	got, err := ReadAttemptResultsJSONL(path)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "dan.Dan_11_0", got[0].Probe)
+	assert.True(t, got[0].Passed)
+	assert.Equal(t, "test.Test", got[1].Probe)
+	assert.False(t, got[1].Passed)
+}
+
+func TestReadAttemptResultsJSONL_MissingFile(t *testing.T) {
+	_, err := ReadAttemptResultsJSONL("/nonexistent/results.jsonl")
+	assert.Error(t, err)
+}
+
+// TestReadAttemptResultsJSONL_RestoresTypedTriggers verifies that metadata
+// written by WriteJSONL round-trips through ReadAttemptResultsJSONL with its
+// canonical Go type (e.g. []string), not the []any shape a plain
+// json.Unmarshal would leave it in.
+func TestReadAttemptResultsJSONL_RestoresTypedTriggers(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "results.jsonl")
+
+	a := &attempt.Attempt{
+		ID:        "test-1",
+		Probe:     "flipattack.FullAttack",
+		Generator: "test.Repeat",
+		Detector:  "flipattack.Bypass",
+		Prompt:    "hello",
+		Outputs:   []string{"hello"},
+		Scores:    []float64{0.0},
+		Timestamp: time.Now(),
+		Status:    attempt.StatusComplete,
+	}
+	a.SetTriggers([]string{"one", "two"})
+	require.NoError(t, WriteJSONL(path, []*attempt.Attempt{a}))
+
+	got, err := ReadAttemptResultsJSONL(path)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+
+	triggers, ok := got[0].Metadata[attempt.MetadataKeyTriggers].([]string)
+	require.True(t, ok, "expected triggers to be restored as []string, got %T", got[0].Metadata[attempt.MetadataKeyTriggers])
+	assert.Equal(t, []string{"one", "two"}, triggers)
+}
+
+// TestReadAttemptResultsJSONL_RestoresProvenanceChain mirrors
+// TestReadAttemptResultsJSONL_RestoresTypedTriggers for provenance, the other
+// slice-typed reserved metadata key.
+func TestReadAttemptResultsJSONL_RestoresProvenanceChain(t *testing.T) {
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "results.jsonl")
+
+	a := &attempt.Attempt{
+		ID:        "test-1",
+		Probe:     "test.Test",
+		Generator: "test.Repeat",
+		Detector:  "always.Pass",
+		Prompt:    "aGVsbG8=",
+		Outputs:   []string{"ok"},
+		Scores:    []float64{0.0},
+		Timestamp: time.Now(),
+		Status:    attempt.StatusComplete,
+	}
+	a.AppendProvenance("encoding.Base64", "", "hello")
+	require.NoError(t, WriteJSONL(path, []*attempt.Attempt{a}))
+
+	got, err := ReadAttemptResultsJSONL(path)
+	require.NoError(t, err)
+
+	chain, ok := got[0].Metadata[attempt.MetadataKeyProvenance].([]attempt.ProvenanceStep)
+	require.True(t, ok, "expected provenance to be restored as []attempt.ProvenanceStep, got %T", got[0].Metadata[attempt.MetadataKeyProvenance])
+	require.Len(t, chain, 1)
+	assert.Equal(t, "encoding.Base64", chain[0].Buff)
+	assert.Equal(t, "hello", chain[0].Prompt)
+}
+
+func TestBuildExecutiveSummary(t *testing.T) {
+	attempts := []AttemptResult{
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Prompt: "p1", Scores: []float64{0.0}, Passed: true},
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Prompt: "p2", Scores: []float64{0.9}, Passed: false},
+		{Probe: "test.Test", Detector: "always.Fail", Prompt: "p3", Scores: []float64{1.0}, Passed: false},
+	}
+
+	summary := BuildExecutiveSummary(attempts, 5)
+
+	assert.Equal(t, 3, summary.TotalAttempts)
+	assert.Equal(t, 1, summary.Passed)
+	assert.Equal(t, 2, summary.Failed)
+
+	require.Len(t, summary.TopRisks, 2)
+	assert.Equal(t, "test.Test", summary.TopRisks[0].Probe, "100%% fail rate ranks above 50%%")
+	assert.Equal(t, 1, summary.TopRisks[0].Failed)
+
+	require.Len(t, summary.NotableExamples, 2)
+	assert.Equal(t, "p3", summary.NotableExamples[0].Prompt, "highest score ranks first")
+}
+
+func TestBuildExecutiveSummary_TruncatesToTopN(t *testing.T) {
+	attempts := []AttemptResult{
+		{Probe: "a.A", Scores: []float64{0.9}, Passed: false},
+		{Probe: "b.B", Scores: []float64{0.8}, Passed: false},
+		{Probe: "c.C", Scores: []float64{0.7}, Passed: false},
+	}
+
+	summary := BuildExecutiveSummary(attempts, 1)
+
+	assert.Len(t, summary.TopRisks, 1)
+	assert.Len(t, summary.NotableExamples, 1)
+}
+
+func TestDefaultSummaryTemplate_Renders(t *testing.T) {
+	summary := BuildExecutiveSummary([]AttemptResult{
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Prompt: "p1", Scores: []float64{0.9}, Passed: false},
+	}, 5)
+	summary.Narrative = "Overall risk is moderate."
+	summary.NarrativeIsMachineGenerated = true
+
+	tmpl, err := template.New("summary").Parse(DefaultSummaryTemplate)
+	require.NoError(t, err)
+
+	var sb strings.Builder
+	require.NoError(t, tmpl.Execute(&sb, summary))
+
+	out := sb.String()
+	assert.Contains(t, out, "dan.Dan_11_0")
+	assert.Contains(t, out, "Overall risk is moderate.")
+	assert.Contains(t, out, "machine-generated")
+}
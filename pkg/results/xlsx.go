@@ -0,0 +1,234 @@
+// XLSX support is hand-rolled against the OOXML spreadsheet format (a zip of
+// plain XML parts) instead of pulling in a third-party spreadsheet library:
+// the repo has no existing spreadsheet dependency, and the format needed here
+// - inline-string cells, one sheet per probe, no styling - is small enough to
+// write directly with archive/zip and encoding/xml from the standard library.
+package results
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// maxSheetNameLen is Excel's hard limit on worksheet name length.
+const maxSheetNameLen = 31
+
+// WriteXLSX writes attempts to an XLSX workbook with one worksheet per probe,
+// each using the same csvHeader column set as WriteCSV.
+func WriteXLSX(outputPath string, attempts []*attempt.Attempt) error {
+	if err := os.MkdirAll(filepath.Dir(outputPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create parent directories: %w", err)
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	defer file.Close()
+
+	sheets := xlsxSheetsByProbe(attempts)
+
+	zw := zip.NewWriter(file)
+	if err := writeXLSXParts(zw, sheets); err != nil {
+		zw.Close()
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize XLSX archive: %w", err)
+	}
+
+	return nil
+}
+
+// xlsxSheet is one worksheet's worth of rows, keyed by probe name.
+type xlsxSheet struct {
+	name string
+	rows [][]string
+}
+
+// xlsxSheetsByProbe groups attempts into one sheet per probe, in the order
+// probes are first seen, with sanitized/deduplicated sheet names.
+func xlsxSheetsByProbe(attempts []*attempt.Attempt) []xlsxSheet {
+	var order []string
+	rowsByProbe := map[string][][]string{}
+	for _, a := range attempts {
+		if _, ok := rowsByProbe[a.Probe]; !ok {
+			order = append(order, a.Probe)
+		}
+		rowsByProbe[a.Probe] = append(rowsByProbe[a.Probe], csvRow(a))
+	}
+
+	used := map[string]int{}
+	sheets := make([]xlsxSheet, 0, len(order))
+	for _, probe := range order {
+		sheets = append(sheets, xlsxSheet{name: uniqueSheetName(probe, used), rows: rowsByProbe[probe]})
+	}
+	return sheets
+}
+
+// sheetNameReplacer strips characters Excel forbids in worksheet names.
+var sheetNameReplacer = strings.NewReplacer("\\", "_", "/", "_", "?", "_", "*", "_", "[", "_", "]", "_", ":", "_")
+
+// uniqueSheetName sanitizes probe into a valid, unique worksheet name,
+// truncating to Excel's 31-character limit and disambiguating collisions
+// that truncation or sanitization can introduce.
+func uniqueSheetName(probe string, used map[string]int) string {
+	name := sheetNameReplacer.Replace(probe)
+	if len(name) > maxSheetNameLen {
+		name = name[:maxSheetNameLen]
+	}
+	if name == "" {
+		name = "Sheet"
+	}
+
+	base := name
+	for {
+		count := used[name]
+		used[base]++
+		if count == 0 {
+			used[name] = 1
+			return name
+		}
+		suffix := fmt.Sprintf("_%d", count+1)
+		name = base
+		if len(name)+len(suffix) > maxSheetNameLen {
+			name = name[:maxSheetNameLen-len(suffix)]
+		}
+		name += suffix
+	}
+}
+
+// writeXLSXParts writes every fixed and per-sheet XML part of the workbook.
+func writeXLSXParts(zw *zip.Writer, sheets []xlsxSheet) error {
+	parts := map[string]string{
+		"[Content_Types].xml":        xlsxContentTypes(len(sheets)),
+		"_rels/.rels":                xlsxRootRels,
+		"xl/workbook.xml":            xlsxWorkbook(sheets),
+		"xl/_rels/workbook.xml.rels": xlsxWorkbookRels(len(sheets)),
+	}
+	for name, content := range parts {
+		if err := writeZIPPart(zw, name, content); err != nil {
+			return err
+		}
+	}
+	for i, sheet := range sheets {
+		name := fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1)
+		if err := writeZIPPart(zw, name, xlsxWorksheet(sheet.rows)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeZIPPart(zw *zip.Writer, name, content string) error {
+	w, err := zw.Create(name)
+	if err != nil {
+		return fmt.Errorf("failed to create XLSX part %s: %w", name, err)
+	}
+	if _, err := w.Write([]byte(content)); err != nil {
+		return fmt.Errorf("failed to write XLSX part %s: %w", name, err)
+	}
+	return nil
+}
+
+func xlsxContentTypes(sheetCount int) string {
+	var overrides strings.Builder
+	for i := 1; i <= sheetCount; i++ {
+		fmt.Fprintf(&overrides, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		overrides.String() +
+		`</Types>`
+}
+
+const xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+	`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+func xlsxWorkbook(sheets []xlsxSheet) string {
+	var sheetTags strings.Builder
+	for i, sheet := range sheets {
+		fmt.Fprintf(&sheetTags, `<sheet name=%q sheetId="%d" r:id="rId%d"/>`, sheet.name, i+1, i+1)
+	}
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets>` + sheetTags.String() + `</sheets>` +
+		`</workbook>`
+}
+
+func xlsxWorkbookRels(sheetCount int) string {
+	var rels strings.Builder
+	for i := 1; i <= sheetCount; i++ {
+		fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	return `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+		`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		rels.String() +
+		`</Relationships>`
+}
+
+// scoreColumnIndex is csvHeader's 0-based "score" column, the only column
+// written as a numeric cell rather than an inline string.
+const scoreColumnIndex = 2
+
+// xlsxWorksheet renders csvHeader plus rows as a single worksheet's sheetData.
+func xlsxWorksheet(rows [][]string) string {
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>`)
+	sb.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+
+	writeXLSXRow(&sb, 1, csvHeader, -1)
+	for i, row := range rows {
+		writeXLSXRow(&sb, i+2, row, scoreColumnIndex)
+	}
+
+	sb.WriteString(`</sheetData></worksheet>`)
+	return sb.String()
+}
+
+// writeXLSXRow appends one <row> element; numericCol (or -1 for none) is the
+// 0-based column index to render as a numeric cell instead of inline text.
+func writeXLSXRow(sb *strings.Builder, rowNum int, cells []string, numericCol int) {
+	fmt.Fprintf(sb, `<row r="%d">`, rowNum)
+	for col, value := range cells {
+		ref := columnRef(col) + strconv.Itoa(rowNum)
+		if col == numericCol {
+			if _, err := strconv.ParseFloat(value, 64); err == nil {
+				fmt.Fprintf(sb, `<c r="%s"><v>%s</v></c>`, ref, value)
+				continue
+			}
+		}
+		fmt.Fprintf(sb, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, xmlEscape(value))
+	}
+	sb.WriteString(`</row>`)
+}
+
+// columnRef converts a 0-based column index into its spreadsheet letter
+// reference (0 -> "A", 25 -> "Z", 26 -> "AA").
+func columnRef(col int) string {
+	var letters []byte
+	for col >= 0 {
+		letters = append([]byte{byte('A' + col%26)}, letters...)
+		col = col/26 - 1
+	}
+	return string(letters)
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,107 @@
+package results
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+func TestWriteXLSX_OneSheetPerProbe(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "findings.xlsx")
+
+	attempts := []*attempt.Attempt{
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Prompt: "p1", Outputs: []string{"o1"}, Scores: []float64{0.0}, Status: attempt.StatusComplete},
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Prompt: "p2", Outputs: []string{"o2"}, Scores: []float64{0.9}, Status: attempt.StatusComplete},
+		{Probe: "test.Test", Detector: "always.Fail", Prompt: "p3", Outputs: []string{"o3"}, Scores: []float64{1.0}, Status: attempt.StatusComplete},
+	}
+
+	require.NoError(t, WriteXLSX(outputPath, attempts))
+
+	zr, err := zip.OpenReader(outputPath)
+	require.NoError(t, err)
+	defer zr.Close()
+
+	names := map[string]bool{}
+	for _, f := range zr.File {
+		names[f.Name] = true
+	}
+	assert.True(t, names["[Content_Types].xml"])
+	assert.True(t, names["xl/workbook.xml"])
+	assert.True(t, names["xl/worksheets/sheet1.xml"])
+	assert.True(t, names["xl/worksheets/sheet2.xml"])
+	assert.False(t, names["xl/worksheets/sheet3.xml"], "only 2 probes were exercised")
+
+	workbookXML := readZIPEntry(t, zr, "xl/workbook.xml")
+	assert.Contains(t, workbookXML, `name="dan.Dan_11_0"`)
+	assert.Contains(t, workbookXML, `name="test.Test"`)
+
+	sheet1XML := readZIPEntry(t, zr, "xl/worksheets/sheet1.xml")
+	assert.Contains(t, sheet1XML, "probe")
+	assert.Contains(t, sheet1XML, "p1")
+	assert.Contains(t, sheet1XML, "p2")
+	assert.NotContains(t, sheet1XML, "p3")
+}
+
+func TestWriteXLSX_ValidXML(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "findings.xlsx")
+
+	attempts := []*attempt.Attempt{
+		{Probe: "test.Test", Detector: "always.Fail", Prompt: "<inject>&\"'", Outputs: []string{"ok"}, Scores: []float64{0.1}, Status: attempt.StatusComplete},
+	}
+	require.NoError(t, WriteXLSX(outputPath, attempts))
+
+	zr, err := zip.OpenReader(outputPath)
+	require.NoError(t, err)
+	defer zr.Close()
+
+	sheetXML := readZIPEntry(t, zr, "xl/worksheets/sheet1.xml")
+	var doc struct {
+		XMLName xml.Name `xml:"worksheet"`
+	}
+	require.NoError(t, xml.Unmarshal([]byte(sheetXML), &doc))
+}
+
+func TestUniqueSheetName_DedupesCollisions(t *testing.T) {
+	used := map[string]int{}
+	first := uniqueSheetName("dan.Dan_11_0", used)
+	second := uniqueSheetName("dan.Dan_11_0", used)
+	assert.Equal(t, "dan.Dan_11_0", first)
+	assert.NotEqual(t, first, second)
+	assert.LessOrEqual(t, len(second), maxSheetNameLen)
+}
+
+func TestWriteXLSX_InvalidPath(t *testing.T) {
+	tmpDir := t.TempDir()
+	blocker := filepath.Join(tmpDir, "blocker")
+	require.NoError(t, os.WriteFile(blocker, []byte("x"), 0o644))
+
+	err := WriteXLSX(filepath.Join(blocker, "findings.xlsx"), []*attempt.Attempt{})
+	assert.Error(t, err)
+}
+
+func readZIPEntry(t *testing.T, zr *zip.ReadCloser, name string) string {
+	t.Helper()
+	for _, f := range zr.File {
+		if f.Name != name {
+			continue
+		}
+		rc, err := f.Open()
+		require.NoError(t, err)
+		defer rc.Close()
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		return string(data)
+	}
+	t.Fatalf("zip entry %s not found", name)
+	return ""
+}
@@ -0,0 +1,49 @@
+package scanner
+
+import "github.com/praetorian-inc/augustus/pkg/types"
+
+// estimatedAttempts returns how many generator calls a probe is expected to
+// make, used to enforce Options.MaxTotalAttempts. Probes that build their
+// prompts dynamically (PAIR, TAP, AutoDAN, tree search) report a real upper
+// bound via types.EstimatedAttemptCounter, since their GetPrompts() can't
+// reflect a count that isn't known upfront. Other probes that expose their
+// prompt list via types.ProbeMetadata are counted exactly; probes that
+// implement neither are conservatively estimated at one attempt.
+func estimatedAttempts(p Prober) int {
+	if ec, ok := p.(types.EstimatedAttemptCounter); ok {
+		if n := ec.EstimatedAttempts(); n > 0 {
+			return n
+		}
+	}
+	if pm, ok := p.(types.ProbeMetadata); ok {
+		if n := len(pm.GetPrompts()); n > 0 {
+			return n
+		}
+	}
+	return 1
+}
+
+// applyMaxTotalAttempts walks probeList in order, accumulating each probe's
+// estimated attempt cost, and truncates at the first probe that would push
+// the running total over maxTotalAttempts - that probe and every probe
+// after it are reported as skipped. A maxTotalAttempts of 0 means
+// unlimited, and every probe runs.
+func applyMaxTotalAttempts(probeList []Prober, maxTotalAttempts int) (runList []Prober, skipped []string) {
+	if maxTotalAttempts <= 0 {
+		return probeList, nil
+	}
+
+	used := 0
+	for i, p := range probeList {
+		cost := estimatedAttempts(p)
+		if used+cost > maxTotalAttempts {
+			skipped = make([]string, 0, len(probeList)-i)
+			for _, rest := range probeList[i:] {
+				skipped = append(skipped, rest.Name())
+			}
+			return probeList[:i], skipped
+		}
+		used += cost
+	}
+	return probeList, nil
+}
@@ -17,12 +17,48 @@ type Options struct {
 	// ProbeTimeout is the maximum time allowed for a single probe execution.
 	ProbeTimeout time.Duration
 
+	// AttemptTimeout is the maximum time allowed for a single generator call
+	// (one Generate invocation) within a probe. Unlike ProbeTimeout, which
+	// bounds a probe's entire run (which may issue many generator calls),
+	// this bounds each individual call so one slow attempt can't consume a
+	// whole probe's budget. 0 means no per-attempt timeout.
+	AttemptTimeout time.Duration
+
+	// DetectorTimeout is the maximum time allowed for a single detector's
+	// Detect call on a single attempt. 0 means no per-detector timeout.
+	// Enforced by harnesses.ApplyDetectors, not the scanner loop itself,
+	// but lives alongside the other timeout budgets here since together
+	// they form the full per-attempt/per-probe/per-detector budget tree.
+	DetectorTimeout time.Duration
+
+	// ShutdownGracePeriod bounds how long probes already in flight when ctx
+	// is canceled (e.g. SIGINT/SIGTERM via signal.NotifyContext) are given
+	// to finish normally before being forcibly canceled too. 0 means probes
+	// are canceled the instant ctx is canceled, same as before this option
+	// existed. Probes that haven't started yet when ctx is canceled are
+	// never started, regardless of this setting - only work already under
+	// way gets the grace period.
+	ShutdownGracePeriod time.Duration
+
 	// RetryCount is the number of times to retry failed probes.
 	RetryCount int
 
 	// RetryBackoff is the delay between retry attempts.
 	RetryBackoff time.Duration
 
+	// MaxTotalAttempts caps the total number of generator calls (estimated
+	// from each probe's prompt count) across every probe in the scan.
+	// 0 means unlimited. Probes are truncated deterministically in list
+	// order: once the budget is exhausted, every remaining probe is
+	// skipped rather than run partially.
+	MaxTotalAttempts int
+
+	// MaxContinuations caps how many automatic follow-up "continue" calls
+	// TruncationGenerator issues per attempt when a response looks cut off
+	// by a token/length limit. 0 disables truncation detection entirely,
+	// leaving truncated responses as-is.
+	MaxContinuations int
+
 	// Metrics is the optional metrics tracker for scan statistics.
 	// If nil, metrics tracking is disabled.
 	Metrics *metrics.Metrics
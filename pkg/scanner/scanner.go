@@ -2,7 +2,9 @@ package scanner
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
@@ -48,6 +50,25 @@ type Results struct {
 
 	// Error is the overall error if scanner execution failed.
 	Error error
+
+	// SkippedProbes lists probes that were not run because
+	// Options.MaxTotalAttempts was reached by earlier probes in the list,
+	// or because ctx was canceled before they got a concurrency slot.
+	SkippedProbes []string
+
+	// IncompleteProbes lists probes that started but were cut short by
+	// Options.ProbeTimeout elapsing before they finished their full prompt
+	// list. Unlike SkippedProbes (never started), these probes may have
+	// contributed some attempts to Attempts - each one marked
+	// attempt.StatusTimedOut - before the deadline hit.
+	IncompleteProbes []string
+
+	// Interrupted is true when Error is set because ctx was canceled
+	// (as opposed to Options.Timeout elapsing, which surfaces as
+	// context.DeadlineExceeded instead). Callers use this to distinguish
+	// an external interruption (e.g. SIGINT) from the scan's own timeout
+	// budget running out, so they can label partial results accordingly.
+	Interrupted bool
 }
 
 // New creates a new Scanner with the given options.
@@ -96,6 +117,18 @@ func (s *Scanner) Run(ctx context.Context, probes []Prober, gen Generator) Resul
 		return results
 	}
 
+	// Enforce the scan-wide attempt budget, if configured. Truncation is
+	// deterministic: probes run in list order until the estimated total
+	// would exceed the budget, then every remaining probe is skipped.
+	probes, results.SkippedProbes = applyMaxTotalAttempts(probes, s.opts.MaxTotalAttempts)
+	if len(results.SkippedProbes) > 0 {
+		slog.Warn("run.max_total_attempts reached; skipping remaining probes",
+			"max_total_attempts", s.opts.MaxTotalAttempts, "skipped", results.SkippedProbes)
+	}
+	if len(probes) == 0 {
+		return results
+	}
+
 	// Thread-safe result collection
 	var mu sync.Mutex
 	completed := 0
@@ -104,18 +137,56 @@ func (s *Scanner) Run(ctx context.Context, probes []Prober, gen Generator) Resul
 	g, gctx := errgroup.WithContext(ctx)
 	g.SetLimit(s.opts.Concurrency)
 
+	// workCtx is what each probe actually runs with. With no shutdown grace
+	// period configured it's just gctx, so cancellation propagates exactly
+	// as before. With a grace period, workCtx instead stays open for that
+	// long after ctx is canceled, giving probes already in flight a bounded
+	// window to finish normally (and have their attempts collected) instead
+	// of being cut off mid-call.
+	workCtx := gctx
+	if s.opts.ShutdownGracePeriod > 0 {
+		var graceCtx context.Context
+		var cancelGrace context.CancelFunc
+		graceCtx, cancelGrace = context.WithCancel(context.Background())
+		defer cancelGrace()
+		go func() {
+			select {
+			case <-ctx.Done():
+			case <-graceCtx.Done():
+				return
+			}
+			select {
+			case <-time.After(s.opts.ShutdownGracePeriod):
+			case <-graceCtx.Done():
+			}
+			cancelGrace()
+		}()
+		workCtx = graceCtx
+	}
+
 	// Execute each probe concurrently
 	for _, probe := range probes {
 		probe := probe // Capture loop variable
 
 		g.Go(func() error {
+			// If a shutdown grace period is configured and ctx was already
+			// canceled before this probe reached the front of the
+			// concurrency queue, don't start it - only probes already
+			// running when the signal arrived get the grace window.
+			if s.opts.ShutdownGracePeriod > 0 && ctx.Err() != nil {
+				mu.Lock()
+				results.SkippedProbes = append(results.SkippedProbes, probe.Name())
+				mu.Unlock()
+				return nil
+			}
+
 			start := time.Now()
 
 			// Apply per-probe timeout if configured
-			probeCtx := gctx
+			probeCtx := workCtx
 			if s.opts.ProbeTimeout > 0 {
 				var cancel context.CancelFunc
-				probeCtx, cancel = context.WithTimeout(gctx, s.opts.ProbeTimeout)
+				probeCtx, cancel = context.WithTimeout(workCtx, s.opts.ProbeTimeout)
 				defer cancel()
 			}
 
@@ -149,15 +220,32 @@ func (s *Scanner) Run(ctx context.Context, probes []Prober, gen Generator) Resul
 				// Capture timeout error with probe name
 				timeoutErr := fmt.Errorf("probe %s timeout: %w", probe.Name(), probeCtx.Err())
 
-				// If context was canceled, return error to stop other probes
-				if gctx.Err() != nil {
+				// If the scan itself is shutting down (workCtx expired -
+				// either ctx was canceled with no grace period, or the
+				// grace period ran out), stop other probes too. A
+				// probe-only timeout (workCtx still open) just fails this
+				// one probe and continues the rest.
+				if workCtx.Err() != nil {
 					return gctx.Err()
 				}
-				// If only probe context timed out, record as probe failure
+				// If only probe context timed out, record as probe failure.
+				// Whatever attempts the probe managed to produce before the
+				// deadline hit are still useful - surface them as
+				// StatusTimedOut instead of silently dropping them, and list
+				// the probe as incomplete so every output format can flag it.
+				for _, a := range attempts {
+					a.Status = attempt.StatusTimedOut
+					if a.Error == "" {
+						a.Error = timeoutErr.Error()
+					}
+				}
+
 				mu.Lock()
 				completed++
 				results.Failed++
 				results.Errors = append(results.Errors, timeoutErr)
+				results.IncompleteProbes = append(results.IncompleteProbes, probe.Name())
+				results.Attempts = append(results.Attempts, attempts...)
 				currentCompleted := completed
 				currentTotal := results.Total
 				mu.Unlock()
@@ -222,5 +310,12 @@ func (s *Scanner) Run(ctx context.Context, probes []Prober, gen Generator) Resul
 		results.Error = err
 	}
 
+	// ctx is canceled (as opposed to merely timing out via Options.Timeout)
+	// whenever the caller interrupted the scan - e.g. SIGINT/SIGTERM via
+	// signal.NotifyContext. This holds even if every in-flight probe still
+	// finished successfully within ShutdownGracePeriod and g.Wait() returned
+	// no error, so the caller can still label the run as interrupted.
+	results.Interrupted = errors.Is(ctx.Err(), context.Canceled)
+
 	return results
 }
@@ -21,6 +21,7 @@ type mockProbe struct {
 	delay    time.Duration
 	err      error
 	attempts []*attempt.Attempt
+	prompts  []string
 }
 
 func (m *mockProbe) Probe(ctx context.Context, gen scanner.Generator) ([]*attempt.Attempt, error) {
@@ -39,11 +40,37 @@ func (m *mockProbe) Probe(ctx context.Context, gen scanner.Generator) ([]*attemp
 	return m.attempts, nil
 }
 
-func (m *mockProbe) Name() string        { return m.name }
-func (m *mockProbe) Description() string { return m.name + " description" }
-func (m *mockProbe) Goal() string        { return m.name + " goal" }
+func (m *mockProbe) Name() string               { return m.name }
+func (m *mockProbe) Description() string        { return m.name + " description" }
+func (m *mockProbe) Goal() string               { return m.name + " goal" }
 func (m *mockProbe) GetPrimaryDetector() string { return "test.Detector" }
-func (m *mockProbe) GetPrompts() []string { return []string{"test prompt"} }
+func (m *mockProbe) GetPrompts() []string {
+	if m.prompts != nil {
+		return m.prompts
+	}
+	return []string{"test prompt"}
+}
+
+// bareProbe implements only types.Prober, with no GetPrompts/ProbeMetadata,
+// to exercise the conservative one-attempt cost estimate.
+type bareProbe struct {
+	name string
+}
+
+func (b *bareProbe) Probe(ctx context.Context, gen scanner.Generator) ([]*attempt.Attempt, error) {
+	return nil, nil
+}
+func (b *bareProbe) Name() string { return b.name }
+
+// iterativeProbe mimics PAIR/TAP/AutoDAN/tree-search-style probes: GetPrompts
+// reports nothing useful (dynamically built during Probe), but the probe
+// implements types.EstimatedAttemptCounter with the real upper bound.
+type iterativeProbe struct {
+	mockProbe
+	estimatedAttempts int
+}
+
+func (p *iterativeProbe) EstimatedAttempts() int { return p.estimatedAttempts }
 
 // mockGenerator is a test generator
 type mockGenerator struct{}
@@ -92,8 +119,8 @@ func TestScanner_Run_ConcurrencyLimit(t *testing.T) {
 	probes := make([]scanner.Prober, 10)
 	for i := 0; i < 10; i++ {
 		probes[i] = &mockProbe{
-			name:  fmt.Sprintf("probe%d", i),
-			delay: 50 * time.Millisecond,
+			name:     fmt.Sprintf("probe%d", i),
+			delay:    50 * time.Millisecond,
 			attempts: []*attempt.Attempt{{ID: fmt.Sprintf("test%d", i)}},
 		}
 	}
@@ -206,6 +233,120 @@ func TestScanner_Run_ProbeTimeout(t *testing.T) {
 	assert.Equal(t, 1, results.Succeeded, "only fast probe should succeed")
 	assert.Equal(t, 1, results.Failed, "slow probe should timeout")
 	assert.Len(t, results.Errors, 1, "should have error for timeout")
+	assert.Equal(t, []string{"slow"}, results.IncompleteProbes, "timed-out probe should be listed as incomplete")
+}
+
+// probePartialProbe returns whatever attempts it had accumulated so far when
+// its context is canceled, like pkg/probes.SimpleProbe does on ctx.Done().
+type probePartialProbe struct {
+	name     string
+	delay    time.Duration
+	attempts []*attempt.Attempt
+}
+
+func (p *probePartialProbe) Probe(ctx context.Context, gen scanner.Generator) ([]*attempt.Attempt, error) {
+	select {
+	case <-time.After(p.delay):
+	case <-ctx.Done():
+		return p.attempts, ctx.Err()
+	}
+	return p.attempts, nil
+}
+
+func (p *probePartialProbe) Name() string               { return p.name }
+func (p *probePartialProbe) Description() string        { return p.name + " description" }
+func (p *probePartialProbe) Goal() string               { return p.name + " goal" }
+func (p *probePartialProbe) GetPrimaryDetector() string { return "test.Detector" }
+func (p *probePartialProbe) GetPrompts() []string       { return []string{"test prompt"} }
+
+func TestScanner_Run_ProbeTimeout_SurfacesPartialAttemptsAsTimedOut(t *testing.T) {
+	ctx := context.Background()
+	gen := &mockGenerator{}
+
+	probes := []scanner.Prober{
+		&probePartialProbe{
+			name:     "slow",
+			delay:    200 * time.Millisecond,
+			attempts: []*attempt.Attempt{{ID: "1", Status: attempt.StatusComplete}},
+		},
+	}
+
+	opts := scanner.Options{
+		Concurrency:  1,
+		Timeout:      10 * time.Second,
+		ProbeTimeout: 50 * time.Millisecond,
+	}
+
+	s := scanner.New(opts)
+	results := s.Run(ctx, probes, gen)
+
+	require.NoError(t, results.Error)
+	assert.Equal(t, []string{"slow"}, results.IncompleteProbes)
+	require.Len(t, results.Attempts, 1, "partial attempts should still be collected, not dropped")
+	assert.Equal(t, attempt.StatusTimedOut, results.Attempts[0].Status, "attempts from a timed-out probe should be marked timed_out")
+	assert.NotEmpty(t, results.Attempts[0].Error)
+}
+
+func TestScanner_Run_ShutdownGracePeriod_CollectsInFlightProbe(t *testing.T) {
+	// A probe that's mid-flight when ctx is canceled should still complete
+	// and have its attempts collected when the grace period comfortably
+	// covers its remaining runtime.
+	ctx, cancel := context.WithCancel(context.Background())
+	gen := &mockGenerator{}
+
+	probes := []scanner.Prober{
+		&mockProbe{name: "probe1", delay: 50 * time.Millisecond, attempts: []*attempt.Attempt{{ID: "1"}}},
+	}
+
+	opts := scanner.Options{
+		Concurrency:         1,
+		Timeout:             10 * time.Second,
+		ShutdownGracePeriod: 500 * time.Millisecond,
+	}
+
+	s := scanner.New(opts)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	results := s.Run(ctx, probes, gen)
+
+	require.NoError(t, results.Error)
+	assert.True(t, results.Interrupted, "results should be marked interrupted")
+	assert.Equal(t, 1, results.Succeeded, "in-flight probe should finish within the grace period")
+	require.Len(t, results.Attempts, 1)
+}
+
+func TestScanner_Run_ShutdownGracePeriod_CutsOffSlowProbe(t *testing.T) {
+	// A probe that doesn't finish within the grace period is still
+	// canceled, same as with no grace period at all.
+	ctx, cancel := context.WithCancel(context.Background())
+	gen := &mockGenerator{}
+
+	probes := []scanner.Prober{
+		&mockProbe{name: "probe1", delay: 500 * time.Millisecond, attempts: []*attempt.Attempt{{ID: "1"}}},
+	}
+
+	opts := scanner.Options{
+		Concurrency:         1,
+		Timeout:             10 * time.Second,
+		ShutdownGracePeriod: 20 * time.Millisecond,
+	}
+
+	s := scanner.New(opts)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	results := s.Run(ctx, probes, gen)
+
+	assert.Error(t, results.Error)
+	assert.True(t, results.Interrupted, "results should be marked interrupted")
+	assert.Equal(t, 0, results.Succeeded, "probe should be canceled once the grace period elapses")
 }
 
 func TestScanner_Run_ProbeError(t *testing.T) {
@@ -358,11 +499,11 @@ func (r *retryableProbe) Probe(ctx context.Context, gen scanner.Generator) ([]*a
 	return r.attempts, nil
 }
 
-func (r *retryableProbe) Name() string                { return r.name }
-func (r *retryableProbe) Description() string         { return r.name + " description" }
-func (r *retryableProbe) Goal() string                { return r.name + " goal" }
-func (r *retryableProbe) GetPrimaryDetector() string  { return "test.Detector" }
-func (r *retryableProbe) GetPrompts() []string        { return []string{"test prompt"} }
+func (r *retryableProbe) Name() string               { return r.name }
+func (r *retryableProbe) Description() string        { return r.name + " description" }
+func (r *retryableProbe) Goal() string               { return r.name + " goal" }
+func (r *retryableProbe) GetPrimaryDetector() string { return "test.Detector" }
+func (r *retryableProbe) GetPrompts() []string       { return []string{"test prompt"} }
 
 func TestScanner_Run_RetriesOnFailure(t *testing.T) {
 	// Test that Scanner retries failed probes according to RetryCount
@@ -381,7 +522,7 @@ func TestScanner_Run_RetriesOnFailure(t *testing.T) {
 	opts := scanner.Options{
 		Concurrency:  1,
 		Timeout:      10 * time.Second,
-		RetryCount:   3,                    // Retry up to 3 times
+		RetryCount:   3,                     // Retry up to 3 times
 		RetryBackoff: 10 * time.Millisecond, // Short backoff for tests
 	}
 
@@ -441,3 +582,81 @@ func TestScanner_Run_PopulatesMetrics(t *testing.T) {
 	assert.Equal(t, int64(3), snapshot.AttemptsTotal, "should count all attempts")
 	assert.Equal(t, int64(2), snapshot.AttemptsVuln, "should count vulnerable attempts")
 }
+
+func TestScanner_Run_MaxTotalAttemptsUnlimited(t *testing.T) {
+	// MaxTotalAttempts of 0 (the default) should run every probe.
+	ctx := context.Background()
+	gen := &mockGenerator{}
+
+	probes := []scanner.Prober{
+		&mockProbe{name: "probe1", attempts: []*attempt.Attempt{{ID: "1"}}},
+		&mockProbe{name: "probe2", attempts: []*attempt.Attempt{{ID: "2"}}},
+		&mockProbe{name: "probe3", attempts: []*attempt.Attempt{{ID: "3"}}},
+	}
+
+	s := scanner.New(scanner.Options{Concurrency: 2, Timeout: 10 * time.Second})
+	results := s.Run(ctx, probes, gen)
+
+	require.NoError(t, results.Error)
+	assert.Equal(t, 3, results.Total)
+	assert.Len(t, results.Attempts, 3)
+	assert.Empty(t, results.SkippedProbes)
+}
+
+func TestScanner_Run_MaxTotalAttemptsTruncates(t *testing.T) {
+	// Each probe costs 2 estimated attempts (two prompts). A budget of 3
+	// only fits the first probe; the rest are skipped.
+	ctx := context.Background()
+	gen := &mockGenerator{}
+
+	probes := []scanner.Prober{
+		&mockProbe{name: "probe1", prompts: []string{"p1", "p2"}, attempts: []*attempt.Attempt{{ID: "1"}}},
+		&mockProbe{name: "probe2", prompts: []string{"p1", "p2"}, attempts: []*attempt.Attempt{{ID: "2"}}},
+		&mockProbe{name: "probe3", prompts: []string{"p1", "p2"}, attempts: []*attempt.Attempt{{ID: "3"}}},
+	}
+
+	s := scanner.New(scanner.Options{Concurrency: 2, Timeout: 10 * time.Second, MaxTotalAttempts: 3})
+	results := s.Run(ctx, probes, gen)
+
+	require.NoError(t, results.Error)
+	assert.Equal(t, 3, results.Total, "Total should reflect the original probe count")
+	assert.Len(t, results.Attempts, 1, "only the first probe should have run")
+	assert.Equal(t, []string{"probe2", "probe3"}, results.SkippedProbes)
+}
+
+func TestScanner_Run_MaxTotalAttemptsFallsBackForUnmeteredProbes(t *testing.T) {
+	// Probes without GetPrompts are estimated at one attempt each.
+	ctx := context.Background()
+	gen := &mockGenerator{}
+
+	probes := []scanner.Prober{
+		&bareProbe{name: "probe1"},
+		&bareProbe{name: "probe2"},
+		&bareProbe{name: "probe3"},
+	}
+
+	s := scanner.New(scanner.Options{Concurrency: 2, Timeout: 10 * time.Second, MaxTotalAttempts: 2})
+	results := s.Run(ctx, probes, gen)
+
+	require.NoError(t, results.Error)
+	assert.Equal(t, []string{"probe3"}, results.SkippedProbes)
+}
+
+func TestScanner_Run_MaxTotalAttemptsUsesEstimatedAttemptCounter(t *testing.T) {
+	// An iterative probe reports an empty GetPrompts() (it builds prompts
+	// dynamically) but a real cost via EstimatedAttempts, which must be used
+	// instead of the GetPrompts()/one-attempt fallback.
+	ctx := context.Background()
+	gen := &mockGenerator{}
+
+	probes := []scanner.Prober{
+		&iterativeProbe{mockProbe: mockProbe{name: "probe1", prompts: []string{}, attempts: []*attempt.Attempt{{ID: "1"}}}, estimatedAttempts: 40},
+		&mockProbe{name: "probe2", attempts: []*attempt.Attempt{{ID: "2"}}},
+	}
+
+	s := scanner.New(scanner.Options{Concurrency: 2, Timeout: 10 * time.Second, MaxTotalAttempts: 40})
+	results := s.Run(ctx, probes, gen)
+
+	require.NoError(t, results.Error)
+	assert.Equal(t, []string{"probe2"}, results.SkippedProbes, "probe1's real cost of 40 should already exhaust the budget")
+}
@@ -0,0 +1,119 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+)
+
+func init() {
+	Register("aws-sm", &AWSSecretsManagerProvider{})
+}
+
+// AWSSecretsManagerProvider resolves secrets from AWS Secrets Manager.
+// References are secret names or ARNs, e.g. "aws-sm:prod/augustus/openai".
+// Region and credentials come from the standard AWS SDK default chain
+// (AWS_REGION, AWS_PROFILE, instance/task roles, etc.) via
+// config.LoadDefaultConfig, the same mechanism the bedrock generator uses.
+//
+// github.com/aws/aws-sdk-go-v2/service/secretsmanager is not a dependency of
+// this module, so rather than pulling in a dedicated client, this sends a
+// SigV4-signed HTTP request directly against the GetSecretValue JSON API
+// using the core SDK's credential chain and request signer, both of which
+// are already dependencies (via the bedrock generator and its transitive
+// imports).
+type AWSSecretsManagerProvider struct {
+	// HTTPClient allows tests to inject a fake server; defaults to
+	// http.DefaultClient when nil.
+	HTTPClient *http.Client
+
+	// BaseURL overrides the Secrets Manager endpoint for testing; defaults
+	// to the real "https://secretsmanager.<region>.amazonaws.com" when empty.
+	BaseURL string
+}
+
+func (p *AWSSecretsManagerProvider) Resolve(ref string) (string, error) {
+	ctx := context.Background()
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("aws-sm: failed to load AWS config: %w", err)
+	}
+	region := cfg.Region
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+	if region == "" {
+		return "", fmt.Errorf("aws-sm: no AWS region configured (set AWS_REGION or a default region in your AWS profile)")
+	}
+
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("aws-sm: failed to retrieve AWS credentials: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]string{"SecretId": ref})
+	if err != nil {
+		return "", fmt.Errorf("aws-sm: failed to build request body: %w", err)
+	}
+
+	host := fmt.Sprintf("secretsmanager.%s.amazonaws.com", region)
+	url := "https://" + host + "/"
+	if p.BaseURL != "" {
+		url = p.BaseURL
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("aws-sm: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+	if p.BaseURL == "" {
+		req.Host = host
+	}
+
+	hash := sha256.Sum256(body)
+	signer := v4.NewSigner()
+	if err := signer.SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "secretsmanager", region, time.Now()); err != nil {
+		return "", fmt.Errorf("aws-sm: failed to sign request: %w", err)
+	}
+
+	client := p.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("aws-sm: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("aws-sm: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("aws-sm: GetSecretValue for %q failed with status %d: %s", ref, resp.StatusCode, respBody)
+	}
+
+	var parsed struct {
+		SecretString string `json:"SecretString"`
+	}
+	if err := json.Unmarshal(respBody, &parsed); err != nil {
+		return "", fmt.Errorf("aws-sm: failed to parse response: %w", err)
+	}
+	if parsed.SecretString == "" {
+		return "", fmt.Errorf("aws-sm: secret %q has no SecretString (binary secrets are not supported)", ref)
+	}
+	return parsed.SecretString, nil
+}
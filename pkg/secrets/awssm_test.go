@@ -0,0 +1,58 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setFakeAWSCreds(t *testing.T) {
+	t.Helper()
+	t.Setenv("AWS_ACCESS_KEY_ID", "fake-access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "fake-secret-key")
+	t.Setenv("AWS_REGION", "us-east-1")
+}
+
+func TestAWSSecretsManagerProvider_ResolveSuccess(t *testing.T) {
+	setFakeAWSCreds(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secretsmanager.GetSecretValue", r.Header.Get("X-Amz-Target"))
+		w.Write([]byte(`{"SecretString":"aws-secret-value"}`))
+	}))
+	defer server.Close()
+
+	p := &AWSSecretsManagerProvider{HTTPClient: server.Client(), BaseURL: server.URL}
+	val, err := p.Resolve("prod/augustus/openai")
+	require.NoError(t, err)
+	assert.Equal(t, "aws-secret-value", val)
+}
+
+func TestAWSSecretsManagerProvider_NoRegion(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "fake-access-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "fake-secret-key")
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "")
+
+	p := &AWSSecretsManagerProvider{}
+	_, err := p.Resolve("prod/augustus/openai")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "region")
+}
+
+func TestAWSSecretsManagerProvider_BinarySecretUnsupported(t *testing.T) {
+	setFakeAWSCreds(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"SecretBinary":"c29tZWJ5dGVz"}`))
+	}))
+	defer server.Close()
+
+	p := &AWSSecretsManagerProvider{HTTPClient: server.Client(), BaseURL: server.URL}
+	_, err := p.Resolve("prod/augustus/openai")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "binary")
+}
@@ -0,0 +1,41 @@
+// Package secrets provides pluggable resolvers for secret references used
+// during YAML config interpolation, e.g. "${vault:secret/path#key}" or
+// "${aws-sm:my-secret}". Providers self-register under a scheme name via
+// Register, mirroring the self-registration pattern used by
+// probes/detectors/generators elsewhere in Augustus, but keyed by a flat
+// scheme string rather than a registry.Config since a provider resolves a
+// single reference string rather than being instantiated from config.
+package secrets
+
+import "fmt"
+
+// Provider resolves a secret reference - everything after the scheme and
+// its trailing ":" in a "${scheme:reference}" config value - to its
+// plaintext value.
+type Provider interface {
+	Resolve(ref string) (string, error)
+}
+
+var providers = map[string]Provider{}
+
+// Register associates a Provider with a scheme name. Called from each
+// provider's init().
+func Register(scheme string, p Provider) {
+	providers[scheme] = p
+}
+
+// Get returns the Provider registered for scheme, if any.
+func Get(scheme string) (Provider, bool) {
+	p, ok := providers[scheme]
+	return p, ok
+}
+
+// Resolve looks up the provider registered for scheme and resolves ref
+// against it.
+func Resolve(scheme, ref string) (string, error) {
+	p, ok := Get(scheme)
+	if !ok {
+		return "", fmt.Errorf("no secret provider registered for scheme %q", scheme)
+	}
+	return p.Resolve(ref)
+}
@@ -0,0 +1,57 @@
+package secrets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockProvider struct {
+	value string
+	err   error
+}
+
+func (p *mockProvider) Resolve(ref string) (string, error) {
+	if p.err != nil {
+		return "", p.err
+	}
+	return p.value, nil
+}
+
+func TestRegisterAndGet(t *testing.T) {
+	Register("mocktest", &mockProvider{value: "hello"})
+
+	p, ok := Get("mocktest")
+	require.True(t, ok)
+	val, err := p.Resolve("anything")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", val)
+}
+
+func TestGet_UnknownScheme(t *testing.T) {
+	_, ok := Get("does-not-exist-scheme")
+	assert.False(t, ok)
+}
+
+func TestResolve_DispatchesToRegisteredProvider(t *testing.T) {
+	Register("mocktest2", &mockProvider{value: "world"})
+
+	val, err := Resolve("mocktest2", "ref")
+	require.NoError(t, err)
+	assert.Equal(t, "world", val)
+}
+
+func TestResolve_UnregisteredSchemeErrors(t *testing.T) {
+	_, err := Resolve("unregistered-scheme", "ref")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unregistered-scheme")
+}
+
+func TestVaultAndAWSSMRegisteredByDefault(t *testing.T) {
+	_, ok := Get("vault")
+	assert.True(t, ok)
+
+	_, ok = Get("aws-sm")
+	assert.True(t, ok)
+}
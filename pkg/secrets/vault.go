@@ -0,0 +1,94 @@
+package secrets
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+func init() {
+	Register("vault", &VaultProvider{})
+}
+
+// VaultProvider resolves secrets from HashiCorp Vault's KV v2 HTTP API.
+// References take the form "mount/path#key", e.g.
+// "secret/augustus/openai#api_key". Connection details come from the
+// standard VAULT_ADDR and VAULT_TOKEN environment variables.
+type VaultProvider struct {
+	// HTTPClient allows tests to inject a fake server; defaults to
+	// http.DefaultClient when nil.
+	HTTPClient *http.Client
+}
+
+func (p *VaultProvider) Resolve(ref string) (string, error) {
+	path, key, ok := strings.Cut(ref, "#")
+	if !ok {
+		return "", fmt.Errorf("vault reference %q must be in the form \"path#key\"", ref)
+	}
+
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		return "", fmt.Errorf("VAULT_ADDR is not set")
+	}
+	token := os.Getenv("VAULT_TOKEN")
+	if token == "" {
+		return "", fmt.Errorf("VAULT_TOKEN is not set")
+	}
+
+	url := fmt.Sprintf("%s/v1/%s", strings.TrimRight(addr, "/"), kv2DataPath(path))
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("vault: failed to build request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", token)
+
+	client := p.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("vault: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("vault: failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("vault: request to %s failed with status %d: %s", url, resp.StatusCode, body)
+	}
+
+	var parsed struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return "", fmt.Errorf("vault: failed to parse response: %w", err)
+	}
+
+	value, ok := parsed.Data.Data[key]
+	if !ok {
+		return "", fmt.Errorf("vault: key %q not found at %q", key, path)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("vault: key %q at %q is not a string", key, path)
+	}
+	return str, nil
+}
+
+// kv2DataPath rewrites a KV v2 "mount/sub/path" reference into Vault's
+// "mount/data/sub/path" HTTP API path.
+func kv2DataPath(path string) string {
+	mount, rest, ok := strings.Cut(path, "/")
+	if !ok {
+		return path + "/data"
+	}
+	return mount + "/data/" + rest
+}
@@ -0,0 +1,62 @@
+package secrets
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVaultProvider_ResolveSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/secret/data/augustus/openai", r.URL.Path)
+		assert.Equal(t, "test-token", r.Header.Get("X-Vault-Token"))
+		w.Write([]byte(`{"data":{"data":{"api_key":"vault-secret-value"}}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "test-token")
+
+	p := &VaultProvider{HTTPClient: server.Client()}
+	val, err := p.Resolve("secret/augustus/openai#api_key")
+	require.NoError(t, err)
+	assert.Equal(t, "vault-secret-value", val)
+}
+
+func TestVaultProvider_MissingAddr(t *testing.T) {
+	t.Setenv("VAULT_ADDR", "")
+	t.Setenv("VAULT_TOKEN", "test-token")
+
+	p := &VaultProvider{}
+	_, err := p.Resolve("secret/augustus/openai#api_key")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "VAULT_ADDR")
+}
+
+func TestVaultProvider_InvalidRefFormat(t *testing.T) {
+	t.Setenv("VAULT_ADDR", "http://example.invalid")
+	t.Setenv("VAULT_TOKEN", "test-token")
+
+	p := &VaultProvider{}
+	_, err := p.Resolve("secret/augustus/openai")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "path#key")
+}
+
+func TestVaultProvider_KeyNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"data":{"other_key":"value"}}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("VAULT_ADDR", server.URL)
+	t.Setenv("VAULT_TOKEN", "test-token")
+
+	p := &VaultProvider{HTTPClient: server.Client()}
+	_, err := p.Resolve("secret/augustus/openai#api_key")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "api_key")
+}
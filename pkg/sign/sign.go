@@ -0,0 +1,196 @@
+// Package sign provides detached digital signatures for scan result
+// artifacts, so a JSONL report can be used as audit evidence with proof it
+// wasn't edited after the scan ran. Signing is key-based (Ed25519): there
+// is no Sigstore/Fulcio keyless mode here, since that requires a live OIDC
+// identity provider and Rekor transparency log, and augustus makes no
+// assumption that either is reachable from wherever a scan runs. A
+// provided keypair (see GenerateKey) is the supported mode; "keyless"
+// verification is intentionally out of scope until augustus has a reason
+// to depend on a network signing service.
+package sign
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+)
+
+const (
+	pemPrivateKeyType = "AUGUSTUS SIGNING PRIVATE KEY"
+	pemPublicKeyType  = "AUGUSTUS SIGNING PUBLIC KEY"
+
+	// AlgorithmEd25519 identifies the signature scheme in a Signature.
+	AlgorithmEd25519 = "ed25519"
+)
+
+// Signature is the detached, JSON-serializable proof that a file's sha256
+// digest was signed by the holder of a private key. It is written
+// alongside the signed file as "<path>.sig".
+type Signature struct {
+	Algorithm string `json:"algorithm"`
+	Digest    string `json:"digest"`     // hex sha256 of the signed file's contents
+	PublicKey string `json:"public_key"` // hex Ed25519 public key
+	Value     string `json:"value"`      // hex Ed25519 signature over Digest
+}
+
+// GenerateKey creates a new Ed25519 keypair and writes PEM-encoded private
+// and public keys to privPath and pubPath.
+func GenerateKey(privPath, pubPath string) error {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	if err := os.WriteFile(privPath, pem.EncodeToMemory(&pem.Block{
+		Type:  pemPrivateKeyType,
+		Bytes: priv,
+	}), 0o600); err != nil {
+		return fmt.Errorf("failed to write private key: %w", err)
+	}
+
+	if err := os.WriteFile(pubPath, pem.EncodeToMemory(&pem.Block{
+		Type:  pemPublicKeyType,
+		Bytes: pub,
+	}), 0o644); err != nil {
+		return fmt.Errorf("failed to write public key: %w", err)
+	}
+
+	return nil
+}
+
+// SignFile computes path's sha256 digest and signs it with the Ed25519
+// private key PEM-encoded at keyPath, writing the result to "<path>.sig".
+func SignFile(path, keyPath string) (*Signature, error) {
+	priv, err := loadPrivateKey(keyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := digestFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	sig := &Signature{
+		Algorithm: AlgorithmEd25519,
+		Digest:    hex.EncodeToString(digest),
+		PublicKey: hex.EncodeToString(priv.Public().(ed25519.PublicKey)),
+		Value:     hex.EncodeToString(ed25519.Sign(priv, digest)),
+	}
+
+	sigPath := path + ".sig"
+	data, err := json.MarshalIndent(sig, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signature: %w", err)
+	}
+	if err := os.WriteFile(sigPath, data, 0o644); err != nil {
+		return nil, fmt.Errorf("failed to write signature file: %w", err)
+	}
+
+	return sig, nil
+}
+
+// VerifyFile recomputes path's sha256 digest, confirms it matches the
+// digest embedded in the signature at "<path>.sig", and checks the
+// signature's value against its embedded public key. If pubKeyPath is
+// non-empty, the embedded public key must also match the PEM-encoded
+// public key at pubKeyPath - without that pin, a tampered file and a
+// freshly forged signature over it would otherwise verify just as cleanly
+// as a legitimate one, since nothing else ties the signature to a key the
+// caller actually trusts.
+func VerifyFile(path, pubKeyPath string) error {
+	sigPath := path + ".sig"
+	data, err := os.ReadFile(sigPath)
+	if err != nil {
+		return fmt.Errorf("failed to read signature file %s: %w", sigPath, err)
+	}
+
+	var sig Signature
+	if err := json.Unmarshal(data, &sig); err != nil {
+		return fmt.Errorf("failed to parse signature file %s: %w", sigPath, err)
+	}
+	if sig.Algorithm != AlgorithmEd25519 {
+		return fmt.Errorf("unsupported signature algorithm: %s", sig.Algorithm)
+	}
+
+	pub, err := hex.DecodeString(sig.PublicKey)
+	if err != nil {
+		return fmt.Errorf("invalid public key encoding in signature: %w", err)
+	}
+	value, err := hex.DecodeString(sig.Value)
+	if err != nil {
+		return fmt.Errorf("invalid signature value encoding: %w", err)
+	}
+	wantDigest, err := hex.DecodeString(sig.Digest)
+	if err != nil {
+		return fmt.Errorf("invalid digest encoding in signature: %w", err)
+	}
+
+	if pubKeyPath != "" {
+		pinned, err := loadPublicKey(pubKeyPath)
+		if err != nil {
+			return err
+		}
+		if !pinned.Equal(ed25519.PublicKey(pub)) {
+			return fmt.Errorf("signature's public key does not match %s", pubKeyPath)
+		}
+	}
+
+	digest, err := digestFile(path)
+	if err != nil {
+		return err
+	}
+	if hex.EncodeToString(digest) != hex.EncodeToString(wantDigest) {
+		return fmt.Errorf("%s has been modified since it was signed: digest mismatch", path)
+	}
+
+	if !ed25519.Verify(ed25519.PublicKey(pub), digest, value) {
+		return fmt.Errorf("signature does not match %s", path)
+	}
+
+	return nil
+}
+
+func digestFile(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	sum := sha256.Sum256(data)
+	return sum[:], nil
+}
+
+func loadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key %s: %w", path, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != pemPrivateKeyType {
+		return nil, fmt.Errorf("%s is not an augustus Ed25519 private key", path)
+	}
+	if len(block.Bytes) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("%s does not contain a valid Ed25519 private key", path)
+	}
+	return ed25519.PrivateKey(block.Bytes), nil
+}
+
+func loadPublicKey(path string) (ed25519.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key %s: %w", path, err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != pemPublicKeyType {
+		return nil, fmt.Errorf("%s is not an augustus Ed25519 public key", path)
+	}
+	if len(block.Bytes) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%s does not contain a valid Ed25519 public key", path)
+	}
+	return ed25519.PublicKey(block.Bytes), nil
+}
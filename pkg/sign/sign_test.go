@@ -0,0 +1,74 @@
+package sign_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/sign"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndVerifyFile_RoundTrips(t *testing.T) {
+	dir := t.TempDir()
+	privPath := filepath.Join(dir, "key.priv")
+	pubPath := filepath.Join(dir, "key.pub")
+	require.NoError(t, sign.GenerateKey(privPath, pubPath))
+
+	artifactPath := filepath.Join(dir, "results.jsonl")
+	require.NoError(t, os.WriteFile(artifactPath, []byte(`{"probe":"dan.Dan_11_0"}`+"\n"), 0o644))
+
+	sig, err := sign.SignFile(artifactPath, privPath)
+	require.NoError(t, err)
+	assert.Equal(t, sign.AlgorithmEd25519, sig.Algorithm)
+	assert.FileExists(t, artifactPath+".sig")
+
+	assert.NoError(t, sign.VerifyFile(artifactPath, ""))
+	assert.NoError(t, sign.VerifyFile(artifactPath, pubPath))
+}
+
+func TestVerifyFile_DetectsTampering(t *testing.T) {
+	dir := t.TempDir()
+	privPath := filepath.Join(dir, "key.priv")
+	pubPath := filepath.Join(dir, "key.pub")
+	require.NoError(t, sign.GenerateKey(privPath, pubPath))
+
+	artifactPath := filepath.Join(dir, "results.jsonl")
+	require.NoError(t, os.WriteFile(artifactPath, []byte(`{"probe":"dan.Dan_11_0"}`+"\n"), 0o644))
+	_, err := sign.SignFile(artifactPath, privPath)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(artifactPath, []byte(`{"probe":"dan.Dan_11_0","passed":true}`+"\n"), 0o644))
+
+	err = sign.VerifyFile(artifactPath, "")
+	assert.ErrorContains(t, err, "has been modified")
+}
+
+func TestVerifyFile_RejectsWrongPinnedKey(t *testing.T) {
+	dir := t.TempDir()
+	privPath := filepath.Join(dir, "key.priv")
+	pubPath := filepath.Join(dir, "key.pub")
+	require.NoError(t, sign.GenerateKey(privPath, pubPath))
+
+	otherPrivPath := filepath.Join(dir, "other.priv")
+	otherPubPath := filepath.Join(dir, "other.pub")
+	require.NoError(t, sign.GenerateKey(otherPrivPath, otherPubPath))
+
+	artifactPath := filepath.Join(dir, "results.jsonl")
+	require.NoError(t, os.WriteFile(artifactPath, []byte(`{"probe":"dan.Dan_11_0"}`+"\n"), 0o644))
+	_, err := sign.SignFile(artifactPath, privPath)
+	require.NoError(t, err)
+
+	err = sign.VerifyFile(artifactPath, otherPubPath)
+	assert.ErrorContains(t, err, "does not match")
+}
+
+func TestVerifyFile_MissingSignature(t *testing.T) {
+	dir := t.TempDir()
+	artifactPath := filepath.Join(dir, "results.jsonl")
+	require.NoError(t, os.WriteFile(artifactPath, []byte("{}\n"), 0o644))
+
+	err := sign.VerifyFile(artifactPath, "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package sinks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// Pipeline runs a fixed set of sinks over the same scan results.
+//
+// A sink failure does not stop the others from running; all errors are
+// collected and returned together so a broken webhook doesn't prevent the
+// JSONL file from being written.
+type Pipeline struct {
+	sinks []Sink
+}
+
+// NewPipeline creates a Pipeline that writes to all of the given sinks.
+func NewPipeline(sinks ...Sink) *Pipeline {
+	return &Pipeline{sinks: sinks}
+}
+
+// Run delivers attempts to every sink in the pipeline.
+func (p *Pipeline) Run(ctx context.Context, attempts []*attempt.Attempt) error {
+	var errs []error
+	for _, s := range p.sinks {
+		if err := s.Write(ctx, attempts); err != nil {
+			errs = append(errs, fmt.Errorf("sink %s: %w", s.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
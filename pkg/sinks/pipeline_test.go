@@ -0,0 +1,51 @@
+package sinks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+type fakeSink struct {
+	name    string
+	err     error
+	called  bool
+	written []*attempt.Attempt
+}
+
+func (f *fakeSink) Write(_ context.Context, attempts []*attempt.Attempt) error {
+	f.called = true
+	f.written = attempts
+	return f.err
+}
+
+func (f *fakeSink) Name() string { return f.name }
+
+func TestPipeline_Run_WritesToAllSinks(t *testing.T) {
+	a := attempt.New("hello")
+	s1 := &fakeSink{name: "fake.One"}
+	s2 := &fakeSink{name: "fake.Two"}
+
+	p := NewPipeline(s1, s2)
+	err := p.Run(context.Background(), []*attempt.Attempt{a})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*attempt.Attempt{a}, s1.written)
+	assert.Equal(t, []*attempt.Attempt{a}, s2.written)
+}
+
+func TestPipeline_Run_CollectsErrorsButRunsAllSinks(t *testing.T) {
+	s1 := &fakeSink{name: "fake.Failing", err: errors.New("boom")}
+	s2 := &fakeSink{name: "fake.Ok"}
+
+	p := NewPipeline(s1, s2)
+	err := p.Run(context.Background(), nil)
+
+	assert.ErrorContains(t, err, "fake.Failing")
+	assert.ErrorContains(t, err, "boom")
+	assert.True(t, s2.called, "s2 should still run despite s1 failing")
+}
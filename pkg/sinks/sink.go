@@ -0,0 +1,47 @@
+// Package sinks provides the Sink interface for delivering scan results.
+//
+// A sink receives the attempts produced by a completed scan and persists or
+// forwards them somewhere: a terminal table, a JSONL/HTML file, a webhook,
+// object storage, or a third-party platform. Unlike a harnesses.Evaluator
+// (which a harness calls exactly once per scan to decide pass/fail), sinks
+// are purely about result delivery and a scan can run any number of them
+// side by side.
+package sinks
+
+import (
+	"context"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+)
+
+// Sink delivers completed scan attempts to a destination.
+type Sink interface {
+	// Write delivers the final set of attempts for a scan.
+	Write(ctx context.Context, attempts []*attempt.Attempt) error
+	// Name returns the sink's fully qualified name (e.g., "jsonl.File").
+	Name() string
+}
+
+// Registry is the global sink registry.
+var Registry = registry.New[Sink]("sinks")
+
+// Register adds a sink factory to the global registry.
+func Register(name string, factory func(registry.Config) (Sink, error)) {
+	Registry.Register(name, factory)
+}
+
+// List returns all registered sink names.
+func List() []string {
+	return Registry.List()
+}
+
+// Get retrieves a sink factory by name.
+func Get(name string) (func(registry.Config) (Sink, error), bool) {
+	return Registry.Get(name)
+}
+
+// Create instantiates a sink by name.
+func Create(name string, cfg registry.Config) (Sink, error) {
+	return Registry.Create(name, cfg)
+}
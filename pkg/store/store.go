@@ -0,0 +1,153 @@
+// Package store provides an embedded, append-only history of scan runs so
+// trend and regression reporting can work across runs instead of a single
+// JSONL/HTML report. Each run is ingested as one JSON line appended to the
+// store file, keeping the format streamable and diff-friendly like
+// pkg/results' JSONL output, rather than pulling in an external SQL engine.
+package store
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+)
+
+// AttemptRecord is the subset of an attempt's fields relevant to trend
+// analysis. Full prompts/outputs are intentionally omitted - those already
+// live in the run's JSONL/HTML report, and keeping the store file small
+// matters since every run appends a new record.
+type AttemptRecord struct {
+	Probe     string  `json:"probe"`
+	Generator string  `json:"generator"`
+	Detector  string  `json:"detector"`
+	Passed    bool    `json:"passed"`
+	MaxScore  float64 `json:"max_score"`
+}
+
+// RunRecord captures one ingested scan run.
+type RunRecord struct {
+	RunID     string          `json:"run_id"`
+	Generator string          `json:"generator"`
+	Timestamp time.Time       `json:"timestamp"`
+	Attempts  []AttemptRecord `json:"attempts"`
+}
+
+// Store is an embedded, file-backed history of scan runs.
+//
+// Store is safe for concurrent use.
+type Store struct {
+	path string
+	mu   sync.Mutex
+	runs []RunRecord
+}
+
+// Open loads an existing store file, or creates a new empty store if path
+// does not yet exist. The file is not held open between calls - IngestRun
+// appends and closes it each time, so the store file can be inspected
+// (or copied) between runs.
+func Open(path string) (*Store, error) {
+	s := &Store{path: path}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return s, nil
+		}
+		return nil, fmt.Errorf("failed to open store file: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var run RunRecord
+		if err := json.Unmarshal(line, &run); err != nil {
+			return nil, fmt.Errorf("failed to parse store file: %w", err)
+		}
+		s.runs = append(s.runs, run)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read store file: %w", err)
+	}
+
+	return s, nil
+}
+
+// IngestRun records one scan run's attempts under runID, appending it to
+// the store file and the in-memory index. generator is the generator name
+// under test, attributed to every attempt record from this run.
+func (s *Store) IngestRun(runID, generator string, timestamp time.Time, attempts []*attempt.Attempt) error {
+	run := RunRecord{
+		RunID:     runID,
+		Generator: generator,
+		Timestamp: timestamp,
+		Attempts:  make([]AttemptRecord, 0, len(attempts)),
+	}
+	for _, a := range attempts {
+		run.Attempts = append(run.Attempts, AttemptRecord{
+			Probe:     a.Probe,
+			Generator: generator,
+			Detector:  a.Detector,
+			Passed:    passed(a),
+			MaxScore:  a.MaxScore(),
+		})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create parent directories: %w", err)
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open store file: %w", err)
+	}
+	defer file.Close()
+
+	line, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to marshal run record: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write run record: %w", err)
+	}
+
+	s.runs = append(s.runs, run)
+	return nil
+}
+
+// Runs returns a copy of every ingested run, in ingestion order.
+func (s *Store) Runs() []RunRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	runs := make([]RunRecord, len(s.runs))
+	copy(runs, s.runs)
+	return runs
+}
+
+// passed mirrors pkg/results' pass/fail semantics: error/pending attempts
+// fail, complete/timed-out attempts fail if any effective score exceeds
+// attempt.DefaultVulnerabilityThreshold.
+func passed(a *attempt.Attempt) bool {
+	if a.Status == attempt.StatusError || a.Status == attempt.StatusPending {
+		return false
+	}
+	for _, score := range a.GetEffectiveScores() {
+		if score > attempt.DefaultVulnerabilityThreshold {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,66 @@
+package store_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen_MissingFileStartsEmpty(t *testing.T) {
+	s, err := store.Open(filepath.Join(t.TempDir(), "history.jsonl"))
+	require.NoError(t, err)
+	assert.Empty(t, s.Runs())
+}
+
+func TestIngestRun_PersistsAndIndexes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s, err := store.Open(path)
+	require.NoError(t, err)
+
+	attempts := []*attempt.Attempt{
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Status: attempt.StatusComplete, Scores: []float64{0.1}},
+		{Probe: "dan.Dan_11_0", Detector: "dan.DAN", Status: attempt.StatusComplete, Scores: []float64{0.9}},
+	}
+	require.NoError(t, s.IngestRun("run-1", "openai.OpenAI", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), attempts))
+
+	runs := s.Runs()
+	require.Len(t, runs, 1)
+	assert.Equal(t, "run-1", runs[0].RunID)
+	assert.Equal(t, "openai.OpenAI", runs[0].Generator)
+	require.Len(t, runs[0].Attempts, 2)
+	assert.True(t, runs[0].Attempts[0].Passed)
+	assert.False(t, runs[0].Attempts[1].Passed)
+
+	// Reopen from disk and confirm the ingested run survives a round trip.
+	reopened, err := store.Open(path)
+	require.NoError(t, err)
+	assert.Equal(t, runs, reopened.Runs())
+}
+
+func TestIngestRun_AppendsAcrossMultipleRuns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	s, err := store.Open(path)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		attempts := []*attempt.Attempt{{Probe: "dan.Dan_11_0", Status: attempt.StatusComplete, Scores: []float64{0.0}}}
+		require.NoError(t, s.IngestRun("run", "openai.OpenAI", time.Now(), attempts))
+	}
+
+	assert.Len(t, s.Runs(), 3)
+}
+
+func TestIngestRun_ErrorStatusFails(t *testing.T) {
+	s, err := store.Open(filepath.Join(t.TempDir(), "history.jsonl"))
+	require.NoError(t, err)
+
+	attempts := []*attempt.Attempt{{Probe: "dan.Dan_11_0", Status: attempt.StatusError}}
+	require.NoError(t, s.IngestRun("run-1", "openai.OpenAI", time.Now(), attempts))
+
+	assert.False(t, s.Runs()[0].Attempts[0].Passed)
+}
@@ -0,0 +1,162 @@
+package store
+
+import "sort"
+
+// ProbeTrendPoint is one run's pass rate for a single probe.
+type ProbeTrendPoint struct {
+	RunID     string  `json:"run_id"`
+	Generator string  `json:"generator"`
+	Timestamp string  `json:"timestamp"`
+	Total     int     `json:"total"`
+	Passed    int     `json:"passed"`
+	PassRate  float64 `json:"pass_rate"`
+}
+
+// ProbeTrend returns probe's pass rate across every run that exercised it,
+// ordered oldest to newest, so callers can plot or tabulate pass rate over
+// time.
+func (s *Store) ProbeTrend(probe string) []ProbeTrendPoint {
+	var points []ProbeTrendPoint
+	for _, run := range s.Runs() {
+		total, passedCount := 0, 0
+		for _, a := range run.Attempts {
+			if a.Probe != probe {
+				continue
+			}
+			total++
+			if a.Passed {
+				passedCount++
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		points = append(points, ProbeTrendPoint{
+			RunID:     run.RunID,
+			Generator: run.Generator,
+			Timestamp: run.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+			Total:     total,
+			Passed:    passedCount,
+			PassRate:  float64(passedCount) / float64(total),
+		})
+	}
+	return points
+}
+
+// Regression flags a probe+generator pair whose pass rate dropped by at
+// least the caller's threshold between two consecutive runs.
+type Regression struct {
+	Probe        string  `json:"probe"`
+	Generator    string  `json:"generator"`
+	FromRunID    string  `json:"from_run_id"`
+	ToRunID      string  `json:"to_run_id"`
+	FromPassRate float64 `json:"from_pass_rate"`
+	ToPassRate   float64 `json:"to_pass_rate"`
+	Drop         float64 `json:"drop"`
+}
+
+// RegressionHighlights compares each (probe, generator) pair's pass rate
+// across consecutive runs and returns every transition whose pass rate
+// dropped by at least minDrop, ordered by largest drop first.
+func (s *Store) RegressionHighlights(minDrop float64) []Regression {
+	type key struct{ probe, generator string }
+	history := map[key][]ProbeTrendPoint{}
+
+	for _, run := range s.Runs() {
+		byProbe := map[string]struct{ total, passed int }{}
+		for _, a := range run.Attempts {
+			cur := byProbe[a.Probe]
+			cur.total++
+			if a.Passed {
+				cur.passed++
+			}
+			byProbe[a.Probe] = cur
+		}
+		for probe, agg := range byProbe {
+			k := key{probe: probe, generator: run.Generator}
+			history[k] = append(history[k], ProbeTrendPoint{
+				RunID:     run.RunID,
+				Generator: run.Generator,
+				Timestamp: run.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+				Total:     agg.total,
+				Passed:    agg.passed,
+				PassRate:  float64(agg.passed) / float64(agg.total),
+			})
+		}
+	}
+
+	var regressions []Regression
+	for k, points := range history {
+		for i := 1; i < len(points); i++ {
+			drop := points[i-1].PassRate - points[i].PassRate
+			if drop >= minDrop {
+				regressions = append(regressions, Regression{
+					Probe:        k.probe,
+					Generator:    k.generator,
+					FromRunID:    points[i-1].RunID,
+					ToRunID:      points[i].RunID,
+					FromPassRate: points[i-1].PassRate,
+					ToPassRate:   points[i].PassRate,
+					Drop:         drop,
+				})
+			}
+		}
+	}
+
+	sort.Slice(regressions, func(i, j int) bool {
+		return regressions[i].Drop > regressions[j].Drop
+	})
+	return regressions
+}
+
+// ModelComparisonPoint is one generator's most recent pass rate for a probe.
+type ModelComparisonPoint struct {
+	Generator string  `json:"generator"`
+	Total     int     `json:"total"`
+	Passed    int     `json:"passed"`
+	PassRate  float64 `json:"pass_rate"`
+}
+
+// ModelComparison returns, for probe, each generator's pass rate as of its
+// most recent run that exercised that probe - letting callers compare how
+// different model versions currently fare against the same probe.
+func (s *Store) ModelComparison(probe string) []ModelComparisonPoint {
+	latest := map[string]ModelComparisonPoint{}
+	latestTimestamp := map[string]string{}
+
+	for _, run := range s.Runs() {
+		total, passedCount := 0, 0
+		for _, a := range run.Attempts {
+			if a.Probe != probe {
+				continue
+			}
+			total++
+			if a.Passed {
+				passedCount++
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		ts := run.Timestamp.Format("2006-01-02T15:04:05Z07:00")
+		if prev, ok := latestTimestamp[run.Generator]; ok && prev > ts {
+			continue
+		}
+		latestTimestamp[run.Generator] = ts
+		latest[run.Generator] = ModelComparisonPoint{
+			Generator: run.Generator,
+			Total:     total,
+			Passed:    passedCount,
+			PassRate:  float64(passedCount) / float64(total),
+		}
+	}
+
+	comparisons := make([]ModelComparisonPoint, 0, len(latest))
+	for _, point := range latest {
+		comparisons = append(comparisons, point)
+	}
+	sort.Slice(comparisons, func(i, j int) bool {
+		return comparisons[i].Generator < comparisons[j].Generator
+	})
+	return comparisons
+}
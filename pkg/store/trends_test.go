@@ -0,0 +1,85 @@
+package store_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/attempt"
+	"github.com/praetorian-inc/augustus/pkg/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStoreWithRuns(t *testing.T) *store.Store {
+	t.Helper()
+	s, err := store.Open(filepath.Join(t.TempDir(), "history.jsonl"))
+	require.NoError(t, err)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// openai.OpenAI: dan.Dan_11_0 pass rate drops from 1.0 to 0.0 (regression).
+	require.NoError(t, s.IngestRun("run-1", "openai.OpenAI", base, []*attempt.Attempt{
+		{Probe: "dan.Dan_11_0", Status: attempt.StatusComplete, Scores: []float64{0.1}},
+		{Probe: "dan.Dan_11_0", Status: attempt.StatusComplete, Scores: []float64{0.2}},
+	}))
+	require.NoError(t, s.IngestRun("run-2", "openai.OpenAI", base.Add(24*time.Hour), []*attempt.Attempt{
+		{Probe: "dan.Dan_11_0", Status: attempt.StatusComplete, Scores: []float64{0.9}},
+		{Probe: "dan.Dan_11_0", Status: attempt.StatusComplete, Scores: []float64{0.9}},
+	}))
+
+	// anthropic.Anthropic: stable, fully passing.
+	require.NoError(t, s.IngestRun("run-3", "anthropic.Anthropic", base.Add(24*time.Hour), []*attempt.Attempt{
+		{Probe: "dan.Dan_11_0", Status: attempt.StatusComplete, Scores: []float64{0.0}},
+	}))
+
+	return s
+}
+
+func TestProbeTrend_OrdersOldestToNewestAndIgnoresOtherProbes(t *testing.T) {
+	s := newStoreWithRuns(t)
+
+	points := s.ProbeTrend("dan.Dan_11_0")
+
+	require.Len(t, points, 3)
+	assert.Equal(t, "run-1", points[0].RunID)
+	assert.Equal(t, 1.0, points[0].PassRate)
+	assert.Equal(t, "run-2", points[1].RunID)
+	assert.Equal(t, 0.0, points[1].PassRate)
+	assert.Equal(t, "run-3", points[2].RunID)
+}
+
+func TestProbeTrend_UnknownProbeReturnsEmpty(t *testing.T) {
+	s := newStoreWithRuns(t)
+	assert.Empty(t, s.ProbeTrend("nonexistent.Probe"))
+}
+
+func TestRegressionHighlights_DetectsPassRateDrop(t *testing.T) {
+	s := newStoreWithRuns(t)
+
+	regressions := s.RegressionHighlights(0.5)
+
+	require.Len(t, regressions, 1)
+	assert.Equal(t, "dan.Dan_11_0", regressions[0].Probe)
+	assert.Equal(t, "openai.OpenAI", regressions[0].Generator)
+	assert.Equal(t, "run-1", regressions[0].FromRunID)
+	assert.Equal(t, "run-2", regressions[0].ToRunID)
+	assert.InDelta(t, 1.0, regressions[0].Drop, 0.001)
+}
+
+func TestRegressionHighlights_ThresholdExcludesSmallDrops(t *testing.T) {
+	s := newStoreWithRuns(t)
+	assert.Empty(t, s.RegressionHighlights(1.5))
+}
+
+func TestModelComparison_ReturnsLatestPassRatePerGenerator(t *testing.T) {
+	s := newStoreWithRuns(t)
+
+	comparisons := s.ModelComparison("dan.Dan_11_0")
+
+	require.Len(t, comparisons, 2)
+	assert.Equal(t, "anthropic.Anthropic", comparisons[0].Generator)
+	assert.Equal(t, 1.0, comparisons[0].PassRate)
+	assert.Equal(t, "openai.OpenAI", comparisons[1].Generator)
+	assert.Equal(t, 0.0, comparisons[1].PassRate)
+}
@@ -0,0 +1,44 @@
+// Package tokens provides approximate token counting and truncation for
+// sizing prompts to a generator's context window.
+//
+// Augustus doesn't vendor a real tokenizer for each of its 28 providers
+// (BPE vocabularies differ per model and drift with provider updates), so
+// this package uses a single character-based heuristic good enough for
+// staying safely under a context window, not for billing-accurate counts.
+package tokens
+
+// CharsPerToken approximates the number of characters per token for
+// English-language prose, the rough average reported across GPT/Claude/Llama
+// tokenizers. Good enough to size a prompt conservatively; not exact.
+const CharsPerToken = 4
+
+// Estimate approximates the number of tokens s would consume.
+func Estimate(s string) int {
+	if s == "" {
+		return 0
+	}
+	n := len(s) / CharsPerToken
+	if n == 0 {
+		n = 1
+	}
+	return n
+}
+
+// Truncate returns the longest prefix of s (on a rune boundary) that
+// approximately fits within maxTokens, per Estimate. maxTokens <= 0 truncates
+// to empty. Truncation is a deterministic prefix cut, not content-aware.
+func Truncate(s string, maxTokens int) string {
+	if maxTokens <= 0 {
+		return ""
+	}
+	if Estimate(s) <= maxTokens {
+		return s
+	}
+
+	maxChars := maxTokens * CharsPerToken
+	runes := []rune(s)
+	if len(runes) <= maxChars {
+		return s
+	}
+	return string(runes[:maxChars])
+}
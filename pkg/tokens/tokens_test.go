@@ -0,0 +1,68 @@
+package tokens
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want int
+	}{
+		{name: "empty", s: "", want: 0},
+		{name: "short string rounds up to 1", s: "hi", want: 1},
+		{name: "eight chars is two tokens", s: "12345678", want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Estimate(tt.s); got != tt.want {
+				t.Errorf("Estimate(%q) = %d, want %d", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	long := strings.Repeat("a", 100)
+
+	t.Run("under budget is unchanged", func(t *testing.T) {
+		if got := Truncate("short", 100); got != "short" {
+			t.Errorf("Truncate() = %q, want unchanged", got)
+		}
+	})
+
+	t.Run("over budget is cut to the token budget", func(t *testing.T) {
+		got := Truncate(long, 10)
+		if len(got) != 40 {
+			t.Errorf("Truncate() len = %d, want 40", len(got))
+		}
+		if Estimate(got) > 10 {
+			t.Errorf("Truncate() result estimates to more than the requested budget")
+		}
+	})
+
+	t.Run("zero or negative budget truncates to empty", func(t *testing.T) {
+		if got := Truncate(long, 0); got != "" {
+			t.Errorf("Truncate() with 0 budget = %q, want empty", got)
+		}
+		if got := Truncate(long, -1); got != "" {
+			t.Errorf("Truncate() with negative budget = %q, want empty", got)
+		}
+	})
+
+	t.Run("does not split a multi-byte rune", func(t *testing.T) {
+		s := strings.Repeat("é", 50) // 2 bytes/rune in UTF-8
+		got := Truncate(s, 10)
+		if !strings.HasSuffix(got, "é") && got != "" {
+			t.Errorf("Truncate() produced invalid UTF-8 suffix: %q", got)
+		}
+		for _, r := range got {
+			if r != 'é' {
+				t.Errorf("Truncate() corrupted a rune: %q", got)
+			}
+		}
+	})
+}
@@ -0,0 +1,162 @@
+// Package transport builds *http.Transport values shared by augustus's
+// HTTP-based generators (rest.Rest, rest.RawHTTP, openai.OpenAI,
+// anthropic.Anthropic), so a single set of config keys can point any of
+// them at a TLS-intercepting proxy, a SOCKS5 pivot, or an internal staging
+// endpoint with a private CA.
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/praetorian-inc/augustus/pkg/har"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/proxy"
+)
+
+// HARRecorderConfigKey is the registry.Config key under which the CLI
+// injects a *har.Recorder for the --capture har:<path> flag. It is not a
+// user-facing configuration option - scan.go sets it at generator
+// construction time, after config resolution, so it can't be set (or
+// overridden) via YAML/JSON generator config.
+const HARRecorderConfigKey = "_augustus_har_recorder"
+
+// Config holds the TLS and proxy settings for an HTTP transport.
+type Config struct {
+	// ProxyURL is the forward proxy to dial through. http/https schemes use
+	// net/http's CONNECT-based proxying; socks5/socks5h schemes dial through
+	// golang.org/x/net/proxy instead.
+	ProxyURL *url.URL
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+	// CACertFile, if set, is a PEM bundle of additional trusted CAs - for
+	// talking to endpoints behind corporate TLS-intercepting proxies.
+	CACertFile string
+	// ClientCertFile and ClientKeyFile, if set, configure a client
+	// certificate for mTLS. Both must be set together or both left empty.
+	ClientCertFile string
+	ClientKeyFile  string
+	// Recorder, if set, captures every request/response made through the
+	// built transport for --capture har:<path>.
+	Recorder *har.Recorder
+	// GeneratorName identifies the calling generator (e.g. "openai.OpenAI")
+	// for log messages emitted by New, so a warning like the
+	// insecure_skip_verify one below can be traced back to the generator
+	// that configured it.
+	GeneratorName string
+}
+
+// ConfigFromMap parses the shared "proxy", "insecure_skip_verify",
+// "ca_cert_file", "client_cert_file", and "client_key_file" configuration
+// keys into a Config. "proxy" falls back to the HTTPS_PROXY/HTTP_PROXY
+// environment variables (both case variants) when unset, matching the
+// existing rest.Rest behavior.
+func ConfigFromMap(cfg registry.Config) (Config, error) {
+	var result Config
+
+	proxyStr := registry.GetString(cfg, "proxy", "")
+	if proxyStr == "" {
+		for _, envVar := range []string{"HTTPS_PROXY", "https_proxy", "HTTP_PROXY", "http_proxy"} {
+			if v := os.Getenv(envVar); v != "" {
+				proxyStr = v
+				break
+			}
+		}
+	}
+	if proxyStr != "" {
+		proxyURL, err := url.Parse(proxyStr)
+		if err != nil {
+			return Config{}, fmt.Errorf("transport: invalid proxy URL: %w", err)
+		}
+		result.ProxyURL = proxyURL
+	}
+
+	result.InsecureSkipVerify = registry.GetBool(cfg, "insecure_skip_verify", false)
+	result.CACertFile = registry.GetString(cfg, "ca_cert_file", "")
+	result.ClientCertFile = registry.GetString(cfg, "client_cert_file", "")
+	result.ClientKeyFile = registry.GetString(cfg, "client_key_file", "")
+
+	if rec, ok := cfg[HARRecorderConfigKey].(*har.Recorder); ok {
+		result.Recorder = rec
+	}
+
+	return result, nil
+}
+
+// New builds an http.RoundTripper from cfg, with connection pooling tuned
+// for concurrent scanning and HTTP/2 enabled. If cfg.Recorder is set, the
+// returned RoundTripper also records every exchange for HAR capture.
+func New(cfg Config) (http.RoundTripper, error) {
+	tlsConfig := &tls.Config{}
+
+	if cfg.CACertFile != "" {
+		pemBytes, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("transport: failed to read ca_cert_file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pemBytes) {
+			return nil, fmt.Errorf("transport: no certificates found in ca_cert_file %q", cfg.CACertFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if (cfg.ClientCertFile != "") != (cfg.ClientKeyFile != "") {
+		return nil, fmt.Errorf("transport: client_cert_file and client_key_file must both be set or both be empty")
+	}
+	if cfg.ClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("transport: failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if cfg.InsecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true
+		slog.Warn("TLS certificate verification disabled", "generator", cfg.GeneratorName, "insecure_skip_verify", true)
+	}
+
+	t := &http.Transport{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: 100,
+		MaxConnsPerHost:     100,
+		IdleConnTimeout:     90 * time.Second,
+		DisableKeepAlives:   false,
+		TLSClientConfig:     tlsConfig,
+	}
+
+	if cfg.ProxyURL != nil {
+		switch cfg.ProxyURL.Scheme {
+		case "socks5", "socks5h":
+			dialer, err := proxy.FromURL(cfg.ProxyURL, proxy.Direct)
+			if err != nil {
+				return nil, fmt.Errorf("transport: failed to configure SOCKS5 proxy: %w", err)
+			}
+			t.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return dialer.Dial(network, addr)
+			}
+		default:
+			t.Proxy = http.ProxyURL(cfg.ProxyURL)
+		}
+	}
+
+	// Enable HTTP/2 support.
+	if err := http2.ConfigureTransport(t); err != nil {
+		return nil, fmt.Errorf("transport: failed to configure HTTP/2: %w", err)
+	}
+
+	if cfg.Recorder != nil {
+		return cfg.Recorder.Wrap(t), nil
+	}
+	return t, nil
+}
@@ -0,0 +1,149 @@
+package transport_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/praetorian-inc/augustus/pkg/har"
+	"github.com/praetorian-inc/augustus/pkg/logging"
+	"github.com/praetorian-inc/augustus/pkg/registry"
+	"github.com/praetorian-inc/augustus/pkg/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromMap_Defaults(t *testing.T) {
+	cfg, err := transport.ConfigFromMap(registry.Config{})
+	require.NoError(t, err)
+
+	assert.Nil(t, cfg.ProxyURL)
+	assert.False(t, cfg.InsecureSkipVerify)
+	assert.Empty(t, cfg.CACertFile)
+}
+
+func TestConfigFromMap_ParsesProxyAndFlags(t *testing.T) {
+	cfg, err := transport.ConfigFromMap(registry.Config{
+		"proxy":                "http://127.0.0.1:8080",
+		"insecure_skip_verify": true,
+		"ca_cert_file":         "/tmp/ca.pem",
+		"client_cert_file":     "/tmp/client.pem",
+		"client_key_file":      "/tmp/client.key",
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg.ProxyURL)
+	assert.Equal(t, "http://127.0.0.1:8080", cfg.ProxyURL.String())
+	assert.True(t, cfg.InsecureSkipVerify)
+	assert.Equal(t, "/tmp/ca.pem", cfg.CACertFile)
+	assert.Equal(t, "/tmp/client.pem", cfg.ClientCertFile)
+	assert.Equal(t, "/tmp/client.key", cfg.ClientKeyFile)
+}
+
+func TestConfigFromMap_InvalidProxyURL(t *testing.T) {
+	_, err := transport.ConfigFromMap(registry.Config{
+		"proxy": "://not-a-url",
+	})
+	require.Error(t, err)
+}
+
+func TestConfigFromMap_FallsBackToEnvProxy(t *testing.T) {
+	t.Setenv("HTTPS_PROXY", "http://env-proxy.example:3128")
+
+	cfg, err := transport.ConfigFromMap(registry.Config{})
+	require.NoError(t, err)
+
+	require.NotNil(t, cfg.ProxyURL)
+	assert.Equal(t, "http://env-proxy.example:3128", cfg.ProxyURL.String())
+}
+
+func TestNew_DefaultsToSecureTransport(t *testing.T) {
+	rt, err := transport.New(transport.Config{})
+	require.NoError(t, err)
+	require.NotNil(t, rt)
+
+	tr, ok := rt.(*http.Transport)
+	require.True(t, ok)
+	assert.False(t, tr.TLSClientConfig.InsecureSkipVerify)
+}
+
+func TestNew_InsecureSkipVerify(t *testing.T) {
+	rt, err := transport.New(transport.Config{InsecureSkipVerify: true})
+	require.NoError(t, err)
+
+	tr, ok := rt.(*http.Transport)
+	require.True(t, ok)
+	assert.True(t, tr.TLSClientConfig.InsecureSkipVerify)
+}
+
+func TestNew_InsecureSkipVerifyWarnsWithGeneratorName(t *testing.T) {
+	prev := slog.Default()
+	defer slog.SetDefault(prev)
+
+	var logBuf bytes.Buffer
+	logging.Configure(slog.LevelWarn, "text", &logBuf)
+
+	_, err := transport.New(transport.Config{InsecureSkipVerify: true, GeneratorName: "rest.Rest"})
+	require.NoError(t, err)
+
+	assert.Contains(t, logBuf.String(), "TLS certificate verification disabled")
+	assert.Contains(t, logBuf.String(), "generator=rest.Rest")
+	assert.Contains(t, logBuf.String(), "insecure_skip_verify=true")
+}
+
+func TestNew_HTTPProxy(t *testing.T) {
+	proxyURL, err := url.Parse("http://127.0.0.1:8080")
+	require.NoError(t, err)
+
+	rt, err := transport.New(transport.Config{ProxyURL: proxyURL})
+	require.NoError(t, err)
+
+	tr, ok := rt.(*http.Transport)
+	require.True(t, ok)
+	require.NotNil(t, tr.Proxy)
+}
+
+func TestNew_SOCKS5Proxy(t *testing.T) {
+	proxyURL, err := url.Parse("socks5://127.0.0.1:1080")
+	require.NoError(t, err)
+
+	rt, err := transport.New(transport.Config{ProxyURL: proxyURL})
+	require.NoError(t, err)
+
+	tr, ok := rt.(*http.Transport)
+	require.True(t, ok)
+	require.NotNil(t, tr.DialContext)
+}
+
+func TestNew_WithRecorderWrapsTransport(t *testing.T) {
+	rec := har.NewRecorder()
+
+	rt, err := transport.New(transport.Config{Recorder: rec})
+	require.NoError(t, err)
+
+	_, isPlainTransport := rt.(*http.Transport)
+	assert.False(t, isPlainTransport, "expected the recorder to wrap the transport")
+}
+
+func TestConfigFromMap_ParsesHARRecorder(t *testing.T) {
+	rec := har.NewRecorder()
+
+	cfg, err := transport.ConfigFromMap(registry.Config{
+		transport.HARRecorderConfigKey: rec,
+	})
+	require.NoError(t, err)
+
+	assert.Same(t, rec, cfg.Recorder)
+}
+
+func TestNew_InvalidCACertFile(t *testing.T) {
+	_, err := transport.New(transport.Config{CACertFile: "/nonexistent/ca.pem"})
+	require.Error(t, err)
+}
+
+func TestNew_MismatchedClientCertAndKey(t *testing.T) {
+	_, err := transport.New(transport.Config{ClientCertFile: "/tmp/client.pem"})
+	require.Error(t, err)
+}
@@ -19,3 +19,57 @@ type Detector interface {
 	// Description returns a human-readable description.
 	Description() string
 }
+
+// BatchDetector is an optional interface a Detector can implement to score
+// many attempts in as few calls as its batching strategy allows, instead of
+// one call per attempt. Judge-type detectors that call an LLM per output are
+// the primary use case: packing several candidate outputs into one rubric
+// prompt cuts per-output judge calls, which dominate scan cost for large
+// runs.
+//
+// Harnesses use BatchDetect when a detector implements it; detectors that
+// don't implement it are scored one attempt at a time via Detect, with
+// identical results either way.
+type BatchDetector interface {
+	// BatchDetect scores multiple attempts and returns one []float64 per
+	// attempt, in the same order as attempts and with the same per-output
+	// semantics as Detect.
+	BatchDetect(ctx context.Context, attempts []*attempt.Attempt) ([][]float64, error)
+}
+
+// Calibratable is an optional interface a Detector can implement to run a
+// self-check before a scan: scoring a small bundled set of known-harmful
+// and known-benign cases and reporting how well it separated them.
+// Judge-type detectors, whose scoring depends on an external LLM that may
+// be misconfigured or simply unreliable at its configured confidence
+// cutoff, are the primary use case.
+type Calibratable interface {
+	// Calibrate scores the detector's bundled calibration set and returns
+	// the observed result, so a caller can warn before trusting the
+	// detector's output for a real scan.
+	Calibrate(ctx context.Context) (CalibrationResult, error)
+}
+
+// CalibrationResult summarizes how well a Calibratable detector separated
+// its bundled calibration set's known-harmful cases from its known-benign
+// ones.
+type CalibrationResult struct {
+	Total          int
+	Correct        int
+	FalsePositives int // benign case the detector scored as vulnerable
+	FalseNegatives int // harmful case the detector scored as safe
+}
+
+// Accuracy returns the fraction of calibration cases the detector scored
+// correctly, or 0 if no cases were run.
+func (r CalibrationResult) Accuracy() float64 {
+	if r.Total == 0 {
+		return 0
+	}
+	return float64(r.Correct) / float64(r.Total)
+}
+
+// MinAcceptableCalibrationAccuracy is the observed-accuracy floor below
+// which a caller of Calibratable.Calibrate should warn that the detector
+// may not be reliable for a real scan.
+const MinAcceptableCalibrationAccuracy = 0.7
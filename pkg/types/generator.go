@@ -24,3 +24,31 @@ type Generator interface {
 	// Description returns a human-readable description.
 	Description() string
 }
+
+// GeneratorCapabilities is an optional interface for generators that support
+// more than Augustus's baseline single-turn, text-only, no-tools completion
+// call. A generator that doesn't implement this interface is assumed to
+// support every capability a probe might require, so ProbeRequirements
+// checking only takes effect once a generator opts in to advertise
+// restrictions - existing generators don't need updating for requirement
+// checking to be usable.
+type GeneratorCapabilities interface {
+	// Capabilities returns the capability tags (see the Capability*
+	// constants) this generator supports, matched against a probe's
+	// ProbeRequirements.Requires().
+	Capabilities() []string
+}
+
+// GeneratorContextWindow is an optional interface for generators that know
+// the target model's maximum context length. Unlike the boolean tags in
+// GeneratorCapabilities, context length is a size a caller needs to fit a
+// prompt within (e.g. a many-shot or document-injection probe sizing its
+// payload), not a yes/no requirement check, so it gets its own interface
+// rather than an entry in Capabilities(). A generator that doesn't implement
+// this interface has an unknown context window; callers should not assume a
+// default.
+type GeneratorContextWindow interface {
+	// MaxContextTokens returns the target model's maximum context length, in
+	// (approximate) tokens, including both prompt and completion.
+	MaxContextTokens() int
+}
@@ -30,3 +30,38 @@ type ProbeMetadata interface {
 	// GetPrompts returns the attack prompts used by this probe.
 	GetPrompts() []string
 }
+
+// Capability tags exchanged between ProbeRequirements and
+// GeneratorCapabilities. Kept as named constants so both sides spell them
+// the same way.
+const (
+	CapabilityMultiTurn    = "multi-turn"
+	CapabilityVision       = "vision"
+	CapabilityTools        = "tools"
+	CapabilitySystemPrompt = "system-prompt"
+)
+
+// EstimatedAttemptCounter is an optional interface for probes whose attempt
+// count can't be read off GetPrompts() - iterative/search probes (PAIR, TAP,
+// AutoDAN, tree search) build their prompts dynamically during Probe()
+// instead of listing them upfront, so GetPrompts() returns nothing or a
+// placeholder. Implement it to report a real upper bound on generator calls
+// so callers budgeting total attempts (e.g. Scanner's MaxTotalAttempts) see
+// an honest cost instead of undercounting to whatever GetPrompts() returns.
+type EstimatedAttemptCounter interface {
+	// EstimatedAttempts returns an upper bound on the number of generator
+	// calls this probe's Probe() can make.
+	EstimatedAttempts() int
+}
+
+// ProbeRequirements is an optional interface for probes that only produce
+// meaningful attempts against generators with specific capabilities (e.g. a
+// probe that attaches images needs a vision-capable generator). Implement it
+// so callers can skip-and-report an incompatible probe/generator pairing up
+// front instead of the probe failing confusingly mid-scan. Clients check via
+// type assertion, as with ProbeMetadata.
+type ProbeRequirements interface {
+	// Requires returns the capability tags (see the Capability* constants)
+	// this probe needs from its generator.
+	Requires() []string
+}